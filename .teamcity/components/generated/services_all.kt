@@ -125,6 +125,7 @@ val services = mapOf(
     "iot" to ServiceSpec("IoT Core"),
     "iotanalytics" to ServiceSpec("IoT Analytics"),
     "iotevents" to ServiceSpec("IoT Events"),
+    "iotsitewise" to ServiceSpec("IoT SiteWise"),
     "ipam" to ServiceSpec("VPC IPAM (IP Address Manager)", vpcLock = true, patternOverride = "TestAccIPAM", splitPackageRealPackage = "ec2"),
     "ivs" to ServiceSpec("IVS (Interactive Video)"),
     "ivschat" to ServiceSpec("IVS (Interactive Video) Chat"),
@@ -816,6 +816,19 @@ func PrimaryInstanceState(s *terraform.State, name string) (*terraform.InstanceS
 	return is, nil
 }
 
+// AttrImportStateIdFunc returns a resource.ImportStateIdFunc which uses the value of the specified attribute as the import ID,
+// e.g. to verify that a resource can be imported using its ARN.
+func AttrImportStateIdFunc(resourceName, attrName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		is, err := PrimaryInstanceState(s, resourceName)
+		if err != nil {
+			return "", err
+		}
+
+		return is.Attributes[attrName], nil
+	}
+}
+
 // AccountID returns the account ID of Provider
 // Must be used within a resource.TestCheckFunc
 func AccountID() string {
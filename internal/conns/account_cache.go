@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// accountDetails is shared by all provider configurations in the provider process.
+// Provider configurations (e.g. aliases for different Regions) that resolve to the same credentials in the same partition
+// make a single round trip to STS (or IAM) to validate the credentials and to determine the account ID and partition.
+var accountDetails accountDetailsCache
+
+type accountDetailsCacheKey struct {
+	accessKeyID             string
+	partition               string
+	skipCredsValidation     bool
+	skipRequestingAccountID bool
+}
+
+type accountDetailsCacheEntry struct {
+	lock      sync.Mutex
+	accountID string
+	partition string
+	warnings  basediag.Diagnostics
+	ok        bool
+}
+
+type accountDetailsCache struct {
+	lock    sync.Mutex
+	entries map[accountDetailsCacheKey]*accountDetailsCacheEntry
+}
+
+func (c *accountDetailsCache) entry(key accountDetailsCacheKey) *accountDetailsCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[accountDetailsCacheKey]*accountDetailsCacheEntry)
+	}
+
+	e, ok := c.entries[key]
+	if !ok {
+		e = &accountDetailsCacheEntry{}
+		c.entries[key] = e
+	}
+
+	return e
+}
+
+// get returns the account ID and partition for the specified key, calling f if they haven't yet been successfully retrieved.
+// Concurrent calls for the same key are serialized so that f is called at most once on success.
+// Any warnings from the successful call are returned again on each subsequent call.
+func (c *accountDetailsCache) get(key accountDetailsCacheKey, f func() (string, string, basediag.Diagnostics)) (string, string, basediag.Diagnostics) {
+	e := c.entry(key)
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.ok {
+		return e.accountID, e.partition, e.warnings
+	}
+
+	accountID, partition, diags := f()
+
+	if !diags.HasError() {
+		e.accountID, e.partition, e.warnings, e.ok = accountID, partition, diags.Warnings(), true
+	}
+
+	return accountID, partition, diags
+}
+
+// getAccountIDAndPartition returns the AWS account ID and partition for the specified configuration.
+// Results are shared across provider configurations using the same resolved credentials.
+// If the credentials can't be resolved the lookup isn't cached.
+func getAccountIDAndPartition(ctx context.Context, cfg aws_sdkv2.Config, awsbaseConfig *awsbase.Config) (string, string, basediag.Diagnostics) {
+	f := func() (string, string, basediag.Diagnostics) {
+		return awsbase.GetAwsAccountIDAndPartition(ctx, cfg, awsbaseConfig)
+	}
+
+	// No network calls are made.
+	if awsbaseConfig.SkipCredsValidation && awsbaseConfig.SkipRequestingAccountId {
+		return f()
+	}
+
+	if cfg.Credentials == nil {
+		return f()
+	}
+
+	credentials, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil || credentials.AccessKeyID == "" {
+		return f()
+	}
+
+	key := accountDetailsCacheKey{
+		accessKeyID:             credentials.AccessKeyID,
+		partition:               names.PartitionForRegion(cfg.Region),
+		skipCredsValidation:     awsbaseConfig.SkipCredsValidation,
+		skipRequestingAccountID: awsbaseConfig.SkipRequestingAccountId,
+	}
+
+	return accountDetails.get(key, f)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
+)
+
+func TestAccountDetailsCache(t *testing.T) {
+	t.Parallel()
+
+	var cache accountDetailsCache
+
+	var calls atomic.Int32
+	f := func() (string, string, basediag.Diagnostics) {
+		calls.Add(1)
+		return "123456789012", "aws", nil
+	}
+
+	key1 := accountDetailsCacheKey{accessKeyID: "AKIA1", partition: "aws"}
+	key2 := accountDetailsCacheKey{accessKeyID: "AKIA2", partition: "aws"}
+
+	const n = 10
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for range n {
+		go func() {
+			defer wg.Done()
+
+			accountID, partition, diags := cache.get(key1, f)
+
+			if diags.HasError() {
+				t.Errorf("unexpected error: %v", diags)
+			}
+			if accountID != "123456789012" || partition != "aws" {
+				t.Errorf("accountID = %q, partition = %q", accountID, partition)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	if _, _, diags := cache.get(key2, f); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestAccountDetailsCacheErrorsNotCached(t *testing.T) {
+	t.Parallel()
+
+	var cache accountDetailsCache
+	key := accountDetailsCacheKey{accessKeyID: "AKIA1", partition: "aws"}
+
+	var calls int
+	f := func() (string, string, basediag.Diagnostics) {
+		calls++
+		if calls == 1 {
+			var diags basediag.Diagnostics
+			return "", "", diags.AddSimpleError(errors.New("test error"))
+		}
+		return "123456789012", "aws", nil
+	}
+
+	if _, _, diags := cache.get(key, f); !diags.HasError() {
+		t.Fatal("expected error")
+	}
+
+	accountID, _, diags := cache.get(key, f)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got, want := accountID, "123456789012"; got != want {
+		t.Errorf("accountID = %q, want %q", got, want)
+	}
+}
+
+func TestAccountDetailsCacheWarningsReplayed(t *testing.T) {
+	t.Parallel()
+
+	var cache accountDetailsCache
+	key := accountDetailsCacheKey{accessKeyID: "AKIA1", partition: "aws"}
+
+	var calls int
+	f := func() (string, string, basediag.Diagnostics) {
+		calls++
+		var diags basediag.Diagnostics
+		return "123456789012", "aws", diags.AddWarning("test warning", "detail")
+	}
+
+	for range 2 {
+		_, _, diags := cache.get(key, f)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if got, want := diags.WarningsCount(), 1; got != want {
+			t.Errorf("warnings = %d, want %d", got, want)
+		}
+	}
+
+	if got, want := calls, 1; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
@@ -37,19 +37,26 @@ type AWSClient struct {
 	Region            string
 	ServicePackages   map[string]ServicePackage
 
-	awsConfig                 *aws_sdkv2.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	dnsSuffix                 string
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	awsConfig                      *aws_sdkv2.Config
+	clients                        apiClientCache
+	conns                          apiClientCache
+	describeCache                  *describeCache
+	dnsSuffix                      string
+	ec2DryRunOnPlan                bool              // From provider configuration.
+	endpoints                      map[string]string // From provider configuration.
+	httpClient                     *http.Client
+	lock                           sync.Mutex
+	logger                         baselogging.Logger
+	regionalClients                map[string]*AWSClient
+	session                        *session_sdkv1.Session
+	s3ExpressClient                *s3_sdkv2.Client
+	s3UsePathStyle                 bool                              // From provider configuration.
+	s3USEast1RegionalEndpoint      string                            // From provider configuration.
+	serviceEndpointOptions         map[string]ServiceEndpointOptions // From provider configuration.
+	serviceRetries                 map[string]ServiceRetryConfig     // From provider configuration.
+	stsRegion                      string                            // From provider configuration.
+	timeoutsScaleFactor            float64                           // From provider configuration.
+	tokenBucketRateLimiterCapacity int                               // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -64,32 +71,50 @@ func (c *AWSClient) AwsConfig(context.Context) aws_sdkv2.Config { // nosemgrep:c
 	return c.awsConfig.Copy()
 }
 
+// ResourceDefaultTagsConfig returns the default tags configuration applicable to the resource or data source
+// whose tagging information is in Context, honoring any provider-level default tags exclusions.
+func (c *AWSClient) ResourceDefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.DefaultConfig
+	}
+	return c.DefaultTagsConfig
+}
+
+// ResourceIgnoreTagsConfig returns the ignore tags configuration applicable to the resource or data source
+// whose tagging information is in Context, including any resource-level ignore_tags.
+func (c *AWSClient) ResourceIgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.IgnoreConfig
+	}
+	return c.IgnoreTagsConfig
+}
+
 // DSConnForRegion returns an AWS SDK For Go v1 DS API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
+// If the specified region is in a different partition a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) DSConnForRegion(ctx context.Context, region string) *directoryservice_sdkv1.DirectoryService {
-	if region == c.Region {
-		return c.DSConn(ctx)
+	if rc, err := c.RegionalClient(ctx, region); err == nil {
+		return rc.DSConn(ctx)
 	}
 	return directoryservice_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // EFSConnForRegion returns an AWS SDK For Go v1 EFS API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
+// If the specified region is in a different partition a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) EFSConnForRegion(ctx context.Context, region string) *efs_sdkv1.EFS {
-	if region == c.Region {
-		return c.EFSConn(ctx)
+	if rc, err := c.RegionalClient(ctx, region); err == nil {
+		return rc.EFSConn(ctx)
 	}
 	return efs_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
 // OpsWorksConnForRegion returns an AWS SDK For Go v1 OpsWorks API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
+// If the specified region is in a different partition a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) OpsWorksConnForRegion(ctx context.Context, region string) *opsworks_sdkv1.OpsWorks {
-	if region == c.Region {
-		return c.OpsWorksConn(ctx)
+	if rc, err := c.RegionalClient(ctx, region); err == nil {
+		return rc.OpsWorksConn(ctx)
 	}
 	return opsworks_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
@@ -109,15 +134,75 @@ func (c *AWSClient) RegionalHostname(ctx context.Context, prefix string) string
 }
 
 // RDSConnForRegion returns an AWS SDK For Go v1 RDS API client for the specified AWS Region.
-// If the specified region is not the default a new "simple" client is created.
+// If the specified region is in a different partition a new "simple" client is created.
 // This new client does not use any configured endpoint override.
 func (c *AWSClient) RDSConnForRegion(ctx context.Context, region string) *rds_sdkv1.RDS {
-	if region == c.Region {
-		return c.RDSConn(ctx)
+	if rc, err := c.RegionalClient(ctx, region); err == nil {
+		return rc.RDSConn(ctx)
 	}
 	return rds_sdkv1.New(c.session, aws_sdkv1.NewConfig().WithRegion(region))
 }
 
+// RegionalClient returns an AWSClient whose API clients call the specified AWS Region.
+// The returned client shares the provider's credentials, endpoint overrides, FIPS and dual-stack
+// settings, retry configuration and tagging configuration, and is cached for the lifetime of the provider.
+// An empty region or the provider's configured region returns the receiver.
+// The region must be in the same partition as the provider's configured region.
+func (c *AWSClient) RegionalClient(_ context.Context, region string) (*AWSClient, error) {
+	if region == "" || region == c.Region {
+		return c, nil
+	}
+
+	if partition := names.PartitionForRegion(region); partition != c.Partition {
+		return nil, fmt.Errorf("AWS Region (%s) is not in the provider's partition (%s)", region, c.Partition)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if rc, ok := c.regionalClients[region]; ok {
+		return rc, nil
+	}
+
+	rc := &AWSClient{
+		AccountID:                      c.AccountID,
+		DefaultTagsConfig:              c.DefaultTagsConfig,
+		IgnoreTagsConfig:               c.IgnoreTagsConfig,
+		Partition:                      c.Partition,
+		Region:                         region,
+		ServicePackages:                c.ServicePackages,
+		dnsSuffix:                      c.dnsSuffix,
+		ec2DryRunOnPlan:                c.ec2DryRunOnPlan,
+		endpoints:                      c.endpoints,
+		httpClient:                     c.httpClient,
+		logger:                         c.logger,
+		s3UsePathStyle:                 c.s3UsePathStyle,
+		s3USEast1RegionalEndpoint:      c.s3USEast1RegionalEndpoint,
+		serviceEndpointOptions:         c.serviceEndpointOptions,
+		serviceRetries:                 c.serviceRetries,
+		stsRegion:                      c.stsRegion,
+		timeoutsScaleFactor:            c.timeoutsScaleFactor,
+		tokenBucketRateLimiterCapacity: c.tokenBucketRateLimiterCapacity,
+	}
+
+	if c.awsConfig != nil {
+		cfg := c.awsConfig.Copy()
+		cfg.Region = region
+		rc.awsConfig = &cfg
+	}
+
+	if c.session != nil {
+		rc.session = c.session.Copy(aws_sdkv1.NewConfig().WithRegion(region))
+	}
+
+	if c.regionalClients == nil {
+		c.regionalClients = make(map[string]*AWSClient)
+	}
+	c.regionalClients[region] = rc
+
+	return rc, nil
+}
+
 // S3ExpressClient returns an AWS SDK for Go v2 S3 API client suitable for use with S3 Express (directory buckets).
 // This client differs from the standard S3 API client only in us-east-1 if the global S3 endpoint is used.
 // In that case the returned client uses the regional S3 endpoint.
@@ -145,6 +230,25 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// EC2DryRunOnPlan returns the ec2_dry_run_on_plan provider configuration value.
+func (c *AWSClient) EC2DryRunOnPlan(context.Context) bool {
+	return c.ec2DryRunOnPlan
+}
+
+// TimeoutsScaleFactor returns the factor by which the default operation timeouts of the specified service's resources are multiplied.
+// Any service_retries timeouts_scale_factor overrides the timeouts_scale_factor provider configuration value.
+func (c *AWSClient) TimeoutsScaleFactor(_ context.Context, servicePackageName string) float64 {
+	if v, ok := c.serviceRetries[servicePackageName]; ok && v.TimeoutsScaleFactor > 0 {
+		return v.TimeoutsScaleFactor
+	}
+
+	if c.timeoutsScaleFactor > 0 {
+		return c.timeoutsScaleFactor
+	}
+
+	return 1
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -285,6 +389,20 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		m["sts_region"] = c.stsRegion
 	}
 
+	if v, ok := c.serviceRetries[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = v.sdkv2Config(c.awsConfig, c.tokenBucketRateLimiterCapacity)
+		if c.session != nil {
+			m["session"] = v.sdkv1Session(c.session)
+		}
+	}
+
+	if v, ok := c.serviceEndpointOptions[servicePackageName]; ok {
+		m["aws_sdkv2_config"] = v.sdkv2Config(m["aws_sdkv2_config"].(*aws_sdkv2.Config))
+		if sess := m["session"].(*session_sdkv1.Session); sess != nil {
+			m["session"] = v.sdkv1Session(sess)
+		}
+	}
+
 	return m
 }
 
@@ -342,18 +460,21 @@ func resolveServiceBaseEndpoint(ctx context.Context, sdkID string, configs []any
 }
 
 // conn returns the AWS SDK for Go v1 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached and constructed on first use.
+// Construction of different services' default clients proceeds concurrently.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	var e *apiClientCacheEntry
 	// Default service client is cached.
 	if isDefault {
-		c.lock.Lock()
-		defer c.lock.Unlock() // Runs at function exit, NOT block.
+		e = c.conns.entry(servicePackageName)
+		e.lock.Lock()
+		defer e.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.conns[servicePackageName]; ok {
+		if raw := e.value; raw != nil {
 			if conn, ok := raw.(T); ok {
 				return conn, nil
 			} else {
@@ -397,25 +518,28 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string, e
 
 	// Default service client is cached.
 	if isDefault {
-		c.conns[servicePackageName] = conn
+		e.value = conn
 	}
 
 	return conn, nil
 }
 
 // client returns the AWS SDK for Go v2 API client for the specified service.
-// The default service client (`extra` is empty) is cached. In this case the AWSClient lock is held.
+// The default service client (`extra` is empty) is cached and constructed on first use.
+// Construction of different services' default clients proceeds concurrently.
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
 
 	isDefault := len(extra) == 0
+	var e *apiClientCacheEntry
 	// Default service client is cached.
 	if isDefault {
-		c.lock.Lock()
-		defer c.lock.Unlock() // Runs at function exit, NOT block.
+		e = c.clients.entry(servicePackageName)
+		e.lock.Lock()
+		defer e.lock.Unlock() // Runs at function exit, NOT block.
 
-		if raw, ok := c.clients[servicePackageName]; ok {
+		if raw := e.value; raw != nil {
 			if client, ok := raw.(T); ok {
 				return client, nil
 			} else {
@@ -450,8 +574,37 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		c.clients[servicePackageName] = client
+		e.value = client
 	}
 
 	return client, nil
 }
+
+// apiClientCache caches the default AWS API client for each service package.
+// Each service package's entry has its own lock so that constructing one service's client doesn't block others.
+type apiClientCache struct {
+	lock    sync.Mutex
+	entries map[string]*apiClientCacheEntry
+}
+
+type apiClientCacheEntry struct {
+	lock  sync.Mutex
+	value any
+}
+
+func (c *apiClientCache) entry(servicePackageName string) *apiClientCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]*apiClientCacheEntry)
+	}
+
+	e, ok := c.entries[servicePackageName]
+	if !ok {
+		e = &apiClientCacheEntry{}
+		c.entries[servicePackageName] = e
+	}
+
+	return e
+}
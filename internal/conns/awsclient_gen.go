@@ -222,6 +222,7 @@ import (
 	iot_sdkv1 "github.com/aws/aws-sdk-go/service/iot"
 	iotanalytics_sdkv1 "github.com/aws/aws-sdk-go/service/iotanalytics"
 	iotevents_sdkv1 "github.com/aws/aws-sdk-go/service/iotevents"
+	iotsitewise_sdkv1 "github.com/aws/aws-sdk-go/service/iotsitewise"
 	ivs_sdkv1 "github.com/aws/aws-sdk-go/service/ivs"
 	kafkaconnect_sdkv1 "github.com/aws/aws-sdk-go/service/kafkaconnect"
 	kinesisanalytics_sdkv1 "github.com/aws/aws-sdk-go/service/kinesisanalytics"
@@ -786,6 +787,10 @@ func (c *AWSClient) IoTEventsConn(ctx context.Context) *iotevents_sdkv1.IoTEvent
 	return errs.Must(conn[*iotevents_sdkv1.IoTEvents](ctx, c, names.IoTEvents, make(map[string]any)))
 }
 
+func (c *AWSClient) IoTSiteWiseConn(ctx context.Context) *iotsitewise_sdkv1.IoTSiteWise {
+	return errs.Must(conn[*iotsitewise_sdkv1.IoTSiteWise](ctx, c, names.IoTSiteWise, make(map[string]any)))
+}
+
 func (c *AWSClient) KMSClient(ctx context.Context) *kms_sdkv2.Client {
 	return errs.Must(client[*kms_sdkv2.Client](ctx, c, names.KMS, make(map[string]any)))
 }
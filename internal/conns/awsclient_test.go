@@ -6,6 +6,9 @@ package conns
 import (
 	"context"
 	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +184,213 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAPIClientCacheEntry(t *testing.T) {
+	t.Parallel()
+
+	var cache apiClientCache
+
+	e1 := cache.entry("s3")
+	e2 := cache.entry("ec2")
+
+	if e1 == e2 {
+		t.Error("expected different entries for different service packages")
+	}
+
+	if cache.entry("s3") != e1 {
+		t.Error("expected same entry for same service package")
+	}
+}
+
+func TestAWSClientRegionalClient(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	c := &AWSClient{
+		AccountID: "123456789012",
+		Partition: names.StandardPartitionID,
+		Region:    names.USWest2RegionID,
+		awsConfig: &aws_sdkv2.Config{
+			Region: names.USWest2RegionID,
+		},
+		dnsSuffix: "amazonaws.com",
+		endpoints: map[string]string{
+			names.KMS: "http://localhost:4566",
+		},
+	}
+
+	for _, region := range []string{"", names.USWest2RegionID} {
+		rc, err := c.RegionalClient(ctx, region)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if rc != c {
+			t.Errorf("RegionalClient(%q) did not return the provider's client", region)
+		}
+	}
+
+	rc, err := c.RegionalClient(ctx, names.EUWest1RegionID)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := rc.Region, names.EUWest1RegionID; got != want {
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+
+	if got, want := rc.awsConfig.Region, names.EUWest1RegionID; got != want {
+		t.Errorf("AWS SDK v2 config Region = %q, want %q", got, want)
+	}
+
+	if got, want := c.awsConfig.Region, names.USWest2RegionID; got != want {
+		t.Errorf("provider AWS SDK v2 config Region = %q, want %q", got, want)
+	}
+
+	if got, want := rc.AccountID, c.AccountID; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+
+	if got, want := rc.RegionalHostname(ctx, "test"), "test.eu-west-1.amazonaws.com"; got != want {
+		t.Errorf("RegionalHostname = %q, want %q", got, want)
+	}
+
+	if got, want := rc.resolveEndpoint(ctx, names.KMS), "http://localhost:4566"; got != want {
+		t.Errorf("endpoint = %q, want %q", got, want)
+	}
+
+	if again, err := c.RegionalClient(ctx, names.EUWest1RegionID); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	} else if again != rc {
+		t.Error("RegionalClient did not return the cached client")
+	}
+
+	if _, err := c.RegionalClient(ctx, names.CNNorth1RegionID); err == nil {
+		t.Error("expected error for Region in another partition")
+	}
+}
+
+func TestAWSClientTimeoutsScaleFactor(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	testCases := []struct {
+		Name               string
+		AWSClient          *AWSClient
+		ServicePackageName string
+		Expected           float64
+	}{
+		{
+			Name:               "not configured",
+			AWSClient:          &AWSClient{},
+			ServicePackageName: names.RDS,
+			Expected:           1,
+		},
+		{
+			Name: "provider",
+			AWSClient: &AWSClient{
+				timeoutsScaleFactor: 2,
+			},
+			ServicePackageName: names.RDS,
+			Expected:           2,
+		},
+		{
+			Name: "service override",
+			AWSClient: &AWSClient{
+				serviceRetries: map[string]ServiceRetryConfig{
+					names.RDS: {TimeoutsScaleFactor: 3},
+				},
+				timeoutsScaleFactor: 2,
+			},
+			ServicePackageName: names.RDS,
+			Expected:           3,
+		},
+		{
+			Name: "other service override",
+			AWSClient: &AWSClient{
+				serviceRetries: map[string]ServiceRetryConfig{
+					names.EC2: {TimeoutsScaleFactor: 3},
+				},
+				timeoutsScaleFactor: 2,
+			},
+			ServicePackageName: names.RDS,
+			Expected:           2,
+		},
+		{
+			Name: "service retries without override",
+			AWSClient: &AWSClient{
+				serviceRetries: map[string]ServiceRetryConfig{
+					names.RDS: {MaxAttempts: 10},
+				},
+			},
+			ServicePackageName: names.RDS,
+			Expected:           1,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.AWSClient.TimeoutsScaleFactor(ctx, testCase.ServicePackageName), testCase.Expected; got != want {
+				t.Errorf("TimeoutsScaleFactor = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestAWSClientServiceEndpointOptions(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := context.TODO()
+	c := &AWSClient{
+		awsConfig: &aws_sdkv2.Config{
+			Region:        names.USGovWest1RegionID,
+			ConfigSources: []interface{}{serviceEndpointOptionsSource{UseFIPSEndpoint: aws_sdkv2.Bool(true)}},
+		},
+		serviceEndpointOptions: map[string]ServiceEndpointOptions{
+			names.SNS: {UseFIPSEndpoint: aws_sdkv2.Bool(false)},
+		},
+	}
+
+	testCases := []struct {
+		ServicePackageName string
+		Expected           aws_sdkv2.FIPSEndpointState
+	}{
+		{
+			ServicePackageName: names.SNS,
+			Expected:           aws_sdkv2.FIPSEndpointStateDisabled,
+		},
+		{
+			ServicePackageName: names.SQS,
+			Expected:           aws_sdkv2.FIPSEndpointStateEnabled,
+		},
+	}
+
+	for _, testCase := range testCases {
+		cfg := c.apiClientConfig(ctx, testCase.ServicePackageName)["aws_sdkv2_config"].(*aws_sdkv2.Config)
+
+		var got aws_sdkv2.FIPSEndpointState
+		for _, v := range cfg.ConfigSources {
+			if v, ok := v.(interface {
+				GetUseFIPSEndpoint(context.Context) (aws_sdkv2.FIPSEndpointState, bool, error)
+			}); ok {
+				if state, found, _ := v.GetUseFIPSEndpoint(ctx); found {
+					got = state
+					break
+				}
+			}
+		}
+
+		if want := testCase.Expected; got != want {
+			t.Errorf("%s: UseFIPSEndpoint = %v, want %v", testCase.ServicePackageName, got, want)
+		}
+	}
+
+	if got, want := len(c.awsConfig.ConfigSources), 1; got != want {
+		t.Errorf("provider AWS SDK v2 config sources = %d, want %d", got, want)
+	}
+}
@@ -33,6 +33,7 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	EC2DryRunOnPlan                bool
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -50,6 +51,8 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceEndpointOptions         map[string]ServiceEndpointOptions
+	ServiceRetries                 map[string]ServiceRetryConfig
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -58,8 +61,10 @@ type Config struct {
 	STSRegion                      string
 	SuppressDebugLog               bool
 	TerraformVersion               string
+	TimeoutsScaleFactor            float64
 	Token                          string
 	TokenBucketRateLimiterCapacity int
+	TraceAPICalls                  bool
 	UseDualStackEndpoint           bool
 	UseFIPSEndpoint                bool
 }
@@ -183,8 +188,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.TraceAPICalls {
+		tflog.Debug(ctx, "Enabling AWS API call tracing")
+		tracer := newAPICallTracer(nil)
+		tracer.instrumentSDKv2Config(&cfg)
+		tracer.instrumentSDKv1Session(session)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
-	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
+	accountID, partition, awsDiags := getAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
 		diags = append(diags, diag.Diagnostic{
 			Severity: baseSeverityToSDKSeverity(d.Severity()),
@@ -220,13 +232,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
-	client.clients = make(map[string]any, 0)
-	client.conns = make(map[string]any, 0)
+	client.ec2DryRunOnPlan = c.EC2DryRunOnPlan
 	client.endpoints = c.Endpoints
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceEndpointOptions = c.ServiceEndpointOptions
+	client.serviceRetries = c.ServiceRetries
 	client.stsRegion = c.STSRegion
+	client.timeoutsScaleFactor = c.TimeoutsScaleFactor
+	client.tokenBucketRateLimiterCapacity = c.TokenBucketRateLimiterCapacity
 
 	return client, diags
 }
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+const (
+	// describeCacheTTL is how long a successful API operation result is reused.
+	describeCacheTTL = 15 * time.Second
+)
+
+// describeCache is a short-lived cache of API operation results, keyed by operation and input.
+// Concurrent requests for the same key share a single API call.
+type describeCache struct {
+	lock    sync.Mutex
+	entries map[string]*describeCacheEntry
+	now     func() time.Time
+	ttl     time.Duration
+}
+
+type describeCacheEntry struct {
+	done    chan struct{}
+	expires time.Time // Zero while the API call is in flight.
+	value   any
+	err     error
+}
+
+func newDescribeCache(ttl time.Duration) *describeCache {
+	return &describeCache{
+		entries: make(map[string]*describeCacheEntry),
+		now:     time.Now,
+		ttl:     ttl,
+	}
+}
+
+// get returns the cached result for the specified key, calling f if there is no unexpired result.
+// Errors are not cached.
+func (c *describeCache) get(ctx context.Context, key string, f func(context.Context) (any, error)) (any, error) {
+	c.lock.Lock()
+	now := c.now()
+	if e, ok := c.entries[key]; ok && (e.expires.IsZero() || now.Before(e.expires)) {
+		c.lock.Unlock()
+
+		select {
+		case <-e.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		if e.err == nil {
+			return e.value, nil
+		}
+
+		// The shared call failed, possibly because the original caller's Context was canceled.
+		return f(ctx)
+	}
+
+	// Evict expired entries.
+	for k, e := range c.entries {
+		if !e.expires.IsZero() && !now.Before(e.expires) {
+			delete(c.entries, k)
+		}
+	}
+
+	e := &describeCacheEntry{
+		done: make(chan struct{}),
+	}
+	c.entries[key] = e
+	c.lock.Unlock()
+
+	e.value, e.err = f(ctx)
+
+	c.lock.Lock()
+	if e.err != nil {
+		delete(c.entries, key)
+	} else {
+		e.expires = c.now().Add(c.ttl)
+	}
+	c.lock.Unlock()
+
+	close(e.done)
+
+	return e.value, e.err
+}
+
+// describeCacheKey returns the cache key for the specified API operation and input.
+func describeCacheKey(operation string, input any) (string, error) {
+	b, err := json.Marshal(input)
+
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.Sum256(b)
+
+	return operation + "/" + hex.EncodeToString(h[:]), nil
+}
+
+type describeCacheContextKeyType int
+
+var describeCacheContextKey describeCacheContextKeyType
+
+// NewDescribeCacheContext returns a Context in which finders that opt in via DescribeCached reuse
+// recent API operation results from the AWSClient's cache.
+// The provider enables caching when refreshing resources.
+func NewDescribeCacheContext(ctx context.Context, c *AWSClient) context.Context {
+	c.lock.Lock()
+	if c.describeCache == nil {
+		c.describeCache = newDescribeCache(describeCacheTTL)
+	}
+	cache := c.describeCache
+	c.lock.Unlock()
+
+	return context.WithValue(ctx, describeCacheContextKey, cache)
+}
+
+// DescribeCached returns the result of f, which calls the specified API operation with the specified input.
+// If caching is enabled in Context, a recent result for the same operation and input is reused instead of calling f.
+// Only finders used in read paths should opt in, and callers must not modify the returned value.
+func DescribeCached[T any](ctx context.Context, operation string, input any, f func(context.Context) (T, error)) (T, error) {
+	cache, ok := ctx.Value(describeCacheContextKey).(*describeCache)
+	if !ok {
+		return f(ctx)
+	}
+
+	key, err := describeCacheKey(operation, input)
+	if err != nil {
+		return f(ctx)
+	}
+
+	v, err := cache.get(ctx, key, func(ctx context.Context) (any, error) {
+		return f(ctx)
+	})
+
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+
+	t, _ := v.(T)
+
+	return t, nil
+}
@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDescribeCached(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		ID string
+	}
+
+	ctx := context.Background()
+	ctx = NewDescribeCacheContext(ctx, &AWSClient{})
+
+	var calls atomic.Int32
+	f := func(ctx context.Context) (string, error) {
+		calls.Add(1)
+		return "output", nil
+	}
+
+	for range 3 {
+		output, err := DescribeCached(ctx, "Describe", &input{ID: "id1"}, f)
+
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if got, want := output, "output"; got != want {
+			t.Errorf("output = %q, want %q", got, want)
+		}
+	}
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+
+	// Different input.
+	if _, err := DescribeCached(ctx, "Describe", &input{ID: "id2"}, f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// Different operation.
+	if _, err := DescribeCached(ctx, "List", &input{ID: "id1"}, f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(3); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeCachedNotEnabled(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var calls int
+	f := func(ctx context.Context) (string, error) {
+		calls++
+		return "output", nil
+	}
+
+	for range 3 {
+		if _, err := DescribeCached(ctx, "Describe", "id", f); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := calls, 3; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestDescribeCacheErrorsNotCached(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newDescribeCache(time.Minute)
+
+	var calls int
+	f := func(ctx context.Context) (any, error) {
+		calls++
+		if calls == 1 {
+			return nil, errors.New("test error")
+		}
+		return "output", nil
+	}
+
+	if _, err := cache.get(ctx, "key", f); err == nil {
+		t.Fatal("expected error")
+	}
+
+	output, err := cache.get(ctx, "key", f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := output, "output"; got != want {
+		t.Errorf("output = %v, want %v", got, want)
+	}
+}
+
+func TestDescribeCacheExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newDescribeCache(time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	var calls int
+	f := func(ctx context.Context) (any, error) {
+		calls++
+		return calls, nil
+	}
+
+	if _, err := cache.get(ctx, "key", f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	now = now.Add(30 * time.Second)
+
+	if output, _ := cache.get(ctx, "key", f); output != 1 {
+		t.Errorf("output = %v, want 1", output)
+	}
+
+	now = now.Add(time.Minute)
+
+	if output, _ := cache.get(ctx, "key", f); output != 2 {
+		t.Errorf("output = %v, want 2", output)
+	}
+}
+
+func TestDescribeCacheConcurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newDescribeCache(time.Minute)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	f := func(ctx context.Context) (any, error) {
+		calls.Add(1)
+		<-release
+		return "output", nil
+	}
+
+	const n = 10
+	var wg sync.WaitGroup
+	wg.Add(n)
+	for range n {
+		go func() {
+			defer wg.Done()
+
+			if output, err := cache.get(ctx, "key", f); err != nil || output != "output" {
+				t.Errorf("output = %v, err = %v", output, err)
+			}
+		}()
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
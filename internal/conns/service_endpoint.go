@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+)
+
+// ServiceEndpointOptions overrides the provider-level FIPS and dual-stack endpoint settings for a single service's API clients.
+// Nil values leave the provider-level settings in place.
+type ServiceEndpointOptions struct {
+	// UseDualStackEndpoint specifies whether the service's dual-stack endpoint is resolved.
+	UseDualStackEndpoint *bool
+	// UseFIPSEndpoint specifies whether the service's FIPS endpoint is resolved.
+	UseFIPSEndpoint *bool
+}
+
+// sdkv2Config returns a copy of the specified AWS SDK for Go v2 configuration with the service's endpoint options applied.
+// AWS SDK for Go v2 API clients resolve these options from the first configuration source that provides them,
+// so the service's options are added ahead of the shared configuration sources.
+func (o ServiceEndpointOptions) sdkv2Config(cfg *aws_sdkv2.Config) *aws_sdkv2.Config {
+	if cfg == nil {
+		return nil
+	}
+
+	v := cfg.Copy()
+	v.ConfigSources = append([]interface{}{serviceEndpointOptionsSource(o)}, cfg.ConfigSources...)
+
+	return &v
+}
+
+// sdkv1Session returns a copy of the specified AWS SDK for Go v1 session with the service's endpoint options applied.
+func (o ServiceEndpointOptions) sdkv1Session(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	cfg := aws_sdkv1.NewConfig()
+
+	if v := o.UseDualStackEndpoint; v != nil {
+		if *v {
+			cfg.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateEnabled
+		} else {
+			cfg.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateDisabled
+		}
+	}
+
+	if v := o.UseFIPSEndpoint; v != nil {
+		cfg = cfg.WithUseFIPSEndpoint(*v)
+	}
+
+	return sess.Copy(cfg)
+}
+
+// serviceEndpointOptionsSource is an AWS SDK for Go v2 configuration source for a service's endpoint options.
+type serviceEndpointOptionsSource ServiceEndpointOptions
+
+func (s serviceEndpointOptionsSource) GetUseDualStackEndpoint(context.Context) (aws_sdkv2.DualStackEndpointState, bool, error) {
+	if s.UseDualStackEndpoint == nil {
+		return aws_sdkv2.DualStackEndpointStateUnset, false, nil
+	}
+
+	if *s.UseDualStackEndpoint {
+		return aws_sdkv2.DualStackEndpointStateEnabled, true, nil
+	}
+
+	return aws_sdkv2.DualStackEndpointStateDisabled, true, nil
+}
+
+func (s serviceEndpointOptionsSource) GetUseFIPSEndpoint(context.Context) (aws_sdkv2.FIPSEndpointState, bool, error) {
+	if s.UseFIPSEndpoint == nil {
+		return aws_sdkv2.FIPSEndpointStateUnset, false, nil
+	}
+
+	if *s.UseFIPSEndpoint {
+		return aws_sdkv2.FIPSEndpointStateEnabled, true, nil
+	}
+
+	return aws_sdkv2.FIPSEndpointStateDisabled, true, nil
+}
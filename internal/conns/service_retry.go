@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ratelimit_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/ratelimit"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	client_sdkv1 "github.com/aws/aws-sdk-go/aws/client"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+)
+
+// ServiceRetryConfig overrides the provider-level retry settings for a single service's API clients.
+// Zero values leave the provider-level settings in place.
+type ServiceRetryConfig struct {
+	// AdaptiveRateLimiting enables client-side rate limiting, as with `retry_mode = "adaptive"`.
+	// Only AWS SDK for Go v2 API clients support adaptive rate limiting.
+	AdaptiveRateLimiting bool
+	// MaxAttempts is the maximum number of times an API request is attempted.
+	MaxAttempts int
+	// MaxBackoff is the maximum delay between attempts.
+	MaxBackoff time.Duration
+	// TimeoutsScaleFactor is the factor by which the default operation timeouts of the service's resources are multiplied.
+	TimeoutsScaleFactor float64
+}
+
+// sdkv2Config returns a copy of the specified AWS SDK for Go v2 configuration with the service's retry settings applied.
+func (c ServiceRetryConfig) sdkv2Config(cfg *aws_sdkv2.Config, tokenBucketRateLimiterCapacity int) *aws_sdkv2.Config {
+	v := cfg.Copy()
+
+	if c.MaxAttempts > 0 {
+		// API clients apply RetryMaxAttempts on top of any configured Retryer.
+		v.RetryMaxAttempts = c.MaxAttempts
+	}
+
+	newBaseRetryer := v.Retryer
+	v.Retryer = func() aws_sdkv2.Retryer {
+		var retryer aws_sdkv2.Retryer
+		if newBaseRetryer != nil {
+			retryer = newBaseRetryer()
+		} else {
+			retryer = retry_sdkv2.NewStandard()
+		}
+
+		if c.AdaptiveRateLimiting {
+			retryer = c.adaptiveRetryer(retryer, tokenBucketRateLimiterCapacity)
+		}
+
+		if c.MaxBackoff > 0 {
+			retryer = retry_sdkv2.AddWithMaxBackoffDelay(retryer, c.MaxBackoff)
+		}
+
+		return retryer
+	}
+
+	return &v
+}
+
+// adaptiveRetryer returns an adaptive mode Retryer which keeps the retry decisions and attempt limit of the specified Retryer.
+func (c ServiceRetryConfig) adaptiveRetryer(base aws_sdkv2.Retryer, tokenBucketRateLimiterCapacity int) aws_sdkv2.Retryer {
+	retryer := retry_sdkv2.NewAdaptiveMode(func(o *retry_sdkv2.AdaptiveModeOptions) {
+		o.StandardOptions = append(o.StandardOptions, func(o *retry_sdkv2.StandardOptions) {
+			o.MaxAttempts = base.MaxAttempts()
+			if tokenBucketRateLimiterCapacity > 0 {
+				o.RateLimiter = ratelimit_sdkv2.NewTokenRateLimit(uint(tokenBucketRateLimiterCapacity))
+			} else {
+				o.RateLimiter = ratelimit_sdkv2.None
+			}
+		})
+	})
+
+	return AddIsErrorRetryables(retryer, retry_sdkv2.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+		return aws_sdkv2.BoolTernary(base.IsErrorRetryable(err))
+	}))
+}
+
+// sdkv1Session returns a copy of the specified AWS SDK for Go v1 session with the service's retry settings applied.
+func (c ServiceRetryConfig) sdkv1Session(sess *session_sdkv1.Session) *session_sdkv1.Session {
+	cfg := aws_sdkv1.NewConfig()
+
+	if c.MaxAttempts > 0 {
+		cfg = cfg.WithMaxRetries(c.MaxAttempts)
+	}
+
+	if c.MaxBackoff > 0 {
+		maxRetries := aws_sdkv1.IntValue(sess.Config.MaxRetries)
+		if c.MaxAttempts > 0 {
+			maxRetries = c.MaxAttempts
+		}
+
+		cfg = request_sdkv1.WithRetryer(cfg, client_sdkv1.DefaultRetryer{
+			NumMaxRetries:    maxRetries,
+			MaxRetryDelay:    c.MaxBackoff,
+			MaxThrottleDelay: c.MaxBackoff,
+		})
+	}
+
+	return sess.Copy(cfg)
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestServiceRetryConfigSDKv2Config(t *testing.T) {
+	t.Parallel()
+
+	errRetryable := errors.New("retryable")
+	base := &aws.Config{
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard(func(o *retry.StandardOptions) {
+				o.MaxAttempts = 25
+				o.Retryables = append(o.Retryables, retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+					return aws.BoolTernary(errors.Is(err, errRetryable))
+				}))
+			})
+		},
+		RetryMaxAttempts: 25,
+	}
+
+	testCases := []struct {
+		name                 string
+		config               ServiceRetryConfig
+		wantRetryMaxAttempts int
+		wantMaxAttempts      int
+		wantAdaptive         bool
+	}{
+		{
+			name:                 "empty",
+			wantRetryMaxAttempts: 25,
+			wantMaxAttempts:      25,
+		},
+		{
+			name:                 "max attempts",
+			config:               ServiceRetryConfig{MaxAttempts: 5},
+			wantRetryMaxAttempts: 5,
+			wantMaxAttempts:      25,
+		},
+		{
+			name:                 "max backoff",
+			config:               ServiceRetryConfig{MaxBackoff: 10 * time.Second},
+			wantRetryMaxAttempts: 25,
+			wantMaxAttempts:      25,
+		},
+		{
+			name:                 "adaptive",
+			config:               ServiceRetryConfig{AdaptiveRateLimiting: true},
+			wantRetryMaxAttempts: 25,
+			wantMaxAttempts:      25,
+			wantAdaptive:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := testCase.config.sdkv2Config(base, 0)
+
+			if cfg == base {
+				t.Fatal("expected a copy of the configuration")
+			}
+
+			if got, want := cfg.RetryMaxAttempts, testCase.wantRetryMaxAttempts; got != want {
+				t.Errorf("RetryMaxAttempts = %d, want %d", got, want)
+			}
+
+			retryer := cfg.Retryer()
+
+			if got, want := retryer.MaxAttempts(), testCase.wantMaxAttempts; got != want {
+				t.Errorf("MaxAttempts() = %d, want %d", got, want)
+			}
+
+			if !retryer.IsErrorRetryable(errRetryable) {
+				t.Error("expected the base Retryer's retryable errors to be retried")
+			}
+
+			if _, ok := retryer.(*withIsErrorRetryables); ok != testCase.wantAdaptive {
+				t.Errorf("adaptive = %t, want %t", ok, testCase.wantAdaptive)
+			}
+		})
+	}
+
+	if got, want := base.RetryMaxAttempts, 25; got != want {
+		t.Errorf("base configuration modified: RetryMaxAttempts = %d, want %d", got, want)
+	}
+}
+
+func TestServiceRetryConfigSDKv1Session(t *testing.T) {
+	t.Parallel()
+
+	sess := session_sdkv1.Must(session_sdkv1.NewSession(aws_sdkv1.NewConfig().WithMaxRetries(25).WithRegion("us-west-2"))) //lintignore:AWSAT003
+
+	got := ServiceRetryConfig{MaxAttempts: 5, MaxBackoff: 10 * time.Second}.sdkv1Session(sess)
+
+	if got, want := aws_sdkv1.IntValue(got.Config.MaxRetries), 5; got != want {
+		t.Errorf("MaxRetries = %d, want %d", got, want)
+	}
+
+	if got.Config.Retryer == nil {
+		t.Error("expected a Retryer")
+	}
+
+	if got, want := aws_sdkv1.IntValue(sess.Config.MaxRetries), 25; got != want {
+		t.Errorf("base session modified: MaxRetries = %d, want %d", got, want)
+	}
+}
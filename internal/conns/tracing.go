@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	tracerName = "github.com/hashicorp/terraform-provider-aws"
+
+	apiCallTracingMiddlewareID = "TFAWSAPICallTracing"
+	apiCallTracingHandlerName  = "tf_aws.APICallTracing"
+)
+
+// Span attributes, in addition to the OpenTelemetry RPC semantic conventions.
+const (
+	attrAPICallLatency       = attribute.Key("aws.latency_ms")
+	attrAPICallRequestID     = attribute.Key("aws.request_id")
+	attrAPICallRetryCount    = attribute.Key("aws.retry_count")
+	attrAPICallThrottleCount = attribute.Key("aws.throttle_count")
+)
+
+// apiCallTracer emits an OpenTelemetry span for each AWS API call.
+// Each span covers all attempts of a call, so its duration includes time spent in retries.
+type apiCallTracer struct {
+	tracer    trace.Tracer
+	throttles retry_sdkv2.IsErrorThrottles
+}
+
+// newAPICallTracer returns an apiCallTracer using the specified TracerProvider.
+// If no TracerProvider is specified the global TracerProvider is used.
+func newAPICallTracer(tp trace.TracerProvider) *apiCallTracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+
+	return &apiCallTracer{
+		tracer:    tp.Tracer(tracerName),
+		throttles: retry_sdkv2.IsErrorThrottles(retry_sdkv2.DefaultThrottles),
+	}
+}
+
+func (t *apiCallTracer) start(ctx context.Context, service, operation, region string) (context.Context, trace.Span) {
+	return t.tracer.Start(ctx, service+"."+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemKey.String("aws-api"),
+			semconv.RPCService(service),
+			semconv.RPCMethod(operation),
+			semconv.CloudRegion(region),
+		),
+	)
+}
+
+func (t *apiCallTracer) end(span trace.Span, start time.Time, requestID string, retryCount, throttleCount int, err error) {
+	span.SetAttributes(
+		attrAPICallLatency.Int64(time.Since(start).Milliseconds()),
+		attrAPICallRetryCount.Int(retryCount),
+		attrAPICallThrottleCount.Int(throttleCount),
+	)
+
+	if requestID != "" {
+		span.SetAttributes(attrAPICallRequestID.String(requestID))
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// instrumentSDKv2Config adds API call tracing to the specified AWS SDK for Go v2 configuration.
+func (t *apiCallTracer) instrumentSDKv2Config(cfg *aws_sdkv2.Config) {
+	cfg.APIOptions = append(cfg.APIOptions, func(stack *middleware.Stack) error {
+		// Initialize runs once per API call, before any retries.
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(apiCallTracingMiddlewareID, t.handleInitialize), middleware.After)
+	})
+}
+
+func (t *apiCallTracer) handleInitialize(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+	start := time.Now()
+	ctx, span := t.start(ctx, awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx), awsmiddleware_sdkv2.GetRegion(ctx))
+
+	out, metadata, err := next.HandleInitialize(ctx, in)
+
+	var retryCount, throttleCount int
+	if results, ok := retry_sdkv2.GetAttemptResults(metadata); ok {
+		for _, result := range results.Results {
+			if result.Retried {
+				retryCount++
+			}
+			if t.isErrorThrottle(result.Err) {
+				throttleCount++
+			}
+		}
+	}
+	requestID, _ := awsmiddleware_sdkv2.GetRequestIDMetadata(metadata)
+
+	t.end(span, start, requestID, retryCount, throttleCount, err)
+
+	return out, metadata, err
+}
+
+func (t *apiCallTracer) isErrorThrottle(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	return t.throttles.IsErrorThrottle(err) == aws_sdkv2.TrueTernary
+}
+
+// instrumentSDKv1Session adds API call tracing to the specified AWS SDK for Go v1 session.
+func (t *apiCallTracer) instrumentSDKv1Session(sess *session_sdkv1.Session) {
+	// Validate runs once per API call, before any retries.
+	sess.Handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: apiCallTracingHandlerName,
+		Fn:   t.startSDKv1Request,
+	})
+	sess.Handlers.Retry.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: apiCallTracingHandlerName,
+		Fn:   t.retrySDKv1Request,
+	})
+	sess.Handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: apiCallTracingHandlerName,
+		Fn:   t.completeSDKv1Request,
+	})
+}
+
+type sdkv1APICallKey struct{}
+
+// sdkv1APICall holds the tracing state of an AWS SDK for Go v1 API call.
+type sdkv1APICall struct {
+	span          trace.Span
+	start         time.Time
+	throttleCount int
+}
+
+func (t *apiCallTracer) startSDKv1Request(r *request_sdkv1.Request) {
+	// Presigned requests are never sent.
+	if r.ExpireTime > 0 {
+		return
+	}
+
+	ctx, span := t.start(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name, aws_sdkv1.StringValue(r.Config.Region))
+
+	r.SetContext(context.WithValue(ctx, sdkv1APICallKey{}, &sdkv1APICall{
+		span:  span,
+		start: time.Now(),
+	}))
+}
+
+func (t *apiCallTracer) retrySDKv1Request(r *request_sdkv1.Request) {
+	if call, ok := r.Context().Value(sdkv1APICallKey{}).(*sdkv1APICall); ok && request_sdkv1.IsErrorThrottle(r.Error) {
+		call.throttleCount++
+	}
+}
+
+func (t *apiCallTracer) completeSDKv1Request(r *request_sdkv1.Request) {
+	if call, ok := r.Context().Value(sdkv1APICallKey{}).(*sdkv1APICall); ok {
+		t.end(call.span, call.start, r.RequestID, r.RetryCount, call.throttleCount, r.Error)
+	}
+}
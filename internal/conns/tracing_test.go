@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	credentials_sdkv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
+	sts_sdkv1 "github.com/aws/aws-sdk-go/service/sts"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const getCallerIdentityResponse = `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</GetCallerIdentityResponse>`
+
+const throttlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>fedcba98-7654-3210-fedc-ba9876543210</RequestId>
+</ErrorResponse>`
+
+func TestAPICallTracerSDKv2(t *testing.T) {
+	t.Parallel()
+
+	tp := &recordingTracerProvider{}
+	cfg := aws_sdkv2.Config{
+		Credentials:  aws_sdkv2.AnonymousCredentials{},
+		BaseEndpoint: aws_sdkv2.String(newThrottlingServer(t, 1).URL),
+		Region:       "us-west-2", //lintignore:AWSAT003
+	}
+	newAPICallTracer(tp).instrumentSDKv2Config(&cfg)
+
+	client := sts_sdkv2.NewFromConfig(cfg, func(o *sts_sdkv2.Options) {
+		o.Retryer = newNoDelayRetryer()
+	})
+
+	if _, err := client.GetCallerIdentity(context.Background(), &sts_sdkv2.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tp.checkSpan(t, "STS.GetCallerIdentity", map[attribute.Key]attribute.Value{
+		"rpc.service":        attribute.StringValue("STS"),
+		"rpc.method":         attribute.StringValue("GetCallerIdentity"),
+		"aws.retry_count":    attribute.IntValue(1),
+		"aws.throttle_count": attribute.IntValue(1),
+		"aws.request_id":     attribute.StringValue("01234567-89ab-cdef-0123-456789abcdef"),
+	})
+}
+
+func TestAPICallTracerSDKv1(t *testing.T) {
+	t.Parallel()
+
+	tp := &recordingTracerProvider{}
+	sess := session_sdkv1.Must(session_sdkv1.NewSession(aws_sdkv1.NewConfig().
+		WithCredentials(credentials_sdkv1.AnonymousCredentials).
+		WithEndpoint(newThrottlingServer(t, 1).URL).
+		WithRegion("us-west-2"). //lintignore:AWSAT003
+		WithSleepDelay(func(time.Duration) {})))
+	newAPICallTracer(tp).instrumentSDKv1Session(sess)
+
+	conn := sts_sdkv1.New(sess)
+
+	if _, err := conn.GetCallerIdentity(&sts_sdkv1.GetCallerIdentityInput{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	tp.checkSpan(t, "STS.GetCallerIdentity", map[attribute.Key]attribute.Value{
+		"rpc.service":        attribute.StringValue("STS"),
+		"rpc.method":         attribute.StringValue("GetCallerIdentity"),
+		"aws.retry_count":    attribute.IntValue(1),
+		"aws.throttle_count": attribute.IntValue(1),
+		"aws.request_id":     attribute.StringValue("01234567-89ab-cdef-0123-456789abcdef"),
+	})
+}
+
+// newThrottlingServer returns a test server that throttles the specified number of requests
+// before responding successfully to STS GetCallerIdentity.
+func newThrottlingServer(t *testing.T, throttles int) *httptest.Server {
+	t.Helper()
+
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		statusCode, body, requestID := http.StatusOK, getCallerIdentityResponse, "01234567-89ab-cdef-0123-456789abcdef"
+		if throttles > 0 {
+			throttles--
+			statusCode, body, requestID = http.StatusBadRequest, throttlingResponse, "fedcba98-7654-3210-fedc-ba9876543210"
+		}
+
+		w.Header().Set("Content-Type", "text/xml")
+		w.Header().Set("X-Amzn-Requestid", requestID)
+		w.WriteHeader(statusCode)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newNoDelayRetryer() aws_sdkv2.Retryer {
+	return retry_sdkv2.NewStandard(func(o *retry_sdkv2.StandardOptions) {
+		o.Backoff = retry_sdkv2.BackoffDelayerFunc(func(int, error) (time.Duration, error) {
+			return 0, nil
+		})
+	})
+}
+
+type recordingTracerProvider struct {
+	noop.TracerProvider
+
+	mu    sync.Mutex
+	spans []*recordingSpan
+}
+
+func (tp *recordingTracerProvider) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return &recordingTracer{tp: tp}
+}
+
+func (tp *recordingTracerProvider) checkSpan(t *testing.T, name string, want map[attribute.Key]attribute.Value) {
+	t.Helper()
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if got, want := len(tp.spans), 1; got != want {
+		t.Fatalf("spans = %d, want %d", got, want)
+	}
+
+	span := tp.spans[0]
+
+	if got, want := span.name, name; got != want {
+		t.Errorf("span name = %q, want %q", got, want)
+	}
+
+	if !span.ended {
+		t.Error("expected span to be ended")
+	}
+
+	for k, want := range want {
+		if got, ok := span.attributes[k]; !ok {
+			t.Errorf("missing span attribute %q", k)
+		} else if got != want {
+			t.Errorf("span attribute %q = %v, want %v", k, got.Emit(), want.Emit())
+		}
+	}
+}
+
+type recordingTracer struct {
+	noop.Tracer
+
+	tp *recordingTracerProvider
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	span := &recordingSpan{
+		name:       name,
+		attributes: make(map[attribute.Key]attribute.Value),
+	}
+	config := trace.NewSpanStartConfig(opts...)
+	span.SetAttributes(config.Attributes()...)
+
+	t.tp.mu.Lock()
+	t.tp.spans = append(t.tp.spans, span)
+	t.tp.mu.Unlock()
+
+	return trace.ContextWithSpan(ctx, span), span
+}
+
+type recordingSpan struct {
+	noop.Span
+
+	name       string
+	attributes map[attribute.Key]attribute.Value
+	ended      bool
+}
+
+func (s *recordingSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for _, kv := range kv {
+		s.attributes[kv.Key] = kv.Value
+	}
+}
+
+func (s *recordingSpan) End(...trace.SpanEndOption) {
+	s.ended = true
+}
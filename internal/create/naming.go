@@ -4,6 +4,8 @@
 package create
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
@@ -17,7 +19,11 @@ func Name(name string, namePrefix string) string {
 
 // hasResourceUniqueIDPlusAdditionalSuffix returns true if the string has the built-in unique ID suffix plus an additional suffix
 func hasResourceUniqueIDPlusAdditionalSuffix(s string, additionalSuffix string) bool {
-	re := regexache.MustCompile(fmt.Sprintf("[[:xdigit:]]{%d}%s$", id.UniqueIDSuffixLength, additionalSuffix))
+	return hasUniqueIDPlusAdditionalSuffix(s, id.UniqueIDSuffixLength, additionalSuffix)
+}
+
+func hasUniqueIDPlusAdditionalSuffix(s string, uniqueIDSuffixLength int, additionalSuffix string) bool {
+	re := regexache.MustCompile(fmt.Sprintf("[[:xdigit:]]{%d}%s$", uniqueIDSuffixLength, additionalSuffix))
 	return re.MatchString(s)
 }
 
@@ -34,11 +40,21 @@ func NamePrefixFromName(name string) *string {
 }
 
 func NamePrefixFromNameWithSuffix(name, nameSuffix string) *string {
-	if !hasResourceUniqueIDPlusAdditionalSuffix(name, nameSuffix) {
+	return namePrefixFromName(name, nameSuffix, id.UniqueIDSuffixLength)
+}
+
+// NamePrefixFromNameWithShortUniqueIDSuffix returns a name prefix if the string matches prefix criteria
+// for names generated with the WithShortUniqueIDSuffix option.
+func NamePrefixFromNameWithShortUniqueIDSuffix(name string, uniqueIDSuffixLength int) *string {
+	return namePrefixFromName(name, "", uniqueIDSuffixLength)
+}
+
+func namePrefixFromName(name, nameSuffix string, uniqueIDSuffixLength int) *string {
+	if !hasUniqueIDPlusAdditionalSuffix(name, uniqueIDSuffixLength, nameSuffix) {
 		return nil
 	}
 
-	namePrefixIndex := len(name) - id.UniqueIDSuffixLength - len(nameSuffix)
+	namePrefixIndex := len(name) - uniqueIDSuffixLength - len(nameSuffix)
 
 	if namePrefixIndex <= 0 {
 		return nil
@@ -50,10 +66,11 @@ func NamePrefixFromNameWithSuffix(name, nameSuffix string) *string {
 }
 
 type nameGenerator struct {
-	configuredName   string
-	configuredPrefix string
-	defaultPrefix    string
-	suffix           string
+	configuredName       string
+	configuredPrefix     string
+	defaultPrefix        string
+	suffix               string
+	uniqueIDSuffixLength int
 }
 
 // nameGeneratorOptionsFunc is a type alias for a name generator functional option.
@@ -93,6 +110,15 @@ func WithSuffix(suffix string) NameGeneratorOptionsFunc {
 	}
 }
 
+// WithShortUniqueIDSuffix is a helper function to construct functional options
+// that make a name generator use a random hexadecimal unique ID suffix of the specified length.
+// Use it for names whose maximum length cannot accommodate the standard unique ID suffix.
+func WithShortUniqueIDSuffix(length int) NameGeneratorOptionsFunc {
+	return func(g *nameGenerator) {
+		g.uniqueIDSuffixLength = length
+	}
+}
+
 // NewNameGenerator returns a new name generator from the specified varidaic list of functional options.
 func NewNameGenerator(optFns ...NameGeneratorOptionsFunc) *nameGenerator {
 	g := &nameGenerator{defaultPrefix: id.UniqueIdPrefix}
@@ -114,5 +140,20 @@ func (g *nameGenerator) Generate() string {
 	if g.configuredPrefix != "" {
 		prefix = g.configuredPrefix
 	}
+	if g.uniqueIDSuffixLength > 0 {
+		return prefix + shortUniqueID(g.uniqueIDSuffixLength) + g.suffix
+	}
 	return id.PrefixedUniqueId(prefix) + g.suffix
 }
+
+// shortUniqueID returns a random hexadecimal string of the specified length.
+func shortUniqueID(length int) string {
+	b := make([]byte, (length+1)/2)
+	if _, err := rand.Read(b); err != nil {
+		// Fall back to the low-order digits of a standard unique ID.
+		s := id.UniqueId()
+		return s[len(s)-length:]
+	}
+
+	return hex.EncodeToString(b)[:length]
+}
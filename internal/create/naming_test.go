@@ -422,3 +422,62 @@ func TestNamePrefixFromNameWithSuffix(t *testing.T) {
 		}
 	})
 }
+
+func TestNameWithShortUniqueIDSuffix(t *testing.T) {
+	t.Parallel()
+
+	const suffixLength = 8
+
+	testCases := []struct {
+		testName         string
+		configuredName   string
+		configuredPrefix string
+		expectedRegexp   *regexp.Regexp
+	}{
+		{
+			testName:       "no configured name or prefix",
+			expectedRegexp: regexache.MustCompile(fmt.Sprintf("^terraform-[[:xdigit:]]{%d}$", suffixLength)),
+		},
+		{
+			testName:       "configured name only",
+			configuredName: "testing",
+			expectedRegexp: regexache.MustCompile(`^testing$`),
+		},
+		{
+			testName:         "configured prefix only",
+			configuredPrefix: "pfx-",
+			expectedRegexp:   regexache.MustCompile(fmt.Sprintf("^pfx-[[:xdigit:]]{%d}$", suffixLength)),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewNameGenerator(WithConfiguredName(testCase.configuredName), WithConfiguredPrefix(testCase.configuredPrefix), WithShortUniqueIDSuffix(suffixLength)).Generate()
+
+			if !testCase.expectedRegexp.MatchString(got) {
+				t.Errorf("generated name %q doesn't match regexp %q", got, testCase.expectedRegexp)
+			}
+		})
+	}
+
+	t.Run("extracting prefix from generated name", func(t *testing.T) {
+		t.Parallel()
+
+		for i := 0; i < 10; i++ {
+			prefix := "test-"
+			input := NewNameGenerator(WithConfiguredPrefix(prefix), WithShortUniqueIDSuffix(suffixLength)).Generate()
+			got := NamePrefixFromNameWithShortUniqueIDSuffix(input, suffixLength)
+
+			if got == nil {
+				t.Errorf("run%d: got nil, expected %s for input %s", i, prefix, input)
+			}
+
+			if got != nil && prefix != *got {
+				t.Errorf("run%d: got %s, expected %s for input %s", i, *got, prefix, input)
+			}
+		}
+	})
+}
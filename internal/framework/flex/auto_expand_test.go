@@ -1180,6 +1180,52 @@ func TestExpandOptions(t *testing.T) {
 	runAutoExpandTestCases(ctx, t, testCases)
 }
 
+func TestExpandFieldNameTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "field name tag",
+			Source: &TestFlexFieldNameTagTF01{
+				Name:        types.StringValue("a"),
+				DisplayName: types.StringValue("b"),
+			},
+			Target: &TestFlexFieldNameTagAWS01{},
+			WantTarget: &TestFlexFieldNameTagAWS01{
+				Name:        aws.String("a"),
+				Description: aws.String("b"),
+			},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
+func TestExpandEmbeddedStruct(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "embedded struct",
+			Source: &TestFlexEmbeddedTF01{
+				TestFlexEmbeddedTF02: TestFlexEmbeddedTF02{
+					Field2: types.Int64Value(42),
+					Field3: types.StringValue("b"),
+				},
+				Field1: types.StringValue("a"),
+			},
+			Target: &TestFlexEmbeddedAWS01{},
+			WantTarget: &TestFlexEmbeddedAWS01{
+				Field1: aws.String("a"),
+				Field2: 42,
+				Field4: aws.String("b"),
+			},
+		},
+	}
+	runAutoExpandTestCases(ctx, t, testCases)
+}
+
 type autoFlexTestCase struct {
 	Context    context.Context //nolint:containedctx // testing context use
 	Options    []AutoFlexOptionsFunc
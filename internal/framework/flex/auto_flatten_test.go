@@ -1052,6 +1052,53 @@ func TestFlattenComplexNestedBlockWithFloat64(t *testing.T) {
 	runAutoFlattenTestCases(ctx, t, testCases)
 }
 
+func TestFlattenFieldNameTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "field name tag",
+			Source: &TestFlexFieldNameTagAWS01{
+				Name:        aws.String("a"),
+				DisplayName: aws.String("c"),
+				Description: aws.String("b"),
+			},
+			Target: &TestFlexFieldNameTagTF01{},
+			WantTarget: &TestFlexFieldNameTagTF01{
+				Name:        types.StringValue("a"),
+				DisplayName: types.StringValue("b"),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
+func TestFlattenEmbeddedStruct(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := autoFlexTestCases{
+		{
+			TestName: "embedded struct",
+			Source: &TestFlexEmbeddedAWS01{
+				Field1: aws.String("a"),
+				Field2: 42,
+				Field4: aws.String("b"),
+			},
+			Target: &TestFlexEmbeddedTF01{},
+			WantTarget: &TestFlexEmbeddedTF01{
+				TestFlexEmbeddedTF02: TestFlexEmbeddedTF02{
+					Field2: types.Int64Value(42),
+					Field3: types.StringValue("b"),
+				},
+				Field1: types.StringValue("a"),
+			},
+		},
+	}
+	runAutoFlattenTestCases(ctx, t, testCases)
+}
+
 func TestFlattenOptions(t *testing.T) {
 	t.Parallel()
 
@@ -22,6 +22,12 @@ const (
 	MapBlockKey                                = "MapBlockKey"
 )
 
+// autoFlexTagKey is the key of AutoFlex struct tags.
+//
+// A field tagged `autoflex:"name=Other"` is mapped to and from the field named Other,
+// in place of a field matched by name.
+const autoFlexTagKey = "autoflex"
+
 // Expand  = TF -->  AWS
 // Flatten = AWS --> TF
 
@@ -125,11 +131,7 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	}
 
 	opts := flexer.getOptions()
-	for i, typFrom := 0, valFrom.Type(); i < typFrom.NumField(); i++ {
-		field := typFrom.Field(i)
-		if field.PkgPath != "" {
-			continue // Skip unexported fields.
-		}
+	for _, field := range structFields(valFrom.Type()) {
 		fieldName := field.Name
 		if opts.IsIgnoredField(fieldName) {
 			continue
@@ -138,7 +140,7 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 			continue
 		}
 
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo, valFrom, flexer)
+		toFieldVal := findFieldFuzzy(ctx, field, valTo, valFrom, flexer)
 		if !toFieldVal.IsValid() {
 			continue // Corresponding field not found in to.
 		}
@@ -146,7 +148,7 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 			continue // Corresponding field value can't be changed.
 		}
 
-		diags.Append(flexer.convert(ctx, valFrom.Field(i), toFieldVal)...)
+		diags.Append(flexer.convert(ctx, valFrom.FieldByIndex(field.Index), toFieldVal)...)
 		if diags.HasError() {
 			diags.AddError("AutoFlEx", fmt.Sprintf("convert (%s)", fieldName))
 			return diags
@@ -156,9 +158,23 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 	return diags
 }
 
-func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom reflect.Value, flexer autoFlexer) reflect.Value {
-	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
+func findFieldFuzzy(ctx context.Context, fieldFrom reflect.StructField, valTo, valFrom reflect.Value, flexer autoFlexer) reflect.Value {
+	fieldNameFrom := fieldFrom.Name
+
+	// first precedence is a field name specified in a struct tag
+	if name := fieldNameFromTag(fieldFrom); name != "" {
+		return fieldByName(valTo, name)
+	}
+
+	fieldsTo := structFields(valTo.Type())
+	for _, field := range fieldsTo {
+		if fieldNameFromTag(field) == fieldNameFrom {
+			return valTo.FieldByIndex(field.Index)
+		}
+	}
+
+	// second precedence is exact match (case sensitive)
+	if v := fieldByName(valTo, fieldNameFrom); v.IsValid() {
 		return v
 	}
 
@@ -168,59 +184,124 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo, valFrom re
 	// fuzzy match "Value" in "to" since "from" also has "Value". We check "from"
 	// to make sure fuzzy matches are not in "from".
 
-	// second precedence is exact match (case insensitive)
+	// third precedence is exact match (case insensitive)
 	opts := flexer.getOptions()
-	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
-		field := typTo.Field(i)
-		if field.PkgPath != "" {
-			continue // Skip unexported fields.
-		}
+	for _, field := range fieldsTo {
 		fieldNameTo := field.Name
 		if opts.IsIgnoredField(fieldNameTo) {
 			continue
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
-			// probably could assume validity here since reflect gave the field name
-			return v
+		if fieldNameFromTag(field) != "" {
+			continue // Fields with a name in a struct tag only match that name.
+		}
+		if strings.EqualFold(fieldNameFrom, fieldNameTo) && !fieldExistsInStruct(fieldNameTo, valFrom) {
+			return valTo.FieldByIndex(field.Index)
 		}
 	}
 
-	// third precedence is singular/plural
+	// fourth precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) && !fieldExistsInStruct(plural.Plural(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Plural(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) && !fieldExistsInStruct(plural.Singular(fieldNameFrom), valFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Singular(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
 
-	// fourth precedence is using resource prefix
+	// fifth precedence is using resource prefix
 	if v, ok := ctx.Value(ResourcePrefix).(string); ok && v != "" {
 		v = strings.ReplaceAll(v, " ", "")
 		if ctx.Value(ResourcePrefixRecurse) == nil {
 			// so it will only recurse once
 			ctx = context.WithValue(ctx, ResourcePrefixRecurse, true)
+			fieldFrom.Tag = ""
 			if strings.HasPrefix(fieldNameFrom, v) {
-				return findFieldFuzzy(ctx, strings.TrimPrefix(fieldNameFrom, v), valTo, valFrom, flexer)
+				fieldFrom.Name = strings.TrimPrefix(fieldNameFrom, v)
+			} else {
+				fieldFrom.Name = v + fieldNameFrom
 			}
-			return findFieldFuzzy(ctx, v+fieldNameFrom, valTo, valFrom, flexer)
+			return findFieldFuzzy(ctx, fieldFrom, valTo, valFrom, flexer)
 		}
 	}
 
 	// no finds, fuzzy or otherwise - return zero value
-	return valTo.FieldByName(fieldNameFrom)
+	return reflect.Value{}
 }
 
 func fieldExistsInStruct(field string, str reflect.Value) bool {
-	if v := str.FieldByName(field); v.IsValid() {
-		return true
+	return fieldByName(str, field).IsValid()
+}
+
+// fieldByName returns the exported field of struct `val` with the specified name,
+// or the zero Value if no field is found.
+// Fields promoted from embedded structs are found. Fields with a name in a struct tag are not.
+func fieldByName(val reflect.Value, name string) reflect.Value {
+	for _, field := range structFields(val.Type()) {
+		if field.Name == name && fieldNameFromTag(field) == "" {
+			return val.FieldByIndex(field.Index)
+		}
 	}
 
-	return false
+	return reflect.Value{}
+}
+
+// structFields returns the exported fields of struct type `typ`,
+// including the fields promoted from embedded (non-pointer) structs.
+// The embedded structs themselves are not returned.
+func structFields(typ reflect.Type) []reflect.StructField {
+	var fields []reflect.StructField
+
+	for _, field := range reflect.VisibleFields(typ) {
+		if !field.IsExported() {
+			continue
+		}
+		if isEmbeddedStruct(field) {
+			continue // Fields are promoted.
+		}
+		if field.Anonymous && field.Type.Kind() == reflect.Ptr {
+			continue // Embedded pointers are not traversed.
+		}
+		if len(field.Index) > 1 && !isPromotedThroughStructs(typ, field.Index) {
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+// isEmbeddedStruct returns whether `field` is an embedded struct whose fields should be traversed.
+// Embedded Terraform Plugin Framework values are treated as regular fields.
+func isEmbeddedStruct(field reflect.StructField) bool {
+	return field.Anonymous && field.Type.Kind() == reflect.Struct && !field.Type.Implements(reflect.TypeFor[attr.Value]())
+}
+
+// isPromotedThroughStructs returns whether the field at `index` is reached only through embedded structs.
+func isPromotedThroughStructs(typ reflect.Type, index []int) bool {
+	for i := range index[:len(index)-1] {
+		if !isEmbeddedStruct(typ.FieldByIndex(index[:i+1])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldNameFromTag returns the counterpart field name specified in
+// an `autoflex:"name=..."` struct tag, or "" if none is specified.
+func fieldNameFromTag(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get(autoFlexTagKey), ",") {
+		if name, ok := strings.CutPrefix(option, "name="); ok {
+			return name
+		}
+	}
+
+	return ""
 }
 
 // valueWithElementsAs extends the Value interface for values that have an ElementsAs method.
@@ -267,6 +267,30 @@ type TestFlexPluralityAWS01 struct {
 	Values string
 }
 
+type TestFlexFieldNameTagTF01 struct {
+	Name        types.String `tfsdk:"name"`
+	DisplayName types.String `tfsdk:"display_name" autoflex:"name=Description"`
+}
+type TestFlexFieldNameTagAWS01 struct {
+	Name        *string
+	DisplayName *string
+	Description *string
+}
+
+type TestFlexEmbeddedTF01 struct {
+	TestFlexEmbeddedTF02
+	Field1 types.String `tfsdk:"field1"`
+}
+type TestFlexEmbeddedTF02 struct {
+	Field2 types.Int64  `tfsdk:"field2"`
+	Field3 types.String `tfsdk:"field3" autoflex:"name=Field4"`
+}
+type TestFlexEmbeddedAWS01 struct {
+	Field1 *string
+	Field2 int64
+	Field4 *string
+}
+
 type TestFlexTF17 struct {
 	Field1 fwtypes.ARN `tfsdk:"field1"`
 }
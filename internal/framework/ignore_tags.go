@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// IgnoreTagsBlock returns the schema for a resource's ignore_tags block, which supplements any provider configured ignore_tags.
+// Resources opt in by adding the block to their schema and a `tftags.IgnoreTagsModel` list to their model.
+func IgnoreTagsBlock() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"key_prefixes": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"key_regexes": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.Set{
+						setvalidator.ValueStringsAre(fwvalidators.Regex()),
+					},
+				},
+				"keys": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+				},
+				"value_regexes": schema.SetAttribute{
+					ElementType: types.StringType,
+					Optional:    true,
+					Validators: []validator.Set{
+						setvalidator.ValueStringsAre(fwvalidators.Regex()),
+					},
+				},
+			},
+		},
+	}
+}
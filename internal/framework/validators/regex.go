@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// regexValidator validates that a string Attribute's value is a valid regular expression.
+type regexValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator regexValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator regexValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator regexValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	if valueString := configValue.ValueString(); !isValidRegex(valueString) {
+		response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			request.Path,
+			validator.Description(ctx),
+			valueString,
+		))
+		return
+	}
+}
+
+func isValidRegex(s string) bool {
+	_, err := regexp.Compile(s)
+
+	return err == nil
+}
+
+// Regex returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid regular expression.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func Regex() validator.String {
+	return regexValidator{}
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// WithPollInterval is intended to be embedded in resources which allow the interval at which
+// their waiters poll for state changes to be configured via the "poll_interval" attribute.
+// It complements WithTimeouts for slow-moving partitions and Regions.
+type WithPollInterval struct {
+	defaultPollInterval time.Duration
+}
+
+// SetDefaultPollInterval sets the resource's default waiter poll interval.
+// A zero value means that waiters use their own exponential backoff.
+func (w *WithPollInterval) SetDefaultPollInterval(interval time.Duration) {
+	w.defaultPollInterval = interval
+}
+
+// PollInterval returns any configured waiter poll interval or the default value.
+func (w *WithPollInterval) PollInterval(interval fwtypes.Duration) time.Duration {
+	if interval.IsNull() || interval.IsUnknown() {
+		return w.defaultPollInterval
+	}
+
+	if v := interval.ValueDuration(); v > 0 {
+		return v
+	}
+
+	return w.defaultPollInterval
+}
+
+// PollIntervalAttribute returns the schema for the standard "poll_interval" attribute.
+func PollIntervalAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		CustomType:  fwtypes.DurationType,
+		Optional:    true,
+		Description: "Interval at which to poll for resource state changes, for example \"30s\" or \"2m\".",
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+	"time"
+
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestWithPollInterval(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interval fwtypes.Duration
+		want     time.Duration
+	}{
+		"null": {
+			interval: fwtypes.DurationNull(),
+			want:     10 * time.Second,
+		},
+		"unknown": {
+			interval: fwtypes.DurationUnknown(),
+			want:     10 * time.Second,
+		},
+		"zero": {
+			interval: fwtypes.DurationValue("0s"),
+			want:     10 * time.Second,
+		},
+		"set": {
+			interval: fwtypes.DurationValue("90s"),
+			want:     90 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var w WithPollInterval
+			w.SetDefaultPollInterval(10 * time.Second)
+
+			if got, want := w.PollInterval(testCase.interval), testCase.want; got != want {
+				t.Errorf("PollInterval() = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -37,9 +38,10 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 	w.defaultDeleteTimeout = timeout
 }
 
-// CreateTimeout returns any configured Create timeout value or the default value.
+// CreateTimeout returns any configured Create timeout value or the (scaled) default value.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
+	defaultTimeout := scaleDefaultTimeout(ctx, w.defaultCreateTimeout)
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Create timeout", map[string]interface{}{
@@ -47,15 +49,16 @@ func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultCreateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
-// ReadTimeout returns any configured Read timeout value or the default value.
+// ReadTimeout returns any configured Read timeout value or the (scaled) default value.
 func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Read(ctx, w.defaultReadTimeout)
+	defaultTimeout := scaleDefaultTimeout(ctx, w.defaultReadTimeout)
+	timeout, diags := timeouts.Read(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Read timeout", map[string]interface{}{
@@ -63,15 +66,16 @@ func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value)
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultReadTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
-// UpdateTimeout returns any configured Update timeout value or the default value.
+// UpdateTimeout returns any configured Update timeout value or the (scaled) default value.
 func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Update(ctx, w.defaultUpdateTimeout)
+	defaultTimeout := scaleDefaultTimeout(ctx, w.defaultUpdateTimeout)
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Update timeout", map[string]interface{}{
@@ -79,15 +83,16 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultUpdateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
-// DeleteTimeout returns any configured Delete timeout value or the default value.
+// DeleteTimeout returns any configured Delete timeout value or the (scaled) default value.
 func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Delete(ctx, w.defaultDeleteTimeout)
+	defaultTimeout := scaleDefaultTimeout(ctx, w.defaultDeleteTimeout)
+	timeout, diags := timeouts.Delete(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Delete timeout", map[string]interface{}{
@@ -95,8 +100,13 @@ func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultDeleteTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
+
+// scaleDefaultTimeout multiplies a default timeout value by any timeouts_scale_factor in effect for the resource.
+func scaleDefaultTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	return tfresource.ScaleTimeout(timeout, tfresource.TimeoutsScaleFactor(ctx))
+}
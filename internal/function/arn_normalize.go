@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = arnNormalizeFunction{}
+
+func NewARNNormalizeFunction() function.Function {
+	return &arnNormalizeFunction{}
+}
+
+type arnNormalizeFunction struct{}
+
+func (f arnNormalizeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "arn_normalize"
+}
+
+func (f arnNormalizeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "arn_normalize Function",
+		MarkdownDescription: "Validates an ARN and replaces its partition with the partition containing the specified region. " +
+			"Returns an error if the ARN is invalid",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "arn",
+				MarkdownDescription: "ARN (Amazon Resource Name) to normalize",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f arnNormalizeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if region == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(1, "region must not be empty"))
+		return
+	}
+
+	parts, err := arn.Parse(arg)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	parts.Partition = names.PartitionForRegion(region)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, parts.String()))
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var _ function.Function = consoleURLFunction{}
+
+func NewConsoleURLFunction() function.Function {
+	return &consoleURLFunction{}
+}
+
+type consoleURLFunction struct{}
+
+func (f consoleURLFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "console_url"
+}
+
+func (f consoleURLFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "console_url Function",
+		MarkdownDescription: "Returns the AWS Management Console URL for a service in the partition containing the specified region",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "service",
+				MarkdownDescription: "Service console name, e.g. cloudwatch",
+			},
+			function.StringParameter{
+				Name:                "region",
+				MarkdownDescription: "Region code",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f consoleURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var service, region string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &service, &region))
+	if resp.Error != nil {
+		return
+	}
+
+	if service == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(0, "service must not be empty"))
+		return
+	}
+	if region == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(1, "region must not be empty"))
+		return
+	}
+
+	partition := names.PartitionForRegion(region)
+	hostname := consoleHostnameForPartition(partition)
+	if hostname == "" {
+		resp.Error = function.ConcatFuncErrors(function.NewArgumentFuncError(1, fmt.Sprintf("no public AWS Management Console for partition %s", partition)))
+		return
+	}
+
+	result := fmt.Sprintf("https://%s/%s/home?region=%s", hostname, url.PathEscape(service), url.QueryEscape(region))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// consoleHostnameForPartition returns the AWS Management Console hostname for the specified partition.
+// Partitions without a publicly reachable console return an empty string.
+func consoleHostnameForPartition(partition string) string {
+	switch partition {
+	case names.StandardPartitionID:
+		return "console.aws.amazon.com"
+	case names.ChinaPartitionID:
+		return "console.amazonaws.cn"
+	case names.USGovCloudPartitionID:
+		return "console.amazonaws-us-gov.com"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestConsoleURLFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testConsoleURLFunctionConfig("cloudwatch", "us-west-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://console.aws.amazon.com/cloudwatch/home?region=us-west-2"),
+				),
+			},
+			{
+				Config: testConsoleURLFunctionConfig("cloudwatch", "cn-north-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://console.amazonaws.cn/cloudwatch/home?region=cn-north-1"),
+				),
+			},
+			{
+				Config: testConsoleURLFunctionConfig("cloudwatch", "us-gov-west-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "https://console.amazonaws-us-gov.com/cloudwatch/home?region=us-gov-west-1"),
+				),
+			},
+		},
+	})
+}
+
+func TestConsoleURLFunction_unsupportedPartition(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testConsoleURLFunctionConfig("cloudwatch", "us-iso-east-1"),
+				ExpectError: regexache.MustCompile(`no[\s\n]*public[\s\n]*AWS[\s\n]*Management[\s\n]*Console`),
+			},
+		},
+	})
+}
+
+func testConsoleURLFunctionConfig(service, region string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::console_url(%[1]q, %[2]q)
+}`, service, region)
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfilters
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// NameRegexFilterName is the name of the filter whose values are regular expressions
+// matched client-side against the name of each resource returned by the AWS API.
+const NameRegexFilterName = "name-regex"
+
+// ClientSideFilters are filters that are not sent to the AWS API but are instead
+// evaluated by the provider against each resource returned by the API call.
+// A nil *ClientSideFilters matches every resource.
+type ClientSideFilters struct {
+	nameRegexes        []*regexp.Regexp
+	negatedNameRegexes []*regexp.Regexp
+	negated            map[string][]string
+}
+
+// Split separates the set of filters described by Schema() into the filters
+// that are sent to the AWS API and the filters that are evaluated client-side.
+// "name-regex" filters and filters with negate set are evaluated client-side.
+func Split(set *schema.Set) (NameValuesFilters, *ClientSideFilters, error) {
+	filters := make(NameValuesFilters)
+	clientSide := &ClientSideFilters{
+		negated: make(map[string][]string),
+	}
+	empty := true
+
+	for _, filter := range set.List() {
+		m := filter.(map[string]interface{})
+		name := m["name"].(string)
+		negate, _ := m["negate"].(bool)
+
+		var values []string
+		for _, v := range m["values"].(*schema.Set).List() {
+			if v := v.(string); v != "" {
+				values = append(values, v)
+			}
+		}
+
+		switch {
+		case name == NameRegexFilterName:
+			for _, v := range values {
+				re, err := regexp.Compile(v)
+
+				if err != nil {
+					return nil, nil, fmt.Errorf("%s filter value (%s): %w", NameRegexFilterName, v, err)
+				}
+
+				if negate {
+					clientSide.negatedNameRegexes = append(clientSide.negatedNameRegexes, re)
+				} else {
+					clientSide.nameRegexes = append(clientSide.nameRegexes, re)
+				}
+			}
+			empty = false
+
+		case negate:
+			clientSide.negated[name] = append(clientSide.negated[name], values...)
+			empty = false
+
+		default:
+			filters.Add(map[string][]string{name: values})
+		}
+	}
+
+	if empty {
+		clientSide = nil
+	}
+
+	return filters, clientSide, nil
+}
+
+// Match returns whether a resource with the specified name and filterable attribute values
+// passes all client-side filters.
+// The name must match at least one "name-regex" value and none of the negated "name-regex" values.
+// For each negated filter, none of the resource's values for the filter's name may equal any of the filter's values.
+// A negated "name" filter is evaluated against the resource name unless attributes contains "name".
+// Resources that have no values for a negated filter's name pass that filter.
+func (filters *ClientSideFilters) Match(name string, attributes map[string][]string) bool {
+	if filters == nil {
+		return true
+	}
+
+	if len(filters.nameRegexes) > 0 {
+		var matched bool
+
+		for _, re := range filters.nameRegexes {
+			if re.MatchString(name) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false
+		}
+	}
+
+	for _, re := range filters.negatedNameRegexes {
+		if re.MatchString(name) {
+			return false
+		}
+	}
+
+	for k, vs := range filters.negated {
+		attributeValues := attributes[k]
+		if k == "name" && attributeValues == nil {
+			attributeValues = []string{name}
+		}
+
+		for _, attribute := range attributeValues {
+			for _, v := range vs {
+				if attribute == v {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfiltersv2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
+)
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	set := schema.NewSet(testNameValuesFiltersHashSet, []interface{}{
+		map[string]interface{}{
+			"name":   "platform",
+			"values": schema.NewSet(schema.HashString, []interface{}{"Linux"}),
+		},
+		map[string]interface{}{
+			"name":   namevaluesfiltersv2.NameRegexFilterName,
+			"values": schema.NewSet(schema.HashString, []interface{}{"^prod-"}),
+		},
+		map[string]interface{}{
+			"name":   "owner",
+			"negate": true,
+			"values": schema.NewSet(schema.HashString, []interface{}{"Amazon"}),
+		},
+	})
+
+	filters, clientSide, err := namevaluesfiltersv2.Split(set)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testNameValuesFiltersVerifyMap(t, filters.Map(), map[string][]string{
+		"platform": {"Linux"},
+	})
+
+	if clientSide == nil {
+		t.Fatal("expected client-side filters")
+	}
+}
+
+func TestSplit_serverSideOnly(t *testing.T) {
+	t.Parallel()
+
+	set := schema.NewSet(testNameValuesFiltersHashSet, []interface{}{
+		map[string]interface{}{
+			"name":   "platform",
+			"negate": false,
+			"values": schema.NewSet(schema.HashString, []interface{}{"Linux"}),
+		},
+	})
+
+	filters, clientSide, err := namevaluesfiltersv2.Split(set)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testNameValuesFiltersVerifyMap(t, filters.Map(), map[string][]string{
+		"platform": {"Linux"},
+	})
+
+	if clientSide != nil {
+		t.Errorf("expected no client-side filters, got %#v", clientSide)
+	}
+
+	// A nil *ClientSideFilters matches everything.
+	if !clientSide.Match("anything", nil) {
+		t.Error("expected nil client-side filters to match")
+	}
+}
+
+func TestSplit_invalidRegex(t *testing.T) {
+	t.Parallel()
+
+	set := schema.NewSet(testNameValuesFiltersHashSet, []interface{}{
+		map[string]interface{}{
+			"name":   namevaluesfiltersv2.NameRegexFilterName,
+			"values": schema.NewSet(schema.HashString, []interface{}{"("}),
+		},
+	})
+
+	if _, _, err := namevaluesfiltersv2.Split(set); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestClientSideFiltersMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		filters    []interface{}
+		objectName string
+		attributes map[string][]string
+		want       bool
+	}{
+		{
+			name: "name_regex_match",
+			filters: []interface{}{
+				map[string]interface{}{
+					"name":   namevaluesfiltersv2.NameRegexFilterName,
+					"values": schema.NewSet(schema.HashString, []interface{}{"^prod-", "^stage-"}),
+				},
+			},
+			objectName: "stage-web",
+			want:       true,
+		},
+		{
+			name: "name_regex_no_match",
+			filters: []interface{}{
+				map[string]interface{}{
+					"name":   namevaluesfiltersv2.NameRegexFilterName,
+					"values": schema.NewSet(schema.HashString, []interface{}{"^prod-"}),
+				},
+			},
+			objectName: "dev-web",
+			want:       false,
+		},
+		{
+			name: "negated_name_regex",
+			filters: []interface{}{
+				map[string]interface{}{
+					"name":   namevaluesfiltersv2.NameRegexFilterName,
+					"negate": true,
+					"values": schema.NewSet(schema.HashString, []interface{}{"-test$"}),
+				},
+			},
+			objectName: "prod-test",
+			want:       false,
+		},
+		{
+			name: "negated_value_match",
+			filters: []interface{}{
+				map[string]interface{}{
+					"name":   "platform",
+					"negate": true,
+					"values": schema.NewSet(schema.HashString, []interface{}{"Windows"}),
+				},
+			},
+			objectName: "web",
+			attributes: map[string][]string{
+				"platform": {"Windows"},
+			},
+			want: false,
+		},
+		{
+			name: "negated_value_no_match",
+			filters: []interface{}{
+				map[string]interface{}{
+					"name":   "platform",
+					"negate": true,
+					"values": schema.NewSet(schema.HashString, []interface{}{"Windows"}),
+				},
+			},
+			objectName: "web",
+			attributes: map[string][]string{
+				"platform": {"Linux"},
+			},
+			want: true,
+		},
+		{
+			name: "negated_name",
+			filters: []interface{}{
+				map[string]interface{}{
+					"name":   "name",
+					"negate": true,
+					"values": schema.NewSet(schema.HashString, []interface{}{"web"}),
+				},
+			},
+			objectName: "web",
+			want:       false,
+		},
+		{
+			name: "negated_value_missing_attribute",
+			filters: []interface{}{
+				map[string]interface{}{
+					"name":   "platform",
+					"negate": true,
+					"values": schema.NewSet(schema.HashString, []interface{}{"Windows"}),
+				},
+			},
+			objectName: "web",
+			want:       true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, clientSide, err := namevaluesfiltersv2.Split(schema.NewSet(testNameValuesFiltersHashSet, testCase.filters))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := clientSide.Match(testCase.objectName, testCase.attributes), testCase.want; got != want {
+				t.Errorf("got %t; want %t", got, want)
+			}
+		})
+	}
+}
@@ -104,6 +104,7 @@ func New(i interface{}) NameValuesFilters {
 // Schema returns a *schema.Schema that represents a set of custom filtering criteria
 // that a user can specify as input to a data source.
 // It is conventional for an attribute of this type to be included as a top-level attribute called "filter".
+// Data sources that support client-side filtering pass the set to Split instead of New.
 func Schema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -115,6 +116,11 @@ func Schema() *schema.Schema {
 					Required: true,
 				},
 
+				"negate": {
+					Type:     schema.TypeBool,
+					Optional: true,
+				},
+
 				"values": {
 					Type:     schema.TypeSet,
 					Required: true,
@@ -0,0 +1,370 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+)
+
+var (
+	arnElem             = flag.String("ARNElem", "", "name of the list item field containing the resource ARN")
+	humanName           = flag.String("HumanName", "", "human friendly plural name of the resources, e.g. Vaults")
+	listMissing         = flag.Bool("ListMissing", false, "whether to list tagged resources without a PluralDataSource annotation")
+	listOp              = flag.String("ListOp", "", "name of the AWS SDK for Go v2 list operation, e.g. ListVaults")
+	listOpOutputElem    = flag.String("ListOpOutputElem", "", "name of the list operation output field containing the list items")
+	nameElem            = flag.String("NameElem", "", "name of the list item field containing the resource name")
+	namePrefixElem      = flag.String("NamePrefixElem", "", "name of the list operation input field filtering by name prefix server-side")
+	parentAttr          = flag.String("ParentAttr", "", "name of the required data source argument identifying the parent resource")
+	parentElem          = flag.String("ParentElem", "", "name of the list operation input field identifying the parent resource")
+	tags                = flag.Bool("Tags", false, "whether to generate a tags filter")
+	taggingResourceType = flag.String("TaggingResourceType", "", "Resource Groups Tagging API resource type filter used for the tags filter, e.g. glacier")
+	typeName            = flag.String("TypeName", "", "Terraform type name of the data source, e.g. aws_glacier_vaults")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags] [<generated-data-source-file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	AWSServiceUpper string
+	ClientMethod    string
+	DataSourceName  string
+	FactoryName     string
+	HumanName       string
+	SDKPackage      string
+	ServicePackage  string
+	TypeName        string
+
+	ARNElem             string
+	ListOp              string
+	ListOpOutputElem    string
+	NameElem            string
+	NamePrefixElem      string
+	ParentAttr          string
+	ParentAttrName      string
+	ParentElem          string
+	Tags                bool
+	TaggingResourceType string
+}
+
+// DataSourceDatum describes a single plural data source.
+type DataSourceDatum struct {
+	TypeName  string
+	HumanName string
+
+	ARNElem             string
+	ListOp              string
+	ListOpOutputElem    string
+	NameElem            string
+	NamePrefixElem      string
+	ParentAttr          string
+	ParentElem          string
+	Tags                bool
+	TaggingResourceType string
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	// Without a type name, generate a data source for each PluralDataSource annotation in the service package.
+	if *typeName == "" {
+		v := &visitor{g: g}
+
+		v.processDir(".")
+
+		for _, err := range v.errs {
+			g.Errorf("%s", err)
+		}
+		if len(v.errs) > 0 {
+			os.Exit(1)
+		}
+
+		if *listMissing {
+			for _, typeName := range v.missing {
+				g.Infof("%s is tagged but has no PluralDataSource annotation", typeName)
+			}
+		}
+
+		for _, d := range v.dataSources {
+			generate(g, servicePackage, d, "")
+		}
+
+		return
+	}
+
+	if *humanName == "" || *listOp == "" || *listOpOutputElem == "" || *nameElem == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var filename string
+	if args := flag.Args(); len(args) > 0 {
+		filename = args[0]
+	}
+
+	generate(g, servicePackage, DataSourceDatum{
+		TypeName:            *typeName,
+		HumanName:           *humanName,
+		ARNElem:             *arnElem,
+		ListOp:              *listOp,
+		ListOpOutputElem:    *listOpOutputElem,
+		NameElem:            *nameElem,
+		NamePrefixElem:      *namePrefixElem,
+		ParentAttr:          *parentAttr,
+		ParentElem:          *parentElem,
+		Tags:                *tags,
+		TaggingResourceType: *taggingResourceType,
+	}, filename)
+}
+
+func generate(g *common.Generator, servicePackage string, d DataSourceDatum, filename string) {
+	if (d.ParentAttr == "") != (d.ParentElem == "") {
+		g.Fatalf("%s: both ParentAttr and ParentElem must be specified if one is", d.TypeName)
+	}
+
+	if d.Tags && (d.ARNElem == "" || d.TaggingResourceType == "") {
+		g.Fatalf("%s: both ARNElem and TaggingResourceType must be specified with Tags", d.TypeName)
+	}
+
+	u, err := names.ProviderNameUpper(servicePackage)
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	sdkPackage, err := names.AWSGoV2Package(servicePackage)
+	if err != nil {
+		g.Fatalf("encountered: %s", err)
+	}
+
+	if filename == "" {
+		resourceName, ok := strings.CutPrefix(d.TypeName, fmt.Sprintf("aws_%s_", servicePackage))
+		if !ok {
+			// The type name's service prefix differs from the service package name, e.g. aws_msk_clusters in kafka.
+			resourceName = strings.ToLower(strings.ReplaceAll(d.HumanName, " ", "_"))
+		}
+		filename = fmt.Sprintf("%s_data_source_gen.go", resourceName)
+	}
+
+	goName := strings.ReplaceAll(d.HumanName, " ", "")
+	dataSourceName := strings.ToLower(goName[:1]) + goName[1:] + "DataSource"
+
+	templateData := TemplateData{
+		AWSServiceUpper: u,
+		ClientMethod:    fmt.Sprintf("%sClient", u),
+		DataSourceName:  dataSourceName,
+		FactoryName:     fmt.Sprintf("new%sDataSource", goName),
+		HumanName:       d.HumanName,
+		SDKPackage:      sdkPackage,
+		ServicePackage:  servicePackage,
+		TypeName:        d.TypeName,
+
+		ARNElem:             d.ARNElem,
+		ListOp:              d.ListOp,
+		ListOpOutputElem:    d.ListOpOutputElem,
+		NameElem:            d.NameElem,
+		NamePrefixElem:      d.NamePrefixElem,
+		ParentAttr:          namesgen.ConstOrQuote(d.ParentAttr),
+		ParentAttrName:      d.ParentAttr,
+		ParentElem:          d.ParentElem,
+		Tags:                d.Tags,
+		TaggingResourceType: d.TaggingResourceType,
+	}
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+	dest := g.NewGoFileDestination(filename)
+
+	if err := dest.WriteTemplate("pluraldatasource", dataSourceTemplateBody, templateData); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := dest.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// pluralize returns the plural of a friendly resource name, e.g. "Vaults" for "Vault" or "Firewall Policies" for "Firewall Policy".
+func pluralize(s string) string {
+	switch {
+	case strings.HasSuffix(s, "y") && !strings.HasSuffix(s, "ay") && !strings.HasSuffix(s, "ey") && !strings.HasSuffix(s, "oy"):
+		return strings.TrimSuffix(s, "y") + "ies"
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	default:
+		return s + "s"
+	}
+}
+
+// Annotation processing.
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\(([^)]*)\))?\s*$`)
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	dataSources []DataSourceDatum
+	missing     []string
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			ast.Walk(v, file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+
+	sort.Slice(v.dataSources, func(i, j int) bool {
+		return v.dataSources[i].TypeName < v.dataSources[j].TypeName
+	})
+	sort.Strings(v.missing)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for a PluralDataSource annotation on a resource factory.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	var (
+		resourceName, resourceTypeName string
+		tagged                         bool
+		pluralArgs                     *common.Args
+	)
+
+	for _, line := range funcDecl.Doc.List {
+		m := annotation.FindStringSubmatch(line.Text)
+		if len(m) == 0 {
+			continue
+		}
+
+		args := common.ParseArgs(m[3])
+
+		switch m[1] {
+		case "FrameworkResource", "SDKResource":
+			resourceName = args.Keyword["name"]
+			if len(args.Positional) > 0 {
+				resourceTypeName = args.Positional[0]
+			}
+		case "PluralDataSource":
+			pluralArgs = &args
+		case "Tags":
+			tagged = true
+		}
+	}
+
+	if pluralArgs == nil {
+		if tagged && resourceTypeName != "" {
+			v.missing = append(v.missing, resourceTypeName)
+		}
+
+		v.functionName = ""
+
+		return
+	}
+
+	id := fmt.Sprintf("%s.%s", v.packageName, v.functionName)
+
+	if len(pluralArgs.Positional) == 0 {
+		v.errs = append(v.errs, fmt.Errorf("no type name: %s", id))
+		return
+	}
+
+	d := DataSourceDatum{
+		TypeName:            pluralArgs.Positional[0],
+		HumanName:           pluralArgs.Keyword["name"],
+		ARNElem:             pluralArgs.Keyword["arnElem"],
+		ListOp:              pluralArgs.Keyword["listOp"],
+		ListOpOutputElem:    pluralArgs.Keyword["listOpOutputElem"],
+		NameElem:            pluralArgs.Keyword["nameElem"],
+		NamePrefixElem:      pluralArgs.Keyword["namePrefixElem"],
+		ParentAttr:          pluralArgs.Keyword["parentAttr"],
+		ParentElem:          pluralArgs.Keyword["parentElem"],
+		TaggingResourceType: pluralArgs.Keyword["taggingResourceType"],
+	}
+
+	if d.HumanName == "" {
+		if resourceName == "" {
+			v.errs = append(v.errs, fmt.Errorf("no name for %s: %s", d.TypeName, id))
+			return
+		}
+
+		d.HumanName = pluralize(resourceName)
+	}
+
+	if d.ListOp == "" || d.ListOpOutputElem == "" || d.NameElem == "" {
+		v.errs = append(v.errs, fmt.Errorf("listOp, listOpOutputElem and nameElem must be specified for %s: %s", d.TypeName, id))
+		return
+	}
+
+	// Tagged resources get a tags filter when their list items can be matched to tagging results.
+	d.Tags = tagged && d.ARNElem != "" && d.TaggingResourceType != ""
+
+	v.dataSources = append(v.dataSources, d)
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+//go:embed datasource.tmpl
+var dataSourceTemplateBody string
@@ -16,6 +16,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -125,6 +126,9 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	TagsVerifyAfterWrite    bool
+	TagsResourceIgnoreTags  bool
+	SkipDestroy             bool
 }
 
 type ServiceDatum struct {
@@ -206,12 +210,16 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging and skip_destroy annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "SkipDestroy" {
+			d.SkipDestroy = true
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -228,6 +236,22 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 			if attr, ok := args.Keyword["resourceType"]; ok {
 				d.TagsResourceType = attr
 			}
+
+			if attr, ok := args.Keyword["verifyAfterWrite"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid verifyAfterWrite value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.TagsVerifyAfterWrite = b
+				}
+			}
+
+			if attr, ok := args.Keyword["resourceIgnoreTags"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid resourceIgnoreTags value (%s): %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+				} else {
+					d.TagsResourceIgnoreTags = b
+				}
+			}
 		}
 	}
 
@@ -245,12 +269,22 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 
 			switch annotationName := m[1]; annotationName {
 			case "FrameworkDataSource":
+				if d.TagsResourceIgnoreTags {
+					v.errs = append(v.errs, fmt.Errorf("resourceIgnoreTags not supported for Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if slices.ContainsFunc(v.frameworkDataSources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
 					v.frameworkDataSources = append(v.frameworkDataSources, d)
 				}
 			case "FrameworkResource":
+				if d.SkipDestroy {
+					v.errs = append(v.errs, fmt.Errorf("SkipDestroy annotation not supported for Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				if slices.ContainsFunc(v.frameworkResources, func(d ResourceDatum) bool { return d.FactoryName == v.functionName }) {
 					v.errs = append(v.errs, fmt.Errorf("duplicate Framework Resource: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
 				} else {
@@ -262,6 +296,11 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 					continue
 				}
 
+				if d.TagsResourceIgnoreTags {
+					v.errs = append(v.errs, fmt.Errorf("resourceIgnoreTags not supported for SDK Data Source: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+
 				typeName := args.Positional[0]
 
 				if _, ok := v.sdkDataSources[typeName]; ok {
@@ -282,6 +321,10 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
+			case "PluralDataSource":
+				// Handled by internal/generate/pluraldatasource/main.go.
+			case "SkipDestroy":
+				// Handled above.
 			case "Tags":
 				// Handled above.
 			case "Testing":
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// ImportStateByARNOrID imports a Plugin Framework resource's state via the "id" attribute, accepting either the resource's ID or its ARN.
+// An ARN is converted to the resource's ID using the specified function.
+func ImportStateByARNOrID(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse, f ARNToIDFunc) {
+	id, err := ResolveID(request.ID, f)
+
+	if err != nil {
+		response.Diagnostics.AddError("Resolving import ID", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+}
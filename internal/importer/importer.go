@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// ARNToIDFunc returns a resource's ID given its ARN.
+// It is used to reconstruct the composite ID of resources which can be imported using their ARN.
+type ARNToIDFunc func(arn.ARN) (string, error)
+
+// ResolveID returns the resource ID for an import ID which is either the resource's ID or its ARN.
+// Import IDs which are not ARNs are returned unchanged.
+func ResolveID(importID string, f ARNToIDFunc) (string, error) {
+	if !arn.IsARN(importID) {
+		return importID, nil
+	}
+
+	v, err := arn.Parse(importID)
+
+	if err != nil {
+		return "", err
+	}
+
+	id, err := f(v)
+
+	if err != nil {
+		return "", fmt.Errorf("resolving ID from ARN (%s): %w", importID, err)
+	}
+
+	return id, nil
+}
+
+// ARNResourceParts returns the "/"-delimited parts of an ARN's resource following the specified resource type.
+// For example, the parts of "arn:aws:sso:::permissionSet/ssoins-1234/ps-5678" with resource type "permissionSet" are "ssoins-1234" and "ps-5678".
+func ARNResourceParts(v arn.ARN, resourceType string, partCount int) ([]string, error) {
+	parts := strings.Split(v.Resource, "/")
+
+	if parts[0] != resourceType {
+		return nil, fmt.Errorf("unexpected resource type (%s), expected %s", parts[0], resourceType)
+	}
+
+	parts = parts[1:]
+
+	if len(parts) != partCount {
+		return nil, fmt.Errorf("unexpected format for ARN resource (%s), expected %s followed by (%d) parts separated by (/)", v.Resource, resourceType, partCount)
+	}
+
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format for ARN resource (%s), blank part", v.Resource)
+		}
+	}
+
+	return parts, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestResolveID(t *testing.T) {
+	t.Parallel()
+
+	f := func(v arn.ARN) (string, error) {
+		parts, err := ARNResourceParts(v, "namespace", 1)
+
+		if err != nil {
+			return "", err
+		}
+
+		return v.AccountID + "," + parts[0], nil
+	}
+
+	testCases := map[string]struct {
+		importID    string
+		want        string
+		expectError bool
+	}{
+		"id": {
+			importID: "123456789012,default",
+			want:     "123456789012,default",
+		},
+		"arn": {
+			importID: "arn:aws:quicksight:us-west-2:123456789012:namespace/default", //lintignore:AWSAT003,AWSAT005
+			want:     "123456789012,default",
+		},
+		"invalid arn": {
+			importID:    "arn:aws:quicksight:us-west-2:123456789012:dashboard/default", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ResolveID(testCase.importID, f)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ResolveID(%s) err %t, want %t", testCase.importID, got, want)
+			}
+
+			if got, want := got, testCase.want; got != want {
+				t.Errorf("ResolveID(%s) = %s, want %s", testCase.importID, got, want)
+			}
+		})
+	}
+}
+
+func TestARNResourceParts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resource     string
+		resourceType string
+		partCount    int
+		want         []string
+		wantErr      string
+	}{
+		"valid": {
+			resource:     "permissionSet/ssoins-1234/ps-5678",
+			resourceType: "permissionSet",
+			partCount:    2,
+			want:         []string{"ssoins-1234", "ps-5678"},
+		},
+		"wrong resource type": {
+			resource:     "instance/ssoins-1234",
+			resourceType: "permissionSet",
+			partCount:    2,
+			wantErr:      "unexpected resource type",
+		},
+		"wrong part count": {
+			resource:     "permissionSet/ssoins-1234",
+			resourceType: "permissionSet",
+			partCount:    2,
+			wantErr:      "expected permissionSet followed by (2) parts",
+		},
+		"blank part": {
+			resource:     "permissionSet//ps-5678",
+			resourceType: "permissionSet",
+			partCount:    2,
+			wantErr:      "blank part",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ARNResourceParts(arn.ARN{Resource: testCase.resource}, testCase.resourceType, testCase.partCount)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("ARNResourceParts(%s) err = %v, want containing %q", testCase.resource, err, testCase.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("ARNResourceParts(%s) unexpected error: %s", testCase.resource, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
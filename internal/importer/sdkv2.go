@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package importer
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// ImportByARNOrIDContext returns a Plugin SDK V2 importer StateContext function which accepts either the resource's ID or its ARN.
+// An ARN is converted to the resource's ID using the specified function.
+func ImportByARNOrIDContext(f ARNToIDFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		id, err := ResolveID(d.Id(), f)
+
+		if err != nil {
+			return nil, err
+		}
+
+		d.SetId(id)
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
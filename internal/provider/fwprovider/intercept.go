@@ -255,14 +255,48 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// resourceIgnoreTags is set if the resource supports resource-level ignore_tags.
+	resourceIgnoreTags bool
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, resourceIgnoreTags bool) resource.ResourceWithConfigure {
 	return &wrappedResource{
-		bootstrapContext: bootstrapContext,
-		inner:            inner,
-		interceptors:     interceptors,
+		bootstrapContext:   bootstrapContext,
+		inner:              inner,
+		interceptors:       interceptors,
+		resourceIgnoreTags: resourceIgnoreTags,
+	}
+}
+
+// withResourceIgnoreTags supplements the provider's ignore_tags in Context with any resource-level ignore_tags.
+// This is the only place in which resource-level ignore_tags are merged for Terraform Plugin Framework resources.
+func (w *wrappedResource) withResourceIgnoreTags(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !w.resourceIgnoreTags {
+		return diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return diags
+	}
+
+	var ignoreTags fwtypes.List
+	diags.Append(getAttribute(ctx, path.Root("ignore_tags"), &ignoreTags)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ignoreConfig, d := tftags.ResourceIgnoreConfigFramework(ctx, tagsInContext.IgnoreConfig, ignoreTags)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
 	}
+
+	tagsInContext.IgnoreConfig = ignoreConfig
+
+	return diags
 }
 
 func (w *wrappedResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -281,6 +315,10 @@ func (w *wrappedResource) Create(ctx context.Context, request resource.CreateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	response.Diagnostics.Append(w.withResourceIgnoreTags(ctx, request.Plan.GetAttribute)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	diags := interceptedResourceHandler(w.interceptors.create(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -291,6 +329,14 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	// Finders may reuse recent API operation results when refreshing resources.
+	if w.meta != nil {
+		ctx = conns.NewDescribeCacheContext(ctx, w.meta)
+	}
+	response.Diagnostics.Append(w.withResourceIgnoreTags(ctx, request.State.GetAttribute)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	diags := interceptedResourceHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -301,6 +347,10 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	response.Diagnostics.Append(w.withResourceIgnoreTags(ctx, request.Plan.GetAttribute)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
 	diags := interceptedResourceHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -340,6 +390,12 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+		if !request.Plan.Raw.IsNull() {
+			response.Diagnostics.Append(w.withResourceIgnoreTags(ctx, request.Plan.GetAttribute)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
 		v.ModifyPlan(ctx, request, response)
 	}
 }
@@ -425,6 +481,11 @@ func (r tagsResourceInterceptor) create(ctx context.Context, request resource.Cr
 		if diags.HasError() {
 			return ctx, diags
 		}
+
+		// If the resource has opted in, wait for the written tags to be consistently readable.
+		if r.tags.VerifyAfterWrite && !response.State.Raw.IsNull() {
+			ctx, diags = r.verify(ctx, response.State.GetAttribute, tftags.New(ctx, map[string]string{}), meta, diags)
+		}
 	}
 
 	return ctx, diags
@@ -645,6 +706,112 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 			}
 			// TODO If the only change was to tags it would be nice to not call the resource's U handler.
 		}
+	case After:
+		// If the resource has opted in, wait for any written tags to be consistently readable.
+		if r.tags.VerifyAfterWrite && !response.State.Raw.IsNull() {
+			var oldTagsAll fwtypes.Map
+
+			diags.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTagsAll), &oldTagsAll)...)
+
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			ctx, diags = r.verify(ctx, response.State.GetAttribute, tftags.New(ctx, oldTagsAll), meta, diags)
+		}
+	}
+
+	return ctx, diags
+}
+
+// verify waits for the tags in Context to be consistently readable from the service API.
+func (r tagsResourceInterceptor) verify(ctx context.Context, getAttribute func(context.Context, path.Path, any) diag.Diagnostics, oldTagsAll tftags.KeyValueTags, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	sp, ok := meta.ServicePackages[inContext.ServicePackageName]
+	if !ok {
+		return ctx, diags
+	}
+
+	serviceName, err := names.HumanFriendly(inContext.ServicePackageName)
+	if err != nil {
+		serviceName = "<service>"
+	}
+
+	resourceName := inContext.ResourceName
+	if resourceName == "" {
+		resourceName = "<thing>"
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok || tagsInContext.TagsIn.IsNone() {
+		return ctx, diags
+	}
+
+	identifierAttribute := r.tags.IdentifierAttribute
+	if identifierAttribute == "" {
+		return ctx, diags
+	}
+
+	var identifier string
+
+	diags.Append(getAttribute(ctx, path.Root(identifierAttribute), &identifier)...)
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	// Some old resources may not have the required attribute set after Read:
+	// https://github.com/hashicorp/terraform-provider-aws/issues/31180
+	if identifier == "" {
+		return ctx, diags
+	}
+
+	var listTags func(context.Context) error
+	if v, ok := sp.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		listTags = func(ctx context.Context) error {
+			return v.ListTags(ctx, meta, identifier) // Sets tags in Context
+		}
+	} else if v, ok := sp.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && r.tags.ResourceType != "" {
+		listTags = func(ctx context.Context) error {
+			return v.ListTags(ctx, meta, identifier, r.tags.ResourceType) // Sets tags in Context
+		}
+	} else {
+		tflog.Warn(ctx, "No ListTags method found", map[string]interface{}{
+			"ServicePackage": sp.ServicePackageName(),
+			"ResourceType":   r.tags.ResourceType,
+		})
+
+		return ctx, diags
+	}
+
+	want := tagsInContext.TagsIn.MustUnwrap().IgnoreConfig(tagsInContext.IgnoreConfig)
+	removed := oldTagsAll.Removed(want).IgnoreConfig(tagsInContext.IgnoreConfig)
+
+	err = tftags.WaitTagsPropagated(ctx, tftags.PropagationTimeout, want, removed, func(ctx context.Context) (tftags.KeyValueTags, error) {
+		if err := listTags(ctx); err != nil {
+			return nil, err
+		}
+
+		return tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig), nil
+	})
+
+	// ISO partitions may not support tagging, giving error.
+	if errs.IsUnsupportedOperationInPartitionError(meta.Partition, err) {
+		return ctx, diags
+	}
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("waiting for tags for %s %s (%s) to propagate", serviceName, resourceName, identifier), err.Error())
+
+		return ctx, diags
 	}
 
 	return ctx, diags
@@ -8,7 +8,10 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -19,8 +22,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -60,6 +65,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"ec2_dry_run_on_plan": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Perform EC2 API calls with DryRun set during plan for supported resources, reporting authorization and parameter errors as plan-time diagnostics.",
+			},
 			"ec2_metadata_service_endpoint": schema.StringAttribute{
 				Optional:    true,
 				Description: "Address of the EC2 metadata service endpoint to use. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT` environment variable.",
@@ -148,6 +157,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"timeouts_scale_factor": schema.Float64Attribute{
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(1),
+				},
+				Description: "The factor by which the default Create, Read, Update and Delete timeouts of all resources are multiplied. " +
+					"Timeouts configured in a resource's `timeouts` block are not scaled.",
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -156,6 +173,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The capacity of the AWS SDK's token bucket rate limiter.",
 			},
+			"trace_api_calls": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Emit an OpenTelemetry span for each AWS API call, recording the service, operation, retry count, throttle count and latency.",
+			},
 			"use_dualstack_endpoint": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Resolve an endpoint with DualStack capability",
@@ -259,6 +280,16 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"exclude_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types to which default tags are not applied",
+						},
+						"exclude_services": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Services to whose resources default tags are not applied",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -280,6 +311,22 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 							Optional:    true,
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(fwvalidators.Regex()),
+							},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"value_regexes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.ValueStringsAre(fwvalidators.Regex()),
+							},
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
 						"keys": schema.SetAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -288,6 +335,7 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"service_retries": serviceRetriesBlock(),
 		},
 	}
 }
@@ -335,7 +383,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -406,13 +454,15 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig.ForResource(servicePackageName, typeName), meta.IgnoreTagsConfig)
+					ctx = tfresource.WithTimeoutsScaleFactor(ctx, meta.TimeoutsScaleFactor(ctx, servicePackageName))
 					ctx = meta.RegisterLogger(ctx)
 				}
 
 				return ctx
 			}
 			interceptors := resourceInterceptors{}
+			resourceIgnoreTags := false
 
 			if v.Tags != nil {
 				// The resource has opted in to transparent tagging.
@@ -440,10 +490,21 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				}
 
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
+
+				if v.Tags.ResourceIgnoreTags {
+					// The resource has opted in to resource-level ignore_tags.
+					// Ensure that the schema includes the block.
+					if _, ok := schemaResponse.Schema.Blocks["ignore_tags"]; !ok {
+						errs = append(errs, fmt.Errorf("no `ignore_tags` block defined in schema: %s", typeName))
+						continue
+					}
+
+					resourceIgnoreTags = true
+				}
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, resourceIgnoreTags)
 			})
 		}
 	}
@@ -465,11 +526,66 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
+		tffunction.NewARNNormalizeFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewConsoleURLFunction,
+		tffunction.NewServicePrincipalFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
 
+func serviceRetriesBlock() schema.ListNestedBlock {
+	serviceRetryBlock := schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		Description: "Use this to override the provider-level retry settings for the service",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"adaptive_rate_limiting": schema.BoolAttribute{
+					Optional: true,
+					Description: "Whether to apply client-side rate limiting to the service's API requests, " +
+						"as with `retry_mode = \"adaptive\"`. Only supported for services using AWS SDK for Go v2.",
+				},
+				"max_attempts": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+					Description: "The maximum number of times an API request to the service is attempted. Overrides `max_retries`.",
+				},
+				"max_backoff": schema.StringAttribute{
+					CustomType:  fwtypes.DurationType,
+					Optional:    true,
+					Description: "The maximum delay between attempts of an API request to the service, e.g. `30s`.",
+				},
+				"timeouts_scale_factor": schema.Float64Attribute{
+					Optional: true,
+					Validators: []validator.Float64{
+						float64validator.AtLeast(1),
+					},
+					Description: "The factor by which the default operation timeouts of the service's resources are multiplied. Overrides `timeouts_scale_factor`.",
+				},
+			},
+		},
+	}
+
+	serviceRetriesBlocks := make(map[string]schema.Block)
+
+	for _, serviceKey := range names.ProviderPackages() {
+		serviceRetriesBlocks[serviceKey] = serviceRetryBlock
+	}
+
+	return schema.ListNestedBlock{
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: serviceRetriesBlocks,
+		},
+	}
+}
+
 func endpointsBlock() schema.SetNestedBlock {
 	endpointsAttributes := make(map[string]schema.Attribute)
 
@@ -480,6 +596,17 @@ func endpointsBlock() schema.SetNestedBlock {
 		}
 	}
 
+	endpointsAttributes["use_dualstack_endpoint"] = schema.MapAttribute{
+		ElementType: types.BoolType,
+		Optional:    true,
+		Description: "Per-service overrides of `use_dualstack_endpoint`, keyed by service name",
+	}
+	endpointsAttributes["use_fips_endpoint"] = schema.MapAttribute{
+		ElementType: types.BoolType,
+		Optional:    true,
+		Description: "Per-service overrides of `use_fips_endpoint`, keyed by service name",
+	}
+
 	return schema.SetNestedBlock{
 		NestedObject: schema.NestedBlockObject{
 			Attributes: endpointsAttributes,
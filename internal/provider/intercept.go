@@ -153,6 +153,10 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// resourceIgnoreTags is set if the resource supports resource-level ignore_tags.
+	resourceIgnoreTags bool
+	// skipDestroy is set if the resource supports the standardized skip_destroy argument.
+	skipDestroy bool
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -160,7 +164,18 @@ func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContex
 }
 
 func (r *wrappedResource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Read)
+	// Finders may reuse recent API operation results when refreshing resources.
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		ctx = r.bootstrapContext(ctx, meta)
+		if v, ok := meta.(*conns.AWSClient); ok {
+			ctx = conns.NewDescribeCacheContext(ctx, v)
+		}
+		return ctx
+	}
+	if r.skipDestroy {
+		f = skipDestroyReadHandler(f)
+	}
+	return interceptedHandler(bootstrapContext, r.interceptors, f, Read)
 }
 
 func (r *wrappedResource) Update(f schema.UpdateContextFunc) schema.UpdateContextFunc {
@@ -168,9 +183,41 @@ func (r *wrappedResource) Update(f schema.UpdateContextFunc) schema.UpdateContex
 }
 
 func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContextFunc {
+	if r.skipDestroy {
+		f = skipDestroyHandler(f)
+	}
 	return interceptedHandler(r.bootstrapContext, r.interceptors, f, Delete)
 }
 
+// skipDestroyReadHandler returns a Read handler that persists skip_destroy's configured (or default) value.
+// skip_destroy isn't returned by any API and must be set after import.
+func skipDestroyReadHandler(f schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		diags := f(ctx, d, meta)
+
+		if !diags.HasError() && d.Id() != "" {
+			d.Set(names.AttrSkipDestroy, d.Get(names.AttrSkipDestroy))
+		}
+
+		return diags
+	}
+}
+
+// skipDestroyHandler returns a Delete handler that only removes the resource from state if skip_destroy is true.
+func skipDestroyHandler(f schema.DeleteContextFunc) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		if v, ok := d.GetOk(names.AttrSkipDestroy); ok && v.(bool) {
+			tflog.Debug(ctx, "Retaining resource", map[string]any{
+				"id":                  d.Id(),
+				names.AttrSkipDestroy: true,
+			})
+			return nil
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
@@ -183,10 +230,35 @@ func (r *wrappedResource) CustomizeDiff(f schema.CustomizeDiffFunc) schema.Custo
 	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		if r.resourceIgnoreTags {
+			if err := withResourceIgnoreTags(ctx, d); err != nil {
+				return err
+			}
+		}
+
 		return f(ctx, d, meta)
 	}
 }
 
+// withResourceIgnoreTags supplements the provider's ignore_tags in Context with any resource-level ignore_tags.
+// This is the only place in which resource-level ignore_tags are merged for Plugin SDK v2 resources.
+func withResourceIgnoreTags(ctx context.Context, d interface{ Get(string) any }) error {
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	ignoreConfig, err := tftags.ResourceIgnoreConfig(ctx, tagsInContext.IgnoreConfig, d)
+
+	if err != nil {
+		return err
+	}
+
+	tagsInContext.IgnoreConfig = ignoreConfig
+
+	return nil
+}
+
 func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUpgradeFunc {
 	return func(ctx context.Context, rawState map[string]interface{}, meta any) (map[string]interface{}, error) {
 		ctx = r.bootstrapContext(ctx, meta)
@@ -202,6 +274,7 @@ type tagsResourceInterceptor struct {
 	tags       *types.ServicePackageResourceTags
 	updateFunc tagsCRUDFunc
 	readFunc   tagsCRUDFunc
+	verifyFunc tagsCRUDFunc
 }
 
 func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
@@ -236,6 +309,12 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 	switch when {
 	case Before:
+		if r.tags.ResourceIgnoreTags {
+			if err := withResourceIgnoreTags(ctx, d); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "%s %s: %s", serviceName, resourceName, err)
+			}
+		}
+
 		switch why {
 		case Create, Update:
 			// Merge the resource's configured tags with any provider configured default_tags.
@@ -308,6 +387,16 @@ func (r tagsResourceInterceptor) run(ctx context.Context, d schemaResourceData,
 
 			fallthrough
 		case Create, Update:
+			// If the resource has opted in, wait for any written tags to be consistently readable.
+			// Tags with values unknown at plan time are written, and not verified, in the Finally handler.
+			if why != Read && r.tags.VerifyAfterWrite && r.tags.IdentifierAttribute != "" && d.GetRawPlan().GetAttr(names.AttrTagsAll).IsWhollyKnown() {
+				ctx, diags = r.verifyFunc(ctx, d, sp, r.tags, serviceName, resourceName, meta, diags)
+
+				if diags.HasError() {
+					return ctx, diags
+				}
+			}
+
 			// If the R handler didn't set tags, try and read them from the service API.
 			if tagsInContext.TagsOut.IsNone() {
 				if identifierAttribute := r.tags.IdentifierAttribute; identifierAttribute != "" {
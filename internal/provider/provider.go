@@ -24,8 +24,10 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -65,6 +67,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types to which default tags are not applied",
+						},
+						"exclude_services": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Services to whose resources default tags are not applied",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -74,6 +88,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"ec2_dry_run_on_plan": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Perform EC2 API calls with DryRun set during plan for supported resources, " +
+					"reporting authorization and parameter errors as plan-time diagnostics.",
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -124,6 +144,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 							Elem:        &schema.Schema{Type: schema.TypeString},
 							Description: "Resource tag key prefixes to ignore across all resources.",
 						},
+						"key_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching resource tag keys to ignore across all resources.",
+						},
+						"value_regexes": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsValidRegExp,
+							},
+							Description: "Regular expressions matching resource tag values to ignore across all resources.",
+						},
 					},
 				},
 			},
@@ -185,6 +223,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_retries": serviceRetriesSchema(),
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -228,6 +267,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"timeouts_scale_factor": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(1),
+				Description: "The factor by which the default Create, Read, Update and Delete timeouts of all resources are multiplied. " +
+					"Timeouts configured in a resource's `timeouts` block are not scaled.",
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -239,6 +285,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:    true,
 				Description: "The capacity of the AWS SDK's token bucket rate limiter.",
 			},
+			"trace_api_calls": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Emit an OpenTelemetry span for each AWS API call, " +
+					"recording the service, operation, retry count, throttle count and latency.",
+			},
 			"use_dualstack_endpoint": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -290,7 +342,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -367,13 +419,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig.ForResource(servicePackageName, typeName), v.IgnoreTagsConfig)
+					ctx = tfresource.WithTimeoutsScaleFactor(ctx, v.TimeoutsScaleFactor(ctx, servicePackageName))
 					ctx = v.RegisterLogger(ctx)
 				}
+				ctx = sdkv2.WithDefaultTimeouts(ctx, r.Timeouts)
 
 				return ctx
 			}
 			interceptors := interceptorItems{}
+			resourceIgnoreTags := false
 
 			if v.Tags != nil {
 				schema := r.SchemaMap()
@@ -399,6 +454,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					continue
 				}
 
+				if v.Tags.ResourceIgnoreTags {
+					// The resource has opted in to resource-level ignore_tags.
+					// The argument is added to the schema here.
+					if _, ok := schema["ignore_tags"]; ok {
+						errs = append(errs, fmt.Errorf("`ignore_tags` attribute must not be defined in schema: %s", typeName))
+						continue
+					}
+
+					addIgnoreTagsSchema(r)
+					resourceIgnoreTags = true
+				}
+
 				interceptors = append(interceptors, interceptorItem{
 					when: Before | After | Finally,
 					why:  Create | Read | Update,
@@ -406,13 +473,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 						tags:       v.Tags,
 						updateFunc: tagsUpdateFunc,
 						readFunc:   tagsReadFunc,
+						verifyFunc: tagsVerifyFunc,
 					},
 				})
 			}
 
+			if v.SkipDestroy {
+				// The resource has opted in to standardized skip_destroy.
+				// Ensure that any existing definition is compatible.
+				if v, ok := r.SchemaMap()[names.AttrSkipDestroy]; ok {
+					if v.Type != schema.TypeBool || !v.Optional || v.Computed || v.ForceNew {
+						errs = append(errs, fmt.Errorf("`%s` attribute must be Optional, non-Computed and non-ForceNew TypeBool: %s", names.AttrSkipDestroy, typeName))
+						continue
+					}
+				} else {
+					addSkipDestroySchema(r)
+				}
+
+				// skip_destroy can always be updated in-place.
+				if r.UpdateWithoutTimeout == nil {
+					r.UpdateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+						return r.ReadWithoutTimeout(ctx, d, meta)
+					}
+				}
+			}
+
 			rs := &wrappedResource{
-				bootstrapContext: bootstrapContext,
-				interceptors:     interceptors,
+				bootstrapContext:   bootstrapContext,
+				interceptors:       interceptors,
+				resourceIgnoreTags: resourceIgnoreTags,
+				skipDestroy:        v.SkipDestroy,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -478,6 +568,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
+		EC2DryRunOnPlan:                d.Get("ec2_dry_run_on_plan").(bool),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
@@ -492,8 +583,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
 		TerraformVersion:               terraformVersion,
+		TimeoutsScaleFactor:            d.Get("timeouts_scale_factor").(float64),
 		Token:                          d.Get("token").(string),
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
+		TraceAPICalls:                  d.Get("trace_api_calls").(bool),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
@@ -544,6 +637,13 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 	config.Endpoints = endpoints
 
+	serviceEndpointOptions, dx := expandServiceEndpointOptions(ctx, v.(*schema.Set).List())
+	diags = append(diags, dx...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	config.ServiceEndpointOptions = serviceEndpointOptions
+
 	if v, ok := d.GetOk("forbidden_account_ids"); ok && v.(*schema.Set).Len() > 0 {
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
@@ -564,13 +664,27 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 	}
 
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		ignoreTagsConfig, dx := expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.IgnoreTagsConfig = ignoreTagsConfig
 	}
 
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("service_retries"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		serviceRetries, dx := expandServiceRetries(ctx, v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, dx...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		config.ServiceRetries = serviceRetries
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -743,6 +857,19 @@ func endpointsSchema() *schema.Schema {
 		}
 	}
 
+	endpointsAttributes["use_dualstack_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Per-service overrides of `use_dualstack_endpoint`, keyed by service name",
+	}
+	endpointsAttributes["use_fips_endpoint"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Elem:        &schema.Schema{Type: schema.TypeBool},
+		Description: "Per-service overrides of `use_fips_endpoint`, keyed by service name",
+	}
+
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
@@ -752,6 +879,58 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func serviceRetriesSchema() *schema.Schema {
+	serviceRetryResource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"adaptive_rate_limiting": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to apply client-side rate limiting to the service's API requests, " +
+					"as with `retry_mode = \"adaptive\"`. Only supported for services using AWS SDK for Go v2.",
+			},
+			"max_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The maximum number of times an API request to the service is attempted. Overrides `max_retries`.",
+			},
+			"max_backoff": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidDuration,
+				Description:  "The maximum delay between attempts of an API request to the service, e.g. `30s`.",
+			},
+			"timeouts_scale_factor": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(1),
+				Description:  "The factor by which the default operation timeouts of the service's resources are multiplied. Overrides `timeouts_scale_factor`.",
+			},
+		},
+	}
+
+	serviceRetriesAttributes := make(map[string]*schema.Schema)
+
+	for _, serviceKey := range names.ProviderPackages() {
+		serviceRetriesAttributes[serviceKey] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem:        serviceRetryResource,
+			Description: "Use this to override the provider-level retry settings for the service",
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: serviceRetriesAttributes,
+		},
+	}
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -845,6 +1024,14 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["exclude_services"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeServices = flex.ExpandStringValueSet(v)
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -852,22 +1039,57 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
-func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
-	if tfMap == nil {
-		return nil
+// addIgnoreTagsSchema adds a resource-level ignore_tags attribute to the resource's schema.
+func addIgnoreTagsSchema(r *schema.Resource) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s["ignore_tags"] = tftags.IgnoreTagsSchema()
+
+			return s
+		}
+
+		return
 	}
 
-	ignoreConfig := &tftags.IgnoreConfig{}
+	r.Schema["ignore_tags"] = tftags.IgnoreTagsSchema()
+}
+
+func addSkipDestroySchema(r *schema.Resource) {
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.AttrSkipDestroy] = skipDestroySchema()
+
+			return s
+		}
+
+		return
+	}
+
+	r.Schema[names.AttrSkipDestroy] = skipDestroySchema()
+}
 
-	if v, ok := tfMap["keys"].(*schema.Set); ok {
-		ignoreConfig.Keys = tftags.New(ctx, v.List())
+// skipDestroySchema returns the schema for the standardized skip_destroy argument.
+// If true, destroying the resource removes it from Terraform state without deleting it.
+func skipDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
 	}
+}
+
+func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) (*tftags.IgnoreConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
 
-	if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
-		ignoreConfig.KeyPrefixes = tftags.New(ctx, v.List())
+	ignoreConfig, err := tftags.ExpandIgnoreConfig(ctx, tfMap)
+
+	if err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "expanding ignore_tags: %s", err)
 	}
 
-	return ignoreConfig
+	return ignoreConfig, diags
 }
 
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
@@ -982,6 +1204,98 @@ func DeprecatedEnvVarDiag(envvar, replacement string) diag.Diagnostic {
 	)
 }
 
+func expandServiceEndpointOptions(_ context.Context, tfList []interface{}) (map[string]conns.ServiceEndpointOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	endpointsPath := cty.GetAttrPath("endpoints")
+	serviceEndpointOptions := make(map[string]conns.ServiceEndpointOptions)
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		for _, attr := range []string{"use_dualstack_endpoint", "use_fips_endpoint"} {
+			v, ok := tfMap[attr].(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			for serviceKey, v := range v {
+				pkg, err := names.ProviderPackageForAlias(serviceKey)
+
+				if err != nil {
+					diags = append(diags, errs.NewInvalidValueAttributeError(endpointsPath.IndexInt(i).GetAttr(attr).IndexString(serviceKey), err.Error()))
+					continue
+				}
+
+				enabled := v.(bool)
+				options := serviceEndpointOptions[pkg]
+
+				switch attr {
+				case "use_dualstack_endpoint":
+					if options.UseDualStackEndpoint == nil {
+						options.UseDualStackEndpoint = &enabled
+					}
+				case "use_fips_endpoint":
+					if options.UseFIPSEndpoint == nil {
+						options.UseFIPSEndpoint = &enabled
+					}
+				}
+
+				serviceEndpointOptions[pkg] = options
+			}
+		}
+	}
+
+	return serviceEndpointOptions, diags
+}
+
+func expandServiceRetries(_ context.Context, tfMap map[string]interface{}) (map[string]conns.ServiceRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	serviceRetriesPath := cty.GetAttrPath("service_retries").IndexInt(0)
+	serviceRetries := make(map[string]conns.ServiceRetryConfig)
+
+	for pkg, v := range tfMap {
+		tfList, ok := v.([]interface{})
+		if !ok || len(tfList) == 0 || tfList[0] == nil {
+			continue
+		}
+
+		tfMap := tfList[0].(map[string]interface{})
+		serviceRetry := conns.ServiceRetryConfig{
+			AdaptiveRateLimiting: tfMap["adaptive_rate_limiting"].(bool),
+			MaxAttempts:          tfMap["max_attempts"].(int),
+			TimeoutsScaleFactor:  tfMap["timeouts_scale_factor"].(float64),
+		}
+
+		if v, ok := tfMap["max_backoff"].(string); ok && v != "" {
+			maxBackoff, err := time.ParseDuration(v)
+			if err != nil {
+				diags = append(diags, errs.NewInvalidValueAttributeError(serviceRetriesPath.GetAttr(pkg).IndexInt(0).GetAttr("max_backoff"), err.Error()))
+				continue
+			}
+			serviceRetry.MaxBackoff = maxBackoff
+		}
+
+		if serviceRetry.AdaptiveRateLimiting && names.ClientSDKV1(pkg) {
+			diags = append(diags, errs.NewAttributeWarningDiagnostic(
+				serviceRetriesPath.GetAttr(pkg).IndexInt(0).GetAttr("adaptive_rate_limiting"),
+				"Unsupported Attribute Value",
+				fmt.Sprintf("Service %q has AWS SDK for Go v1 API clients, which do not support adaptive rate limiting. Only max_attempts and max_backoff apply to those clients.", pkg),
+			))
+		}
+
+		serviceRetries[pkg] = serviceRetry
+	}
+
+	return serviceRetries, diags
+}
+
 func ConflictingEndpointsWarningDiag(elementPath cty.Path, attrs ...string) diag.Diagnostic {
 	attrPaths := make([]string, len(attrs))
 	for i, attr := range attrs {
@@ -8,10 +8,12 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +61,95 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceRetries(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	serviceRetries := make(map[string]interface{})
+	for _, serviceKey := range names.ProviderPackages() {
+		serviceRetries[serviceKey] = []interface{}{}
+	}
+	serviceRetries[names.SSOAdmin] = []interface{}{
+		map[string]interface{}{
+			"adaptive_rate_limiting": true,
+			"max_attempts":           10,
+			"max_backoff":            "1m",
+			"timeouts_scale_factor":  2.5,
+		},
+	}
+
+	results, diags := expandServiceRetries(ctx, serviceRetries)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(results) != 1 {
+		t.Fatalf("Expected 1 service retry configuration, got %d", len(results))
+	}
+
+	if got, want := results[names.SSOAdmin], (conns.ServiceRetryConfig{AdaptiveRateLimiting: true, MaxAttempts: 10, MaxBackoff: time.Minute, TimeoutsScaleFactor: 2.5}); got != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	serviceRetries[names.SSOAdmin] = []interface{}{
+		map[string]interface{}{
+			"adaptive_rate_limiting": false,
+			"max_attempts":           0,
+			"max_backoff":            "one minute",
+			"timeouts_scale_factor":  0.0,
+		},
+	}
+
+	if _, diags := expandServiceRetries(ctx, serviceRetries); !diags.HasError() {
+		t.Error("Expected error for invalid max_backoff")
+	}
+}
+
+func TestExpandServiceEndpointOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	endpoints := map[string]interface{}{
+		"use_dualstack_endpoint": map[string]interface{}{
+			names.S3: true,
+		},
+		"use_fips_endpoint": map[string]interface{}{
+			names.S3:        true,
+			"sns":           false,
+			"cloudwatchlog": false,
+		},
+	}
+
+	results, diags := expandServiceEndpointOptions(ctx, []interface{}{endpoints})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("Expected 3 service endpoint option configurations, got %d", len(results))
+	}
+
+	if v := results[names.S3]; v.UseDualStackEndpoint == nil || !*v.UseDualStackEndpoint || v.UseFIPSEndpoint == nil || !*v.UseFIPSEndpoint {
+		t.Errorf("Expected dual-stack and FIPS endpoints for %s, got %v", names.S3, v)
+	}
+
+	if v := results[names.SNS]; v.UseDualStackEndpoint != nil || v.UseFIPSEndpoint == nil || *v.UseFIPSEndpoint {
+		t.Errorf("Expected FIPS endpoint disabled for %s, got %v", names.SNS, v)
+	}
+
+	if v := results[names.Logs]; v.UseFIPSEndpoint == nil || *v.UseFIPSEndpoint {
+		t.Errorf("Expected FIPS endpoint disabled for %s alias, got %v", names.Logs, v)
+	}
+
+	endpoints["use_fips_endpoint"] = map[string]interface{}{
+		"notaservice": true,
+	}
+
+	if _, diags := expandServiceEndpointOptions(ctx, []interface{}{endpoints}); !diags.HasError() {
+		t.Error("Expected error for unknown service")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
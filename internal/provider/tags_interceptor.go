@@ -172,3 +172,86 @@ func tagsReadFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePac
 
 	return ctx, diags
 }
+
+// tagsVerifyFunc waits for a resource's tags to be consistently readable after create or update.
+// On return the tags read from the service API are in Context.
+func tagsVerifyFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePackage, spt *types.ServicePackageResourceTags, serviceName, resourceName string, meta any, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok || tagsInContext.TagsIn.IsNone() {
+		return ctx, diags
+	}
+
+	var identifier string
+	if identifierAttribute := spt.IdentifierAttribute; identifierAttribute == "id" {
+		identifier = d.Id()
+	} else {
+		identifier = d.Get(identifierAttribute).(string)
+	}
+
+	// Some old resources may not have the required attribute set after Read:
+	// https://github.com/hashicorp/terraform-provider-aws/issues/31180
+	if identifier == "" {
+		return ctx, diags
+	}
+
+	var listTags func(context.Context) error
+	if v, ok := sp.(interface {
+		ListTags(context.Context, any, string) error
+	}); ok {
+		listTags = func(ctx context.Context) error {
+			return v.ListTags(ctx, meta, identifier) // Sets tags in Context
+		}
+	} else if v, ok := sp.(interface {
+		ListTags(context.Context, any, string, string) error
+	}); ok && spt.ResourceType != "" {
+		listTags = func(ctx context.Context) error {
+			return v.ListTags(ctx, meta, identifier, spt.ResourceType) // Sets tags in Context
+		}
+	} else {
+		tflog.Warn(ctx, "No ListTags method found", map[string]interface{}{
+			"ServicePackage": sp.ServicePackageName(),
+			"ResourceType":   spt.ResourceType,
+		})
+
+		return ctx, diags
+	}
+
+	stateTags := make(map[string]string)
+	if state := d.GetRawState(); !state.IsNull() && state.IsKnown() {
+		s := state.GetAttr(names.AttrTagsAll)
+		if !s.IsNull() && s.IsWhollyKnown() {
+			for k, v := range s.AsValueMap() {
+				if !v.IsNull() {
+					stateTags[k] = v.AsString()
+				}
+			}
+		}
+	}
+
+	want := tagsInContext.TagsIn.MustUnwrap().IgnoreConfig(tagsInContext.IgnoreConfig)
+	removed := tftags.New(ctx, stateTags).Removed(want).IgnoreConfig(tagsInContext.IgnoreConfig)
+
+	err := tftags.WaitTagsPropagated(ctx, tftags.PropagationTimeout, want, removed, func(ctx context.Context) (tftags.KeyValueTags, error) {
+		if err := listTags(ctx); err != nil {
+			return nil, err
+		}
+
+		return tagsInContext.TagsOut.UnwrapOrDefault().IgnoreSystem(inContext.ServicePackageName).IgnoreConfig(tagsInContext.IgnoreConfig), nil
+	})
+
+	// ISO partitions may not support tagging, giving error.
+	if errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err) {
+		return ctx, diags
+	}
+
+	if err != nil {
+		return ctx, sdkdiag.AppendErrorf(diags, "waiting for tags for %s %s (%s) to propagate: %s", serviceName, resourceName, identifier, err)
+	}
+
+	return ctx, diags
+}
@@ -74,6 +74,13 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		interceptor: tags,
 	})
 
+	ignoreTagsConfig, diags := expandIgnoreTags(context.Background(), map[string]interface{}{
+		"tag2": "tag",
+	})
+	if diags.HasError() {
+		t.Fatalf("expanding ignore_tags: %v", diags)
+	}
+
 	conn := &conns.AWSClient{
 		ServicePackages: map[string]conns.ServicePackage{
 			"Test": &mockService{},
@@ -81,9 +88,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
 			"tag": "",
 		}),
-		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
-			"tag2": "tag",
-		}),
+		IgnoreTagsConfig: ignoreTagsConfig,
 	}
 
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
@@ -0,0 +1,61 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
+)
+
+// SuppressEquivalentEngineVersions provides custom difference suppression
+// for managed engine versions that refer to the same version, e.g. "7.1" and "7.1.0".
+func SuppressEquivalentEngineVersions(k, old, new string, d *schema.ResourceData) bool {
+	return semver.EngineVersionsEquivalent(old, new)
+}
+
+// EngineVersionIsDowngrade returns whether or not the planned change to the specified engine version attribute is a downgrade.
+// Engine versions that cannot be parsed are never downgrades.
+func EngineVersionIsDowngrade(d ResourceDiffer, key string) bool {
+	if d.Id() == "" || !d.HasChange(key) {
+		return false
+	}
+
+	o, n := d.GetChange(key)
+	if o.(string) == "" || n.(string) == "" {
+		return false
+	}
+
+	downgrade, err := semver.EngineVersionIsDowngrade(o.(string), n.(string))
+
+	return err == nil && downgrade
+}
+
+// ForceNewIfEngineVersionDowngrade returns a CustomizeDiffFunc that forces
+// re-creation of the resource if the specified engine version attribute is downgraded.
+func ForceNewIfEngineVersionDowngrade(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		if !EngineVersionIsDowngrade(d, key) {
+			return nil
+		}
+
+		return d.ForceNew(key)
+	}
+}
+
+// ErrorIfEngineVersionDowngrade returns a CustomizeDiffFunc that fails planning
+// if the specified engine version attribute is downgraded.
+func ErrorIfEngineVersionDowngrade(key string) schema.CustomizeDiffFunc {
+	return func(_ context.Context, d *schema.ResourceDiff, _ any) error {
+		if !EngineVersionIsDowngrade(d, key) {
+			return nil
+		}
+
+		o, n := d.GetChange(key)
+
+		return fmt.Errorf("%s cannot be downgraded from %s to %s", key, o, n)
+	}
+}
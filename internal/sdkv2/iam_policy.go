@@ -0,0 +1,445 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+// IAMPolicyDocumentSchemaRequired returns the standard schema for a required
+// identity-based IAM policy document attribute.
+func IAMPolicyDocumentSchemaRequired() *schema.Schema {
+	return iamPolicyDocumentSchema(&schema.Schema{
+		Required:     true,
+		ValidateFunc: ValidIAMPolicyDocument,
+	})
+}
+
+// IAMPolicyDocumentSchemaOptional returns the standard schema for an optional
+// identity-based IAM policy document attribute.
+func IAMPolicyDocumentSchemaOptional() *schema.Schema {
+	return iamPolicyDocumentSchema(&schema.Schema{
+		Optional:     true,
+		ValidateFunc: ValidIAMPolicyDocument,
+	})
+}
+
+// IAMPolicyDocumentSchemaOptionalComputed returns the standard schema for an
+// optional identity-based IAM policy document attribute whose value defaults to one
+// set by AWS. An empty string is accepted and means "use the AWS default".
+func IAMPolicyDocumentSchemaOptionalComputed() *schema.Schema {
+	return iamPolicyDocumentSchema(&schema.Schema{
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: ValidIAMPolicyDocumentOrEmpty,
+	})
+}
+
+// IAMResourcePolicyDocumentSchemaRequired returns the standard schema for a required
+// resource-based IAM policy document attribute.
+func IAMResourcePolicyDocumentSchemaRequired() *schema.Schema {
+	return iamPolicyDocumentSchema(&schema.Schema{
+		Required:     true,
+		ValidateFunc: ValidIAMResourcePolicyDocument,
+	})
+}
+
+// IAMResourcePolicyDocumentSchemaOptional returns the standard schema for an optional
+// resource-based IAM policy document attribute.
+func IAMResourcePolicyDocumentSchemaOptional() *schema.Schema {
+	return iamPolicyDocumentSchema(&schema.Schema{
+		Optional:     true,
+		ValidateFunc: ValidIAMResourcePolicyDocument,
+	})
+}
+
+// IAMResourcePolicyDocumentSchemaOptionalComputed returns the standard schema for an
+// optional resource-based IAM policy document attribute whose value defaults to one
+// set by AWS. An empty string is accepted and means "use the AWS default".
+func IAMResourcePolicyDocumentSchemaOptionalComputed() *schema.Schema {
+	return iamPolicyDocumentSchema(&schema.Schema{
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: ValidIAMResourcePolicyDocumentOrEmpty,
+	})
+}
+
+func iamPolicyDocumentSchema(s *schema.Schema) *schema.Schema {
+	s.Type = schema.TypeString
+	s.DiffSuppressFunc = SuppressEquivalentIAMPolicyDocuments
+	s.DiffSuppressOnRefresh = true
+	s.StateFunc = func(v interface{}) string {
+		json, _ := structure.NormalizeJsonString(v)
+		return json
+	}
+
+	return s
+}
+
+// SuppressEquivalentIAMPolicyDocuments provides custom difference suppression
+// for IAM policy documents that are semantically equivalent.
+func SuppressEquivalentIAMPolicyDocuments(k, old, new string, d *schema.ResourceData) bool {
+	return IAMPolicyDocumentsEquivalent(old, new)
+}
+
+// IAMPolicyDocumentsEquivalent returns whether or not two IAM policy documents are semantically equivalent.
+// Empty strings and empty JSON objects are considered equivalent.
+func IAMPolicyDocumentsEquivalent(s1, s2 string) bool {
+	isEmpty := func(s string) bool {
+		s = strings.TrimSpace(s)
+		return s == "" || s == "{}"
+	}
+
+	if isEmpty(s1) && isEmpty(s2) {
+		return true
+	}
+
+	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
+	if err != nil {
+		return false
+	}
+
+	return equivalent
+}
+
+type iamPolicyType int
+
+const (
+	iamPolicyTypeIdentity iamPolicyType = iota
+	iamPolicyTypeResource
+)
+
+// ValidIAMPolicyDocument validates that a string is a structurally valid identity-based IAM policy document.
+// Identity-based policies are attached to an IAM principal and so must not specify Principal or NotPrincipal.
+func ValidIAMPolicyDocument(v interface{}, k string) ([]string, []error) {
+	return validIAMPolicyDocument(v, k, iamPolicyTypeIdentity, false)
+}
+
+// ValidIAMResourcePolicyDocument validates that a string is a structurally valid resource-based IAM policy document.
+// Resource-based policies are attached to a resource and so every statement must specify Principal or NotPrincipal.
+func ValidIAMResourcePolicyDocument(v interface{}, k string) ([]string, []error) {
+	return validIAMPolicyDocument(v, k, iamPolicyTypeResource, false)
+}
+
+// ValidIAMPolicyDocumentOrEmpty is ValidIAMPolicyDocument, additionally accepting an empty string or empty JSON object.
+func ValidIAMPolicyDocumentOrEmpty(v interface{}, k string) ([]string, []error) {
+	return validIAMPolicyDocument(v, k, iamPolicyTypeIdentity, true)
+}
+
+// ValidIAMResourcePolicyDocumentOrEmpty is ValidIAMResourcePolicyDocument, additionally accepting an empty string or empty JSON object.
+func ValidIAMResourcePolicyDocumentOrEmpty(v interface{}, k string) ([]string, []error) {
+	return validIAMPolicyDocument(v, k, iamPolicyTypeResource, true)
+}
+
+func validIAMPolicyDocument(v interface{}, k string, policyType iamPolicyType, allowEmpty bool) ([]string, []error) {
+	value, ok := v.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		if allowEmpty {
+			return nil, nil
+		}
+		return nil, []error{fmt.Errorf("%q is an empty string, which is not a valid JSON value", k)}
+	}
+
+	if value[:1] != "{" {
+		return nil, []error{fmt.Errorf("%q contains an invalid JSON policy: not a JSON object", k)}
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal([]byte(value), &document); err != nil {
+		errStr := err.Error()
+		var syntaxErr *json.SyntaxError
+		if errors.As(err, &syntaxErr) {
+			errStr = fmt.Sprintf("%s, at byte offset %d", errStr, syntaxErr.Offset)
+		}
+		return nil, []error{fmt.Errorf("%q contains an invalid JSON policy: %s", k, errStr)}
+	}
+
+	if err := basevalidation.JSONNoDuplicateKeys(value); err != nil {
+		return nil, []error{fmt.Errorf("%q contains duplicate JSON keys: %s", k, err)}
+	}
+
+	if allowEmpty && len(document) == 0 {
+		return nil, nil
+	}
+
+	var errs []error
+	for _, err := range validateIAMPolicyDocument(document, policyType) {
+		errs = append(errs, fmt.Errorf("%q contains an invalid IAM policy: %w", k, err))
+	}
+
+	return nil, errs
+}
+
+const (
+	iamPolicyVersion20081017 = "2008-10-17"
+	iamPolicyVersion20121017 = "2012-10-17"
+)
+
+var (
+	iamPolicyDocumentKeys  = []string{"Id", "Statement", "Version"}
+	iamPolicyStatementKeys = []string{"Action", "Condition", "Effect", "NotAction", "NotPrincipal", "NotResource", "Principal", "Resource", "Sid"}
+	iamPolicyPrincipalKeys = []string{"AWS", "CanonicalUser", "Federated", "Service"}
+
+	iamPolicyActionRegexp = regexache.MustCompile(`^[0-9A-Za-z-]+:[0-9A-Za-z_.*?-]+$`)
+	// Most resources are ARNs, but some services (e.g. API Gateway's "execute-api:/stage/GET/*") accept a service-prefixed shorthand.
+	iamPolicyResourceRegexp = regexache.MustCompile(`^[0-9A-Za-z-]+:\S`)
+)
+
+func validateIAMPolicyDocument(document map[string]any, policyType iamPolicyType) []error {
+	var errs []error
+
+	for _, key := range sortedKeys(document) {
+		if !slices.Contains(iamPolicyDocumentKeys, key) {
+			errs = append(errs, fmt.Errorf("unsupported policy element %q", key))
+		}
+	}
+
+	if v, ok := document["Version"]; ok {
+		if v, ok := v.(string); !ok || (v != iamPolicyVersion20121017 && v != iamPolicyVersion20081017) {
+			errs = append(errs, fmt.Errorf("Version must be %q or %q", iamPolicyVersion20121017, iamPolicyVersion20081017))
+		}
+	}
+
+	if v, ok := document["Id"]; ok {
+		if _, ok := v.(string); !ok {
+			errs = append(errs, errors.New("Id must be a string"))
+		}
+	}
+
+	v, ok := document["Statement"]
+	if !ok {
+		return append(errs, errors.New("missing required Statement element"))
+	}
+
+	var statements []any
+	switch v := v.(type) {
+	case map[string]any:
+		statements = []any{v}
+	case []any:
+		statements = v
+	default:
+		return append(errs, errors.New("Statement must be an object or an array of objects"))
+	}
+
+	if len(statements) == 0 {
+		errs = append(errs, errors.New("Statement must contain at least one statement"))
+	}
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("Statement[%d] must be an object", i))
+			continue
+		}
+
+		for _, err := range validateIAMPolicyStatement(statement, policyType) {
+			if sid, ok := statement["Sid"].(string); ok && sid != "" {
+				errs = append(errs, fmt.Errorf("Statement[%d] (Sid %q): %w", i, sid, err))
+			} else {
+				errs = append(errs, fmt.Errorf("Statement[%d]: %w", i, err))
+			}
+		}
+	}
+
+	return errs
+}
+
+func validateIAMPolicyStatement(statement map[string]any, policyType iamPolicyType) []error {
+	var errs []error
+
+	for _, key := range sortedKeys(statement) {
+		if !slices.Contains(iamPolicyStatementKeys, key) {
+			errs = append(errs, fmt.Errorf("unsupported statement element %q", key))
+		}
+	}
+
+	if v, ok := statement["Sid"]; ok {
+		if _, ok := v.(string); !ok {
+			errs = append(errs, errors.New("Sid must be a string"))
+		}
+	}
+
+	switch v := statement["Effect"]; v {
+	case "Allow", "Deny":
+	case nil:
+		errs = append(errs, errors.New("missing required Effect element"))
+	default:
+		errs = append(errs, fmt.Errorf("Effect must be \"Allow\" or \"Deny\", got %v", v))
+	}
+
+	errs = append(errs, validateIAMPolicyStatementExactlyOneOf(statement, "Action", "NotAction", validateIAMPolicyAction)...)
+
+	switch policyType {
+	case iamPolicyTypeIdentity:
+		errs = append(errs, validateIAMPolicyStatementExactlyOneOf(statement, "Resource", "NotResource", validateIAMPolicyResource)...)
+
+		for _, key := range []string{"Principal", "NotPrincipal"} {
+			if _, ok := statement[key]; ok {
+				errs = append(errs, fmt.Errorf("%s is not supported in identity-based policies", key))
+			}
+		}
+	case iamPolicyTypeResource:
+		errs = append(errs, validateIAMPolicyStatementAtMostOneOf(statement, "Resource", "NotResource", validateIAMPolicyResource)...)
+		errs = append(errs, validateIAMPolicyStatementExactlyOneOf(statement, "Principal", "NotPrincipal", validateIAMPolicyPrincipal)...)
+	}
+
+	if v, ok := statement["Condition"]; ok {
+		errs = append(errs, validateIAMPolicyCondition(v)...)
+	}
+
+	return errs
+}
+
+func validateIAMPolicyStatementExactlyOneOf(statement map[string]any, key, notKey string, f func(string, any) []error) []error {
+	_, ok := statement[key]
+	_, notOK := statement[notKey]
+
+	if !ok && !notOK {
+		return []error{fmt.Errorf("one of %s or %s must be specified", key, notKey)}
+	}
+
+	return validateIAMPolicyStatementAtMostOneOf(statement, key, notKey, f)
+}
+
+func validateIAMPolicyStatementAtMostOneOf(statement map[string]any, key, notKey string, f func(string, any) []error) []error {
+	v, ok := statement[key]
+	notV, notOK := statement[notKey]
+
+	switch {
+	case ok && notOK:
+		return []error{fmt.Errorf("only one of %s or %s can be specified", key, notKey)}
+	case ok:
+		return f(key, v)
+	case notOK:
+		return f(notKey, notV)
+	}
+
+	return nil
+}
+
+func validateIAMPolicyAction(key string, v any) []error {
+	return validateIAMPolicyStringOrStrings(key, v, func(s string) error {
+		if s == "*" || iamPolicyActionRegexp.MatchString(s) {
+			return nil
+		}
+
+		return fmt.Errorf("%s value %q must be \"*\" or of the form \"service:action\"", key, s)
+	})
+}
+
+func validateIAMPolicyResource(key string, v any) []error {
+	return validateIAMPolicyStringOrStrings(key, v, func(s string) error {
+		if s == "*" || iamPolicyResourceRegexp.MatchString(s) {
+			return nil
+		}
+
+		return fmt.Errorf("%s value %q must be \"*\", an ARN or a service-prefixed resource such as \"execute-api:/*\"", key, s)
+	})
+}
+
+func validateIAMPolicyPrincipal(key string, v any) []error {
+	switch v := v.(type) {
+	case string:
+		if v != "*" {
+			return []error{fmt.Errorf("%s must be \"*\" or an object", key)}
+		}
+	case map[string]any:
+		if len(v) == 0 {
+			return []error{fmt.Errorf("%s must not be empty", key)}
+		}
+
+		var errs []error
+		for _, principalType := range sortedKeys(v) {
+			v := v[principalType]
+			if !slices.Contains(iamPolicyPrincipalKeys, principalType) {
+				errs = append(errs, fmt.Errorf("%s type %q must be one of %s", key, principalType, strings.Join(iamPolicyPrincipalKeys, ", ")))
+				continue
+			}
+
+			errs = append(errs, validateIAMPolicyStringOrStrings(key+"."+principalType, v, func(s string) error {
+				if s == "" {
+					return fmt.Errorf("%s.%s values must not be empty", key, principalType)
+				}
+				return nil
+			})...)
+		}
+
+		return errs
+	default:
+		return []error{fmt.Errorf("%s must be \"*\" or an object", key)}
+	}
+
+	return nil
+}
+
+func validateIAMPolicyCondition(v any) []error {
+	conditions, ok := v.(map[string]any)
+	if !ok {
+		return []error{errors.New("Condition must be an object")}
+	}
+
+	var errs []error
+	for _, operator := range sortedKeys(conditions) {
+		if _, ok := conditions[operator].(map[string]any); !ok {
+			errs = append(errs, fmt.Errorf("Condition operator %q must map to an object of condition keys", operator))
+		}
+	}
+
+	return errs
+}
+
+func validateIAMPolicyStringOrStrings(key string, v any, f func(string) error) []error {
+	var values []any
+	switch v := v.(type) {
+	case string:
+		values = []any{v}
+	case []any:
+		if len(v) == 0 {
+			return []error{fmt.Errorf("%s must not be empty", key)}
+		}
+		values = v
+	default:
+		return []error{fmt.Errorf("%s must be a string or an array of strings", key)}
+	}
+
+	var errs []error
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s must be a string or an array of strings", key))
+			continue
+		}
+
+		if err := f(s); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
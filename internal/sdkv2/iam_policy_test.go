@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+)
+
+func TestValidIAMPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value      string
+		wantErrors int
+	}{
+		"empty": {
+			value:      "",
+			wantErrors: 1,
+		},
+		"not an object": {
+			value:      `["Statement"]`,
+			wantErrors: 1,
+		},
+		"invalid JSON": {
+			value:      `{"Version":"2012-10-17",}`,
+			wantErrors: 1,
+		},
+		"duplicate keys": {
+			value:      `{"Version":"2012-10-17","Version":"2012-10-17","Statement":[]}`,
+			wantErrors: 1,
+		},
+		"valid": {
+			value: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "AllowS3",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:List*"],
+    "Resource": "arn:aws:s3:::example/*",
+    "Condition": {"StringEquals": {"aws:RequestedRegion": "us-west-2"}}
+  }]
+}`,
+		},
+		"valid single statement object": {
+			value: `{"Statement":{"Effect":"Deny","NotAction":"iam:*","NotResource":"*"}}`,
+		},
+		"missing statement": {
+			value:      `{"Version":"2012-10-17"}`,
+			wantErrors: 1,
+		},
+		"unsupported top-level element": {
+			value:      `{"Version":"2012-10-17","Statements":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			wantErrors: 2,
+		},
+		"invalid version": {
+			value:      `{"Version":"2012-10-18","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			wantErrors: 1,
+		},
+		"invalid effect": {
+			value:      `{"Statement":[{"Effect":"allow","Action":"*","Resource":"*"}]}`,
+			wantErrors: 1,
+		},
+		"missing action": {
+			value:      `{"Statement":[{"Effect":"Allow","Resource":"*"}]}`,
+			wantErrors: 1,
+		},
+		"action and not action": {
+			value:      `{"Statement":[{"Effect":"Allow","Action":"*","NotAction":"s3:*","Resource":"*"}]}`,
+			wantErrors: 1,
+		},
+		"invalid action format": {
+			value:      `{"Statement":[{"Effect":"Allow","Action":["s3GetObject","s3:Get Object"],"Resource":"*"}]}`,
+			wantErrors: 2,
+		},
+		"missing resource": {
+			value:      `{"Statement":[{"Effect":"Allow","Action":"*"}]}`,
+			wantErrors: 1,
+		},
+		"invalid resource": {
+			value:      `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"example-bucket"}]}`,
+			wantErrors: 1,
+		},
+		"principal not allowed": {
+			value:      `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*"}]}`,
+			wantErrors: 1,
+		},
+		"invalid condition": {
+			value:      `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*","Condition":{"Bool":"true"}}]}`,
+			wantErrors: 1,
+		},
+		"unsupported statement element": {
+			value:      `{"Statement":[{"Effect":"Allow","Actions":"*","Resource":"*"}]}`,
+			wantErrors: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := ValidIAMPolicyDocument(testCase.value, "policy")
+
+			if got, want := len(errs), testCase.wantErrors; got != want {
+				t.Errorf("got %d errors (%v), want %d", got, errs, want)
+			}
+		})
+	}
+}
+
+func TestValidIAMResourcePolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value      string
+		wantErrors int
+	}{
+		"valid": {
+			value: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {"AWS": ["arn:aws:iam::123456789012:root", "123456789012"], "Service": "lambda.amazonaws.com"},
+    "Action": "sqs:SendMessage",
+    "Resource": "arn:aws:sqs:us-west-2:123456789012:example"
+  }]
+}`,
+		},
+		"valid service-prefixed resource": {
+			value: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"execute-api:Invoke","Resource":"execute-api:/*"}]}`,
+		},
+		"valid wildcard principal without resource": {
+			value: `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"ecr:BatchGetImage"}]}`,
+		},
+		"missing principal": {
+			value:      `{"Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+			wantErrors: 1,
+		},
+		"principal and not principal": {
+			value:      `{"Statement":[{"Effect":"Deny","Principal":"*","NotPrincipal":{"AWS":"123456789012"},"Action":"*"}]}`,
+			wantErrors: 1,
+		},
+		"invalid principal string": {
+			value:      `{"Statement":[{"Effect":"Allow","Principal":"123456789012","Action":"*"}]}`,
+			wantErrors: 1,
+		},
+		"invalid principal type": {
+			value:      `{"Statement":[{"Effect":"Allow","Principal":{"User":"example"},"Action":"*"}]}`,
+			wantErrors: 1,
+		},
+		"invalid principal value": {
+			value:      `{"Statement":[{"Effect":"Allow","Principal":{"AWS":["123456789012", 1]},"Action":"*"}]}`,
+			wantErrors: 1,
+		},
+		"resource and not resource": {
+			value:      `{"Statement":[{"Effect":"Allow","Principal":"*","Action":"*","Resource":"*","NotResource":"*"}]}`,
+			wantErrors: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := ValidIAMResourcePolicyDocument(testCase.value, "policy")
+
+			if got, want := len(errs), testCase.wantErrors; got != want {
+				t.Errorf("got %d errors (%v), want %d", got, errs, want)
+			}
+		})
+	}
+}
+
+func TestIAMPolicyDocumentSchemaOptionalComputedAllowsEmpty(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"", "{}"} {
+		if _, errs := IAMResourcePolicyDocumentSchemaOptionalComputed().ValidateFunc(value, "policy"); len(errs) > 0 {
+			t.Errorf("unexpected errors for %q: %v", value, errs)
+		}
+		if _, errs := IAMResourcePolicyDocumentSchemaRequired().ValidateFunc(value, "policy"); len(errs) == 0 {
+			t.Errorf("expected errors for %q", value)
+		}
+	}
+}
+
+func TestIAMPolicyDocumentsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		s1, s2 string
+		want   bool
+	}{
+		"empty": {
+			s1:   "",
+			s2:   "{}",
+			want: true,
+		},
+		"reordered": {
+			s1:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject","s3:PutObject"],"Resource":"*"}]}`,
+			s2:   `{"Statement":[{"Resource":"*","Action":["s3:PutObject","s3:GetObject"],"Effect":"Allow"}],"Version":"2012-10-17"}`,
+			want: true,
+		},
+		"different": {
+			s1: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`,
+			s2: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"*"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := IAMPolicyDocumentsEquivalent(testCase.s1, testCase.s2), testCase.want; got != want {
+				t.Errorf("IAMPolicyDocumentsEquivalent() = %t, want %t", got, want)
+			}
+		})
+	}
+}
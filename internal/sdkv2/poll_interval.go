@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sdktypes "github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types"
+)
+
+// PollIntervalSchema returns the schema for the standard "poll_interval" argument.
+// It is the SDKv2 counterpart of framework.PollIntervalAttribute and allows the interval at which
+// a resource's waiters poll for state changes to be tuned, complementing the "timeouts" block.
+func PollIntervalSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             sdktypes.TypeDuration,
+		Optional:         true,
+		ValidateDiagFunc: sdktypes.ValidateDuration,
+		Description:      "Interval at which to poll for resource state changes, for example \"30s\" or \"2m\".",
+	}
+}
+
+// PollInterval returns any configured waiter poll interval or the default value.
+// A zero default means that waiters use their own exponential backoff.
+func PollInterval(d interface{ Get(string) any }, defaultInterval time.Duration) time.Duration {
+	v, ok := d.Get("poll_interval").(string)
+	if !ok {
+		return defaultInterval
+	}
+
+	interval, null, err := sdktypes.Duration(v).Value()
+	if null || err != nil || interval <= 0 {
+		return defaultInterval
+	}
+
+	return interval
+}
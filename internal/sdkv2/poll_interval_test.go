@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestPollInterval(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw  map[string]any
+		want time.Duration
+	}{
+		"not set": {
+			raw:  map[string]any{},
+			want: 5 * time.Second,
+		},
+		"empty": {
+			raw: map[string]any{
+				"poll_interval": "",
+			},
+			want: 5 * time.Second,
+		},
+		"set": {
+			raw: map[string]any{
+				"poll_interval": "2m",
+			},
+			want: 2 * time.Minute,
+		},
+		"zero": {
+			raw: map[string]any{
+				"poll_interval": "0s",
+			},
+			want: 5 * time.Second,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				"poll_interval": PollIntervalSchema(),
+			}, testCase.raw)
+
+			if got, want := PollInterval(d, 5*time.Second), testCase.want; got != want {
+				t.Errorf("PollInterval() = %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type defaultTimeoutsKey struct{}
+
+// WithDefaultTimeouts returns a new Context that carries a resource's default operation timeouts.
+func WithDefaultTimeouts(ctx context.Context, timeouts *schema.ResourceTimeout) context.Context {
+	return context.WithValue(ctx, defaultTimeoutsKey{}, timeouts)
+}
+
+// Timeout returns the resource's timeout for the specified operation.
+// It is the SDKv2 counterpart of the framework.WithTimeouts methods.
+// A timeout configured in the resource's `timeouts` block is returned as-is and the resource's
+// default timeout is multiplied by any timeouts_scale_factor in effect for the request.
+// A configured timeout equal to the default cannot be told apart from it and is also scaled.
+func Timeout(ctx context.Context, d interface{ Timeout(string) time.Duration }, key string) time.Duration {
+	timeout := d.Timeout(key)
+
+	if timeouts, ok := ctx.Value(defaultTimeoutsKey{}).(*schema.ResourceTimeout); ok && timeout == defaultTimeout(timeouts, key) {
+		return tfresource.ScaleTimeout(timeout, tfresource.TimeoutsScaleFactor(ctx))
+	}
+
+	return timeout
+}
+
+// defaultTimeout returns the default timeout for the specified operation,
+// falling back in the same way as schema.ResourceData's Timeout method.
+func defaultTimeout(timeouts *schema.ResourceTimeout, key string) time.Duration {
+	// System default of 20 minutes.
+	const systemDefault = 20 * time.Minute
+
+	if timeouts == nil {
+		return systemDefault
+	}
+
+	var timeout *time.Duration
+	switch strings.ToLower(key) {
+	case schema.TimeoutCreate:
+		timeout = timeouts.Create
+	case schema.TimeoutRead:
+		timeout = timeouts.Read
+	case schema.TimeoutUpdate:
+		timeout = timeouts.Update
+	case schema.TimeoutDelete:
+		timeout = timeouts.Delete
+	}
+
+	if timeout != nil {
+		return *timeout
+	}
+
+	if timeouts.Default != nil {
+		return *timeouts.Default
+	}
+
+	return systemDefault
+}
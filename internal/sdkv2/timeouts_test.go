@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+type timeoutFunc func(string) time.Duration
+
+func (f timeoutFunc) Timeout(key string) time.Duration {
+	return f(key)
+}
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+
+	timeouts := &schema.ResourceTimeout{
+		Create:  schema.DefaultTimeout(10 * time.Minute),
+		Default: schema.DefaultTimeout(5 * time.Minute),
+	}
+	configured := timeoutFunc(func(key string) time.Duration {
+		switch key {
+		case schema.TimeoutCreate:
+			return 10 * time.Minute
+		case schema.TimeoutUpdate:
+			return 7 * time.Minute
+		default:
+			return 5 * time.Minute
+		}
+	})
+
+	testCases := map[string]struct {
+		ctx  context.Context
+		key  string
+		want time.Duration
+	}{
+		"no defaults": {
+			ctx:  tfresource.WithTimeoutsScaleFactor(context.Background(), 2),
+			key:  schema.TimeoutCreate,
+			want: 10 * time.Minute,
+		},
+		"no factor": {
+			ctx:  WithDefaultTimeouts(context.Background(), timeouts),
+			key:  schema.TimeoutCreate,
+			want: 10 * time.Minute,
+		},
+		"default": {
+			ctx:  tfresource.WithTimeoutsScaleFactor(WithDefaultTimeouts(context.Background(), timeouts), 2),
+			key:  schema.TimeoutCreate,
+			want: 20 * time.Minute,
+		},
+		"fallback default": {
+			ctx:  tfresource.WithTimeoutsScaleFactor(WithDefaultTimeouts(context.Background(), timeouts), 2),
+			key:  schema.TimeoutDelete,
+			want: 10 * time.Minute,
+		},
+		"configured": {
+			ctx:  tfresource.WithTimeoutsScaleFactor(WithDefaultTimeouts(context.Background(), timeouts), 2),
+			key:  schema.TimeoutUpdate,
+			want: 7 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := Timeout(testCase.ctx, configured, testCase.key), testCase.want; got != want {
+				t.Errorf("Timeout(%q) = %v, want %v", testCase.key, got, want)
+			}
+		})
+	}
+}
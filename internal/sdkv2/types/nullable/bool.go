@@ -36,6 +36,15 @@ func NewBool(v bool) Bool {
 	return Bool(strconv.FormatBool(v))
 }
 
+// FlattenBool returns the nullable bool state value of the specified *bool.
+func FlattenBool(v *bool) string {
+	if v == nil {
+		return ""
+	}
+
+	return strconv.FormatBool(*v)
+}
+
 // ValidateTypeStringNullableBool provides custom error messaging for TypeString booleans
 // Some arguments require a boolean value or unspecified, empty field.
 func ValidateTypeStringNullableBool(v interface{}, k string) (ws []string, es []error) {
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nullable
+
+import (
+	"context"
+	"maps"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// BoolStateUpgrader returns a state upgrader from the specified schema version of the resource
+// to the next version, in which the attributes at the specified paths changed from bool to nullable bool.
+// Attributes nested in configuration blocks are addressed by their block and attribute names separated by ".", e.g. "logs.audit".
+// The prior version's schema is derived from the resource's current schema, so no copy of it needs to be maintained.
+func BoolStateUpgrader(version int, r *schema.Resource, paths ...string) schema.StateUpgrader {
+	return schema.StateUpgrader{
+		Version: version,
+		Type:    priorBoolResource(r, paths).CoreConfigSchema().ImpliedType(),
+		Upgrade: func(_ context.Context, rawState map[string]interface{}, _ interface{}) (map[string]interface{}, error) {
+			if rawState == nil {
+				rawState = map[string]interface{}{}
+			}
+
+			for _, path := range paths {
+				upgradeBool(rawState, strings.Split(path, "."))
+			}
+
+			return rawState, nil
+		},
+	}
+}
+
+// priorBoolResource returns a copy of the resource in which the attributes at the specified paths are of type bool.
+func priorBoolResource(r *schema.Resource, paths []string) *schema.Resource {
+	prior := *r
+	prior.Schema = r.SchemaMap()
+	prior.SchemaFunc = nil
+
+	for _, path := range paths {
+		prior.Schema = priorBoolSchemaMap(prior.Schema, strings.Split(path, "."))
+	}
+
+	return &prior
+}
+
+func priorBoolSchemaMap(s map[string]*schema.Schema, path []string) map[string]*schema.Schema {
+	v, ok := s[path[0]]
+	if !ok {
+		return s
+	}
+
+	prior := maps.Clone(s)
+	attr := *v
+
+	if len(path) == 1 {
+		attr.Type = schema.TypeBool
+		attr.ValidateFunc = nil
+		attr.ValidateDiagFunc = nil
+		attr.DiffSuppressFunc = nil
+	} else if elem, ok := v.Elem.(*schema.Resource); ok {
+		attr.Elem = &schema.Resource{
+			Schema: priorBoolSchemaMap(elem.SchemaMap(), path[1:]),
+		}
+	}
+
+	prior[path[0]] = &attr
+
+	return prior
+}
+
+// upgradeBool converts the bool values at the specified path in the raw state to nullable bool values.
+// Values in nested configuration blocks are converted in every block.
+func upgradeBool(rawState map[string]interface{}, path []string) {
+	v, ok := rawState[path[0]]
+	if !ok {
+		return
+	}
+
+	if len(path) == 1 {
+		if v, ok := v.(bool); ok {
+			rawState[path[0]] = strconv.FormatBool(v)
+		}
+
+		return
+	}
+
+	if v, ok := v.([]interface{}); ok {
+		for _, v := range v {
+			if v, ok := v.(map[string]interface{}); ok {
+				upgradeBool(v, path[1:])
+			}
+		}
+	}
+}
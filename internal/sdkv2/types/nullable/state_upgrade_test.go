@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package nullable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestBoolStateUpgrader(t *testing.T) {
+	t.Parallel()
+
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:         TypeNullableBool,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: ValidateTypeStringNullableBool,
+			},
+			"logs": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audit": {
+							Type:         TypeNullableBool,
+							Optional:     true,
+							ValidateFunc: ValidateTypeStringNullableBool,
+						},
+						"general": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+
+	upgrader := BoolStateUpgrader(1, r, "enabled", "logs.audit")
+
+	if got, expected := upgrader.Version, 1; got != expected {
+		t.Errorf("Version = %d, expected %d", got, expected)
+	}
+
+	if got, expected := upgrader.Type.AttributeType("enabled"), cty.Bool; !got.Equals(expected) {
+		t.Errorf("enabled type = %s, expected %s", got.FriendlyName(), expected.FriendlyName())
+	}
+
+	if got, expected := upgrader.Type.AttributeType("logs").ElementType().AttributeType("audit"), cty.Bool; !got.Equals(expected) {
+		t.Errorf("logs.audit type = %s, expected %s", got.FriendlyName(), expected.FriendlyName())
+	}
+
+	// The current schema is unchanged.
+	if got, expected := r.Schema["enabled"].Type, TypeNullableBool; got != expected {
+		t.Errorf("current enabled type = %s, expected %s", got, expected)
+	}
+
+	if got, expected := r.Schema["logs"].Elem.(*schema.Resource).Schema["audit"].Type, TypeNullableBool; got != expected {
+		t.Errorf("current logs.audit type = %s, expected %s", got, expected)
+	}
+
+	testCases := map[string]struct {
+		rawState map[string]interface{}
+		expected map[string]interface{}
+	}{
+		"nil": {
+			expected: map[string]interface{}{},
+		},
+		"bools": {
+			rawState: map[string]interface{}{
+				"enabled": true,
+				"logs": []interface{}{
+					map[string]interface{}{
+						"audit":   false,
+						"general": true,
+					},
+				},
+				"name": "test",
+			},
+			expected: map[string]interface{}{
+				"enabled": "true",
+				"logs": []interface{}{
+					map[string]interface{}{
+						"audit":   "false",
+						"general": true,
+					},
+				},
+				"name": "test",
+			},
+		},
+		"already upgraded": {
+			rawState: map[string]interface{}{
+				"enabled": "",
+				"logs": []interface{}{
+					map[string]interface{}{
+						"audit": "true",
+					},
+				},
+			},
+			expected: map[string]interface{}{
+				"enabled": "",
+				"logs": []interface{}{
+					map[string]interface{}{
+						"audit": "true",
+					},
+				},
+			},
+		},
+		"missing": {
+			rawState: map[string]interface{}{
+				"logs": []interface{}{},
+				"name": "test",
+			},
+			expected: map[string]interface{}{
+				"logs": []interface{}{},
+				"name": "test",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := upgrader.Upgrade(context.Background(), testCase.rawState, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFlattenBool(t *testing.T) {
+	t.Parallel()
+
+	v := true
+
+	if got, expected := FlattenBool(nil), ""; got != expected {
+		t.Errorf("FlattenBool(nil) = %q, expected %q", got, expected)
+	}
+
+	if got, expected := FlattenBool(&v), "true"; got != expected {
+		t.Errorf("FlattenBool(true) = %q, expected %q", got, expected)
+	}
+}
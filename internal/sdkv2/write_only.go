@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// Write-only attributes hold secrets that must never be persisted in state.
+// A write-only attribute, conventionally named with a "_wo" suffix, is paired with
+// a version attribute of the same name suffixed with "_version".
+// The write-only value is sent to AWS only on create or when its version changes,
+// differences in the value alone are suppressed and the value is removed from state on read.
+// Bump the version to rotate the secret.
+
+// WriteOnlyVersionKey returns the name of the version attribute paired with the specified write-only attribute.
+func WriteOnlyVersionKey(key string) string {
+	return key + "_version"
+}
+
+// WriteOnlyStringSchema returns the schema for the specified top-level write-only string attribute.
+func WriteOnlyStringSchema(key string, validateFunc schema.SchemaValidateFunc, conflictsWith ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Sensitive:        true,
+		ConflictsWith:    conflictsWith,
+		RequiredWith:     []string{WriteOnlyVersionKey(key)},
+		ValidateFunc:     validateFunc,
+		DiffSuppressFunc: SuppressWriteOnlyDiff(key),
+	}
+}
+
+// WriteOnlyVersionSchema returns the schema for the version attribute paired with the specified top-level write-only attribute.
+func WriteOnlyVersionSchema(key string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		RequiredWith: []string{key},
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+}
+
+// SuppressWriteOnlyDiff returns a SchemaDiffSuppressFunc that suppresses differences in
+// the specified write-only attribute unless the resource is new or the attribute's version changes.
+func SuppressWriteOnlyDiff(key string) schema.SchemaDiffSuppressFunc {
+	return func(k, old, new string, d *schema.ResourceData) bool {
+		if d.Id() == "" {
+			return false
+		}
+
+		return !d.HasChange(WriteOnlyVersionKey(key))
+	}
+}
+
+// WriteOnlyString returns the configured value of the specified write-only string attribute
+// and whether or not it is to be sent to AWS, i.e. the value is set and the resource is new or the attribute's version has changed.
+func WriteOnlyString(d *schema.ResourceData, key string) (string, bool) {
+	if !d.HasChange(WriteOnlyVersionKey(key)) {
+		return "", false
+	}
+
+	v, ok := d.GetOk(key)
+	if !ok {
+		return "", false
+	}
+
+	return v.(string), true
+}
+
+// HasWriteOnlyValue returns whether or not the specified write-only attribute is in use, i.e. its version is set.
+func HasWriteOnlyValue(d interface{ Get(string) any }, key string) bool {
+	v, ok := d.Get(WriteOnlyVersionKey(key)).(int)
+
+	return ok && v > 0
+}
+
+// ClearWriteOnly removes the values of the specified write-only attributes from state.
+func ClearWriteOnly(d *schema.ResourceData, keys ...string) {
+	for _, key := range keys {
+		d.Set(key, nil)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWriteOnlyString(t *testing.T) {
+	t.Parallel()
+
+	s := map[string]*schema.Schema{
+		"secret_wo":         WriteOnlyStringSchema("secret_wo", nil),
+		"secret_wo_version": WriteOnlyVersionSchema("secret_wo"),
+	}
+
+	testCases := map[string]struct {
+		raw       map[string]any
+		wantValue string
+		wantOK    bool
+		wantInUse bool
+	}{
+		"not set": {
+			raw: map[string]any{},
+		},
+		"set": {
+			raw: map[string]any{
+				"secret_wo":         "s3cr3t",
+				"secret_wo_version": 1,
+			},
+			wantValue: "s3cr3t",
+			wantOK:    true,
+			wantInUse: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, s, testCase.raw)
+
+			value, ok := WriteOnlyString(d, "secret_wo")
+
+			if got, want := value, testCase.wantValue; got != want {
+				t.Errorf("value = %q, want %q", got, want)
+			}
+			if got, want := ok, testCase.wantOK; got != want {
+				t.Errorf("ok = %t, want %t", got, want)
+			}
+			if got, want := HasWriteOnlyValue(d, "secret_wo"), testCase.wantInUse; got != want {
+				t.Errorf("HasWriteOnlyValue = %t, want %t", got, want)
+			}
+
+			ClearWriteOnly(d, "secret_wo")
+
+			if got := d.Get("secret_wo").(string); got != "" {
+				t.Errorf("value after ClearWriteOnly = %q, want \"\"", got)
+			}
+		})
+	}
+}
+
+func TestWriteOnlyVersionKey(t *testing.T) {
+	t.Parallel()
+
+	if got, want := WriteOnlyVersionKey("auth_token_wo"), "auth_token_wo_version"; got != want {
+		t.Errorf("WriteOnlyVersionKey = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package semver
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	gversion "github.com/hashicorp/go-version"
+)
+
+// EngineVersionLatest is the engine version used by some services to request the latest available version.
+const EngineVersionLatest = "latest"
+
+var (
+	engineVersionEnginePrefixRegexp  = regexache.MustCompile(`^([[:alpha:]]+)_(.+)$`)
+	engineVersionMajorWildcardRegexp = regexache.MustCompile(`^([[:digit:]]+)\.x$`)
+)
+
+// ParseEngineVersion parses a managed engine's version string into engine name and version.
+// The following version formats are supported:
+//   - a regular version number, e.g. "7.1" or "7.1.0", which compare equal
+//   - a version number prefixed with an engine name, e.g. "OpenSearch_2.11"
+//   - a major version wildcard, e.g. "6.x", which sorts after all 6.<minor> versions
+//   - "latest", which sorts after all other versions
+func ParseEngineVersion(s string) (string, *gversion.Version, error) {
+	var engine string
+	if matches := engineVersionEnginePrefixRegexp.FindStringSubmatch(s); matches != nil {
+		engine, s = matches[1], matches[2]
+	}
+
+	if strings.EqualFold(s, EngineVersionLatest) {
+		s = fmt.Sprintf("%[1]d.%[1]d.%[1]d", math.MaxInt)
+	} else if matches := engineVersionMajorWildcardRegexp.FindStringSubmatch(s); matches != nil {
+		s = fmt.Sprintf("%s.%d", matches[1], math.MaxInt)
+	}
+
+	v, err := gversion.NewVersion(s)
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	return engine, v, nil
+}
+
+// EngineVersionIsDowngrade returns whether or not changing a managed engine's version from o to n is a downgrade.
+// A change between engines, e.g. from "Elasticsearch_7.10" to "OpenSearch_1.3", is not a downgrade.
+func EngineVersionIsDowngrade(o, n string) (bool, error) {
+	oEngine, oVersion, err := ParseEngineVersion(o)
+
+	if err != nil {
+		return false, fmt.Errorf("parsing old engine version (%s): %w", o, err)
+	}
+
+	nEngine, nVersion, err := ParseEngineVersion(n)
+
+	if err != nil {
+		return false, fmt.Errorf("parsing new engine version (%s): %w", n, err)
+	}
+
+	if !strings.EqualFold(oEngine, nEngine) {
+		return false, nil
+	}
+
+	return nVersion.LessThan(oVersion), nil
+}
+
+// EngineVersionsEquivalent returns whether or not two managed engine version strings
+// refer to the same version, e.g. "7.1" and "7.1.0".
+// Version strings that cannot be parsed are equivalent only if they are equal.
+func EngineVersionsEquivalent(s1, s2 string) bool {
+	if s1 == s2 {
+		return true
+	}
+
+	e1, v1, err := ParseEngineVersion(s1)
+
+	if err != nil {
+		return false
+	}
+
+	e2, v2, err := ParseEngineVersion(s2)
+
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(e1, e2) && v1.Equal(v2)
+}
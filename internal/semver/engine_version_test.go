@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package semver
+
+import (
+	"testing"
+)
+
+func TestEngineVersionIsDowngrade(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		o           string
+		n           string
+		expected    bool
+		expectError bool
+	}{
+		{"7.1", "7.0", true, false},
+		{"7.1", "7.1.0", false, false},
+		{"7.1.0", "7.1", false, false},
+		{"7.1", "7.2", false, false},
+		{"6.x", "6.2", true, false},
+		{"6.2", "6.x", false, false},
+		{"6.x", "7.0", false, false},
+		{"latest", "7.1", true, false},
+		{"7.1", "latest", false, false},
+		{"5.17.6", "5.15.16", true, false},
+		{"OpenSearch_2.11", "OpenSearch_2.9", true, false},
+		{"OpenSearch_2.9", "OpenSearch_2.11", false, false},
+		{"Elasticsearch_7.10", "OpenSearch_1.3", false, false},
+		{"8.0.mysql_aurora.3.05.2", "8.0.mysql_aurora.3.04.0", false, true},
+		{"", "7.1", false, true},
+		{"7.1", "abc", false, true},
+	} {
+		got, err := EngineVersionIsDowngrade(tc.o, tc.n)
+
+		if tc.expectError {
+			if err == nil {
+				t.Fatalf("EngineVersionIsDowngrade(%q, %q) should error", tc.o, tc.n)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("EngineVersionIsDowngrade(%q, %q) unexpected error: %s", tc.o, tc.n, err)
+		}
+
+		if got != tc.expected {
+			t.Fatalf("EngineVersionIsDowngrade(%q, %q) should be: %t", tc.o, tc.n, tc.expected)
+		}
+	}
+}
+
+func TestEngineVersionsEquivalent(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		s1       string
+		s2       string
+		expected bool
+	}{
+		{"7.1", "7.1.0", true},
+		{"7.1.0", "7.1", true},
+		{"7.1", "7.1.1", false},
+		{"6.x", "6.x", true},
+		{"6.x", "6.2", false},
+		{"latest", "LATEST", true},
+		{"latest", "7.1", false},
+		{"OpenSearch_2.11", "OpenSearch_2.11.0", true},
+		{"OpenSearch_1.3", "Elasticsearch_1.3", false},
+		{"8.0.mysql_aurora.3.05.2", "8.0.mysql_aurora.3.05.2", true},
+		{"abc", "xyz", false},
+	} {
+		if got := EngineVersionsEquivalent(tc.s1, tc.s2); got != tc.expected {
+			t.Fatalf("EngineVersionsEquivalent(%q, %q) should be: %t", tc.s1, tc.s2, tc.expected)
+		}
+	}
+}
@@ -4,6 +4,8 @@
 package semver
 
 import (
+	"strings"
+
 	gversion "github.com/hashicorp/go-version"
 )
 
@@ -31,6 +33,37 @@ func GreaterThanOrEqual(s1, s2 string) bool {
 	return v1.GreaterThanOrEqual(v2)
 }
 
+// Compare compares two version strings according to Semantic Versioning rules (https://semver.org/).
+// The result is -1, 0 or +1 depending on whether s1 is less than, equal to or greater than s2.
+// If either string is not a valid version the strings are compared lexically.
+func Compare(s1, s2 string) int {
+	v1, v2, err := parseVersions(s1, s2)
+
+	if err != nil {
+		return strings.Compare(s1, s2)
+	}
+
+	return v1.Compare(v2)
+}
+
+// MatchesConstraints returns whether or not the version string satisfies the
+// version constraints string, e.g. "~> 1.0".
+func MatchesConstraints(s, constraints string) (bool, error) {
+	c, err := gversion.NewConstraint(constraints)
+
+	if err != nil {
+		return false, err
+	}
+
+	v, err := gversion.NewVersion(s)
+
+	if err != nil {
+		return false, err
+	}
+
+	return c.Check(v), nil
+}
+
 func parseVersions(s1, s2 string) (*gversion.Version, *gversion.Version, error) {
 	v1, err := gversion.NewVersion(s1)
 
@@ -48,3 +48,58 @@ func TestSemVerGreaterThanOrEqual(t *testing.T) {
 		}
 	}
 }
+
+func TestSemVerCompare(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		s1       string
+		s2       string
+		expected int
+	}{
+		{"1.0", "2.0", -1},
+		{"3.0", "2.0", 1},
+		{"4.0", "4.0", 0},
+		{"1.10.0", "1.9.0", 1},
+		{"abc", "xyz", -1},
+	} {
+		if got := Compare(tc.s1, tc.s2); got != tc.expected {
+			t.Fatalf("Compare(%q, %q) should be: %d, got: %d", tc.s1, tc.s2, tc.expected, got)
+		}
+	}
+}
+
+func TestSemVerMatchesConstraints(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		s           string
+		constraints string
+		expected    bool
+		expectError bool
+	}{
+		{"1.2.3", "~> 1.0", true, false},
+		{"2.0.0", "~> 1.0", false, false},
+		{"1.2.3", ">= 1.2, < 1.3", true, false},
+		{"1.2.3", "not-a-constraint", false, true},
+		{"abc", "~> 1.0", false, true},
+	} {
+		got, err := MatchesConstraints(tc.s, tc.constraints)
+
+		if tc.expectError {
+			if err == nil {
+				t.Fatalf("MatchesConstraints(%q, %q) should error", tc.s, tc.constraints)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("MatchesConstraints(%q, %q) unexpected error: %s", tc.s, tc.constraints, err)
+		}
+
+		if got != tc.expected {
+			t.Fatalf("MatchesConstraints(%q, %q) should be: %t", tc.s, tc.constraints, tc.expected)
+		}
+	}
+}
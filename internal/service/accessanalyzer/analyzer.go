@@ -37,6 +37,7 @@ const (
 
 // @SDKResource("aws_accessanalyzer_analyzer", name="Analyzer")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_accessanalyzer_analyzers", listOp="ListAnalyzers", listOpOutputElem="Analyzers", nameElem="Name", arnElem="Arn", taggingResourceType="access-analyzer:analyzer")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types;types.AnalyzerSummary", serialize="true", preCheck="true")
 func resourceAnalyzer() *schema.Resource {
 	return &schema.Resource{
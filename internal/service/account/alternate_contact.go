@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -116,7 +117,7 @@ func resourceAlternateContactCreate(ctx context.Context, d *schema.ResourceData,
 	)
 	_, err = retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
 		return findAlternateContactByTwoPartKey(ctx, conn, accountID, contactType)
-	}).UntilFoundN(inARow).Run(ctx, d.Timeout(schema.TimeoutCreate))
+	}).UntilFoundN(inARow).Run(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Account Alternate Contact (%s) create: %s", d.Id(), err)
@@ -202,7 +203,7 @@ func resourceAlternateContactUpdate(ctx context.Context, d *schema.ResourceData,
 		equal := email == aws.ToString(v.EmailAddress) && name == aws.ToString(v.Name) && phone == aws.ToString(v.PhoneNumber) && title == aws.ToString(v.Title)
 
 		return !equal, nil
-	}).Run(ctx, d.Timeout(schema.TimeoutUpdate))
+	}).Run(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Account Alternate Contact (%s) update: %s", d.Id(), err)
@@ -242,7 +243,7 @@ func resourceAlternateContactDelete(ctx context.Context, d *schema.ResourceData,
 
 	_, err = retry.Operation(func(ctx context.Context) (*types.AlternateContact, error) {
 		return findAlternateContactByTwoPartKey(ctx, conn, accountID, contactType)
-	}).UntilNotFound().Run(ctx, d.Timeout(schema.TimeoutDelete))
+	}).UntilNotFound().Run(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Account Alternate Contact (%s) delete: %s", d.Id(), err)
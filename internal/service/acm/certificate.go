@@ -55,6 +55,7 @@ const (
 
 // @SDKResource("aws_acm_certificate", name="Certificate")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_acm_certificates", listOp="ListCertificates", listOpOutputElem="CertificateSummaryList", nameElem="DomainName", arnElem="CertificateArn", taggingResourceType="acm:certificate")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/acm/types;types.CertificateDetail", tlsKey=true, importIgnore="certificate_body;private_key, generator=false)
 func resourceCertificate() *schema.Resource {
 	return &schema.Resource{
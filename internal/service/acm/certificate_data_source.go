@@ -82,7 +82,7 @@ func dataSourceCertificateRead(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).ACMClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	domain := d.Get(names.AttrDomain).(string)
 	input := acm.ListCertificatesInput{}
@@ -74,6 +74,31 @@ func resourceTarget() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"suspended_state": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"dynamic_scaling_in_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"dynamic_scaling_out_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"scheduled_scaling_suspended": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -100,6 +125,10 @@ func resourceTargetCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.RoleARN = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("suspended_state"); ok {
+		input.SuspendedState = expandSuspendedState(v.([]interface{}))
+	}
+
 	err := registerScalableTarget(ctx, conn, input)
 
 	if err != nil {
@@ -141,6 +170,9 @@ func resourceTargetRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set(names.AttrRoleARN, t.RoleARN)
 	d.Set("scalable_dimension", t.ScalableDimension)
 	d.Set("service_namespace", t.ServiceNamespace)
+	if err := d.Set("suspended_state", flattenSuspendedState(t.SuspendedState)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting suspended_state: %s", err)
+	}
 
 	return diags
 }
@@ -162,6 +194,10 @@ func resourceTargetUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.RoleARN = aws.String(v.(string))
 		}
 
+		if v, ok := d.GetOk("suspended_state"); ok {
+			input.SuspendedState = expandSuspendedState(v.([]interface{}))
+		}
+
 		err := registerScalableTarget(ctx, conn, input)
 
 		if err != nil {
@@ -262,6 +298,32 @@ func resourceTargetImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+func expandSuspendedState(tfList []interface{}) *awstypes.SuspendedState {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &awstypes.SuspendedState{
+		DynamicScalingInSuspended:  aws.Bool(tfMap["dynamic_scaling_in_suspended"].(bool)),
+		DynamicScalingOutSuspended: aws.Bool(tfMap["dynamic_scaling_out_suspended"].(bool)),
+		ScheduledScalingSuspended:  aws.Bool(tfMap["scheduled_scaling_suspended"].(bool)),
+	}
+}
+
+func flattenSuspendedState(apiObject *awstypes.SuspendedState) []interface{} {
+	if apiObject == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"dynamic_scaling_in_suspended":  aws.ToBool(apiObject.DynamicScalingInSuspended),
+		"dynamic_scaling_out_suspended": aws.ToBool(apiObject.DynamicScalingOutSuspended),
+		"scheduled_scaling_suspended":   aws.ToBool(apiObject.ScheduledScalingSuspended),
+	}}
+}
+
 func registerScalableTarget(ctx context.Context, conn *applicationautoscaling.Client, input *applicationautoscaling.RegisterScalableTargetInput) error {
 	_, err := tfresource.RetryWhen(ctx, propagationTimeout,
 		func() (interface{}, error) {
@@ -208,6 +208,48 @@ func TestAccAppAutoScalingTarget_optionalRoleARN(t *testing.T) {
 	})
 }
 
+func TestAccAppAutoScalingTarget_suspendedState(t *testing.T) {
+	ctx := acctest.Context(t)
+	var target awstypes.ScalableTarget
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_appautoscaling_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AppAutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTargetConfig_suspendedState(rName, true, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_in_suspended", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_out_suspended", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.scheduled_scaling_suspended", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTargetImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTargetConfig_suspendedState(rName, false, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTargetExists(ctx, resourceName, &target),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_in_suspended", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.dynamic_scaling_out_suspended", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "suspended_state.0.scheduled_scaling_suspended", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTargetDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppAutoScalingClient(ctx)
@@ -317,6 +359,24 @@ resource "aws_appautoscaling_target" "test" {
 `)
 }
 
+func testAccTargetConfig_suspendedState(rName string, dynamicScalingInSuspended, dynamicScalingOutSuspended, scheduledScalingSuspended bool) string {
+	return acctest.ConfigCompose(testAccTargetConfig_baseECS(rName, 1), fmt.Sprintf(`
+resource "aws_appautoscaling_target" "test" {
+  service_namespace  = "ecs"
+  resource_id        = "service/${aws_ecs_cluster.test.name}/${aws_ecs_service.test.name}"
+  scalable_dimension = "ecs:service:DesiredCount"
+  min_capacity       = 1
+  max_capacity       = 3
+
+  suspended_state {
+    dynamic_scaling_in_suspended  = %[1]t
+    dynamic_scaling_out_suspended = %[2]t
+    scheduled_scaling_suspended   = %[3]t
+  }
+}
+`, dynamicScalingInSuspended, dynamicScalingOutSuspended, scheduledScalingSuspended))
+}
+
 func testAccTargetConfig_spotFleetRequest(rName, validUntil string) string {
 	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"), fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -25,6 +25,7 @@ import (
 
 // @SDKResource("aws_appconfig_application", name="Application")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_appconfig_applications", listOp="ListApplications", listOpOutputElem="Items", nameElem="Name")
 func ResourceApplication() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceApplicationCreate,
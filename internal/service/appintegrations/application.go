@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appintegrations
+
+import (
+	"context"
+	"log"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/appintegrations"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/appintegrations/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_appintegrations_application", name="Application")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/appintegrations;appintegrations.GetApplicationOutput")
+func ResourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceApplicationCreate,
+		ReadWithoutTimeout:   resourceApplicationRead,
+		UpdateWithoutTimeout: resourceApplicationUpdate,
+		DeleteWithoutTimeout: resourceApplicationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_source_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"external_url_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_url": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1000),
+									},
+									"approved_origins": {
+										Type:     schema.TypeSet,
+										Optional: true,
+										MaxItems: 50,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringLenBetween(1, 267),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1000),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\/\._ \-]{1,255}$`), "should be not be more than 255 alphanumeric, forward slashes, dots, spaces, underscores, or hyphen characters"),
+			},
+			names.AttrNamespace: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z\/\._\-]{1,255}$`), "should be not be more than 255 alphanumeric, forward slashes, dots, underscores, or hyphen characters"),
+			},
+			names.AttrPermissions: {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 150,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &appintegrations.CreateApplicationInput{
+		ApplicationSourceConfig: expandApplicationSourceConfig(d.Get("application_source_config").([]interface{})),
+		ClientToken:             aws.String(id.UniqueId()),
+		Name:                    aws.String(name),
+		Namespace:               aws.String(d.Get(names.AttrNamespace).(string)),
+		Tags:                    getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrPermissions); ok && v.(*schema.Set).Len() > 0 {
+		input.Permissions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	output, err := conn.CreateApplication(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating AppIntegrations Application (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.Arn))
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	output, err := findApplicationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] AppIntegrations Application (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading AppIntegrations Application (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("application_source_config", flattenApplicationSourceConfig(output.ApplicationSourceConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting application_source_config: %s", err)
+	}
+	d.Set(names.AttrARN, output.Arn)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set(names.AttrName, output.Name)
+	d.Set(names.AttrNamespace, output.Namespace)
+	d.Set(names.AttrPermissions, output.Permissions)
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &appintegrations.UpdateApplicationInput{
+			ApplicationSourceConfig: expandApplicationSourceConfig(d.Get("application_source_config").([]interface{})),
+			Arn:                     aws.String(d.Id()),
+			Description:             aws.String(d.Get(names.AttrDescription).(string)),
+			Name:                    aws.String(d.Get(names.AttrName).(string)),
+			Permissions:             flex.ExpandStringValueSet(d.Get(names.AttrPermissions).(*schema.Set)),
+		}
+
+		_, err := conn.UpdateApplication(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating AppIntegrations Application (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceApplicationRead(ctx, d, meta)...)
+}
+
+func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).AppIntegrationsClient(ctx)
+
+	log.Printf("[DEBUG] Deleting AppIntegrations Application: %s", d.Id())
+	_, err := conn.DeleteApplication(ctx, &appintegrations.DeleteApplicationInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting AppIntegrations Application (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findApplicationByARN(ctx context.Context, conn *appintegrations.Client, arn string) (*appintegrations.GetApplicationOutput, error) {
+	input := &appintegrations.GetApplicationInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetApplication(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandApplicationSourceConfig(tfList []interface{}) *awstypes.ApplicationSourceConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &awstypes.ApplicationSourceConfig{}
+
+	if v, ok := tfMap["external_url_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ExternalUrlConfig = expandExternalURLConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandExternalURLConfig(tfMap map[string]interface{}) *awstypes.ExternalUrlConfig {
+	apiObject := &awstypes.ExternalUrlConfig{}
+
+	if v, ok := tfMap["access_url"].(string); ok && v != "" {
+		apiObject.AccessUrl = aws.String(v)
+	}
+
+	if v, ok := tfMap["approved_origins"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.ApprovedOrigins = flex.ExpandStringValueSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenApplicationSourceConfig(apiObject *awstypes.ApplicationSourceConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.ExternalUrlConfig; v != nil {
+		tfMap["external_url_config"] = []interface{}{map[string]interface{}{
+			"access_url":       aws.ToString(v.AccessUrl),
+			"approved_origins": v.ApprovedOrigins,
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -350,7 +351,7 @@ func resourceGraphQLAPICreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(aws.ToString(output.GraphqlApi.ApiId))
 
 	if v, ok := d.GetOk(names.AttrSchema); ok {
-		if err := putSchema(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if err := putSchema(ctx, conn, d.Id(), v.(string), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -459,7 +460,7 @@ func resourceGraphQLAPIUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 		if d.HasChange(names.AttrSchema) {
 			if v, ok := d.GetOk(names.AttrSchema); ok {
-				if err := putSchema(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := putSchema(ctx, conn, d.Id(), v.(string), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			}
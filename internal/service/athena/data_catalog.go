@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_athena_data_catalog", name="Data Catalog")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_athena_data_catalogs", listOp="ListDataCatalogs", listOpOutputElem="DataCatalogsSummary", nameElem="CatalogName")
 func resourceDataCatalog() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDataCatalogCreate,
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_athena_workgroup", name="WorkGroup")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_athena_workgroups", name="Workgroups", listOp="ListWorkGroups", listOpOutputElem="WorkGroups", nameElem="Name")
 func resourceWorkGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceWorkGroupCreate,
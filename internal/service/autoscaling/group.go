@@ -32,6 +32,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tfelb "github.com/hashicorp/terraform-provider-aws/internal/service/elb"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -227,6 +228,11 @@ func resourceGroup() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"fail_on_rollback": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 						"preferences": {
 							Type:     schema.TypeList,
 							MaxItems: 1,
@@ -318,6 +324,34 @@ func resourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"latest_instance_refresh": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_refresh_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"percentage_complete": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"rollback_reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"launch_configuration": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -1258,7 +1292,7 @@ func resourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta inter
 func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	g, err := findGroupByName(ctx, conn, d.Id())
 
@@ -1292,6 +1326,23 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
 	}
+	// The latest instance refresh is only tracked when instance refreshes are managed by this resource.
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		instanceRefresh, err := findLatestInstanceRefreshByGroupName(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("latest_instance_refresh", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s) instance refreshes: %s", d.Id(), err)
+		default:
+			if err := d.Set("latest_instance_refresh", []interface{}{flattenInstanceRefresh(instanceRefresh)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting latest_instance_refresh: %s", err)
+			}
+		}
+	} else {
+		d.Set("latest_instance_refresh", nil)
+	}
 	d.Set("launch_configuration", g.LaunchConfigurationName)
 	if g.LaunchTemplate != nil {
 		if err := d.Set(names.AttrLaunchTemplate, []interface{}{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
@@ -1521,7 +1572,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendErrorf(diags, "detaching Auto Scaling Group (%s) traffic sources: %s", d.Id(), err)
 			}
 
-			if _, err := waitTrafficSourcesDeleted(ctx, conn, d.Id(), "", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitTrafficSourcesDeleted(ctx, conn, d.Id(), "", sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) traffic sources removed: %s", d.Id(), err)
 			}
 		}
@@ -1536,7 +1587,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendErrorf(diags, "attaching Auto Scaling Group (%s) traffic sources: %s", d.Id(), err)
 			}
 
-			if _, err := waitTrafficSourcesCreated(ctx, conn, d.Id(), "", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitTrafficSourcesCreated(ctx, conn, d.Id(), "", sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) traffic sources added: %s", d.Id(), err)
 			}
 		}
@@ -1565,7 +1616,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendErrorf(diags, "detaching Auto Scaling Group (%s) load balancers: %s", d.Id(), err)
 			}
 
-			if _, err := waitLoadBalancersRemoved(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitLoadBalancersRemoved(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) load balancers removed: %s", d.Id(), err)
 			}
 		}
@@ -1580,7 +1631,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendErrorf(diags, "attaching Auto Scaling Group (%s) load balancers: %s", d.Id(), err)
 			}
 
-			if _, err := waitLoadBalancersAdded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitLoadBalancersAdded(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) load balancers added: %s", d.Id(), err)
 			}
 		}
@@ -1609,7 +1660,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendErrorf(diags, "detaching Auto Scaling Group (%s) target groups: %s", d.Id(), err)
 			}
 
-			if _, err := waitLoadBalancerTargetGroupsRemoved(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitLoadBalancerTargetGroupsRemoved(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) target groups removed: %s", d.Id(), err)
 			}
 		}
@@ -1624,7 +1675,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				return sdkdiag.AppendErrorf(diags, "attaching Auto Scaling Group (%s) target groups: %s", d.Id(), err)
 			}
 
-			if _, err := waitLoadBalancerTargetGroupsAdded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitLoadBalancerTargetGroupsAdded(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) target groups added: %s", d.Id(), err)
 			}
 		}
@@ -1660,9 +1711,17 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 				mixedInstancesPolicy = expandMixedInstancesPolicy(v.([]interface{})[0].(map[string]interface{}), true)
 			}
 
-			if err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy)); err != nil {
+			instanceRefreshID, err := startInstanceRefresh(ctx, conn, expandStartInstanceRefreshInput(d.Id(), tfMap, launchTemplate, mixedInstancesPolicy))
+
+			if err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if v, ok := tfMap["fail_on_rollback"].(bool); ok && v {
+				if _, err := waitInstanceRefreshSuccessful(ctx, conn, d.Id(), instanceRefreshID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for Auto Scaling Group (%s) instance refresh (%s) complete: %s", d.Id(), instanceRefreshID, err)
+				}
+			}
 		}
 	}
 
@@ -1673,7 +1732,7 @@ func resourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		if len(w) == 0 || w[0] == nil {
 			forceDeleteWarmPool := d.Get(names.AttrForceDelete).(bool) || d.Get("force_delete_warm_pool").(bool)
 
-			if err := deleteWarmPool(ctx, conn, d.Id(), forceDeleteWarmPool, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := deleteWarmPool(ctx, conn, d.Id(), forceDeleteWarmPool, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		} else {
@@ -1813,7 +1872,7 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if group.WarmPoolConfiguration != nil {
-		err = deleteWarmPool(ctx, conn, d.Id(), forceDeleteWarmPool, d.Timeout(schema.TimeoutDelete))
+		err = deleteWarmPool(ctx, conn, d.Id(), forceDeleteWarmPool, sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -1821,7 +1880,7 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	if !forceDeleteGroup {
-		err = drainGroup(ctx, conn, d.Id(), group.Instances, d.Timeout(schema.TimeoutDelete))
+		err = drainGroup(ctx, conn, d.Id(), group.Instances, sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
@@ -1829,7 +1888,7 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	log.Printf("[DEBUG] Deleting Auto Scaling Group: %s", d.Id())
-	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete),
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete),
 		func() (interface{}, error) {
 			return conn.DeleteAutoScalingGroup(ctx, &autoscaling.DeleteAutoScalingGroupInput{
 				AutoScalingGroupName: aws.String(d.Id()),
@@ -1846,7 +1905,7 @@ func resourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "deleting Auto Scaling Group (%s): %s", d.Id(), err)
 	}
 
-	_, err = tfresource.RetryUntilNotFound(ctx, d.Timeout(schema.TimeoutDelete),
+	_, err = tfresource.RetryUntilNotFound(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete),
 		func() (interface{}, error) {
 			return findGroupByName(ctx, conn, d.Id())
 		})
@@ -2119,6 +2178,33 @@ func findInstanceRefreshes(ctx context.Context, conn *autoscaling.Client, input
 	return output, nil
 }
 
+func findLatestInstanceRefreshByGroupName(ctx context.Context, conn *autoscaling.Client, name string) (*awstypes.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int32(1),
+	}
+
+	// Instance refreshes are returned most recent first.
+	output, err := conn.DescribeInstanceRefreshes(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, errCodeValidationError, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceRefreshes) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &output.InstanceRefreshes[0], nil
+}
+
 func findLoadBalancerStates(ctx context.Context, conn *autoscaling.Client, name string) ([]awstypes.LoadBalancerState, error) {
 	input := &autoscaling.DescribeLoadBalancersInput{
 		AutoScalingGroupName: aws.String(name),
@@ -2702,6 +2788,34 @@ func waitInstanceRefreshCancelled(ctx context.Context, conn *autoscaling.Client,
 	return nil, err
 }
 
+func waitInstanceRefreshSuccessful(ctx context.Context, conn *autoscaling.Client, name, id string, timeout time.Duration) (*awstypes.InstanceRefresh, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.InstanceRefreshStatusBaking,
+			awstypes.InstanceRefreshStatusInProgress,
+			awstypes.InstanceRefreshStatusPending,
+			awstypes.InstanceRefreshStatusRollbackInProgress,
+		),
+		Target:  enum.Slice(awstypes.InstanceRefreshStatusSuccessful),
+		Refresh: statusInstanceRefresh(ctx, conn, name, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.InstanceRefresh); ok {
+		if v := output.RollbackDetails; v != nil && aws.ToString(v.RollbackReason) != "" {
+			tfresource.SetLastError(err, errors.New(aws.ToString(v.RollbackReason)))
+		} else {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitWarmPoolDeleted(ctx context.Context, conn *autoscaling.Client, name string, timeout time.Duration) (*awstypes.WarmPoolConfiguration, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.WarmPoolStatusPendingDelete),
@@ -3974,6 +4088,25 @@ func flattenWarmPoolInstanceReusePolicy(apiObject *awstypes.InstanceReusePolicy)
 	return tfMap
 }
 
+func flattenInstanceRefresh(apiObject *awstypes.InstanceRefresh) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"instance_refresh_id":  aws.ToString(apiObject.InstanceRefreshId),
+		"percentage_complete":  aws.ToInt32(apiObject.PercentageComplete),
+		names.AttrStatus:       string(apiObject.Status),
+		names.AttrStatusReason: aws.ToString(apiObject.StatusReason),
+	}
+
+	if v := apiObject.RollbackDetails; v != nil {
+		tfMap["rollback_reason"] = aws.ToString(v.RollbackReason)
+	}
+
+	return tfMap
+}
+
 func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name string) error {
 	input := &autoscaling.CancelInstanceRefreshInput{
 		AutoScalingGroupName: aws.String(name),
@@ -3998,10 +4131,10 @@ func cancelInstanceRefresh(ctx context.Context, conn *autoscaling.Client, name s
 	return nil
 }
 
-func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *autoscaling.StartInstanceRefreshInput) error {
+func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *autoscaling.StartInstanceRefreshInput) (string, error) {
 	name := aws.ToString(input.AutoScalingGroupName)
 
-	_, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
+	outputRaw, err := tfresource.RetryWhen(ctx, instanceRefreshStartedTimeout,
 		func() (interface{}, error) {
 			return conn.StartInstanceRefresh(ctx, input)
 		},
@@ -4018,10 +4151,10 @@ func startInstanceRefresh(ctx context.Context, conn *autoscaling.Client, input *
 		})
 
 	if err != nil {
-		return fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
+		return "", fmt.Errorf("starting Auto Scaling Group (%s) instance refresh: %w", name, err)
 	}
 
-	return nil
+	return aws.ToString(outputRaw.(*autoscaling.StartInstanceRefreshOutput).InstanceRefreshId), nil
 }
 
 func validateGroupInstanceRefreshTriggerFields(i interface{}, path cty.Path) diag.Diagnostics {
@@ -1667,6 +1667,45 @@ func TestAccAutoScalingGroup_InstanceRefresh_autoRollback(t *testing.T) {
 	})
 }
 
+func TestAccAutoScalingGroup_InstanceRefresh_failOnRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group awstypes.AutoScalingGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_instanceRefreshFailOnRollback(rName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.fail_on_rollback", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.auto_rollback", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccGroupConfig_instanceRefreshFailOnRollback(rName, "t3.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGroupExists(ctx, resourceName, &group),
+					testAccCheckInstanceRefreshCount(ctx, &group, 1),
+					testAccCheckInstanceRefreshStatus(ctx, &group, 0, awstypes.InstanceRefreshStatusSuccessful),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_instance_refresh.0.instance_refresh_id"),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.0.percentage_complete", "100"),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.0.rollback_reason", ""),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.0.status", string(awstypes.InstanceRefreshStatusSuccessful)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAutoScalingGroup_InstanceRefresh_alarmSpecification(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group awstypes.AutoScalingGroup
@@ -5384,6 +5423,43 @@ resource "aws_autoscaling_group" "test" {
 `, rName))
 }
 
+func testAccGroupConfig_instanceRefreshFailOnRollback(rName, instanceType string) string {
+	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, instanceType), fmt.Sprintf(`
+resource "aws_autoscaling_group" "test" {
+  availability_zones = [data.aws_availability_zones.available.names[0]]
+  name               = %[1]q
+  max_size           = 2
+  min_size           = 1
+  desired_capacity   = 1
+
+  launch_template {
+    id      = aws_launch_template.test.id
+    version = aws_launch_template.test.default_version
+  }
+
+  instance_refresh {
+    strategy         = "Rolling"
+    fail_on_rollback = true
+
+    preferences {
+      auto_rollback          = true
+      min_healthy_percentage = 0
+    }
+  }
+
+  timeouts {
+    update = "30m"
+  }
+
+  tag {
+    key                 = "Name"
+    value               = %[1]q
+    propagate_at_launch = true
+  }
+}
+`, rName))
+}
+
 func testAccGroupConfig_instanceRefreshAlarmSpecification(rName, instanceType string) string {
 	return acctest.ConfigCompose(testAccGroupConfig_launchTemplateBase(rName, instanceType), fmt.Sprintf(`
 resource "aws_autoscaling_group" "test" {
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -165,7 +166,7 @@ func resourceFrameworkCreate(ctx context.Context, d *schema.ResourceData, meta i
 	d.SetId(aws.ToString(resp.FrameworkName))
 
 	// waiter since the status changes from CREATE_IN_PROGRESS to either COMPLETED or FAILED
-	if _, err := waitFrameworkCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitFrameworkCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Framework (%s) creation: %s", d.Id(), err)
 	}
 
@@ -218,7 +219,7 @@ func resourceFrameworkUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 		log.Printf("[DEBUG] Updating Backup Framework: %#v", input)
 
-		_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), func() (interface{}, error) {
 			return conn.UpdateFramework(ctx, input)
 		})
 
@@ -226,7 +227,7 @@ func resourceFrameworkUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			return sdkdiag.AppendErrorf(diags, "updating Backup Framework (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitFrameworkUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitFrameworkUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Framework (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -242,7 +243,7 @@ func resourceFrameworkDelete(ctx context.Context, d *schema.ResourceData, meta i
 		FrameworkName: aws.String(d.Id()),
 	}
 
-	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenIsA[*awstypes.ConflictException](ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
 		return conn.DeleteFramework(ctx, input)
 	})
 
@@ -254,7 +255,7 @@ func resourceFrameworkDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "deleting Backup Framework (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitFrameworkDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitFrameworkDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Framework (%s) deletion: %s", d.Id(), err)
 	}
 
@@ -106,7 +106,7 @@ func DataSourceFramework() *schema.Resource {
 func dataSourceFrameworkRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BackupClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	name := d.Get(names.AttrName).(string)
 
@@ -117,7 +117,7 @@ func DataSourceReportPlan() *schema.Resource {
 func dataSourceReportPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).BackupClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	name := d.Get(names.AttrName).(string)
 	reportPlan, err := FindReportPlanByName(ctx, conn, name)
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"context"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_batch_fair_share_preview", name="Fair Share Preview")
+func dataSourceFairSharePreview() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFairSharePreviewRead,
+
+		Schema: map[string]*schema.Schema{
+			"active_share_identifiers": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 500,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z]{1,255}$`), "must be up to 255 alphanumeric characters"),
+				},
+			},
+			"available_capacity_percent": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"compute_reservation": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"reserved_capacity_percent": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"scheduling_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"share": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"capacity_percent": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"policy_share_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"share_identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"weight_factor": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"share_decay_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceFairSharePreviewRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).BatchConn(ctx)
+
+	arn := d.Get("scheduling_policy_arn").(string)
+	schedulingPolicy, err := FindSchedulingPolicyByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("Batch Scheduling Policy", err))
+	}
+
+	preview := previewFairSharePolicy(schedulingPolicy.FairsharePolicy, flex.ExpandStringValueSet(d.Get("active_share_identifiers").(*schema.Set)))
+
+	d.SetId(aws.StringValue(schedulingPolicy.Arn))
+	d.Set("available_capacity_percent", preview.availableCapacityPercent)
+	d.Set("reserved_capacity_percent", preview.reservedCapacityPercent)
+	if v := schedulingPolicy.FairsharePolicy; v != nil {
+		d.Set("compute_reservation", v.ComputeReservation)
+		d.Set("share_decay_seconds", v.ShareDecaySeconds)
+	} else {
+		d.Set("compute_reservation", 0)
+		d.Set("share_decay_seconds", 0)
+	}
+	if err := d.Set("share", flattenFairSharePreviewShares(preview.shares)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting share: %s", err)
+	}
+
+	return diags
+}
+
+const (
+	// Share identifiers that are not in the scheduling policy have a weight factor of 1.
+	defaultWeightFactor = 1.0
+)
+
+type fairSharePreview struct {
+	availableCapacityPercent float64
+	reservedCapacityPercent  float64
+	shares                   []fairSharePreviewShare
+}
+
+type fairSharePreviewShare struct {
+	capacityPercent       float64
+	policyShareIdentifier string
+	shareIdentifier       string
+	weightFactor          float64
+}
+
+// previewFairSharePolicy estimates how a job queue's vCPU capacity is divided between the specified active share identifiers
+// under a fair share scheduling policy.
+//
+// A fraction (compute_reservation/100)^ActiveFairShares of the capacity is reserved for share identifiers that are not active.
+// The remaining capacity is divided between the active share identifiers in inverse proportion to their weight factors.
+// Each active share identifier takes its weight factor from the matching share distribution: an exact match,
+// otherwise the longest matching wildcard (trailing '*') prefix.
+// Share usage history (share_decay_seconds) and the jobs actually queued are not taken into account.
+func previewFairSharePolicy(policy *batch.FairsharePolicy, activeShareIdentifiers []string) *fairSharePreview {
+	var computeReservation int64
+	var shareDistributions []*batch.ShareAttributes
+
+	if policy != nil {
+		computeReservation = aws.Int64Value(policy.ComputeReservation)
+		shareDistributions = policy.ShareDistribution
+	}
+
+	activeShareIdentifiers = slices.Clone(activeShareIdentifiers)
+	slices.Sort(activeShareIdentifiers)
+	activeShareIdentifiers = slices.Compact(activeShareIdentifiers)
+
+	preview := &fairSharePreview{}
+
+	if n := len(activeShareIdentifiers); n > 0 && computeReservation > 0 {
+		preview.reservedCapacityPercent = 100 * math.Pow(float64(computeReservation)/100, float64(n))
+	}
+	preview.availableCapacityPercent = 100 - preview.reservedCapacityPercent
+
+	var totalShares float64
+
+	for _, shareIdentifier := range activeShareIdentifiers {
+		share := fairSharePreviewShare{
+			shareIdentifier: shareIdentifier,
+			weightFactor:    defaultWeightFactor,
+		}
+
+		if v := matchShareDistribution(shareDistributions, shareIdentifier); v != nil {
+			share.policyShareIdentifier = aws.StringValue(v.ShareIdentifier)
+			if v := aws.Float64Value(v.WeightFactor); v > 0 {
+				share.weightFactor = v
+			}
+		}
+
+		totalShares += 1 / share.weightFactor
+		preview.shares = append(preview.shares, share)
+	}
+
+	for i, share := range preview.shares {
+		preview.shares[i].capacityPercent = roundPercent(preview.availableCapacityPercent * (1 / share.weightFactor) / totalShares)
+	}
+
+	preview.availableCapacityPercent = roundPercent(preview.availableCapacityPercent)
+	preview.reservedCapacityPercent = roundPercent(preview.reservedCapacityPercent)
+
+	return preview
+}
+
+// matchShareDistribution returns the share distribution that applies to the specified share identifier, or nil.
+func matchShareDistribution(shareDistributions []*batch.ShareAttributes, shareIdentifier string) *batch.ShareAttributes {
+	var match *batch.ShareAttributes
+	var matchLen int
+
+	for _, v := range shareDistributions {
+		if v == nil {
+			continue
+		}
+
+		policyShareIdentifier := aws.StringValue(v.ShareIdentifier)
+
+		if policyShareIdentifier == shareIdentifier {
+			return v
+		}
+
+		if prefix, ok := strings.CutSuffix(policyShareIdentifier, "*"); ok && strings.HasPrefix(shareIdentifier, prefix) && (match == nil || len(prefix) > matchLen) {
+			match = v
+			matchLen = len(prefix)
+		}
+	}
+
+	return match
+}
+
+func roundPercent(v float64) float64 {
+	return math.Round(v*10000) / 10000
+}
+
+func flattenFairSharePreviewShares(apiObjects []fairSharePreviewShare) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"capacity_percent":        apiObject.capacityPercent,
+			"policy_share_identifier": apiObject.policyShareIdentifier,
+			"share_identifier":        apiObject.shareIdentifier,
+			"weight_factor":           apiObject.weightFactor,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBatchFairSharePreviewDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_batch_scheduling_policy.test"
+	dataSourceName := "data.aws_batch_fair_share_preview.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFairSharePreviewDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "available_capacity_percent", "75"),
+					resource.TestCheckResourceAttr(dataSourceName, "compute_reservation", "50"),
+					resource.TestCheckResourceAttr(dataSourceName, "reserved_capacity_percent", "25"),
+					resource.TestCheckResourceAttr(dataSourceName, "share.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "share.0.capacity_percent", "50"),
+					resource.TestCheckResourceAttr(dataSourceName, "share.0.policy_share_identifier", "A1*"),
+					resource.TestCheckResourceAttr(dataSourceName, "share.0.share_identifier", "A1x"),
+					resource.TestCheckResourceAttr(dataSourceName, "share.0.weight_factor", "0.5"),
+					resource.TestCheckResourceAttr(dataSourceName, "share.1.capacity_percent", "25"),
+					resource.TestCheckResourceAttr(dataSourceName, "share.1.policy_share_identifier", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "share.1.share_identifier", "B"),
+					resource.TestCheckResourceAttr(dataSourceName, "share.1.weight_factor", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "share_decay_seconds", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFairSharePreviewDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fair_share_policy {
+    compute_reservation = 50
+    share_decay_seconds = 3600
+
+    share_distribution {
+      share_identifier = "A1*"
+      weight_factor    = 0.5
+    }
+
+    share_distribution {
+      share_identifier = "A2"
+      weight_factor    = 0.2
+    }
+  }
+}
+
+data "aws_batch_fair_share_preview" "test" {
+  scheduling_policy_arn    = aws_batch_scheduling_policy.test.arn
+  active_share_identifiers = ["B", "A1x"]
+}
+`, rName)
+}
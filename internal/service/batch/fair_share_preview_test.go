@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package batch
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPreviewFairSharePolicy(t *testing.T) {
+	t.Parallel()
+
+	policy := &batch.FairsharePolicy{
+		ComputeReservation: aws.Int64(50),
+		ShareDecaySeconds:  aws.Int64(3600),
+		ShareDistribution: []*batch.ShareAttributes{
+			{
+				ShareIdentifier: aws.String("A*"),
+				WeightFactor:    aws.Float64(0.5),
+			},
+			{
+				ShareIdentifier: aws.String("AB*"),
+				WeightFactor:    aws.Float64(0.25),
+			},
+			{
+				ShareIdentifier: aws.String("ABC"),
+				WeightFactor:    aws.Float64(2),
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		policy                 *batch.FairsharePolicy
+		activeShareIdentifiers []string
+		expectedReserved       float64
+		expectedShares         []fairSharePreviewShare
+	}{
+		"no active shares": {
+			policy:           policy,
+			expectedReserved: 0,
+		},
+		"one active share": {
+			policy:                 policy,
+			activeShareIdentifiers: []string{"Z"},
+			expectedReserved:       50,
+			expectedShares: []fairSharePreviewShare{
+				{capacityPercent: 50, shareIdentifier: "Z", weightFactor: 1},
+			},
+		},
+		"matching": {
+			policy:                 policy,
+			activeShareIdentifiers: []string{"ABC", "ABD", "AC", "Z", "ABC"},
+			expectedReserved:       6.25,
+			expectedShares: []fairSharePreviewShare{
+				// Shares are 0.5 (ABC), 4 (ABD), 2 (AC) and 1 (Z) of 7.5.
+				{capacityPercent: 6.25, policyShareIdentifier: "ABC", shareIdentifier: "ABC", weightFactor: 2},
+				{capacityPercent: 50, policyShareIdentifier: "AB*", shareIdentifier: "ABD", weightFactor: 0.25},
+				{capacityPercent: 25, policyShareIdentifier: "A*", shareIdentifier: "AC", weightFactor: 0.5},
+				{capacityPercent: 12.5, shareIdentifier: "Z", weightFactor: 1},
+			},
+		},
+		"no policy": {
+			activeShareIdentifiers: []string{"B", "A"},
+			expectedShares: []fairSharePreviewShare{
+				{capacityPercent: 50, shareIdentifier: "A", weightFactor: 1},
+				{capacityPercent: 50, shareIdentifier: "B", weightFactor: 1},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := previewFairSharePolicy(testCase.policy, testCase.activeShareIdentifiers)
+
+			if got, want := got.reservedCapacityPercent, testCase.expectedReserved; got != want {
+				t.Errorf("reserved capacity = %v, want %v", got, want)
+			}
+
+			if got, want := got.availableCapacityPercent, 100-testCase.expectedReserved; got != want {
+				t.Errorf("available capacity = %v, want %v", got, want)
+			}
+
+			if diff := cmp.Diff(got.shares, testCase.expectedShares, cmp.AllowUnexported(fairSharePreviewShare{})); diff != "" {
+				t.Errorf("unexpected shares difference: %s", diff)
+			}
+		})
+	}
+}
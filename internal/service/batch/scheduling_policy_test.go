@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/batch"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -67,6 +68,41 @@ func TestAccBatchSchedulingPolicy_basic(t *testing.T) {
 	})
 }
 
+func TestAccBatchSchedulingPolicy_shareDecaySeconds(t *testing.T) {
+	ctx := acctest.Context(t)
+	var schedulingPolicy1 batch.SchedulingPolicyDetail
+	resourceName := "aws_batch_scheduling_policy.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulingPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulingPolicyConfig_shareDecaySeconds(rName, 3600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(ctx, resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "fair_share_policy.0.share_decay_seconds", "3600"),
+				),
+			},
+			{
+				Config: testAccSchedulingPolicyConfig_shareDecaySeconds(rName, 7200),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulingPolicyExists(ctx, resourceName, &schedulingPolicy1),
+					resource.TestCheckResourceAttr(resourceName, "fair_share_policy.0.share_decay_seconds", "7200"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccBatchSchedulingPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var schedulingPolicy1 batch.SchedulingPolicyDetail
@@ -188,3 +224,21 @@ resource "aws_batch_scheduling_policy" "test" {
 }
 `, rName)
 }
+
+func testAccSchedulingPolicyConfig_shareDecaySeconds(rName string, shareDecaySeconds int) string {
+	return fmt.Sprintf(`
+resource "aws_batch_scheduling_policy" "test" {
+  name = %[1]q
+
+  fair_share_policy {
+    compute_reservation = 1
+    share_decay_seconds = %[2]d
+
+    share_distribution {
+      share_identifier = "A1*"
+      weight_factor    = 0.1
+    }
+  }
+}
+`, rName, shareDecaySeconds)
+}
@@ -47,6 +47,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Compute Environment",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceFairSharePreview,
+			TypeName: "aws_batch_fair_share_preview",
+			Name:     "Fair Share Preview",
+		},
 		{
 			Factory:  DataSourceJobQueue,
 			TypeName: "aws_batch_job_queue",
@@ -138,7 +138,7 @@ func dataSourceCostCategory() *schema.Resource {
 func dataSourceCostCategoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CEClient(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	arn := d.Get("cost_category_arn").(string)
 	costCategory, err := findCostCategoryByARN(ctx, conn, arn)
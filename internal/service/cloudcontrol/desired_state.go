@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/mattbaird/jsonpatch"
+)
+
+const (
+	propertiesJSONPointerPrefix = "/properties"
+)
+
+// parseResourceSchema parses a CloudFormation resource type schema.
+func parseResourceSchema(resourceSchema string) (*cfschema.Resource, error) {
+	resourceSchema, err := cfschema.Sanitize(resourceSchema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(resourceSchema)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return cfResourceSchema.Resource()
+}
+
+// writeOnlyPropertyPaths returns the JSON Pointers, relative to the resource's properties, of the resource type's write-only properties.
+// Array items are represented by "*".
+func writeOnlyPropertyPaths(cfResource *cfschema.Resource) []string {
+	var paths []string
+
+	for _, v := range cfResource.WriteOnlyProperties {
+		if path, ok := strings.CutPrefix(string(v), propertiesJSONPointerPrefix); ok && path != "" {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// refreshDesiredState returns the desired state with the values of the properties that it specifies replaced by their values in the live model.
+// Properties that the desired state does not specify are ignored.
+// Write-only properties, which are never returned in the live model, keep their desired values.
+// If the live model matches the desired state, the desired state is returned unchanged.
+func refreshDesiredState(desiredState, properties string, writeOnlyPaths []string) (string, error) {
+	var desired, live interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", err
+	}
+
+	if err := json.Unmarshal([]byte(properties), &live); err != nil {
+		return "", err
+	}
+
+	refreshed := refreshDesiredValue(desired, live, "", writeOnlyPaths)
+
+	if reflect.DeepEqual(refreshed, desired) {
+		return desiredState, nil
+	}
+
+	b, err := json.Marshal(refreshed)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func refreshDesiredValue(desired, live interface{}, path string, writeOnlyPaths []string) interface{} {
+	if isWriteOnlyPropertyPath(path, writeOnlyPaths) {
+		return desired
+	}
+
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		live, ok := live.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		refreshed := make(map[string]interface{}, len(desired))
+		for k, v := range desired {
+			path := path + "/" + escapeJSONPointerToken(k)
+
+			if lv, ok := live[k]; ok {
+				refreshed[k] = refreshDesiredValue(v, lv, path, writeOnlyPaths)
+			} else if hasWriteOnlyPropertyPath(path, writeOnlyPaths) {
+				refreshed[k] = v
+			}
+		}
+
+		return refreshed
+	case []interface{}:
+		live, ok := live.([]interface{})
+		if !ok || len(live) != len(desired) {
+			break
+		}
+
+		path := path + "/*"
+
+		// Array order is significant unless the items match in some other order.
+		refreshed := make([]interface{}, len(desired))
+		for i, v := range desired {
+			refreshed[i] = refreshDesiredValue(v, live[i], path, writeOnlyPaths)
+		}
+
+		if reflect.DeepEqual(refreshed, desired) {
+			return desired
+		}
+
+		matched := make([]bool, len(live))
+	items:
+		for _, v := range desired {
+			for j, lv := range live {
+				if !matched[j] && reflect.DeepEqual(refreshDesiredValue(v, lv, path, writeOnlyPaths), v) {
+					matched[j] = true
+					continue items
+				}
+			}
+
+			return refreshed
+		}
+
+		return desired
+	}
+
+	return live
+}
+
+// patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
+// Write-only properties are not in the live model that the patch is applied to, so they are added rather than replaced,
+// and removing them is not supported.
+func patchDocument(old, new string, writeOnlyPaths []string) (string, error) {
+	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
+
+	if err != nil {
+		return "", err
+	}
+
+	patch = writeOnlyPatch(patch, writeOnlyPaths)
+
+	b, err := json.Marshal(patch)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+func writeOnlyPatch(patch []jsonpatch.JsonPatchOperation, writeOnlyPaths []string) []jsonpatch.JsonPatchOperation {
+	output := make([]jsonpatch.JsonPatchOperation, 0, len(patch))
+
+	for _, operation := range patch {
+		if isWriteOnlyPropertyPath(operation.Path, writeOnlyPaths) {
+			switch operation.Operation {
+			case "remove":
+				continue
+			case "replace":
+				operation.Operation = "add"
+			}
+		}
+
+		output = append(output, operation)
+	}
+
+	return output
+}
+
+// isWriteOnlyPropertyPath returns whether the JSON Pointer is, or is within, a write-only property.
+func isWriteOnlyPropertyPath(path string, writeOnlyPaths []string) bool {
+	if path == "" {
+		return false
+	}
+
+	tokens := strings.Split(path, "/")
+
+	for _, v := range writeOnlyPaths {
+		writeOnlyTokens := strings.Split(v, "/")
+
+		if len(writeOnlyTokens) > len(tokens) {
+			continue
+		}
+
+		if matchJSONPointerTokens(tokens[:len(writeOnlyTokens)], writeOnlyTokens) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hasWriteOnlyPropertyPath returns whether the JSON Pointer contains a write-only property.
+func hasWriteOnlyPropertyPath(path string, writeOnlyPaths []string) bool {
+	tokens := strings.Split(path, "/")
+
+	for _, v := range writeOnlyPaths {
+		writeOnlyTokens := strings.Split(v, "/")
+
+		if len(writeOnlyTokens) < len(tokens) {
+			continue
+		}
+
+		if matchJSONPointerTokens(tokens, writeOnlyTokens[:len(tokens)]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchJSONPointerTokens returns whether the JSON Pointer tokens match the schema JSON Pointer tokens, in which array items are "*".
+func matchJSONPointerTokens(tokens, schemaTokens []string) bool {
+	for i, v := range schemaTokens {
+		if v == "*" && tokens[i] != "" && strings.Trim(tokens[i], "0123456789*") == "" {
+			continue
+		}
+
+		if v != tokens[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func escapeJSONPointerToken(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
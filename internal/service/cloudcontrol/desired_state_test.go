@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/mattbaird/jsonpatch"
+)
+
+func TestRefreshDesiredState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		desiredState   string
+		properties     string
+		writeOnlyPaths []string
+		expected       string
+	}{
+		"no drift": {
+			desiredState: `{"Name": "test", "Enabled": true}`,
+			properties:   `{"Arn":"arn","Enabled":true,"Name":"test"}`,
+			expected:     `{"Name": "test", "Enabled": true}`,
+		},
+		"value drift": {
+			desiredState: `{"Name":"test","Enabled":true}`,
+			properties:   `{"Arn":"arn","Enabled":false,"Name":"test"}`,
+			expected:     `{"Enabled":false,"Name":"test"}`,
+		},
+		"property removed": {
+			desiredState: `{"Name":"test","Description":"test"}`,
+			properties:   `{"Name":"test"}`,
+			expected:     `{"Name":"test"}`,
+		},
+		"nested object": {
+			desiredState: `{"Config":{"Size":1}}`,
+			properties:   `{"Config":{"Default":"x","Size":2}}`,
+			expected:     `{"Config":{"Size":2}}`,
+		},
+		"unordered array": {
+			desiredState: `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+			properties:   `{"Tags":[{"Key":"b","Value":"2"},{"Key":"a","Value":"1"}]}`,
+			expected:     `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+		},
+		"array drift": {
+			desiredState: `{"Tags":[{"Key":"a","Value":"1"}]}`,
+			properties:   `{"Tags":[{"Key":"a","Value":"2"}]}`,
+			expected:     `{"Tags":[{"Key":"a","Value":"2"}]}`,
+		},
+		"write-only": {
+			desiredState:   `{"Name":"test","Password":"secret","Users":[{"Name":"u","Password":"secret"}]}`,
+			properties:     `{"Name":"other","Users":[{"Name":"u"}]}`,
+			writeOnlyPaths: []string{"/Password", "/Users/*/Password"},
+			expected:       `{"Name":"other","Password":"secret","Users":[{"Name":"u","Password":"secret"}]}`,
+		},
+		"write-only descendant": {
+			desiredState:   `{"Auth":{"Token":"secret"}}`,
+			properties:     `{}`,
+			writeOnlyPaths: []string{"/Auth/Token"},
+			expected:       `{"Auth":{"Token":"secret"}}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := refreshDesiredState(testCase.desiredState, testCase.properties, testCase.writeOnlyPaths)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.expected {
+				t.Errorf("got %s, expected %s", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestWriteOnlyPatch(t *testing.T) {
+	t.Parallel()
+
+	patch := []jsonpatch.JsonPatchOperation{
+		{Operation: "replace", Path: "/Name", Value: "test"},
+		{Operation: "replace", Path: "/Password", Value: "secret"},
+		{Operation: "remove", Path: "/Users/0/Password"},
+		{Operation: "remove", Path: "/Users/1"},
+	}
+	expected := []jsonpatch.JsonPatchOperation{
+		{Operation: "replace", Path: "/Name", Value: "test"},
+		{Operation: "add", Path: "/Password", Value: "secret"},
+		{Operation: "remove", Path: "/Users/1"},
+	}
+
+	got := writeOnlyPatch(patch, []string{"/Password", "/Users/*/Password"})
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
@@ -5,7 +5,6 @@ package cloudcontrol
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -25,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -50,6 +50,10 @@ func resourceResource() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"patch_document": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrProperties: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -79,6 +83,7 @@ func resourceResource() *schema.Resource {
 		CustomizeDiff: customdiff.Sequence(
 			resourceResourceCustomizeDiffGetSchema,
 			resourceResourceCustomizeDiffSchemaDiff,
+			resourceResourceCustomizeDiffPatchDocument,
 			customdiff.ComputedIf(names.AttrProperties, func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("desired_state")
 			}),
@@ -115,7 +120,7 @@ func resourceResourceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	// Always try to capture the identifier before returning errors.
 	d.SetId(aws.ToString(output.ProgressEvent.Identifier))
 
-	output.ProgressEvent, err = waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutCreate))
+	output.ProgressEvent, err = waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Cloud Control API (%s) Resource (%s) create: %s", typeName, d.Id(), err)
@@ -154,6 +159,29 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set(names.AttrProperties, resourceDescription.Properties)
 
+	// Detect drift in the properties specified in desired_state.
+	if v := d.Get("desired_state").(string); v != "" {
+		var writeOnlyPaths []string
+
+		if v := d.Get(names.AttrSchema).(string); v != "" {
+			cfResource, err := parseResourceSchema(v)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "parsing CloudFormation Resource Schema JSON: %s", err)
+			}
+
+			writeOnlyPaths = writeOnlyPropertyPaths(cfResource)
+		}
+
+		desiredState, err := refreshDesiredState(v, aws.ToString(resourceDescription.Properties), writeOnlyPaths)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Cloud Control API (%s) Resource (%s): refreshing desired_state: %s", typeName, d.Id(), err)
+		}
+
+		d.Set("desired_state", desiredState)
+	}
+
 	return diags
 }
 
@@ -163,19 +191,30 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
 
 	if d.HasChange("desired_state") {
-		oldRaw, newRaw := d.GetChange("desired_state")
+		// The patch document is planned by CustomizeDiff.
+		patch := d.Get("patch_document").(string)
 
-		patchDocument, err := patchDocument(oldRaw.(string), newRaw.(string))
+		if patch == "" {
+			cfResource, err := parseResourceSchema(d.Get(names.AttrSchema).(string))
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "creating JSON Patch: %s", err)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "parsing CloudFormation Resource Schema JSON: %s", err)
+			}
+
+			oldRaw, newRaw := d.GetChange("desired_state")
+
+			patch, err = patchDocument(oldRaw.(string), newRaw.(string), writeOnlyPropertyPaths(cfResource))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating JSON Patch: %s", err)
+			}
 		}
 
 		typeName := d.Get("type_name").(string)
 		input := &cloudcontrol.UpdateResourceInput{
 			ClientToken:   aws.String(id.UniqueId()),
 			Identifier:    aws.String(d.Id()),
-			PatchDocument: aws.String(patchDocument),
+			PatchDocument: aws.String(patch),
 			TypeName:      aws.String(typeName),
 		}
 
@@ -193,7 +232,7 @@ func resourceResourceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
 		}
 
-		if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Cloud Control API (%s) Resource (%s) update: %s", typeName, d.Id(), err)
 		}
 	}
@@ -228,7 +267,7 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "deleting Cloud Control API (%s) Resource (%s): %s", typeName, d.Id(), err)
 	}
 
-	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), d.Timeout(schema.TimeoutDelete))
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 
 	if progressEvent != nil && progressEvent.ErrorCode == types.HandlerErrorCodeNotFound {
 		return diags
@@ -241,6 +280,37 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta in
 	return diags
 }
 
+func resourceResourceCustomizeDiffPatchDocument(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("desired_state") {
+		return nil
+	}
+
+	oldDesiredState, newDesiredState := diff.GetChange("desired_state")
+
+	// desired_state can be empty if unknown
+	if newDesiredState.(string) == "" {
+		return diff.SetNewComputed("patch_document")
+	}
+
+	cfResource, err := parseResourceSchema(diff.Get(names.AttrSchema).(string))
+
+	if err != nil {
+		return fmt.Errorf("parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	patch, err := patchDocument(oldDesiredState.(string), newDesiredState.(string), writeOnlyPropertyPaths(cfResource))
+
+	if err != nil {
+		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
+	}
+
+	if err := diff.SetNew("patch_document", patch); err != nil {
+		return fmt.Errorf("setting patch_document New: %w", err)
+	}
+
+	return nil
+}
+
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
@@ -428,20 +498,3 @@ func waitProgressEventOperationStatusSuccess(ctx context.Context, conn *cloudcon
 
 	return nil, err
 }
-
-// patchDocument returns a JSON Patch document describing the difference between `old` and `new`.
-func patchDocument(old, new string) (string, error) {
-	patch, err := jsonpatch.CreatePatch([]byte(old), []byte(new))
-
-	if err != nil {
-		return "", err
-	}
-
-	b, err := json.Marshal(patch)
-
-	if err != nil {
-		return "", err
-	}
-
-	return string(b), nil
-}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_resource_exists", name="Resource Exists")
+func dataSourceResourceExists() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourceExistsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{names.AttrARN, names.AttrIdentifier},
+			},
+			"exists": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrIdentifier: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{names.AttrARN, names.AttrIdentifier},
+			},
+			names.AttrProperties: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrRoleARN: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexache.MustCompile(`[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourceExistsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CloudControlClient(ctx)
+
+	// Resource types whose primary identifier is the resource's ARN can be looked up by ARN.
+	identifier := d.Get(names.AttrIdentifier).(string)
+	if v, ok := d.GetOk(names.AttrARN); ok {
+		identifier = v.(string)
+	}
+	typeName := d.Get("type_name").(string)
+	resourceDescription, err := findResource(ctx, conn,
+		identifier,
+		typeName,
+		d.Get("type_version_id").(string),
+		d.Get(names.AttrRoleARN).(string),
+	)
+
+	switch {
+	case tfresource.NotFound(err):
+		d.SetId(identifier)
+		d.Set("exists", false)
+		d.Set(names.AttrProperties, "")
+	case err != nil:
+		return sdkdiag.AppendErrorf(diags, "reading Cloud Control API (%s) Resource (%s): %s", typeName, identifier, err)
+	default:
+		d.SetId(aws.ToString(resourceDescription.Identifier))
+		d.Set("exists", true)
+		d.Set(names.AttrProperties, resourceDescription.Properties)
+	}
+
+	return diags
+}
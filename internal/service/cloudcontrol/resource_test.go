@@ -10,11 +10,16 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudcontrol "github.com/hashicorp/terraform-provider-aws/internal/service/cloudcontrol"
@@ -521,6 +526,63 @@ func TestAccCloudControlResource_lambdaFunction(t *testing.T) {
 	})
 }
 
+func TestAccCloudControlResource_drift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceConfig_desiredStateRetentionInDays(rName, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "desired_state", fmt.Sprintf(`{"LogGroupName":%[1]q,"RetentionInDays":7}`, rName)),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
+
+					_, err := conn.PutRetentionPolicy(ctx, &cloudwatchlogs.PutRetentionPolicyInput{
+						LogGroupName:    aws.String(rName),
+						RetentionInDays: aws.Int32(14),
+					})
+
+					if err != nil {
+						t.Fatalf("updating CloudWatch Logs Log Group (%s) retention policy: %s", rName, err)
+					}
+				},
+				Config: testAccResourceConfig_desiredStateRetentionInDays(rName, 7),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("patch_document"), knownvalue.StringExact(`[{"op":"replace","path":"/RetentionInDays","value":7}]`)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, names.AttrProperties, regexache.MustCompile(`"RetentionInDays":7`)),
+				),
+			},
+			{
+				Config: testAccResourceConfig_desiredStateRetentionInDays(rName, 30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("patch_document"), knownvalue.StringExact(`[{"op":"replace","path":"/RetentionInDays","value":30}]`)),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(resourceName, names.AttrProperties, regexache.MustCompile(`"RetentionInDays":30`)),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckResourceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudControlClient(ctx)
@@ -677,6 +739,19 @@ resource "aws_cloudcontrolapi_resource" "test" {
 `, rName)
 }
 
+func testAccResourceConfig_desiredStateRetentionInDays(rName string, retentionInDays int) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName    = %[1]q
+    RetentionInDays = %[2]d
+  })
+}
+`, rName, retentionInDays)
+}
+
 func testAccResourceConfig_desiredStateStringValue(rName string, stringValue string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudcontrolapi_resource" "test" {
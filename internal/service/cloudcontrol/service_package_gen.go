@@ -29,6 +29,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_cloudcontrolapi_resource",
 			Name:     "Resource",
 		},
+		{
+			Factory:  dataSourceResourceExists,
+			TypeName: "aws_resource_exists",
+			Name:     "Resource Exists",
+		},
 	}
 }
 
@@ -12,6 +12,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -148,6 +149,41 @@ func TestAccCloudFrontRealtimeLogConfig_updates(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontRealtimeLogConfig_samplingRate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.RealtimeLogConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_realtime_log_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRealtimeLogConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRealtimeLogConfigConfig_basic(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealtimeLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sampling_rate", "5"),
+				),
+			},
+			{
+				Config: testAccRealtimeLogConfigConfig_basic(rName, 50),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRealtimeLogConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "sampling_rate", "50"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRealtimeLogConfigDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+)
+
+const (
+	// Each CloudFront real-time log record is written to Kinesis Data Streams as a single data record.
+	// https://docs.aws.amazon.com/AmazonCloudFront/latest/DeveloperGuide/real-time-logs.html#understand-real-time-log-config-endpoint.
+	realtimeLogDefaultAverageRecordSize  = 500
+	realtimeLogDefaultHeadroomPercentage = 25
+	// Kinesis Data Streams shard write limits.
+	// https://docs.aws.amazon.com/streams/latest/dev/service-sizes-and-limits.html.
+	kinesisShardMaxBytesPerSecond   = 1024 * 1024
+	kinesisShardMaxRecordsPerSecond = 1000
+)
+
+// @FrameworkDataSource("aws_cloudfront_realtime_log_shard_count", name="Real-time Log Shard Count")
+func newRealtimeLogShardCountDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &realtimeLogShardCountDataSource{}, nil
+}
+
+type realtimeLogShardCountDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *realtimeLogShardCountDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudfront_realtime_log_shard_count"
+}
+
+func (d *realtimeLogShardCountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"average_record_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"bytes_per_second": schema.Int64Attribute{
+				Computed: true,
+			},
+			"headroom_percentage": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"records_per_second": schema.Int64Attribute{
+				Computed: true,
+			},
+			"requests_per_second": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"sampling_rate": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"shard_count": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *realtimeLogShardCountDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data realtimeLogShardCountDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AverageRecordSize.IsNull() {
+		data.AverageRecordSize = types.Int64Value(realtimeLogDefaultAverageRecordSize)
+	}
+	if data.HeadroomPercentage.IsNull() {
+		data.HeadroomPercentage = types.Int64Value(realtimeLogDefaultHeadroomPercentage)
+	}
+
+	recordsPerSecond, bytesPerSecond, shardCount := realtimeLogShardCount(data.RequestsPerSecond.ValueInt64(), data.SamplingRate.ValueInt64(), data.AverageRecordSize.ValueInt64(), data.HeadroomPercentage.ValueInt64())
+	data.BytesPerSecond = types.Int64Value(bytesPerSecond)
+	data.RecordsPerSecond = types.Int64Value(recordsPerSecond)
+	data.ShardCount = types.Int64Value(shardCount)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type realtimeLogShardCountDataSourceModel struct {
+	AverageRecordSize  types.Int64 `tfsdk:"average_record_size"`
+	BytesPerSecond     types.Int64 `tfsdk:"bytes_per_second"`
+	HeadroomPercentage types.Int64 `tfsdk:"headroom_percentage"`
+	RecordsPerSecond   types.Int64 `tfsdk:"records_per_second"`
+	RequestsPerSecond  types.Int64 `tfsdk:"requests_per_second"`
+	SamplingRate       types.Int64 `tfsdk:"sampling_rate"`
+	ShardCount         types.Int64 `tfsdk:"shard_count"`
+}
+
+// realtimeLogShardCount returns the expected records and bytes written per second to Kinesis Data Streams
+// by a real-time log configuration, and the number of shards needed to absorb that throughput plus headroom.
+// Writes in excess of a stream's capacity are throttled and the corresponding log records are dropped.
+func realtimeLogShardCount(requestsPerSecond, samplingRate, averageRecordSize, headroomPercentage int64) (int64, int64, int64) {
+	recordsPerSecond := ceilDiv(requestsPerSecond*samplingRate, 100)
+	bytesPerSecond := recordsPerSecond * averageRecordSize
+
+	factor := 100 + headroomPercentage
+	shardCount := max(
+		ceilDiv(bytesPerSecond*factor, kinesisShardMaxBytesPerSecond*100),
+		ceilDiv(recordsPerSecond*factor, kinesisShardMaxRecordsPerSecond*100),
+		1,
+	)
+
+	return recordsPerSecond, bytesPerSecond, shardCount
+}
+
+func ceilDiv(a, b int64) int64 {
+	return (a + b - 1) / b
+}
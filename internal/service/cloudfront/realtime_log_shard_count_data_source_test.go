@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRealtimeLogShardCount(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		requestsPerSecond  int64
+		samplingRate       int64
+		averageRecordSize  int64
+		headroomPercentage int64
+		wantRecords        int64
+		wantBytes          int64
+		wantShards         int64
+	}{
+		"minimum": {
+			requestsPerSecond:  1,
+			samplingRate:       1,
+			averageRecordSize:  500,
+			headroomPercentage: 25,
+			wantRecords:        1,
+			wantBytes:          500,
+			wantShards:         1,
+		},
+		"partial record": {
+			requestsPerSecond:  3,
+			samplingRate:       50,
+			averageRecordSize:  500,
+			headroomPercentage: 0,
+			wantRecords:        2,
+			wantBytes:          1000,
+			wantShards:         1,
+		},
+		"record limited": {
+			requestsPerSecond:  10000,
+			samplingRate:       100,
+			averageRecordSize:  500,
+			headroomPercentage: 25,
+			wantRecords:        10000,
+			wantBytes:          5000000,
+			wantShards:         13,
+		},
+		"byte limited": {
+			requestsPerSecond:  3000,
+			samplingRate:       100,
+			averageRecordSize:  2000,
+			headroomPercentage: 0,
+			wantRecords:        3000,
+			wantBytes:          6000000,
+			wantShards:         6,
+		},
+		"sampled": {
+			requestsPerSecond:  10000,
+			samplingRate:       10,
+			averageRecordSize:  500,
+			headroomPercentage: 25,
+			wantRecords:        1000,
+			wantBytes:          500000,
+			wantShards:         2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			records, bytes, shards := tfcloudfront.RealtimeLogShardCount(testCase.requestsPerSecond, testCase.samplingRate, testCase.averageRecordSize, testCase.headroomPercentage)
+
+			if records != testCase.wantRecords {
+				t.Errorf("records = %d, want %d", records, testCase.wantRecords)
+			}
+			if bytes != testCase.wantBytes {
+				t.Errorf("bytes = %d, want %d", bytes, testCase.wantBytes)
+			}
+			if shards != testCase.wantShards {
+				t.Errorf("shards = %d, want %d", shards, testCase.wantShards)
+			}
+		})
+	}
+}
+
+func TestAccCloudFrontRealtimeLogShardCountDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_cloudfront_realtime_log_shard_count.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRealtimeLogShardCountDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "average_record_size", "500"),
+					resource.TestCheckResourceAttr(dataSourceName, "bytes_per_second", "5000000"),
+					resource.TestCheckResourceAttr(dataSourceName, "headroom_percentage", "25"),
+					resource.TestCheckResourceAttr(dataSourceName, "records_per_second", "10000"),
+					resource.TestCheckResourceAttr(dataSourceName, "shard_count", "13"),
+				),
+			},
+			{
+				Config: testAccRealtimeLogShardCountDataSourceConfig_recordSize,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "average_record_size", "2000"),
+					resource.TestCheckResourceAttr(dataSourceName, "bytes_per_second", "6000000"),
+					resource.TestCheckResourceAttr(dataSourceName, "headroom_percentage", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "records_per_second", "3000"),
+					resource.TestCheckResourceAttr(dataSourceName, "shard_count", "6"),
+				),
+			},
+			{
+				Config:      testAccRealtimeLogShardCountDataSourceConfig_invalidSamplingRate,
+				ExpectError: regexache.MustCompile(`Attribute sampling_rate value must be between 1 and 100`),
+			},
+		},
+	})
+}
+
+const testAccRealtimeLogShardCountDataSourceConfig_basic = `
+data "aws_cloudfront_realtime_log_shard_count" "test" {
+  requests_per_second = 10000
+  sampling_rate       = 100
+}
+`
+
+const testAccRealtimeLogShardCountDataSourceConfig_recordSize = `
+data "aws_cloudfront_realtime_log_shard_count" "test" {
+  requests_per_second = 3000
+  sampling_rate       = 100
+  average_record_size = 2000
+  headroom_percentage = 0
+}
+`
+
+const testAccRealtimeLogShardCountDataSourceConfig_invalidSamplingRate = `
+data "aws_cloudfront_realtime_log_shard_count" "test" {
+  requests_per_second = 3000
+  sampling_rate       = 0
+}
+`
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_cloudwatch_metric_alarm", name="Metric Alarm")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_cloudwatch_metric_alarms", listOp="DescribeAlarms", listOpOutputElem="MetricAlarms", nameElem="AlarmName", arnElem="AlarmArn", namePrefixElem="AlarmNamePrefix", taggingResourceType="cloudwatch:alarm")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/cloudwatch/types;awstypes;awstypes.MetricAlarm")
 func resourceMetricAlarm() *schema.Resource {
 	//lintignore:R011
@@ -28,6 +28,7 @@ import (
 
 // @SDKResource("aws_codeartifact_domain", name="Domain")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_codeartifact_domains", listOp="ListDomains", listOpOutputElem="Domains", nameElem="Name", arnElem="Arn", taggingResourceType="codeartifact:domain")
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
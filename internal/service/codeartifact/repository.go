@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_codeartifact_repository", name="Repository")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_codeartifact_repositories", listOp="ListRepositories", listOpOutputElem="Repositories", nameElem="Name", arnElem="Arn", namePrefixElem="RepositoryPrefix", taggingResourceType="codeartifact:repository")
 func resourceRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryCreate,
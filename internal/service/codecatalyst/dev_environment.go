@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -161,7 +162,7 @@ func resourceDevEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(out.Id))
 
-	if _, err := waitDevEnvironmentCreated(ctx, conn, d.Id(), out.SpaceName, out.ProjectName, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitDevEnvironmentCreated(ctx, conn, d.Id(), out.SpaceName, out.ProjectName, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return create.AppendDiagError(diags, names.CodeCatalyst, create.ErrActionWaitingForCreation, ResNameDevEnvironment, d.Id(), err)
 	}
 
@@ -236,7 +237,7 @@ func resourceDevEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m
 		return create.AppendDiagError(diags, names.CodeCatalyst, create.ErrActionUpdating, ResNameDevEnvironment, d.Id(), err)
 	}
 
-	if _, err := waitDevEnvironmentUpdated(ctx, conn, aws.ToString(out.Id), out.SpaceName, out.ProjectName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitDevEnvironmentUpdated(ctx, conn, aws.ToString(out.Id), out.SpaceName, out.ProjectName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 		return create.AppendDiagError(diags, names.CodeCatalyst, create.ErrActionWaitingForUpdate, ResNameDevEnvironment, d.Id(), err)
 	}
 
@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_codecommit_repository", name="Repository")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_codecommit_repositories", listOp="ListRepositories", listOpOutputElem="Repositories", nameElem="RepositoryName")
 func resourceRepository() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRepositoryCreate,
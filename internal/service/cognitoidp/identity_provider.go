@@ -5,6 +5,7 @@ package cognitoidp
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
@@ -22,11 +23,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	identityProviderDetailClientSecret = "client_secret"
+)
+
 // @SDKResource("aws_cognito_identity_provider", name="Identity Provider")
+// @PluralDataSource("aws_cognito_identity_providers", listOp="ListIdentityProviders", listOpOutputElem="Providers", nameElem="ProviderName", parentAttr="user_pool_id", parentElem="UserPoolId")
 func resourceIdentityProvider() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceIdentityProviderCreate,
@@ -47,6 +54,8 @@ func resourceIdentityProvider() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"client_secret_wo":         sdkv2.WriteOnlyStringSchema("client_secret_wo", nil),
+			"client_secret_wo_version": sdkv2.WriteOnlyVersionSchema("client_secret_wo"),
 			"idp_identifiers": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -85,6 +94,8 @@ func resourceIdentityProvider() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		CustomizeDiff: identityProviderCustomizeDiff,
 	}
 }
 
@@ -113,6 +124,13 @@ func resourceIdentityProviderCreate(ctx context.Context, d *schema.ResourceData,
 		input.ProviderDetails = flex.ExpandStringValueMap(v.(map[string]interface{}))
 	}
 
+	if v, ok := sdkv2.WriteOnlyString(d, "client_secret_wo"); ok {
+		if input.ProviderDetails == nil {
+			input.ProviderDetails = make(map[string]string)
+		}
+		input.ProviderDetails[identityProviderDetailClientSecret] = v
+	}
+
 	_, err := conn.CreateIdentityProvider(ctx, input)
 
 	if err != nil {
@@ -147,11 +165,19 @@ func resourceIdentityProviderRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("attribute_mapping", idp.AttributeMapping)
 	d.Set("idp_identifiers", idp.IdpIdentifiers)
-	d.Set("provider_details", idp.ProviderDetails)
+	providerDetails := idp.ProviderDetails
+	if sdkv2.HasWriteOnlyValue(d, "client_secret_wo") {
+		// The client secret is managed by the write-only attribute and must not be persisted.
+		delete(providerDetails, identityProviderDetailClientSecret)
+	}
+	d.Set("provider_details", providerDetails)
 	d.Set(names.AttrProviderName, idp.ProviderName)
 	d.Set("provider_type", idp.ProviderType)
 	d.Set(names.AttrUserPoolID, idp.UserPoolId)
 
+	// Write-only client secrets are never persisted.
+	sdkv2.ClearWriteOnly(d, "client_secret_wo")
+
 	return diags
 }
 
@@ -177,9 +203,13 @@ func resourceIdentityProviderUpdate(ctx context.Context, d *schema.ResourceData,
 		input.IdpIdentifiers = flex.ExpandStringValueList(d.Get("idp_identifiers").([]interface{}))
 	}
 
-	if d.HasChange("provider_details") {
+	if d.HasChanges("provider_details", "client_secret_wo_version") {
 		v := flex.ExpandStringValueMap(d.Get("provider_details").(map[string]interface{}))
 		delete(v, "ActiveEncryptionCertificate")
+		// The write-only client secret is only sent when its version changes.
+		if secret, ok := sdkv2.WriteOnlyString(d, "client_secret_wo"); ok {
+			v[identityProviderDetailClientSecret] = secret
+		}
 		input.ProviderDetails = v
 	}
 
@@ -237,6 +267,20 @@ func identityProviderParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected UserPoolID%[2]sProviderName", id, identityProviderResourceIDSeparator)
 }
 
+// identityProviderCustomizeDiff ensures that the write-only client secret is resent with any change to the
+// provider details, as UpdateIdentityProvider replaces all provider details and the secret is not in state.
+func identityProviderCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("provider_details") || !sdkv2.HasWriteOnlyValue(d, "client_secret_wo") {
+		return nil
+	}
+
+	if !d.HasChange("client_secret_wo_version") {
+		return errors.New(`"client_secret_wo_version" must be incremented when "provider_details" changes and "client_secret_wo" is configured`)
+	}
+
+	return nil
+}
+
 func findIdentityProviderByTwoPartKey(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID, providerName string) (*awstypes.IdentityProviderType, error) {
 	input := &cognitoidentityprovider.DescribeIdentityProviderInput{
 		ProviderName: aws.String(providerName),
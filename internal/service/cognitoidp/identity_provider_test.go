@@ -81,6 +81,42 @@ func TestAccCognitoIDPIdentityProvider_basic(t *testing.T) {
 	})
 }
 
+func TestAccCognitoIDPIdentityProvider_clientSecretWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var identityProvider awstypes.IdentityProviderType
+	resourceName := "aws_cognito_identity_provider.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckIdentityProviderDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityProviderConfig_clientSecretWriteOnly(rName, "client_secret", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "client_secret_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "client_secret_wo_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.%", "8"),
+					resource.TestCheckNoResourceAttr(resourceName, "provider_details.client_secret"),
+				),
+			},
+			{
+				Config: testAccIdentityProviderConfig_clientSecretWriteOnly(rName, "updated_client_secret", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIdentityProviderExists(ctx, resourceName, &identityProvider),
+					resource.TestCheckResourceAttr(resourceName, "client_secret_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "client_secret_wo_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "provider_details.%", "8"),
+					resource.TestCheckNoResourceAttr(resourceName, "provider_details.client_secret"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCognitoIDPIdentityProvider_idpIdentifiers(t *testing.T) {
 	ctx := acctest.Context(t)
 	var identityProvider awstypes.IdentityProviderType
@@ -325,6 +361,35 @@ resource "aws_cognito_identity_provider" "test" {
 `, rName)
 }
 
+func testAccIdentityProviderConfig_clientSecretWriteOnly(rName, clientSecret string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name                     = %[1]q
+  auto_verified_attributes = ["email"]
+}
+
+resource "aws_cognito_identity_provider" "test" {
+  user_pool_id  = aws_cognito_user_pool.test.id
+  provider_name = "Google"
+  provider_type = "Google"
+
+  provider_details = {
+    attributes_url                = "https://people.googleapis.com/v1/people/me?personFields="
+    attributes_url_add_attributes = "true"
+    authorize_scopes              = "email"
+    authorize_url                 = "https://accounts.google.com/o/oauth2/v2/auth"
+    client_id                     = "test-url.apps.googleusercontent.com"
+    oidc_issuer                   = "https://accounts.google.com"
+    token_request_method          = "POST"
+    token_url                     = "https://www.googleapis.com/oauth2/v4/token"
+  }
+
+  client_secret_wo         = %[2]q
+  client_secret_wo_version = %[3]d
+}
+`, rName, clientSecret, version)
+}
+
 func testAccIdentityProviderConfig_identifier(rName, attribute string) string {
 	return fmt.Sprintf(`
 resource "aws_cognito_user_pool" "test" {
@@ -0,0 +1,28 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccComputeOptimizer_serial(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]map[string]func(t *testing.T){
+		"EnrollmentStatus": {
+			acctest.CtBasic:         testAccEnrollmentStatus_basic,
+			"includeMemberAccounts": testAccEnrollmentStatus_includeMemberAccounts,
+		},
+		"RecommendationExport": {
+			acctest.CtBasic:  testAccRecommendationExport_basic,
+			"fieldsToExport": testAccRecommendationExport_fieldsToExport,
+			"triggers":       testAccRecommendationExport_triggers,
+		},
+	}
+
+	acctest.RunSerialTests2Levels(t, testCases, 0)
+}
@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_computeoptimizer_enrollment_status", name="Enrollment Status")
+func newEnrollmentStatusResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &enrollmentStatusResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type enrollmentStatusResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*enrollmentStatusResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_enrollment_status"
+}
+
+func (r *enrollmentStatusResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"include_member_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"number_of_member_accounts_opted_in": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Status](),
+				Required:   true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(awstypes.StatusActive, awstypes.StatusInactive)...),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *enrollmentStatusResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := updateEnrollmentStatus(ctx, conn, data.Status.ValueEnum(), data.IncludeMemberAccounts.ValueBool(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError("creating Compute Optimizer Enrollment Status", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(r.Meta().AccountID)
+	data.NumberOfMemberAccountsOptedIn = fwflex.Int32ToFramework(ctx, output.NumberOfMemberAccountsOptedIn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *enrollmentStatusResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := findEnrollmentStatus(ctx, conn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.IncludeMemberAccounts = types.BoolValue(output.MemberAccountsEnrolled)
+	data.NumberOfMemberAccountsOptedIn = fwflex.Int32ToFramework(ctx, output.NumberOfMemberAccountsOptedIn)
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *enrollmentStatusResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := updateEnrollmentStatus(ctx, conn, new.Status.ValueEnum(), new.IncludeMemberAccounts.ValueBool(), r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Compute Optimizer Enrollment Status (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.NumberOfMemberAccountsOptedIn = fwflex.Int32ToFramework(ctx, output.NumberOfMemberAccountsOptedIn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *enrollmentStatusResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data enrollmentStatusResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	input := &computeoptimizer.UpdateEnrollmentStatusInput{
+		Status: awstypes.StatusInactive,
+	}
+
+	_, err := conn.UpdateEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Compute Optimizer Enrollment Status (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// updateEnrollmentStatus opts the account, and optionally all member accounts of its organization, in to or out of Compute Optimizer.
+// Member accounts are enrolled asynchronously, so when they are included the call waits until none of them is pending.
+func updateEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client, status awstypes.Status, includeMemberAccounts bool, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	input := &computeoptimizer.UpdateEnrollmentStatusInput{
+		IncludeMemberAccounts: includeMemberAccounts,
+		Status:                status,
+	}
+
+	if _, err := conn.UpdateEnrollmentStatus(ctx, input); err != nil {
+		return nil, err
+	}
+
+	output, err := waitEnrollmentStatusUpdated(ctx, conn, timeout)
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for update: %w", err)
+	}
+
+	if includeMemberAccounts && status == awstypes.StatusActive {
+		if err := waitMemberAccountEnrollmentsUpdated(ctx, conn, timeout); err != nil {
+			return nil, fmt.Errorf("waiting for member account enrollments: %w", err)
+		}
+
+		// Refresh the number of member accounts opted in.
+		output, err = findEnrollmentStatus(ctx, conn)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+func findEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	input := &computeoptimizer.GetEnrollmentStatusInput{}
+
+	output, err := conn.GetEnrollmentStatus(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findMemberAccountEnrollmentStatuses(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.GetEnrollmentStatusesForOrganizationInput) ([]awstypes.AccountEnrollmentStatus, error) {
+	var output []awstypes.AccountEnrollmentStatus
+
+	pages := computeoptimizer.NewGetEnrollmentStatusesForOrganizationPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccountEnrollmentStatuses...)
+	}
+
+	return output, nil
+}
+
+func statusEnrollmentStatus(ctx context.Context, conn *computeoptimizer.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEnrollmentStatus(ctx, conn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+const (
+	memberAccountEnrollmentsStatusPending = "Pending"
+	memberAccountEnrollmentsStatusUpdated = "Updated"
+)
+
+func statusMemberAccountEnrollments(ctx context.Context, conn *computeoptimizer.Client) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &computeoptimizer.GetEnrollmentStatusesForOrganizationInput{
+			Filters: []awstypes.EnrollmentFilter{
+				{
+					Name:   awstypes.EnrollmentFilterNameStatus,
+					Values: enum.Slice(awstypes.StatusPending),
+				},
+			},
+		}
+
+		output, err := findMemberAccountEnrollmentStatuses(ctx, conn, input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(output) > 0 {
+			return output, memberAccountEnrollmentsStatusPending, nil
+		}
+
+		return output, memberAccountEnrollmentsStatusUpdated, nil
+	}
+}
+
+func waitEnrollmentStatusUpdated(ctx context.Context, conn *computeoptimizer.Client, timeout time.Duration) (*computeoptimizer.GetEnrollmentStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.StatusPending),
+		Target:  enum.Slice(awstypes.StatusActive, awstypes.StatusInactive),
+		Refresh: statusEnrollmentStatus(ctx, conn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*computeoptimizer.GetEnrollmentStatusOutput); ok {
+		if output.Status == awstypes.StatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMemberAccountEnrollmentsUpdated(ctx context.Context, conn *computeoptimizer.Client, timeout time.Duration) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{memberAccountEnrollmentsStatusPending},
+		Target:     []string{memberAccountEnrollmentsStatusUpdated},
+		Refresh:    statusMemberAccountEnrollments(ctx, conn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+type enrollmentStatusResourceModel struct {
+	ID                            types.String                        `tfsdk:"id"`
+	IncludeMemberAccounts         types.Bool                          `tfsdk:"include_member_accounts"`
+	NumberOfMemberAccountsOptedIn types.Int64                         `tfsdk:"number_of_member_accounts_opted_in"`
+	Status                        fwtypes.StringEnum[awstypes.Status] `tfsdk:"status"`
+	Timeouts                      timeouts.Value                      `tfsdk:"timeouts"`
+}
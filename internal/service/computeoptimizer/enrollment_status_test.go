@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccEnrollmentStatus_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic(string(awstypes.StatusActive), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName, awstypes.StatusActive),
+					acctest.CheckResourceAttrAccountID(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusActive)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+			{
+				Config: testAccEnrollmentStatusConfig_basic(string(awstypes.StatusInactive), false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName, awstypes.StatusInactive),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusInactive)),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnrollmentStatus_includeMemberAccounts(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_computeoptimizer_enrollment_status.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnrollmentStatusDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnrollmentStatusConfig_basic(string(awstypes.StatusActive), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEnrollmentStatusExists(ctx, resourceName, awstypes.StatusActive),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "number_of_member_accounts_opted_in"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.StatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEnrollmentStatusExists(ctx context.Context, n string, want awstypes.Status) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		output, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+		if err != nil {
+			return err
+		}
+
+		if got := output.Status; got != want {
+			return fmt.Errorf("Compute Optimizer Enrollment Status is %s, want %s", got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnrollmentStatusDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_computeoptimizer_enrollment_status" {
+				continue
+			}
+
+			output, err := tfcomputeoptimizer.FindEnrollmentStatus(ctx, conn)
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status == awstypes.StatusInactive {
+				continue
+			}
+
+			return fmt.Errorf("Compute Optimizer Enrollment Status %s still active", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEnrollmentStatusConfig_basic(status string, includeMemberAccounts bool) string {
+	return fmt.Sprintf(`
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status                  = %[1]q
+  include_member_accounts = %[2]t
+}
+`, status, includeMemberAccounts)
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+// Exports for use in tests only.
+var (
+	ResourceEnrollmentStatus     = newEnrollmentStatusResource
+	ResourceRecommendationExport = newRecommendationExportResource
+
+	FindEnrollmentStatus            = findEnrollmentStatus
+	FindRecommendationExportJobByID = findRecommendationExportJobByID
+)
@@ -0,0 +1,480 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/computeoptimizer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_computeoptimizer_recommendation_export", name="Recommendation Export")
+func newRecommendationExportResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recommendationExportResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type recommendationExportResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*recommendationExportResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_computeoptimizer_recommendation_export"
+}
+
+func (r *recommendationExportResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	fileFormatType := fwtypes.StringEnumType[awstypes.FileFormat]()
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("include_member_accounts")),
+				},
+			},
+			"fields_to_export": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"file_format": schema.StringAttribute{
+				CustomType: fileFormatType,
+				Optional:   true,
+				Computed:   true,
+				Default:    fileFormatType.AttributeDefault(awstypes.FileFormatCsv),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"include_member_accounts": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrResourceType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ResourceType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(enum.Slice(exportableResourceTypes...)...),
+				},
+			},
+			"s3_key": schema.StringAttribute{
+				Computed: true,
+			},
+			"s3_metadata_key": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobStatus](),
+				Computed:   true,
+			},
+			names.AttrTriggers: schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"s3_destination": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[s3DestinationConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrBucket: schema.StringAttribute{
+							Required: true,
+						},
+						"key_prefix": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+var exportableResourceTypes = []awstypes.ResourceType{
+	awstypes.ResourceTypeAutoScalingGroup,
+	awstypes.ResourceTypeEbsVolume,
+	awstypes.ResourceTypeEc2Instance,
+	awstypes.ResourceTypeEcsService,
+	awstypes.ResourceTypeLambdaFunction,
+	awstypes.ResourceTypeLicense,
+	awstypes.ResourceTypeRdsDbInstance,
+}
+
+func (r *recommendationExportResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recommendationExportResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	var s3DestinationConfig awstypes.S3DestinationConfig
+	response.Diagnostics.Append(fwflex.Expand(ctx, data.S3Destination, &s3DestinationConfig)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	resourceType := data.ResourceType.ValueEnum()
+	jobID, s3Destination, err := exportRecommendations(ctx, conn, resourceType, &exportRecommendationsInput{
+		accountIDs:            fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs),
+		fieldsToExport:        fwflex.ExpandFrameworkStringValueSet(ctx, data.FieldsToExport),
+		fileFormat:            data.FileFormat.ValueEnum(),
+		includeMemberAccounts: data.IncludeMemberAccounts.ValueBool(),
+		s3DestinationConfig:   &s3DestinationConfig,
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Compute Optimizer %s Recommendation Export", resourceType), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(jobID)
+	if s3Destination != nil {
+		data.S3Key = fwflex.StringToFramework(ctx, s3Destination.Key)
+		data.S3MetadataKey = fwflex.StringToFramework(ctx, s3Destination.MetadataKey)
+	}
+
+	job, err := waitRecommendationExportJobCompleted(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Compute Optimizer Recommendation Export (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Status = fwtypes.StringEnumValue(job.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recommendationExportResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recommendationExportResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ComputeOptimizerClient(ctx)
+
+	output, err := findRecommendationExportJobByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		// Export jobs are only described for 7 days after they are created.
+		// The exported files remain in the destination bucket, so keep the last known state rather than exporting again.
+		if data.Status.ValueEnum() == awstypes.JobStatusComplete {
+			tflog.Debug(ctx, "Compute Optimizer Recommendation Export job has expired", map[string]interface{}{
+				names.AttrID: data.ID.ValueString(),
+			})
+
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+
+			return
+		}
+
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Compute Optimizer Recommendation Export (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ResourceType = fwtypes.StringEnumValue(output.ResourceType)
+	if output.Destination != nil && output.Destination.S3 != nil {
+		data.S3Key = fwflex.StringToFramework(ctx, output.Destination.S3.Key)
+		data.S3MetadataKey = fwflex.StringToFramework(ctx, output.Destination.S3.MetadataKey)
+	}
+	data.Status = fwtypes.StringEnumValue(output.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type exportRecommendationsInput struct {
+	accountIDs            []string
+	fieldsToExport        []string
+	fileFormat            awstypes.FileFormat
+	includeMemberAccounts bool
+	s3DestinationConfig   *awstypes.S3DestinationConfig
+}
+
+// exportRecommendations starts a recommendation export job using the API for the specified resource type.
+// Each resource type has its own export API and set of exportable fields.
+func exportRecommendations(ctx context.Context, conn *computeoptimizer.Client, resourceType awstypes.ResourceType, in *exportRecommendationsInput) (string, *awstypes.S3Destination, error) {
+	switch resourceType {
+	case awstypes.ResourceTypeAutoScalingGroup:
+		output, err := conn.ExportAutoScalingGroupRecommendations(ctx, &computeoptimizer.ExportAutoScalingGroupRecommendationsInput{
+			AccountIds:            in.accountIDs,
+			FieldsToExport:        stringsToEnums[awstypes.ExportableAutoScalingGroupField](in.fieldsToExport),
+			FileFormat:            in.fileFormat,
+			IncludeMemberAccounts: in.includeMemberAccounts,
+			S3DestinationConfig:   in.s3DestinationConfig,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return aws.ToString(output.JobId), output.S3Destination, nil
+	case awstypes.ResourceTypeEbsVolume:
+		output, err := conn.ExportEBSVolumeRecommendations(ctx, &computeoptimizer.ExportEBSVolumeRecommendationsInput{
+			AccountIds:            in.accountIDs,
+			FieldsToExport:        stringsToEnums[awstypes.ExportableVolumeField](in.fieldsToExport),
+			FileFormat:            in.fileFormat,
+			IncludeMemberAccounts: in.includeMemberAccounts,
+			S3DestinationConfig:   in.s3DestinationConfig,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return aws.ToString(output.JobId), output.S3Destination, nil
+	case awstypes.ResourceTypeEc2Instance:
+		output, err := conn.ExportEC2InstanceRecommendations(ctx, &computeoptimizer.ExportEC2InstanceRecommendationsInput{
+			AccountIds:            in.accountIDs,
+			FieldsToExport:        stringsToEnums[awstypes.ExportableInstanceField](in.fieldsToExport),
+			FileFormat:            in.fileFormat,
+			IncludeMemberAccounts: in.includeMemberAccounts,
+			S3DestinationConfig:   in.s3DestinationConfig,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return aws.ToString(output.JobId), output.S3Destination, nil
+	case awstypes.ResourceTypeEcsService:
+		output, err := conn.ExportECSServiceRecommendations(ctx, &computeoptimizer.ExportECSServiceRecommendationsInput{
+			AccountIds:            in.accountIDs,
+			FieldsToExport:        stringsToEnums[awstypes.ExportableECSServiceField](in.fieldsToExport),
+			FileFormat:            in.fileFormat,
+			IncludeMemberAccounts: in.includeMemberAccounts,
+			S3DestinationConfig:   in.s3DestinationConfig,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return aws.ToString(output.JobId), output.S3Destination, nil
+	case awstypes.ResourceTypeLambdaFunction:
+		output, err := conn.ExportLambdaFunctionRecommendations(ctx, &computeoptimizer.ExportLambdaFunctionRecommendationsInput{
+			AccountIds:            in.accountIDs,
+			FieldsToExport:        stringsToEnums[awstypes.ExportableLambdaFunctionField](in.fieldsToExport),
+			FileFormat:            in.fileFormat,
+			IncludeMemberAccounts: in.includeMemberAccounts,
+			S3DestinationConfig:   in.s3DestinationConfig,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return aws.ToString(output.JobId), output.S3Destination, nil
+	case awstypes.ResourceTypeLicense:
+		output, err := conn.ExportLicenseRecommendations(ctx, &computeoptimizer.ExportLicenseRecommendationsInput{
+			AccountIds:            in.accountIDs,
+			FieldsToExport:        stringsToEnums[awstypes.ExportableLicenseField](in.fieldsToExport),
+			FileFormat:            in.fileFormat,
+			IncludeMemberAccounts: in.includeMemberAccounts,
+			S3DestinationConfig:   in.s3DestinationConfig,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return aws.ToString(output.JobId), output.S3Destination, nil
+	case awstypes.ResourceTypeRdsDbInstance:
+		output, err := conn.ExportRDSDatabaseRecommendations(ctx, &computeoptimizer.ExportRDSDatabaseRecommendationsInput{
+			AccountIds:            in.accountIDs,
+			FieldsToExport:        stringsToEnums[awstypes.ExportableRDSDBField](in.fieldsToExport),
+			FileFormat:            in.fileFormat,
+			IncludeMemberAccounts: in.includeMemberAccounts,
+			S3DestinationConfig:   in.s3DestinationConfig,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		return aws.ToString(output.JobId), output.S3Destination, nil
+	}
+
+	return "", nil, fmt.Errorf("unsupported resource type: %s", resourceType)
+}
+
+func stringsToEnums[T ~string](vs []string) []T {
+	if vs == nil {
+		return nil
+	}
+
+	output := make([]T, 0, len(vs))
+	for _, v := range vs {
+		output = append(output, T(v))
+	}
+
+	return output
+}
+
+func findRecommendationExportJobByID(ctx context.Context, conn *computeoptimizer.Client, id string) (*awstypes.RecommendationExportJob, error) {
+	input := &computeoptimizer.DescribeRecommendationExportJobsInput{
+		JobIds: []string{id},
+	}
+
+	return findRecommendationExportJob(ctx, conn, input)
+}
+
+func findRecommendationExportJob(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.DescribeRecommendationExportJobsInput) (*awstypes.RecommendationExportJob, error) {
+	output, err := findRecommendationExportJobs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findRecommendationExportJobs(ctx context.Context, conn *computeoptimizer.Client, input *computeoptimizer.DescribeRecommendationExportJobsInput) ([]awstypes.RecommendationExportJob, error) {
+	var output []awstypes.RecommendationExportJob
+
+	pages := computeoptimizer.NewDescribeRecommendationExportJobsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.RecommendationExportJobs...)
+	}
+
+	return output, nil
+}
+
+func statusRecommendationExportJob(ctx context.Context, conn *computeoptimizer.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findRecommendationExportJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitRecommendationExportJobCompleted(ctx context.Context, conn *computeoptimizer.Client, id string, timeout time.Duration) (*awstypes.RecommendationExportJob, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusQueued, awstypes.JobStatusInProgress),
+		Target:  enum.Slice(awstypes.JobStatusComplete),
+		Refresh: statusRecommendationExportJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.RecommendationExportJob); ok {
+		if output.Status == awstypes.JobStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type recommendationExportResourceModel struct {
+	AccountIDs            fwtypes.SetValueOf[types.String]                          `tfsdk:"account_ids"`
+	FieldsToExport        fwtypes.SetValueOf[types.String]                          `tfsdk:"fields_to_export"`
+	FileFormat            fwtypes.StringEnum[awstypes.FileFormat]                   `tfsdk:"file_format"`
+	ID                    types.String                                              `tfsdk:"id"`
+	IncludeMemberAccounts types.Bool                                                `tfsdk:"include_member_accounts"`
+	ResourceType          fwtypes.StringEnum[awstypes.ResourceType]                 `tfsdk:"resource_type"`
+	S3Destination         fwtypes.ListNestedObjectValueOf[s3DestinationConfigModel] `tfsdk:"s3_destination"`
+	S3Key                 types.String                                              `tfsdk:"s3_key"`
+	S3MetadataKey         types.String                                              `tfsdk:"s3_metadata_key"`
+	Status                fwtypes.StringEnum[awstypes.JobStatus]                    `tfsdk:"status"`
+	Timeouts              timeouts.Value                                            `tfsdk:"timeouts"`
+	Triggers              types.Map                                                 `tfsdk:"triggers"`
+}
+
+type s3DestinationConfigModel struct {
+	Bucket    types.String `tfsdk:"bucket"`
+	KeyPrefix types.String `tfsdk:"key_prefix"`
+}
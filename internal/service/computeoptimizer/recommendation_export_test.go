@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package computeoptimizer_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/computeoptimizer/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcomputeoptimizer "github.com/hashicorp/terraform-provider-aws/internal/service/computeoptimizer"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRecommendationExport_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_computeoptimizer_recommendation_export.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationExportConfig_basic(rName, string(awstypes.ResourceTypeEc2Instance)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationExportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "fields_to_export.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "file_format", string(awstypes.FileFormatCsv)),
+					resource.TestCheckResourceAttr(resourceName, "include_member_accounts", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, string(awstypes.ResourceTypeEc2Instance)),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "s3_destination.0.bucket", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "s3_destination.0.key_prefix", "exports"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_metadata_key"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusComplete)),
+				),
+			},
+			{
+				Config: testAccRecommendationExportConfig_basic(rName, string(awstypes.ResourceTypeEbsVolume)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationExportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, string(awstypes.ResourceTypeEbsVolume)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusComplete)),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationExport_fieldsToExport(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_computeoptimizer_recommendation_export.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationExportConfig_fieldsToExport(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationExportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "fields_to_export.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields_to_export.*", "AccountId"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "fields_to_export.*", "FunctionArn"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrResourceType, string(awstypes.ResourceTypeLambdaFunction)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.JobStatusComplete)),
+				),
+			},
+		},
+	})
+}
+
+func testAccRecommendationExport_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_computeoptimizer_recommendation_export.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ComputeOptimizerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecommendationExportConfig_triggers(rName, "2024-01-01"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationExportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.schedule", "2024-01-01"),
+				),
+			},
+			{
+				Config: testAccRecommendationExportConfig_triggers(rName, "2024-01-08"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecommendationExportExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.schedule", "2024-01-08"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRecommendationExportExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ComputeOptimizerClient(ctx)
+
+		_, err := tfcomputeoptimizer.FindRecommendationExportJobByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccRecommendationExportConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_computeoptimizer_enrollment_status" "test" {
+  status = "Active"
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "compute-optimizer.amazonaws.com"
+      }
+      Action = [
+        "s3:GetBucketAcl",
+        "s3:GetBucketPolicyStatus",
+      ]
+      Resource = aws_s3_bucket.test.arn
+      }, {
+      Effect = "Allow"
+      Principal = {
+        Service = "compute-optimizer.amazonaws.com"
+      }
+      Action   = "s3:PutObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringEquals = {
+          "s3:x-amz-acl"      = "bucket-owner-full-control"
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccRecommendationExportConfig_basic(rName, resourceType string) string {
+	return acctest.ConfigCompose(testAccRecommendationExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_computeoptimizer_recommendation_export" "test" {
+  resource_type = %[1]q
+
+  s3_destination {
+    bucket     = aws_s3_bucket.test.bucket
+    key_prefix = "exports"
+  }
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test, aws_s3_bucket_policy.test]
+}
+`, resourceType))
+}
+
+func testAccRecommendationExportConfig_fieldsToExport(rName string) string {
+	return acctest.ConfigCompose(testAccRecommendationExportConfig_base(rName), `
+resource "aws_computeoptimizer_recommendation_export" "test" {
+  resource_type    = "LambdaFunction"
+  fields_to_export = ["AccountId", "FunctionArn"]
+
+  s3_destination {
+    bucket = aws_s3_bucket.test.bucket
+  }
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test, aws_s3_bucket_policy.test]
+}
+`)
+}
+
+func testAccRecommendationExportConfig_triggers(rName, schedule string) string {
+	return acctest.ConfigCompose(testAccRecommendationExportConfig_base(rName), fmt.Sprintf(`
+resource "aws_computeoptimizer_recommendation_export" "test" {
+  resource_type = "Ec2Instance"
+
+  s3_destination {
+    bucket = aws_s3_bucket.test.bucket
+  }
+
+  triggers = {
+    schedule = %[1]q
+  }
+
+  depends_on = [aws_computeoptimizer_enrollment_status.test, aws_s3_bucket_policy.test]
+}
+`, schedule))
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -167,7 +168,7 @@ func resourceOrganizationConformancePackCreate(ctx context.Context, d *schema.Re
 
 	d.SetId(name)
 
-	if _, err := waitOrganizationConformancePackCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitOrganizationConformancePackCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Conformance Pack (%s) create: %s", d.Id(), err)
 	}
 
@@ -240,7 +241,7 @@ func resourceOrganizationConformancePackUpdate(ctx context.Context, d *schema.Re
 		return sdkdiag.AppendErrorf(diags, "updating ConfigService Organization Conformance Pack (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOrganizationConformancePackUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitOrganizationConformancePackUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Conformance Pack (%s) update: %s", d.Id(), err)
 	}
 
@@ -269,7 +270,7 @@ func resourceOrganizationConformancePackDelete(ctx context.Context, d *schema.Re
 		return sdkdiag.AppendErrorf(diags, "deleting ConfigService Organization Conformance Pack (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOrganizationConformancePackDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitOrganizationConformancePackDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Conformance Pack (%s) delete: %s", d.Id(), err)
 	}
 
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -172,7 +173,7 @@ func resourceOrganizationManagedRuleCreate(ctx context.Context, d *schema.Resour
 
 	d.SetId(name)
 
-	if _, err := waitOrganizationConfigRuleCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitOrganizationConfigRuleCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Managed Rule (%s) create: %s", d.Id(), err)
 	}
 
@@ -260,7 +261,7 @@ func resourceOrganizationManagedRuleUpdate(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "updating ConfigService Organization Managed Rule (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOrganizationConfigRuleUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitOrganizationConfigRuleUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Managed Rule (%s) update: %s", d.Id(), err)
 	}
 
@@ -289,7 +290,7 @@ func resourceOrganizationManagedRuleDelete(ctx context.Context, d *schema.Resour
 		return sdkdiag.AppendErrorf(diags, "deleting ConfigService Organization Managed Rule (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOrganizationConfigRuleDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitOrganizationConfigRuleDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ConfigService Organization Managed Rule (%s) delete: %s", d.Id(), err)
 	}
 
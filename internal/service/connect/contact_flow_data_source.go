@@ -63,7 +63,7 @@ func dataSourceContactFlowRead(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	instanceID := d.Get(names.AttrInstanceID).(string)
 
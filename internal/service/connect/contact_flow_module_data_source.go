@@ -67,7 +67,7 @@ func dataSourceContactFlowModuleRead(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).ConnectConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	instanceID := d.Get(names.AttrInstanceID).(string)
 
@@ -108,3 +108,48 @@ func FindLambdaFunctionAssociationByARNWithContext(ctx context.Context, conn *co
 
 	return result, nil
 }
+
+func FindIntegrationAssociationByTwoPartKey(ctx context.Context, conn *connect.Connect, instanceID, integrationAssociationID string) (*connect.IntegrationAssociationSummary, error) {
+	var result *connect.IntegrationAssociationSummary
+
+	input := &connect.ListIntegrationAssociationsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListIntegrationAssociationsMaxResults),
+	}
+
+	err := conn.ListIntegrationAssociationsPagesWithContext(ctx, input, func(page *connect.ListIntegrationAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.IntegrationAssociationSummaryList {
+			if v == nil {
+				continue
+			}
+
+			if aws.StringValue(v.IntegrationAssociationId) == integrationAssociationID {
+				result = v
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if result == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return result, nil
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -131,7 +132,7 @@ func resourceVocabularyCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(fmt.Sprintf("%s:%s", instanceID, vocabularyID))
 
 	// waiter since the status changes from CREATION_IN_PROGRESS to either ACTIVE or CREATION_FAILED
-	if _, err := waitVocabularyCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), instanceID, vocabularyID); err != nil {
+	if _, err := waitVocabularyCreated(ctx, conn, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), instanceID, vocabularyID); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Vocabulary (%s) creation: %s", d.Id(), err)
 	}
 
@@ -210,7 +211,7 @@ func resourceVocabularyDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting Vocabulary (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitVocabularyDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete), instanceID, vocabularyID); err != nil {
+	if _, err := waitVocabularyDeleted(ctx, conn, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), instanceID, vocabularyID); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Vocabulary (%s) deletion: %s", d.Id(), err)
 	}
 
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -155,7 +156,7 @@ func resourcePipelineDefinitionPut(ctx context.Context, d *schema.ResourceData,
 
 	var err error
 	var output *datapipeline.PutPipelineDefinitionOutput
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err = retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() *retry.RetryError {
 		output, err = conn.PutPipelineDefinitionWithContext(ctx, input)
 		if err != nil {
 			if tfawserr.ErrCodeEquals(err, datapipeline.ErrCodeInternalServiceError) {
@@ -21,10 +21,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -68,21 +70,39 @@ func ResourceCluster() *schema.Resource {
 					return old == new
 				},
 			},
-			names.AttrClusterName: {
+			"cluster_endpoint_url": {
 				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Computed: true,
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{names.AttrClusterName, "cluster_name_prefix"},
 				StateFunc: func(val interface{}) string {
 					return strings.ToLower(val.(string))
 				},
 				ValidateFunc: validation.All(
-					validation.StringLenBetween(1, 20),
+					validation.StringLenBetween(1, clusterNameMaxLength),
 					validation.StringMatch(regexache.MustCompile(`^[0-9a-z-]+$`), "must contain only lowercase alphanumeric characters and hyphens"),
 					validation.StringMatch(regexache.MustCompile(`^[a-z]`), "must begin with a lowercase letter"),
 					validation.StringDoesNotMatch(regexache.MustCompile(`--`), "cannot contain two consecutive hyphens"),
 					validation.StringDoesNotMatch(regexache.MustCompile(`-$`), "cannot end with a hyphen"),
 				),
 			},
+			"cluster_name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, clusterNameMaxLength-clusterNameSuffixLength),
+					validation.StringMatch(regexache.MustCompile(`^[0-9a-z-]+$`), "must contain only lowercase alphanumeric characters and hyphens"),
+					validation.StringMatch(regexache.MustCompile(`^[a-z]`), "must begin with a lowercase letter"),
+					validation.StringDoesNotMatch(regexache.MustCompile(`--`), "cannot contain two consecutive hyphens"),
+				),
+			},
 			names.AttrIAMRoleARN: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -196,6 +216,14 @@ func ResourceCluster() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"endpoint_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -209,7 +237,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).DAXClient(ctx)
 
-	clusterName := d.Get(names.AttrClusterName).(string)
+	clusterName := create.NewNameGenerator(
+		create.WithConfiguredName(d.Get(names.AttrClusterName).(string)),
+		create.WithConfiguredPrefix(d.Get("cluster_name_prefix").(string)),
+		create.WithShortUniqueIDSuffix(clusterNameSuffixLength),
+	).Generate()
 	iamRoleArn := d.Get(names.AttrIAMRoleARN).(string)
 	nodeType := d.Get("node_type").(string)
 	numNodes := int32(d.Get("replication_factor").(int))
@@ -290,7 +322,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		Pending:    pending,
 		Target:     []string{"available"},
 		Refresh:    clusterStateRefreshFunc(ctx, conn, d.Id(), "available", pending),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    sdkv2.Timeout(ctx, d, schema.TimeoutCreate),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
 	}
@@ -333,6 +365,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	c := res.Clusters[0]
 	d.Set(names.AttrARN, c.ClusterArn)
 	d.Set(names.AttrClusterName, c.ClusterName)
+	d.Set("cluster_name_prefix", create.NamePrefixFromNameWithShortUniqueIDSuffix(aws.ToString(c.ClusterName), clusterNameSuffixLength))
 	d.Set("cluster_endpoint_encryption_type", c.ClusterEndpointEncryptionType)
 	d.Set(names.AttrDescription, c.Description)
 	d.Set(names.AttrIAMRoleARN, c.IamRoleArn)
@@ -343,6 +376,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set(names.AttrPort, c.ClusterDiscoveryEndpoint.Port)
 		d.Set("configuration_endpoint", fmt.Sprintf("%s:%d", aws.ToString(c.ClusterDiscoveryEndpoint.Address), c.ClusterDiscoveryEndpoint.Port))
 		d.Set("cluster_address", c.ClusterDiscoveryEndpoint.Address)
+		d.Set("cluster_endpoint_url", c.ClusterDiscoveryEndpoint.URL)
 	}
 
 	d.Set("subnet_group_name", c.SubnetGroup)
@@ -457,7 +491,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			Pending:    pending,
 			Target:     []string{"available"},
 			Refresh:    clusterStateRefreshFunc(ctx, conn, d.Id(), "available", pending),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Timeout:    sdkv2.Timeout(ctx, d, schema.TimeoutUpdate),
 			MinTimeout: 10 * time.Second,
 			Delay:      30 * time.Second,
 		}
@@ -484,6 +518,8 @@ func setClusterNodeData(d *schema.ResourceData, c awstypes.Cluster) error {
 			names.AttrAddress:          aws.ToString(node.Endpoint.Address),
 			names.AttrPort:             node.Endpoint.Port,
 			names.AttrAvailabilityZone: aws.ToString(node.AvailabilityZone),
+			"endpoint_url":             aws.ToString(node.Endpoint.URL),
+			"node_status":              aws.ToString(node.NodeStatus),
 		})
 	}
 
@@ -536,7 +572,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Pending:    []string{"creating", "available", "deleting", "incompatible-parameters", "incompatible-network"},
 		Target:     []string{},
 		Refresh:    clusterStateRefreshFunc(ctx, conn, d.Id(), "", []string{}),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    sdkv2.Timeout(ctx, d, schema.TimeoutDelete),
 		MinTimeout: 10 * time.Second,
 		Delay:      30 * time.Second,
 	}
@@ -64,6 +64,12 @@ func TestAccDAXCluster_basic(t *testing.T) {
 						resourceName, "configuration_endpoint", regexache.MustCompile(`:\d+$`)),
 					resource.TestCheckResourceAttrSet(
 						resourceName, "cluster_address"),
+					resource.TestMatchResourceAttr(
+						resourceName, "cluster_endpoint_url", regexache.MustCompile(`^dax://`)),
+					resource.TestMatchResourceAttr(
+						resourceName, "nodes.0.endpoint_url", regexache.MustCompile(`^dax://`)),
+					resource.TestCheckResourceAttr(
+						resourceName, "nodes.0.node_status", "available"),
 					resource.TestMatchResourceAttr(
 						resourceName, names.AttrPort, regexache.MustCompile(`^\d+$`)),
 					resource.TestCheckResourceAttr(
@@ -270,6 +276,51 @@ func TestAccDAXCluster_EndpointEncryption_enabled(t *testing.T) {
 	})
 }
 
+func TestAccDAXCluster_EndpointEncryption_createBeforeDestroy(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	ctx := acctest.Context(t)
+	var dc1, dc2 awstypes.Cluster
+	resourceName := "aws_dax_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DAXServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_namePrefixEndpointEncryption("NONE"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc1),
+					resource.TestMatchResourceAttr(resourceName, names.AttrClusterName, regexache.MustCompile(`^tf-acc-[0-9a-f]{8}$`)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_name_prefix", "tf-acc-"),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", "NONE"),
+					resource.TestMatchResourceAttr(resourceName, "cluster_endpoint_url", regexache.MustCompile(`^dax://`)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterConfig_namePrefixEndpointEncryption("TLS"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dc2),
+					testAccCheckClusterRecreated(&dc1, &dc2),
+					resource.TestMatchResourceAttr(resourceName, names.AttrClusterName, regexache.MustCompile(`^tf-acc-[0-9a-f]{8}$`)),
+					resource.TestCheckResourceAttr(resourceName, "cluster_endpoint_encryption_type", "TLS"),
+					resource.TestMatchResourceAttr(resourceName, "cluster_endpoint_url", regexache.MustCompile(`^daxs://`)),
+					resource.TestMatchResourceAttr(resourceName, "nodes.0.endpoint_url", regexache.MustCompile(`^daxs://`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDAXCluster_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dc awstypes.Cluster
@@ -349,6 +400,16 @@ func testAccCheckClusterExists(ctx context.Context, n string, v *awstypes.Cluste
 	}
 }
 
+func testAccCheckClusterRecreated(before, after *awstypes.Cluster) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.ClusterArn), aws.ToString(after.ClusterArn); before == after {
+			return fmt.Errorf("DAX Cluster (%s) not recreated", before)
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).DAXClient(ctx)
 
@@ -453,6 +514,22 @@ resource "aws_dax_cluster" "test" {
 `, baseConfig, rString, encryptionType)
 }
 
+func testAccClusterConfig_namePrefixEndpointEncryption(encryptionType string) string {
+	return fmt.Sprintf(`%s
+resource "aws_dax_cluster" "test" {
+  cluster_name_prefix              = "tf-acc-"
+  cluster_endpoint_encryption_type = %q
+  iam_role_arn                     = aws_iam_role.test.arn
+  node_type                        = "dax.t3.small"
+  replication_factor               = 1
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, baseConfig, encryptionType)
+}
+
 func testAccClusterConfig_resizeSingleNode(rString string) string {
 	return fmt.Sprintf(`%s
 resource "aws_dax_cluster" "test" {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 )
 
 func virtualInterfaceRead(ctx context.Context, id string, conn *directconnect.DirectConnect) (*directconnect.VirtualInterface, error) {
@@ -90,7 +91,7 @@ func virtualInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta in
 			directconnect.VirtualInterfaceStateDeleted,
 		},
 		Refresh:    virtualInterfaceStateRefresh(ctx, conn, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    sdkv2.Timeout(ctx, d, schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 5 * time.Second,
 	}
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -359,7 +360,7 @@ func resourceS3EndpointCreate(ctx context.Context, d *schema.ResourceData, meta
 	log.Println("[DEBUG] DMS create endpoint:", input)
 
 	var out *dms.CreateEndpointOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 		out, err = conn.CreateEndpointWithContext(ctx, input)
 
@@ -519,7 +520,7 @@ func resourceS3EndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 		log.Println("[DEBUG] DMS update endpoint:", input)
 
-		err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() *retry.RetryError {
 			_, err := conn.ModifyEndpointWithContext(ctx, input)
 
 			if tfawserr.ErrCodeEquals(err, "AccessDeniedFault") {
@@ -562,7 +563,7 @@ func resourceS3EndpointDelete(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.DMS, create.ErrActionDeleting, ResNameS3Endpoint, d.Id(), err)
 	}
 
-	if err = waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err = waitEndpointDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.DMS, create.ErrActionWaitingForDeletion, ResNameS3Endpoint, d.Id(), err)
 	}
 
@@ -46,6 +46,7 @@ func newReplicationConfigurationTemplateResource(_ context.Context) (resource.Re
 type replicationConfigurationTemplateResource struct {
 	framework.ResourceWithConfigure
 	framework.WithImportByID
+	framework.WithPollInterval
 	framework.WithTimeouts
 }
 
@@ -93,6 +94,7 @@ func (r *replicationConfigurationTemplateResource) Schema(ctx context.Context, r
 			names.AttrID: schema.StringAttribute{
 				Computed: true,
 			},
+			"poll_interval": framework.PollIntervalAttribute(),
 			"replication_server_instance_type": schema.StringAttribute{
 				Required: true,
 			},
@@ -172,7 +174,7 @@ func (r *replicationConfigurationTemplateResource) Create(ctx context.Context, r
 		return
 	}
 
-	output, err := waitReplicationConfigurationTemplateAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+	output, err := waitReplicationConfigurationTemplateAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts), r.PollInterval(data.PollInterval))
 
 	if err != nil {
 		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionWaitingForCreation, ResNameReplicationConfigurationTemplate, data.ID.ValueString(), err)
@@ -248,7 +250,7 @@ func (r *replicationConfigurationTemplateResource) Update(ctx context.Context, r
 			return
 		}
 
-		if _, err := waitReplicationConfigurationTemplateAvailable(ctx, conn, old.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		if _, err := waitReplicationConfigurationTemplateAvailable(ctx, conn, old.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts), r.PollInterval(new.PollInterval)); err != nil {
 			create.AddError(&response.Diagnostics, names.DRS, create.ErrActionWaitingForUpdate, ResNameReplicationConfigurationTemplate, new.ID.ValueString(), err)
 
 			return
@@ -301,7 +303,7 @@ func (r *replicationConfigurationTemplateResource) Delete(ctx context.Context, r
 		return
 	}
 
-	if _, err := waitReplicationConfigurationTemplateDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+	if _, err := waitReplicationConfigurationTemplateDeleted(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts), r.PollInterval(data.PollInterval)); err != nil {
 		create.AddError(&response.Diagnostics, names.DRS, create.ErrActionWaitingForDeletion, ResNameReplicationConfigurationTemplate, data.ID.ValueString(), err)
 
 		return
@@ -373,14 +375,15 @@ func statusReplicationConfigurationTemplate(ctx context.Context, conn *drs.Clien
 	}
 }
 
-func waitReplicationConfigurationTemplateAvailable(ctx context.Context, conn *drs.Client, id string, timeout time.Duration) (*awstypes.ReplicationConfigurationTemplate, error) {
+func waitReplicationConfigurationTemplateAvailable(ctx context.Context, conn *drs.Client, id string, timeout, pollInterval time.Duration) (*awstypes.ReplicationConfigurationTemplate, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{},
-		Target:     []string{replicationConfigurationTemplateAvailable},
-		Refresh:    statusReplicationConfigurationTemplate(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Pending:      []string{},
+		Target:       []string{replicationConfigurationTemplateAvailable},
+		Refresh:      statusReplicationConfigurationTemplate(ctx, conn, id),
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -392,14 +395,15 @@ func waitReplicationConfigurationTemplateAvailable(ctx context.Context, conn *dr
 	return nil, err
 }
 
-func waitReplicationConfigurationTemplateDeleted(ctx context.Context, conn *drs.Client, id string, timeout time.Duration) (*awstypes.ReplicationConfigurationTemplate, error) {
+func waitReplicationConfigurationTemplateDeleted(ctx context.Context, conn *drs.Client, id string, timeout, pollInterval time.Duration) (*awstypes.ReplicationConfigurationTemplate, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    []string{replicationConfigurationTemplateAvailable},
-		Target:     []string{},
-		Refresh:    statusReplicationConfigurationTemplate(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Pending:      []string{replicationConfigurationTemplateAvailable},
+		Target:       []string{},
+		Refresh:      statusReplicationConfigurationTemplate(ctx, conn, id),
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -423,6 +427,7 @@ type replicationConfigurationTemplateResourceModel struct {
 	EBSEncryptionKeyARN                 types.String                                                                     `tfsdk:"ebs_encryption_key_arn"`
 	ID                                  types.String                                                                     `tfsdk:"id"`
 	PitPolicy                           fwtypes.ListNestedObjectValueOf[pitPolicy]                                       `tfsdk:"pit_policy"`
+	PollInterval                        fwtypes.Duration                                                                 `tfsdk:"poll_interval"`
 	ReplicationServerInstanceType       types.String                                                                     `tfsdk:"replication_server_instance_type"`
 	ReplicationServersSecurityGroupsIDs types.List                                                                       `tfsdk:"replication_servers_security_groups_ids"`
 	StagingAreaSubnetID                 types.String                                                                     `tfsdk:"staging_area_subnet_id"`
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -91,7 +92,7 @@ func resourceSharedDirectoryAccepterCreate(ctx context.Context, d *schema.Resour
 
 	d.Set("notes", output.SharedDirectory.ShareNotes) // only available in response to create
 
-	_, err = waitDirectoryShared(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	_, err = waitDirectoryShared(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 
 	if err != nil {
 		return create.AppendDiagError(diags, names.DS, create.ErrActionWaitingForCreation, ResNameSharedDirectoryAccepter, d.Id(), err)
@@ -139,7 +140,7 @@ func resourceSharedDirectoryAccepterDelete(ctx context.Context, d *schema.Resour
 		return create.AppendDiagError(diags, names.DS, create.ErrActionDeleting, ResNameSharedDirectoryAccepter, d.Id(), err)
 	}
 
-	if _, err := waitDirectoryDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitDirectoryDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.DS, create.ErrActionWaitingForDeletion, ResNameSharedDirectoryAccepter, d.Id(), err)
 	}
 
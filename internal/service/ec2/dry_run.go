@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	tfawserr_sdkv1 "github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	tfawserr_sdkv2 "github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// customizeDiffDryRun returns a CustomizeDiffFunc that, when the provider's ec2_dry_run_on_plan
+// configuration is enabled, calls the specified function during planning of resource creation.
+// The function is expected to perform the resource's create API call with DryRun set.
+func customizeDiffDryRun(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if diff.Id() != "" || !meta.(*conns.AWSClient).EC2DryRunOnPlan(ctx) {
+			return nil
+		}
+
+		return f(ctx, diff, meta)
+	}
+}
+
+// dryRunConfigKnown returns whether the configured values of the specified attributes are all known.
+// Dry runs are skipped while any of the values depend on resources that have not yet been created.
+func dryRunConfigKnown(diff *schema.ResourceDiff, keys ...string) bool {
+	config := diff.GetRawConfig()
+
+	if config.IsNull() || !config.IsKnown() {
+		return false
+	}
+
+	for _, key := range keys {
+		if !config.GetAttr(key).IsWhollyKnown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// dryRunError converts the error returned from an EC2 API call made with DryRun set.
+// A DryRunOperation error indicates that the request would have succeeded.
+// Errors from both AWS SDK for Go v1 and v2 are handled.
+func dryRunError(err error, operation string) error {
+	if err == nil || tfawserr_sdkv1.ErrCodeEquals(err, errCodeDryRunOperation) || tfawserr_sdkv2.ErrCodeEquals(err, errCodeDryRunOperation) {
+		return nil
+	}
+
+	if tfawserr_sdkv1.ErrCodeEquals(err, errCodeUnauthorizedOperation) || tfawserr_sdkv2.ErrCodeEquals(err, errCodeUnauthorizedOperation) {
+		return fmt.Errorf("dry run %s: not authorized: %w", operation, err)
+	}
+
+	return fmt.Errorf("dry run %s: %w", operation, err)
+}
@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestDryRunError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Err           error
+		ExpectedError *regexp.Regexp
+	}{
+		{
+			TestName: "nil",
+		},
+		{
+			TestName: "AWS SDK for Go v1 DryRunOperation",
+			Err:      awserr.New(errCodeDryRunOperation, "Request would have succeeded, but DryRun flag is set.", nil),
+		},
+		{
+			TestName: "AWS SDK for Go v2 DryRunOperation",
+			Err:      &smithy.GenericAPIError{Code: errCodeDryRunOperation, Message: "Request would have succeeded, but DryRun flag is set."},
+		},
+		{
+			TestName:      "AWS SDK for Go v1 UnauthorizedOperation",
+			Err:           awserr.New(errCodeUnauthorizedOperation, "You are not authorized to perform this operation.", nil),
+			ExpectedError: regexache.MustCompile(`dry run test: not authorized: UnauthorizedOperation`),
+		},
+		{
+			TestName:      "AWS SDK for Go v2 UnauthorizedOperation",
+			Err:           &smithy.GenericAPIError{Code: errCodeUnauthorizedOperation, Message: "You are not authorized to perform this operation."},
+			ExpectedError: regexache.MustCompile(`dry run test: not authorized: api error UnauthorizedOperation`),
+		},
+		{
+			TestName:      "AWS SDK for Go v2 InvalidParameterValue",
+			Err:           &smithy.GenericAPIError{Code: errCodeInvalidParameterValue, Message: "Invalid value for instance type."},
+			ExpectedError: regexache.MustCompile(`dry run test: api error InvalidParameterValue`),
+		},
+		{
+			TestName:      "other error",
+			Err:           errors.New("test error"),
+			ExpectedError: regexache.MustCompile(`dry run test: test error`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := dryRunError(testCase.Err, "test")
+
+			if testCase.ExpectedError == nil && err != nil {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if testCase.ExpectedError != nil && err == nil {
+				t.Fatalf("expected error matching %q, got no error", testCase.ExpectedError)
+			}
+
+			if testCase.ExpectedError != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %s", testCase.ExpectedError, err)
+			}
+		})
+	}
+}
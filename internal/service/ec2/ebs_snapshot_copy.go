@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -143,12 +144,12 @@ func resourceEBSSnapshotCopyCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(aws.ToString(output.SnapshotId))
 
-	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutCreate),
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate),
 		func() (interface{}, error) {
 			waiter := ec2.NewSnapshotCompletedWaiter(conn)
 			return waiter.WaitForOutput(ctx, &ec2.DescribeSnapshotsInput{
 				SnapshotIds: []string{d.Id()},
-			}, d.Timeout(schema.TimeoutCreate))
+			}, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 		},
 		errCodeResourceNotReady)
 
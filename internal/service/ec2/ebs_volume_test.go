@@ -412,6 +412,35 @@ func TestAccEC2EBSVolume_withTags(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSVolume_ignoreTags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Volume
+	resourceName := "aws_ebs_volume.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSVolumeConfig_ignoreTags(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "tags_all.backup:plan"),
+				),
+			},
+			{
+				Config:   testAccEBSVolumeConfig_ignoreTags(acctest.CtKey1, acctest.CtValue1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2EBSVolume_multiAttach_io1(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Volume
@@ -1242,6 +1271,34 @@ resource "aws_ebs_volume" "test" {
 `, tagKey1, tagValue1, tagKey2, tagValue2))
 }
 
+func testAccEBSVolumeConfig_ignoreTags(tagKey1, tagValue1 string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(
+		acctest.ConfigAvailableAZsNoOptIn(),
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      "backup:plan" = "daily"
+    }
+  }
+}
+
+resource "aws_ebs_volume" "test" {
+  availability_zone = data.aws_availability_zones.available.names[0]
+  size              = 1
+
+  tags = {
+    %[1]q = %[2]q
+  }
+
+  ignore_tags {
+    key_regexes = ["^backup:"]
+  }
+}
+`, tagKey1, tagValue1))
+}
+
 func testAccEBSVolumeConfig_noIOPS(rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigAvailableAZsNoOptIn(),
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ec2_capacity_block_offerings", name="Capacity Block Offerings")
+func newDataSourceCapacityBlockOfferings(_ context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceCapacityBlockOfferings{}
+
+	return d, nil
+}
+
+type dataSourceCapacityBlockOfferings struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCapacityBlockOfferings) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_ec2_capacity_block_offerings"
+}
+
+func (d *dataSourceCapacityBlockOfferings) Schema(ctx context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"capacity_block_offerings": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[capacityBlockOfferingModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[capacityBlockOfferingModel](ctx),
+				},
+			},
+			"capacity_duration_hours": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"end_date_range": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+			names.AttrInstanceCount: schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrInstanceType: schema.StringAttribute{
+				Required: true,
+			},
+			"start_date_range": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameCapacityBlockOfferings = "Capacity Block Offerings"
+)
+
+func (d *dataSourceCapacityBlockOfferings) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	conn := d.Meta().EC2Client(ctx)
+	var data dataSourceCapacityBlockOfferingsData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &ec2.DescribeCapacityBlockOfferingsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findCapacityBlockOfferings(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, DSNameCapacityBlockOfferings, data.InstanceType.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.CapacityBlockOfferings)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceCapacityBlockOfferingsData struct {
+	CapacityBlockOfferings fwtypes.ListNestedObjectValueOf[capacityBlockOfferingModel] `tfsdk:"capacity_block_offerings"`
+	CapacityDurationHours  types.Int64                                                 `tfsdk:"capacity_duration_hours"`
+	EndDateRange           timetypes.RFC3339                                           `tfsdk:"end_date_range"`
+	InstanceCount          types.Int64                                                 `tfsdk:"instance_count"`
+	InstanceType           types.String                                                `tfsdk:"instance_type"`
+	StartDateRange         timetypes.RFC3339                                           `tfsdk:"start_date_range"`
+}
+
+type capacityBlockOfferingModel struct {
+	AvailabilityZone           types.String                                            `tfsdk:"availability_zone"`
+	CapacityBlockDurationHours types.Int64                                             `tfsdk:"capacity_block_duration_hours"`
+	CapacityBlockOfferingID    types.String                                            `tfsdk:"capacity_block_offering_id"`
+	CurrencyCode               types.String                                            `tfsdk:"currency_code"`
+	EndDate                    timetypes.RFC3339                                       `tfsdk:"end_date"`
+	InstanceCount              types.Int64                                             `tfsdk:"instance_count"`
+	InstanceType               types.String                                            `tfsdk:"instance_type"`
+	StartDate                  timetypes.RFC3339                                       `tfsdk:"start_date"`
+	Tenancy                    fwtypes.StringEnum[awstypes.CapacityReservationTenancy] `tfsdk:"tenancy"`
+	UpfrontFee                 types.String                                            `tfsdk:"upfront_fee"`
+}
+
+func findCapacityBlockOfferings(ctx context.Context, conn *ec2.Client, input *ec2.DescribeCapacityBlockOfferingsInput) ([]awstypes.CapacityBlockOffering, error) {
+	var output []awstypes.CapacityBlockOffering
+
+	pages := ec2.NewDescribeCapacityBlockOfferingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.CapacityBlockOfferings...)
+	}
+
+	return output, nil
+}
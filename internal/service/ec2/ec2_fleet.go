@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -782,7 +783,7 @@ func resourceFleetCreate(ctx context.Context, d *schema.ResourceData, meta inter
 			targetStates = append(targetStates, string(awstypes.FleetStateCodeDeleted), string(awstypes.FleetStateCodeDeletedRunning), string(awstypes.FleetStateCodeDeletedTerminatingInstances))
 		}
 
-		if err := waitFleet(ctx, conn, d.Id(), enum.Slice(awstypes.FleetStateCodeSubmitted), targetStates, d.Timeout(schema.TimeoutCreate), 0); err != nil {
+		if err := waitFleet(ctx, conn, d.Id(), enum.Slice(awstypes.FleetStateCodeSubmitted), targetStates, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), 0); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) create: %s", d.Id(), err)
 		}
 	}
@@ -895,7 +896,7 @@ func resourceFleetUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			return sdkdiag.AppendErrorf(diags, "modifying EC2 Fleet (%s): %s", d.Id(), err)
 		}
 
-		if err := waitFleet(ctx, conn, d.Id(), enum.Slice(awstypes.FleetStateCodeModifying), enum.Slice(awstypes.FleetStateCodeActive), d.Timeout(schema.TimeoutUpdate), 0); err != nil {
+		if err := waitFleet(ctx, conn, d.Id(), enum.Slice(awstypes.FleetStateCodeModifying), enum.Slice(awstypes.FleetStateCodeActive), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), 0); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -938,7 +939,7 @@ func resourceFleetDelete(ctx context.Context, d *schema.ResourceData, meta inter
 			targetStates = append(targetStates, string(awstypes.FleetStateCodeDeletedRunning))
 		}
 
-		if err := waitFleet(ctx, conn, d.Id(), pendingStates, targetStates, d.Timeout(schema.TimeoutDelete), delay); err != nil {
+		if err := waitFleet(ctx, conn, d.Id(), pendingStates, targetStates, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), delay); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for EC2 Fleet (%s) delete: %s", d.Id(), err)
 		}
 	}
@@ -34,15 +34,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_instance", name="Instance")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", resourceIgnoreTags=true)
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/ec2/types;awstypes;awstypes.Instance")
 // @Testing(importIgnore="user_data_replace_on_change")
 func resourceInstance() *schema.Resource {
@@ -54,7 +56,11 @@ func resourceInstance() *schema.Resource {
 		DeleteWithoutTimeout: resourceInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("allow_stop_for_update", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		SchemaVersion: 1,
@@ -79,6 +85,11 @@ func resourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"allow_stop_for_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"associate_public_ip_address": {
 				Type:     schema.TypeBool,
 				ForceNew: true,
@@ -310,7 +321,11 @@ func resourceInstance() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
+			},
+			"ena_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
 			},
 			"enclave_options": {
 				Type:     schema.TypeList,
@@ -781,6 +796,15 @@ func resourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"stop_for_update_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"allow_stop_for_update"},
+				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
 			names.AttrSubnetID: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -905,6 +929,12 @@ func resourceInstance() *schema.Resource {
 			customdiff.ForceNewIf("user_data_base64", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
+			customdiff.ForceNewIf("ebs_optimized", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return !diff.Get("allow_stop_for_update").(bool)
+			}),
+			customdiff.ForceNewIf("ena_support", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return !diff.Get("allow_stop_for_update").(bool)
+			}),
 			customdiff.ForceNewIf(names.AttrInstanceType, func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
@@ -939,6 +969,7 @@ func resourceInstance() *schema.Resource {
 
 				return true
 			}),
+			customizeDiffDryRun(instanceDryRun),
 		),
 	}
 }
@@ -959,6 +990,44 @@ func throughputDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool
 	return strings.ToLower(v) != string(awstypes.VolumeTypeGp3) && new == "0"
 }
 
+// instanceDryRun checks that an instance could be launched with the planned AMI, instance type,
+// key pair, subnet and VPC security groups. Instances launched from a launch template are not checked.
+func instanceDryRun(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk(names.AttrLaunchTemplate); ok {
+		return nil
+	}
+
+	if !dryRunConfigKnown(diff, "ami", names.AttrInstanceType, "key_name", names.AttrSubnetID, "vpc_security_group_ids") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.RunInstancesInput{
+		DryRun:       aws.Bool(true),
+		ImageId:      aws.String(diff.Get("ami").(string)),
+		InstanceType: awstypes.InstanceType(diff.Get(names.AttrInstanceType).(string)),
+		MaxCount:     aws.Int32(1),
+		MinCount:     aws.Int32(1),
+	}
+
+	if v, ok := diff.GetOk("key_name"); ok {
+		input.KeyName = aws.String(v.(string))
+	}
+
+	if v, ok := diff.GetOk(names.AttrSubnetID); ok {
+		input.SubnetId = aws.String(v.(string))
+	}
+
+	if v, ok := diff.GetOk("vpc_security_group_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	_, err := conn.RunInstances(ctx, input)
+
+	return dryRunError(err, "creating EC2 Instance")
+}
+
 func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -972,7 +1041,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	tagSpecifications := getTagSpecificationsInV2(ctx, awstypes.ResourceTypeInstance)
 
 	// block devices
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
 	tagSpecifications = append(tagSpecifications,
 		tagSpecificationsFromKeyValue(
 			defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{}))),
@@ -1046,7 +1115,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.ToString(instanceId))
 
-	instance, err := waitInstanceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate))
+	instance, err := waitInstanceCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) create: %s", d.Id(), err)
@@ -1313,6 +1382,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	d.Set("ebs_optimized", instance.EbsOptimized)
+	d.Set("ena_support", instance.EnaSupport)
 	if aws.ToString(instance.SubnetId) != "" {
 		d.Set("source_dest_check", instance.SourceDestCheck)
 	}
@@ -1330,8 +1400,8 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 		}
 
-		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-		ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+		defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
+		ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 		tags := keyValueTagsV2(ctx, volumeTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 		if err := d.Set("volume_tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
@@ -1443,7 +1513,7 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 	}
 
 	if d.Get("get_password_data").(bool) {
-		passwordData, err := getInstancePasswordData(ctx, aws.ToString(instance.InstanceId), conn, d.Timeout(schema.TimeoutRead))
+		passwordData, err := getInstancePasswordData(ctx, aws.ToString(instance.InstanceId), conn, sdkv2.Timeout(ctx, d, schema.TimeoutRead))
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 		}
@@ -1773,12 +1843,18 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
+	if d.HasChanges("ebs_optimized", "ena_support", names.AttrInstanceType) && d.Get("allow_stop_for_update").(bool) && !d.IsNewResource() {
+		if err := modifyInstanceAttributesWithStopStart(ctx, conn, d); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChanges(names.AttrInstanceType, "user_data", "user_data_base64") && !d.IsNewResource() {
 		// For each argument change, we start and stop the instance
 		// to account for behaviors occurring outside terraform.
 		// Only one attribute can be modified at a time, else we get
 		// "InvalidParameterCombination: Fields for multiple attribute types specified"
-		if d.HasChange(names.AttrInstanceType) {
+		if d.HasChange(names.AttrInstanceType) && !d.Get("allow_stop_for_update").(bool) {
 			if !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
 				instanceType := d.Get(names.AttrInstanceType).(string)
 				input := &ec2.ModifyInstanceAttributeInput{
@@ -1879,7 +1955,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): modifying maintenance options: %s", d.Id(), err)
 		}
 
-		if _, err := waitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx, conn, d.Id(), autoRecovery, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitInstanceMaintenanceOptionsAutoRecoveryUpdated(ctx, conn, d.Id(), autoRecovery, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): modifying maintenance options: waiting for completion: %s", d.Id(), err)
 		}
 	}
@@ -1946,7 +2022,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) metadata options: %s", d.Id(), err)
 				}
 
-				if _, err := waitInstanceMetadataOptionsApplied(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if _, err := waitInstanceMetadataOptionsApplied(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) metadata options update: %s", d.Id(), err)
 				}
 			}
@@ -2006,7 +2082,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) volume (%s): %s", d.Id(), volID, err)
 			}
 
-			if _, err := waitVolumeModificationComplete(ctx, conn, volID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitVolumeModificationComplete(ctx, conn, volID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) volume (%s) update: %s", d.Id(), volID, err)
 			}
 		}
@@ -2032,7 +2108,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 					return sdkdiag.AppendErrorf(diags, "modifying EC2 Instance (%s) BlockDeviceMappings (%s) attribute: %s", d.Id(), deviceName, err)
 				}
 
-				if _, err := waitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if _, err := waitInstanceRootBlockDeviceDeleteOnTerminationUpdated(ctx, conn, d.Id(), v, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for EC2 Instance (%s) root block device DeleteOnTermination update: %s", d.Id(), err)
 				}
 			}
@@ -2161,7 +2237,7 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if err := terminateInstance(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := terminateInstance(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -2234,6 +2310,91 @@ func modifyInstanceAttributeWithStopStart(ctx context.Context, conn *ec2.Client,
 	return nil
 }
 
+// modifyInstanceAttributesWithStopStart modifies the changed attributes that can only be modified
+// while the EC2 instance is stopped within a single stop and start of the instance.
+// The instance is only stopped within the configured stop_for_update_window and
+// an instance that is already stopped is not started.
+func modifyInstanceAttributesWithStopStart(ctx context.Context, conn *ec2.Client, d *schema.ResourceData) error {
+	id := d.Id()
+
+	// Only one attribute can be modified at a time.
+	var inputs []*ec2.ModifyInstanceAttributeInput
+
+	// ENA support must be enabled before changing to an instance type that requires it.
+	if d.HasChange("ena_support") {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			EnaSupport: &awstypes.AttributeBooleanValue{
+				Value: aws.Bool(d.Get("ena_support").(bool)),
+			},
+			InstanceId: aws.String(id),
+		})
+	}
+
+	// Instance type changes along with a capacity reservation change are made while modifying the capacity reservation.
+	if d.HasChange(names.AttrInstanceType) && !d.HasChange("capacity_reservation_specification.0.capacity_reservation_target.0.capacity_reservation_id") {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			InstanceId: aws.String(id),
+			InstanceType: &awstypes.AttributeValue{
+				Value: aws.String(d.Get(names.AttrInstanceType).(string)),
+			},
+		})
+	}
+
+	if d.HasChange("ebs_optimized") {
+		inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+			EbsOptimized: &awstypes.AttributeBooleanValue{
+				Value: aws.Bool(d.Get("ebs_optimized").(bool)),
+			},
+			InstanceId: aws.String(id),
+		})
+	}
+
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	instance, err := findInstanceByID(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Instance (%s): %w", id, err)
+	}
+
+	running := instance.State != nil && instance.State.Name == awstypes.InstanceStateNameRunning
+
+	if v, ok := d.GetOk("stop_for_update_window"); ok && running {
+		window := timestamp.New(v.(string))
+		inWindow, err := window.OnceAWeekWindowContains(time.Now())
+
+		if err != nil {
+			return err
+		}
+
+		if !inWindow {
+			return fmt.Errorf("the instance can only be stopped during stop_for_update_window (%s)", window)
+		}
+	}
+
+	if running {
+		if err := stopInstance(ctx, conn, id, false, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	for _, input := range inputs {
+		if _, err := conn.ModifyInstanceAttribute(ctx, input); err != nil {
+			return fmt.Errorf("modifying EC2 Instance (%s) attribute: %w", id, err)
+		}
+	}
+
+	if running {
+		if err := startInstance(ctx, conn, id, true, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func readBlockDevices(ctx context.Context, d *schema.ResourceData, meta interface{}, instance *awstypes.Instance, ds bool) error {
 	ibds, err := readBlockDevicesFromInstance(ctx, d, meta, instance, ds)
 	if err != nil {
@@ -2318,8 +2479,8 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, m
 		return nil, err
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).ResourceDefaultTagsConfig(ctx)
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	for _, vol := range volResp.Volumes {
 		instanceBd := instanceBlockDevices[aws.ToString(vol.VolumeId)]
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -66,7 +67,7 @@ func resourceInstanceStateCreate(ctx context.Context, d *schema.ResourceData, me
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 	instanceId := d.Get(names.AttrInstanceID).(string)
 
-	instance, instanceErr := waitInstanceReady(ctx, conn, instanceId, d.Timeout(schema.TimeoutCreate))
+	instance, instanceErr := waitInstanceReady(ctx, conn, instanceId, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 
 	if instanceErr != nil {
 		return create.AppendDiagError(diags, names.EC2, create.ErrActionReading, ResInstance, instanceId, instanceErr)
@@ -112,7 +113,7 @@ func resourceInstanceStateUpdate(ctx context.Context, d *schema.ResourceData, me
 
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
-	instance, instanceErr := waitInstanceReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	instance, instanceErr := waitInstanceReady(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
 
 	if instanceErr != nil {
 		return create.AppendDiagError(diags, names.EC2, create.ErrActionReading, ResInstance, aws.ToString(instance.InstanceId), instanceErr)
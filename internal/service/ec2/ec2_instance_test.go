@@ -1655,6 +1655,37 @@ func TestAccEC2Instance_BlockDeviceTags_defaultTagsVolumeTags(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_BlockDeviceTags_defaultTagsExcludedResourceType(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.Instance
+	resourceName := "aws_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_blockDeviceTagsDefaultTagsExcluded(acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tags.Name", "test"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsAllPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "root_block_device.0.tags_all.%", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "ebs_block_device.0.tags_all.%", acctest.Ct0),
+				),
+			},
+			{
+				Config:   testAccInstanceConfig_blockDeviceTagsDefaultTagsExcluded(acctest.CtKey1, acctest.CtValue1),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_BlockDeviceTags_defaultTagsEBSRoot(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -2216,6 +2247,87 @@ func TestAccEC2Instance_changeInstanceTypeReplace(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_allowStopForUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_allowStopForUpdate(rName, "t3.micro", true, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop_for_update", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ena_support", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t3.micro"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_stop_for_update", "stop_for_update_window", "user_data_replace_on_change"},
+			},
+			{
+				// ENA support is disabled before the instance type is changed to one that does not support ENA.
+				Config: testAccInstanceConfig_allowStopForUpdate(rName, "t2.micro", false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "ena_support", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "instance_state", string(awstypes.InstanceStateNameRunning)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t2.micro"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2Instance_stopForUpdateWindow(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	now := time.Now()
+	outsideWindow := testAccInstanceStopForUpdateWindow(now.Add(48*time.Hour), time.Hour)
+	insideWindow := testAccInstanceStopForUpdateWindow(now.Add(-time.Hour), 3*time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_allowStopForUpdate(rName, "t3.micro", true, outsideWindow),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "stop_for_update_window", outsideWindow),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_allowStopForUpdate(rName, "t3.small", true, outsideWindow),
+				ExpectError: regexache.MustCompile(`the instance can only be stopped during stop_for_update_window`),
+			},
+			{
+				Config: testAccInstanceConfig_allowStopForUpdate(rName, "t3.small", true, insideWindow),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t3.small"),
+					resource.TestCheckResourceAttr(resourceName, "stop_for_update_window", insideWindow),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2Instance_changeInstanceTypeAndUserData(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.Instance
@@ -6117,6 +6229,34 @@ resource "aws_instance" "test" {
 `, instanceType, rName, archs))
 }
 
+func testAccInstanceConfig_allowStopForUpdate(rName, instanceType string, enaSupport bool, window string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type          = %[2]q
+  ena_support            = %[3]t
+  allow_stop_for_update  = true
+  stop_for_update_window = %[4]q
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, instanceType, enaSupport, window))
+}
+
+// testAccInstanceStopForUpdateWindow returns a "ddd:hh24:mi-ddd:hh24:mi" window of the specified duration.
+func testAccInstanceStopForUpdateWindow(start time.Time, d time.Duration) string {
+	const layout = "Mon:15:04"
+
+	return strings.ToLower(fmt.Sprintf("%s-%s", start.UTC().Format(layout), start.Add(d).UTC().Format(layout)))
+}
+
 func testAccInstanceConfig_typeAndUserData(rName, instanceType, userData string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
@@ -7030,6 +7170,41 @@ resource "aws_instance" "test" {
 `, defTgCfg, volTgCfg, rbdTgCfg, ebsTgCfg))
 }
 
+func testAccInstanceConfig_blockDeviceTagsDefaultTagsExcluded(tagKey1, tagValue1 string) string {
+	//lintignore:AT004
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      %[1]q = %[2]q
+    }
+
+    exclude_resource_types = ["aws_instance"]
+  }
+}
+
+resource "aws_instance" "test" {
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = "t2.medium"
+
+  root_block_device {
+    volume_type = "gp2"
+  }
+
+  ebs_block_device {
+    device_name = "/dev/sdb"
+    volume_size = 1
+  }
+
+  tags = {
+    Name = "test"
+  }
+}
+`, tagKey1, tagValue1))
+}
+
 func testAccInstanceConfig_blockDeviceTagsEBSTags(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(), fmt.Sprintf(`
 resource "aws_instance" "test" {
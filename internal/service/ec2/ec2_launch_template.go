@@ -7,14 +7,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math/big"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -292,6 +296,10 @@ func resourceLaunchTemplate() *schema.Resource {
 			"image_id": {
 				Type:     schema.TypeString,
 				Optional: true,
+				ValidateFunc: validation.Any(
+					validation.StringMatch(regexache.MustCompile(`^ami-[0-9a-z]+$`), "must be an AMI ID"),
+					validation.StringMatch(regexache.MustCompile(`^resolve:ssm:.+$`), "must be a Systems Manager parameter alias"),
+				),
 			},
 			"instance_initiated_shutdown_behavior": {
 				Type:             schema.TypeString,
@@ -917,6 +925,10 @@ func resourceLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"resolved_image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"security_group_names": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -981,11 +993,20 @@ func resourceLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			customdiff.ComputedIf("resolved_image_id", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("image_id")
+			}),
+			customizeDiffInstanceRequirements,
 			verify.SetTagsDiff,
 		),
 	}
 }
 
+const (
+	// See https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/create-launch-template.html#use-an-ssm-parameter-instead-of-an-ami-id.
+	ssmParameterAliasPrefix = "resolve:ssm:"
+)
+
 func resourceLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -1059,6 +1080,16 @@ func resourceLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	resolvedImageID := aws.ToString(ltv.LaunchTemplateData.ImageId)
+	if strings.HasPrefix(resolvedImageID, ssmParameterAliasPrefix) {
+		resolvedImageID, err = findLaunchTemplateVersionResolvedImageID(ctx, conn, d.Id(), version)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "resolving EC2 Launch Template (%s) Version (%s) image ID: %s", d.Id(), version, err)
+		}
+	}
+	d.Set("resolved_image_id", resolvedImageID)
+
 	setTagsOutV2(ctx, lt.Tags)
 
 	return diags
@@ -3145,3 +3176,105 @@ func expandLaunchTemplateIPv6PrefixSpecificationRequests(tfList []interface{}) [
 
 	return apiObjects
 }
+
+// customizeDiffInstanceRequirements validates combinations of instance_requirements arguments that
+// EC2 accepts when the launch template is created but rejects when instances are launched from it.
+func customizeDiffInstanceRequirements(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	config := diff.GetRawConfig()
+
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	v := config.GetAttr("instance_requirements")
+
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	tfMap := v.Index(cty.NumberIntVal(0))
+
+	if !tfMap.IsKnown() || tfMap.IsNull() {
+		return nil
+	}
+
+	for _, key := range []string{
+		"accelerator_count",
+		"accelerator_total_memory_mib",
+		"baseline_ebs_bandwidth_mbps",
+		"memory_gib_per_vcpu",
+		"memory_mib",
+		"network_bandwidth_gbps",
+		"network_interface_count",
+		"total_local_storage_gb",
+		"vcpu_count",
+	} {
+		minimum, minOK := instanceRequirementsRangeBound(tfMap, key, names.AttrMin)
+		maximum, maxOK := instanceRequirementsRangeBound(tfMap, key, names.AttrMax)
+
+		if minOK && maxOK && minimum.Cmp(maximum) > 0 {
+			return fmt.Errorf(`"instance_requirements.0.%[1]s.0.min" (%[2]s) must not be greater than "instance_requirements.0.%[1]s.0.max" (%[3]s)`, key, minimum.Text('g', -1), maximum.Text('g', -1))
+		}
+	}
+
+	// A maximum accelerator count of 0 excludes all instance types with accelerators.
+	if maximum, ok := instanceRequirementsRangeBound(tfMap, "accelerator_count", names.AttrMax); ok && maximum.Sign() == 0 {
+		for _, key := range []string{
+			"accelerator_manufacturers",
+			"accelerator_names",
+			"accelerator_total_memory_mib",
+			"accelerator_types",
+		} {
+			if v := tfMap.GetAttr(key); !v.IsNull() && (!v.IsKnown() || v.LengthInt() > 0) {
+				return fmt.Errorf(`"instance_requirements.0.%s" cannot be specified when "instance_requirements.0.accelerator_count.0.max" is 0`, key)
+			}
+		}
+	}
+
+	// The memory range must overlap the memory implied by the vCPU and memory per vCPU ranges.
+	const mibPerGiB = 1024
+	vcpuMin, vcpuMinOK := instanceRequirementsRangeBound(tfMap, "vcpu_count", names.AttrMin)
+	vcpuMax, vcpuMaxOK := instanceRequirementsRangeBound(tfMap, "vcpu_count", names.AttrMax)
+	memoryPerVCPUMin, memoryPerVCPUMinOK := instanceRequirementsRangeBound(tfMap, "memory_gib_per_vcpu", names.AttrMin)
+	memoryPerVCPUMax, memoryPerVCPUMaxOK := instanceRequirementsRangeBound(tfMap, "memory_gib_per_vcpu", names.AttrMax)
+	memoryMin, memoryMinOK := instanceRequirementsRangeBound(tfMap, "memory_mib", names.AttrMin)
+	memoryMax, memoryMaxOK := instanceRequirementsRangeBound(tfMap, "memory_mib", names.AttrMax)
+
+	if vcpuMaxOK && memoryPerVCPUMaxOK && memoryMinOK {
+		if v := new(big.Float).Mul(new(big.Float).Mul(vcpuMax, memoryPerVCPUMax), big.NewFloat(mibPerGiB)); v.Cmp(memoryMin) < 0 {
+			return fmt.Errorf(`"instance_requirements.0.memory_mib.0.min" (%s) is greater than the maximum memory (%s MiB) allowed by "vcpu_count" and "memory_gib_per_vcpu"`, memoryMin.Text('g', -1), v.Text('f', 0))
+		}
+	}
+
+	if vcpuMinOK && memoryPerVCPUMinOK && memoryMaxOK {
+		if v := new(big.Float).Mul(new(big.Float).Mul(vcpuMin, memoryPerVCPUMin), big.NewFloat(mibPerGiB)); v.Cmp(memoryMax) > 0 {
+			return fmt.Errorf(`"instance_requirements.0.memory_mib.0.max" (%s) is less than the minimum memory (%s MiB) required by "vcpu_count" and "memory_gib_per_vcpu"`, memoryMax.Text('g', -1), v.Text('f', 0))
+		}
+	}
+
+	return nil
+}
+
+// instanceRequirementsRangeBound returns the configured value of the specified bound of an
+// instance_requirements range block, and whether the value is set and known.
+func instanceRequirementsRangeBound(tfMap cty.Value, key, bound string) (*big.Float, bool) {
+	v := tfMap.GetAttr(key)
+
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return nil, false
+	}
+
+	v = v.Index(cty.NumberIntVal(0))
+
+	if !v.IsKnown() || v.IsNull() {
+		return nil, false
+	}
+
+	v = v.GetAttr(bound)
+
+	if !v.IsKnown() || v.IsNull() {
+		return nil, false
+	}
+
+	return v.AsBigFloat(), true
+}
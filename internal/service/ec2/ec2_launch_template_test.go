@@ -474,6 +474,36 @@ func TestAccEC2LaunchTemplate_data(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_imageIDSSMParameterAlias(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.LaunchTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_launch_template.test"
+	parameterName := "/aws/service/ami-amazon-linux-latest/amzn2-ami-hvm-x86_64-gp2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_imageIDSSMParameterAlias(rName, parameterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "image_id", "resolve:ssm:"+parameterName),
+					resource.TestCheckResourceAttrPair(resourceName, "resolved_image_id", "data.aws_ssm_parameter.test", names.AttrValue),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template awstypes.LaunchTemplate
@@ -1391,6 +1421,62 @@ func TestAccEC2LaunchTemplate_instanceMarketOptions(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_instanceRequirements_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_instanceRequirements(rName,
+					`memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 8
+                       max = 4
+                     }`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"instance_requirements.0.vcpu_count.0.min" \(8\) must not be greater than`),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_instanceRequirements(rName,
+					`accelerator_count {
+                       max = 0
+                     }
+                     accelerator_types = ["gpu"]
+                     memory_mib {
+                       min = 500
+                     }
+                     vcpu_count {
+                       min = 1
+                     }`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"instance_requirements.0.accelerator_types" cannot be specified when`),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_instanceRequirements(rName,
+					`memory_gib_per_vcpu {
+                       max = 2
+                     }
+                     memory_mib {
+                       min = 16384
+                     }
+                     vcpu_count {
+                       min = 1
+                       max = 4
+                     }`),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`"instance_requirements.0.memory_mib.0.min" \(16384\) is greater than the maximum memory \(8192 MiB\)`),
+			},
+		},
+	})
+}
+
 func TestAccEC2LaunchTemplate_instanceRequirements_memoryMiBAndVCPUCount(t *testing.T) {
 	ctx := acctest.Context(t)
 	var template awstypes.LaunchTemplate
@@ -4086,6 +4172,19 @@ resource "aws_autoscaling_group" "test" {
 `, rName))
 }
 
+func testAccLaunchTemplateConfig_imageIDSSMParameterAlias(rName, parameterName string) string {
+	return fmt.Sprintf(`
+data "aws_ssm_parameter" "test" {
+  name = %[2]q
+}
+
+resource "aws_launch_template" "test" {
+  name     = %[1]q
+  image_id = "resolve:ssm:${data.aws_ssm_parameter.test.name}"
+}
+`, rName, parameterName)
+}
+
 func testAccLaunchTemplateConfig_instanceRequirements(rName, instanceRequirements string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
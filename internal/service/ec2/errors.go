@@ -22,6 +22,7 @@ const (
 	errCodeConcurrentMutationLimitExceeded                         = "ConcurrentMutationLimitExceeded"
 	errCodeDefaultSubnetAlreadyExistsInAvailabilityZone            = "DefaultSubnetAlreadyExistsInAvailabilityZone"
 	errCodeDependencyViolation                                     = "DependencyViolation"
+	errCodeDryRunOperation                                         = "DryRunOperation"
 	errCodeGatewayNotAttached                                      = "Gateway.NotAttached"
 	errCodeIPAMOrganizationAccountNotRegistered                    = "IpamOrganizationAccountNotRegistered"
 	errCodeIncorrectState                                          = "IncorrectState"
@@ -134,6 +135,7 @@ const (
 	errCodeTransitGatewayMulticastGroupMemberNotFound              = "TransitGatewayMulticastGroupMember.NotFound"
 	errCodeTransitGatewayMulticastGroupSourceNotFound              = "TransitGatewayMulticastGroupSource.NotFound"
 	errCodeTransitGatewayRouteTablePropagationNotFound             = "TransitGatewayRouteTablePropagation.NotFound"
+	errCodeUnauthorizedOperation                                   = "UnauthorizedOperation"
 	errCodeUnsupportedOperation                                    = "UnsupportedOperation"
 	errCodeVPNConnectionLimitExceeded                              = "VpnConnectionLimitExceeded"
 	errCodeVPNGatewayLimitExceeded                                 = "VpnGatewayLimitExceeded"
@@ -57,6 +57,7 @@ var (
 	ResourceRouteTable                               = resourceRouteTable
 	ResourceSecurityGroupEgressRule                  = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                 = newSecurityGroupIngressRuleResource
+	ResourceSecurityGroupRules                       = newSecurityGroupRulesResource
 	ResourceSnapshotCreateVolumePermission           = resourceSnapshotCreateVolumePermission
 	ResourceSpotDataFeedSubscription                 = resourceSpotDataFeedSubscription
 	ResourceSpotFleetRequest                         = resourceSpotFleetRequest
@@ -618,6 +618,28 @@ func findLaunchTemplateVersionByTwoPartKey(ctx context.Context, conn *ec2.Client
 	return output, nil
 }
 
+// findLaunchTemplateVersionResolvedImageID returns the AMI ID that the launch template version's
+// Systems Manager parameter alias currently resolves to.
+func findLaunchTemplateVersionResolvedImageID(ctx context.Context, conn *ec2.Client, launchTemplateID, version string) (string, error) {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(launchTemplateID),
+		ResolveAlias:     aws.Bool(true),
+		Versions:         []string{version},
+	}
+
+	output, err := findLaunchTemplateVersion(ctx, conn, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output.LaunchTemplateData.ImageId == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.LaunchTemplateData.ImageId), nil
+}
+
 func findPlacementGroup(ctx context.Context, conn *ec2.Client, input *ec2.DescribePlacementGroupsInput) (*awstypes.PlacementGroup, error) {
 	output, err := findPlacementGroups(ctx, conn, input)
 
@@ -2456,6 +2478,32 @@ func findVPNConnectionRouteByTwoPartKey(ctx context.Context, conn *ec2.Client, v
 	}
 }
 
+func findVPNTunnelMaintenanceDetailsByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnConnectionID, outsideIPAddress string) (*awstypes.MaintenanceDetails, error) {
+	input := &ec2.GetVpnTunnelReplacementStatusInput{
+		VpnConnectionId:           aws.String(vpnConnectionID),
+		VpnTunnelOutsideIpAddress: aws.String(outsideIPAddress),
+	}
+
+	output, err := conn.GetVpnTunnelReplacementStatus(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPNConnectionIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MaintenanceDetails == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.MaintenanceDetails, nil
+}
+
 func findVPNGatewayVPCAttachmentByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnGatewayID, vpcID string) (*awstypes.VpcAttachment, error) {
 	vpnGateway, err := findVPNGatewayByID(ctx, conn, vpnGatewayID)
 
@@ -51,7 +51,7 @@ func DataSourceLocalGatewayVirtualInterfaceGroup() *schema.Resource {
 func dataSourceLocalGatewayVirtualInterfaceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	input := &ec2.DescribeLocalGatewayVirtualInterfaceGroupsInput{}
 
@@ -18,6 +18,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			Factory: newDataSourceCapacityBlockOffering,
 			Name:    "Capacity Block Offering",
 		},
+		{
+			Factory: newDataSourceCapacityBlockOfferings,
+			Name:    "Capacity Block Offerings",
+		},
 		{
 			Factory: newSecurityGroupRuleDataSource,
 			Name:    "Security Group Rule",
@@ -79,6 +83,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory: newSecurityGroupRulesResource,
+			Name:    "Security Group Rules",
+		},
 	}
 }
 
@@ -231,6 +239,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Network Insights Path",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  dataSourceNetworkPath,
+			TypeName: "aws_ec2_network_path",
+			Name:     "Network Path",
+		},
 		{
 			Factory:  dataSourcePublicIPv4Pool,
 			TypeName: "aws_ec2_public_ipv4_pool",
@@ -303,6 +316,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_ec2_transit_gateway_peering_attachments",
 			Name:     "Transit Gateway Peering Attachments",
 		},
+		{
+			Factory:  dataSourceTransitGatewayPolicyTable,
+			TypeName: "aws_ec2_transit_gateway_policy_table",
+			Name:     "Transit Gateway Policy Table",
+			Tags:     &types.ServicePackageResourceTags{},
+		},
 		{
 			Factory:  dataSourceTransitGatewayRouteTable,
 			TypeName: "aws_ec2_transit_gateway_route_table",
@@ -626,6 +645,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "EBS Volume",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				ResourceIgnoreTags:  true,
 			},
 		},
 		{
@@ -936,6 +956,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Instance",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				ResourceIgnoreTags:  true,
 			},
 		},
 		{
@@ -43,6 +43,34 @@ func dataSourceTransitGatewayConnectPeer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"bgp_configuration": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bgp_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"peer_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transit_gateway_asn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"bgp_peer_address": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -62,6 +90,10 @@ func dataSourceTransitGatewayConnectPeer() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags: tftags.TagsSchemaComputed(),
 			"transit_gateway_address": {
 				Type:     schema.TypeString,
@@ -112,12 +144,16 @@ func dataSourceTransitGatewayConnectPeerRead(ctx context.Context, d *schema.Reso
 	bgpConfigurations := transitGatewayConnectPeer.ConnectPeerConfiguration.BgpConfigurations
 	d.Set(names.AttrARN, arn)
 	d.Set("bgp_asn", strconv.FormatInt(aws.ToInt64(bgpConfigurations[0].PeerAsn), 10))
+	if err := d.Set("bgp_configuration", flattenTransitGatewayAttachmentBGPConfigurations(bgpConfigurations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting bgp_configuration: %s", err)
+	}
 	d.Set("bgp_peer_address", bgpConfigurations[0].PeerAddress)
 	d.Set("bgp_transit_gateway_addresses", slices.ApplyToAll(bgpConfigurations, func(v awstypes.TransitGatewayAttachmentBgpConfiguration) string {
 		return aws.ToString(v.TransitGatewayAddress)
 	}))
 	d.Set("inside_cidr_blocks", transitGatewayConnectPeer.ConnectPeerConfiguration.InsideCidrBlocks)
 	d.Set("peer_address", transitGatewayConnectPeer.ConnectPeerConfiguration.PeerAddress)
+	d.Set(names.AttrState, transitGatewayConnectPeer.State)
 	d.Set("transit_gateway_address", transitGatewayConnectPeer.ConnectPeerConfiguration.TransitGatewayAddress)
 	d.Set(names.AttrTransitGatewayAttachmentID, transitGatewayConnectPeer.TransitGatewayAttachmentId)
 	d.Set("transit_gateway_connect_peer_id", transitGatewayConnectPeer.TransitGatewayConnectPeerId)
@@ -126,3 +162,15 @@ func dataSourceTransitGatewayConnectPeerRead(ctx context.Context, d *schema.Reso
 
 	return diags
 }
+
+func flattenTransitGatewayAttachmentBGPConfigurations(apiObjects []awstypes.TransitGatewayAttachmentBgpConfiguration) []interface{} {
+	return slices.ApplyToAll(apiObjects, func(apiObject awstypes.TransitGatewayAttachmentBgpConfiguration) interface{} {
+		return map[string]interface{}{
+			"bgp_status":              apiObject.BgpStatus,
+			"peer_address":            aws.ToString(apiObject.PeerAddress),
+			"peer_asn":                strconv.FormatInt(aws.ToInt64(apiObject.PeerAsn), 10),
+			"transit_gateway_address": aws.ToString(apiObject.TransitGatewayAddress),
+			"transit_gateway_asn":     strconv.FormatInt(aws.ToInt64(apiObject.TransitGatewayAsn), 10),
+		}
+	})
+}
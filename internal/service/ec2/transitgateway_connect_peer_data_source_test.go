@@ -35,10 +35,15 @@ func testAccTransitGatewayConnectPeerDataSource_Filter(t *testing.T, semaphore t
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
 					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_asn", resourceName, "bgp_asn"),
+					resource.TestCheckResourceAttr(dataSourceName, "bgp_configuration.#", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(dataSourceName, "bgp_configuration.0.bgp_status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_configuration.0.peer_address", resourceName, "bgp_peer_address"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_configuration.0.peer_asn", resourceName, "bgp_asn"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_peer_address", resourceName, "bgp_peer_address"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_transit_gateway_addresses.#", resourceName, "bgp_transit_gateway_addresses.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "inside_cidr_blocks.#", resourceName, "inside_cidr_blocks.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "peer_address", resourceName, "peer_address"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrState, "available"),
 					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
 					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateway_address", resourceName, "transit_gateway_address"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTransitGatewayAttachmentID, resourceName, names.AttrTransitGatewayAttachmentID),
@@ -74,6 +79,7 @@ func testAccTransitGatewayConnectPeerDataSource_ID(t *testing.T, semaphore tfsyn
 					resource.TestCheckResourceAttrPair(dataSourceName, "bgp_transit_gateway_addresses.#", resourceName, "bgp_transit_gateway_addresses.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "inside_cidr_blocks.#", resourceName, "inside_cidr_blocks.#"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "peer_address", resourceName, "peer_address"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrState, "available"),
 					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
 					resource.TestCheckResourceAttrPair(dataSourceName, "transit_gateway_address", resourceName, "transit_gateway_address"),
 					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTransitGatewayAttachmentID, resourceName, names.AttrTransitGatewayAttachmentID),
@@ -56,6 +56,10 @@ func TestAccTransitGatewayDataSource_serial(t *testing.T) {
 		"PeeringAttachments": {
 			"Filter": testAccTransitGatewayPeeringAttachmentsDataSource_Filter,
 		},
+		"PolicyTable": {
+			"Filter": testAccTransitGatewayPolicyTableDataSource_Filter,
+			"ID":     testAccTransitGatewayPolicyTableDataSource_ID,
+		},
 		"RouteTable": {
 			"Filter": testAccTransitGatewayRouteTableDataSource_Filter,
 			"ID":     testAccTransitGatewayRouteTableDataSource_ID,
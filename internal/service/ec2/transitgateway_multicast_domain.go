@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -109,7 +110,7 @@ func resourceTransitGatewayMulticastDomainCreate(ctx context.Context, d *schema.
 
 	d.SetId(aws.ToString(output.TransitGatewayMulticastDomain.TransitGatewayMulticastDomainId))
 
-	if _, err := waitTransitGatewayMulticastDomainCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitTransitGatewayMulticastDomainCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Multicast Domain (%s) create: %s", d.Id(), err)
 	}
 
@@ -200,7 +201,7 @@ func resourceTransitGatewayMulticastDomainDelete(ctx context.Context, d *schema.
 	}
 
 	for _, v := range associations {
-		err := disassociateTransitGatewayMulticastDomain(ctx, conn, d.Id(), aws.ToString(v.TransitGatewayAttachmentId), aws.ToString(v.Subnet.SubnetId), d.Timeout(schema.TimeoutDelete))
+		err := disassociateTransitGatewayMulticastDomain(ctx, conn, d.Id(), aws.ToString(v.TransitGatewayAttachmentId), aws.ToString(v.Subnet.SubnetId), sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 
 		if err != nil {
 			diags = sdkdiag.AppendFromErr(diags, err)
@@ -224,7 +225,7 @@ func resourceTransitGatewayMulticastDomainDelete(ctx context.Context, d *schema.
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 Transit Gateway Multicast Domain (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitTransitGatewayMulticastDomainDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitTransitGatewayMulticastDomainDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Multicast Domain (%s) delete: %s", d.Id(), err)
 	}
 
@@ -82,7 +82,7 @@ func resourceTransitGatewayPeeringAttachmentAccepterCreate(ctx context.Context,
 
 	d.SetId(aws.ToString(output.TransitGatewayPeeringAttachment.TransitGatewayAttachmentId))
 
-	if _, err := waitTransitGatewayPeeringAttachmentAccepted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitTransitGatewayPeeringAttachmentAccepted(ctx, conn, d.Id(), TransitGatewayPeeringAttachmentUpdatedTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 Transit Gateway Peering Attachment (%s) update: %s", d.Id(), err)
 	}
 
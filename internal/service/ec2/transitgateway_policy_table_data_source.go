@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_transit_gateway_policy_table", name="Transit Gateway Policy Table")
+// @Tags
+// @Testing(tagsTest=false)
+func dataSourceTransitGatewayPolicyTable() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTransitGatewayPolicyTableRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"association": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrResourceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrState: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrTransitGatewayAttachmentID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrFilter: customFiltersSchema(),
+			names.AttrID: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrTransitGatewayID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceTransitGatewayPolicyTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := &ec2.DescribeTransitGatewayPolicyTablesInput{}
+
+	input.Filters = append(input.Filters, newCustomFilterListV2(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		// Don't send an empty filters list; the EC2 API won't accept it.
+		input.Filters = nil
+	}
+
+	if v, ok := d.GetOk(names.AttrID); ok {
+		input.TransitGatewayPolicyTableIds = []string{v.(string)}
+	}
+
+	transitGatewayPolicyTable, err := findTransitGatewayPolicyTable(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Transit Gateway Policy Table", err))
+	}
+
+	d.SetId(aws.ToString(transitGatewayPolicyTable.TransitGatewayPolicyTableId))
+
+	associations, err := findTransitGatewayPolicyTableAssociations(ctx, conn, &ec2.GetTransitGatewayPolicyTableAssociationsInput{
+		TransitGatewayPolicyTableId: aws.String(d.Id()),
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway Policy Table (%s) associations: %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Service:   names.EC2,
+		Region:    meta.(*conns.AWSClient).Region,
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("transit-gateway-policy-table/%s", d.Id()),
+	}.String()
+	d.Set(names.AttrARN, arn)
+	if err := d.Set("association", flattenTransitGatewayPolicyTableAssociations(associations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting association: %s", err)
+	}
+	d.Set(names.AttrState, transitGatewayPolicyTable.State)
+	d.Set(names.AttrTransitGatewayID, transitGatewayPolicyTable.TransitGatewayId)
+
+	setTagsOutV2(ctx, transitGatewayPolicyTable.Tags)
+
+	return diags
+}
+
+func flattenTransitGatewayPolicyTableAssociations(apiObjects []awstypes.TransitGatewayPolicyTableAssociation) []interface{} {
+	return slices.ApplyToAll(apiObjects, func(apiObject awstypes.TransitGatewayPolicyTableAssociation) interface{} {
+		return map[string]interface{}{
+			names.AttrResourceID:                 aws.ToString(apiObject.ResourceId),
+			names.AttrResourceType:               apiObject.ResourceType,
+			names.AttrState:                      apiObject.State,
+			names.AttrTransitGatewayAttachmentID: aws.ToString(apiObject.TransitGatewayAttachmentId),
+		}
+	})
+}
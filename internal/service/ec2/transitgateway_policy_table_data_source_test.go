@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfsync "github.com/hashicorp/terraform-provider-aws/internal/experimental/sync"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccTransitGatewayPolicyTableDataSource_Filter(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_transit_gateway_policy_table.test"
+	resourceName := "aws_ec2_transit_gateway_policy_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayPolicyTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPolicyTableDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "association.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrState, resourceName, names.AttrState),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTransitGatewayID, resourceName, names.AttrTransitGatewayID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayPolicyTableDataSource_ID(t *testing.T, semaphore tfsync.Semaphore) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_transit_gateway_policy_table.test"
+	resourceName := "aws_ec2_transit_gateway_policy_table.test"
+	associationResourceName := "aws_ec2_transit_gateway_policy_table_association.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckTransitGatewaySynchronize(t, semaphore)
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTransitGateway(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayPolicyTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayPolicyTableDataSourceConfig_id(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "association.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "association.0.resource_id", associationResourceName, names.AttrResourceID),
+					resource.TestCheckResourceAttrPair(dataSourceName, "association.0.resource_type", associationResourceName, names.AttrResourceType),
+					resource.TestCheckResourceAttr(dataSourceName, "association.0.state", "associated"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "association.0.transit_gateway_attachment_id", associationResourceName, names.AttrTransitGatewayAttachmentID),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrState, resourceName, names.AttrState),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrTransitGatewayID, resourceName, names.AttrTransitGatewayID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayPolicyTableDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayPolicyTableConfig_basic(rName), `
+data "aws_ec2_transit_gateway_policy_table" "test" {
+  filter {
+    name   = "transit-gateway-policy-table-id"
+    values = [aws_ec2_transit_gateway_policy_table.test.id]
+  }
+}
+`)
+}
+
+func testAccTransitGatewayPolicyTableDataSourceConfig_id(rName string) string {
+	return acctest.ConfigCompose(testAccTransitGatewayPolicyTableAssociationConfig_basic(rName), `
+data "aws_ec2_transit_gateway_policy_table" "test" {
+  id = aws_ec2_transit_gateway_policy_table_association.test.transit_gateway_policy_table_id
+}
+`)
+}
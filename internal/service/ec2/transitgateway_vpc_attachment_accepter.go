@@ -106,7 +106,7 @@ func resourceTransitGatewayVPCAttachmentAccepterCreate(ctx context.Context, d *s
 	d.SetId(aws.ToString(output.TransitGatewayVpcAttachment.TransitGatewayAttachmentId))
 	transitGatewayID := aws.ToString(output.TransitGatewayVpcAttachment.TransitGatewayId)
 
-	if _, err := waitTransitGatewayVPCAttachmentAccepted(ctx, conn, d.Id()); err != nil {
+	if _, err := waitTransitGatewayVPCAttachmentAccepted(ctx, conn, d.Id(), TransitGatewayVPCAttachmentUpdatedTimeout); err != nil {
 		return sdkdiag.AppendErrorf(diags, "accepting EC2 Transit Gateway VPC Attachment (%s): waiting for completion: %s", transitGatewayAttachmentID, err)
 	}
 
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/YakDriver/regexache"
@@ -46,3 +47,123 @@ func validNestedExactlyOneOf(m map[string]interface{}, valid []string) error {
 	}
 	return nil
 }
+
+// flowLogFormatFieldsVPC are the fields available in the custom log format of flow logs for VPCs, subnets and network interfaces.
+// See https://docs.aws.amazon.com/vpc/latest/userguide/flow-log-records.html#flow-logs-fields.
+var flowLogFormatFieldsVPC = []string{
+	"account-id",
+	"action",
+	"az-id",
+	"bytes",
+	"dstaddr",
+	"dstport",
+	"ecs-cluster-arn",
+	"ecs-cluster-name",
+	"ecs-container-id",
+	"ecs-container-instance-arn",
+	"ecs-container-instance-id",
+	"ecs-second-container-id",
+	"ecs-service-name",
+	"ecs-task-arn",
+	"ecs-task-definition-arn",
+	"ecs-task-id",
+	"end",
+	"flow-direction",
+	"instance-id",
+	"interface-id",
+	"log-status",
+	"packets",
+	"pkt-dst-aws-service",
+	"pkt-dstaddr",
+	"pkt-src-aws-service",
+	"pkt-srcaddr",
+	"protocol",
+	"region",
+	"reject-reason",
+	"srcaddr",
+	"srcport",
+	"start",
+	"sublocation-id",
+	"sublocation-type",
+	"subnet-id",
+	"tcp-flags",
+	"traffic-path",
+	"type",
+	"version",
+	"vpc-id",
+}
+
+// flowLogFormatFieldsTransitGateway are the fields available in the custom log format of flow logs for transit gateways and transit gateway attachments.
+// See https://docs.aws.amazon.com/vpc/latest/tgw/tgw-flow-logs.html#flow-log-records.
+var flowLogFormatFieldsTransitGateway = []string{
+	"account-id",
+	"bytes",
+	"dstaddr",
+	"dstport",
+	"end",
+	"flow-direction",
+	"log-status",
+	"packets",
+	"packets-lost-blackhole",
+	"packets-lost-mtu-exceeded",
+	"packets-lost-no-route",
+	"packets-lost-ttl-expired",
+	"pkt-dst-aws-service",
+	"pkt-src-aws-service",
+	"protocol",
+	"region",
+	"resource-type",
+	"srcaddr",
+	"srcport",
+	"start",
+	"tcp-flags",
+	"tgw-attachment-id",
+	"tgw-dst-az-id",
+	"tgw-dst-eni",
+	"tgw-dst-subnet-id",
+	"tgw-dst-vpc-account-id",
+	"tgw-dst-vpc-id",
+	"tgw-id",
+	"tgw-pair-attachment-id",
+	"tgw-src-az-id",
+	"tgw-src-eni",
+	"tgw-src-subnet-id",
+	"tgw-src-vpc-account-id",
+	"tgw-src-vpc-id",
+	"type",
+	"version",
+}
+
+// flowLogFormatFields returns the field names in a flow log custom format such as "${version} ${vpc-id}".
+func flowLogFormatFields(format string) ([]string, error) {
+	var fields []string
+
+	for _, v := range strings.Fields(format) {
+		m := regexache.MustCompile(`^\$\{([0-9a-z-]+)\}$`).FindStringSubmatch(v)
+
+		if m == nil {
+			return nil, fmt.Errorf("%q is not a flow log field, expected ${field-name}", v)
+		}
+
+		fields = append(fields, m[1])
+	}
+
+	return fields, nil
+}
+
+func validFlowLogFormat(v interface{}, k string) (ws []string, errors []error) {
+	fields, err := flowLogFormatFields(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+		return
+	}
+
+	for _, field := range fields {
+		if !slices.Contains(flowLogFormatFieldsVPC, field) && !slices.Contains(flowLogFormatFieldsTransitGateway, field) {
+			errors = append(errors, fmt.Errorf("%q: unsupported flow log field %q", k, field))
+		}
+	}
+
+	return
+}
@@ -37,3 +37,34 @@ func TestValidSecurityGroupRuleDescription(t *testing.T) {
 		}
 	}
 }
+
+func TestValidFlowLogFormat(t *testing.T) {
+	t.Parallel()
+
+	validFormats := []string{
+		"",
+		"${version} ${account-id} ${interface-id} ${srcaddr} ${dstaddr}",
+		"${version} ${vpc-id}  ${subnet-id} ${reject-reason}",
+		"${version} ${tgw-id} ${tgw-attachment-id} ${packets-lost-mtu-exceeded}",
+	}
+	for _, v := range validFormats {
+		_, errors := validFlowLogFormat(v, "log_format")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid flow log format: %q", v, errors)
+		}
+	}
+
+	invalidFormats := []string{
+		"version",
+		"${version},${vpc-id}",
+		"${version} ${VPC-ID}",
+		"${version} ${not-a-field}",
+		"$version",
+	}
+	for _, v := range invalidFormats {
+		_, errors := validFlowLogFormat(v, "log_format")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid flow log format", v)
+		}
+	}
+}
@@ -271,7 +271,7 @@ func resourceDefaultVPCCreate(ctx context.Context, d *schema.ResourceData, meta
 	}
 
 	// Configure tags.
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 	newTags := keyValueTagsV2(ctx, getTagsInV2(ctx))
 	oldTags := keyValueTagsV2(ctx, vpc.Tags).IgnoreSystem(names.EC2).IgnoreConfig(ignoreTagsConfig)
 
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -108,10 +110,11 @@ func ResourceFlowLog() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(ec2.LogDestinationType_Values(), false),
 			},
 			"log_format": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validFlowLogFormat,
 			},
 			names.AttrLogGroupName: {
 				Type:          schema.TypeString,
@@ -162,8 +165,49 @@ func ResourceFlowLog() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceFlowLogCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourceFlowLogCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	isNew := diff.Id() == ""
+	_, tgw := diff.GetOk(names.AttrTransitGatewayID)
+	_, tgwAttachment := diff.GetOk(names.AttrTransitGatewayAttachmentID)
+	isTransitGateway := tgw || tgwAttachment
+
+	if isTransitGateway && (isNew || diff.HasChange("max_aggregation_interval")) {
+		if v := diff.Get("max_aggregation_interval").(int); v != 60 {
+			return fmt.Errorf(`"max_aggregation_interval" must be 60 for transit gateway flow logs, got %d`, v)
+		}
+	}
+
+	if !isNew && !diff.HasChange("log_format") || !diff.NewValueKnown("log_format") {
+		return nil
 	}
+
+	if v, ok := diff.GetOk("log_format"); ok {
+		fields, err := flowLogFormatFields(v.(string))
+
+		if err != nil {
+			return err
+		}
+
+		valid, target := flowLogFormatFieldsVPC, "VPC, subnet and network interface"
+		if isTransitGateway {
+			valid, target = flowLogFormatFieldsTransitGateway, "transit gateway"
+		}
+
+		for _, field := range fields {
+			if !slices.Contains(valid, field) {
+				return fmt.Errorf("flow log field %q is not supported for %s flow logs", field, target)
+			}
+		}
+	}
+
+	return nil
 }
 
 func resourceLogFlowCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -200,6 +200,65 @@ func TestAccVPCFlowLog_transitGatewayAttachmentID(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_transitGatewayLogFormat(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flowLog ec2.FlowLog
+	resourceName := "aws_flow_log.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	logFormat := "${version} ${resource-type} ${tgw-id} ${tgw-attachment-id} ${packets-lost-no-route} ${packets-lost-blackhole}"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCFlowLogConfig_transitGatewayLogFormat(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists(ctx, resourceName, &flowLog),
+					resource.TestCheckResourceAttr(resourceName, "log_format", logFormat),
+					resource.TestCheckResourceAttr(resourceName, "max_aggregation_interval", "60"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCFlowLog_logFormatInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_logFormatInvalid("vpc_id", "vpc-00000000000000000", "$${version} $${not-a-field}", 600),
+				ExpectError: regexache.MustCompile(`unsupported flow log field "not-a-field"`),
+			},
+			{
+				Config:      testAccVPCFlowLogConfig_logFormatInvalid("vpc_id", "vpc-00000000000000000", "$${version} $${tgw-id}", 600),
+				ExpectError: regexache.MustCompile(`flow log field "tgw-id" is not supported for VPC, subnet and network interface flow logs`),
+			},
+			{
+				Config:      testAccVPCFlowLogConfig_logFormatInvalid("transit_gateway_id", "tgw-00000000000000000", "$${version} $${vpc-id}", 60),
+				ExpectError: regexache.MustCompile(`flow log field "vpc-id" is not supported for transit gateway flow logs`),
+			},
+			{
+				Config:      testAccVPCFlowLogConfig_logFormatInvalid("transit_gateway_id", "tgw-00000000000000000", "$${version} $${tgw-id}", 600),
+				ExpectError: regexache.MustCompile(`"max_aggregation_interval" must be 60 for transit gateway flow logs`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_LogDestinationType_cloudWatchLogs(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog ec2.FlowLog
@@ -1151,6 +1210,47 @@ resource "aws_flow_log" "test" {
 `, rName))
 }
 
+func testAccVPCFlowLogConfig_transitGatewayLogFormat(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination          = aws_s3_bucket.test.arn
+  log_destination_type     = "s3"
+  log_format               = "$${version} $${resource-type} $${tgw-id} $${tgw-attachment-id} $${packets-lost-no-route} $${packets-lost-blackhole}"
+  max_aggregation_interval = 60
+  transit_gateway_id       = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccVPCFlowLogConfig_logFormatInvalid(targetAttribute, targetID, logFormat string, maxAggregationInterval int) string {
+	return fmt.Sprintf(`
+resource "aws_flow_log" "test" {
+  log_destination          = "arn:${data.aws_partition.current.partition}:s3:::tf-acc-test-flow-log"
+  log_destination_type     = "s3"
+  log_format               = %[3]q
+  max_aggregation_interval = %[4]d
+  %[1]s = %[2]q
+}
+
+data "aws_partition" "current" {}
+`, targetAttribute, targetID, logFormat, maxAggregationInterval)
+}
+
 func testAccVPCFlowLogConfig_transitGatewayAttachmentID(rName string) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -83,7 +84,7 @@ func resourceInternetGatewayCreate(ctx context.Context, d *schema.ResourceData,
 	d.SetId(aws.StringValue(output.InternetGateway.InternetGatewayId))
 
 	if v, ok := d.GetOk(names.AttrVPCID); ok {
-		if err := attachInternetGateway(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if err := attachInternetGateway(ctx, conn, d.Id(), v.(string), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating EC2 Internet Gateway: %s", err)
 		}
 	}
@@ -141,13 +142,13 @@ func resourceInternetGatewayUpdate(ctx context.Context, d *schema.ResourceData,
 		o, n := d.GetChange(names.AttrVPCID)
 
 		if v := o.(string); v != "" {
-			if err := detachInternetGateway(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := detachInternetGateway(ctx, conn, d.Id(), v, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Internet Gateway (%s): %s", d.Id(), err)
 			}
 		}
 
 		if v := n.(string); v != "" {
-			if err := attachInternetGateway(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := attachInternetGateway(ctx, conn, d.Id(), v, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating EC2 Internet Gateway (%s): %s", d.Id(), err)
 			}
 		}
@@ -162,7 +163,7 @@ func resourceInternetGatewayDelete(ctx context.Context, d *schema.ResourceData,
 
 	// Detach if it is attached.
 	if v, ok := d.GetOk(names.AttrVPCID); ok {
-		if err := detachInternetGateway(ctx, conn, d.Id(), v.(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if err := detachInternetGateway(ctx, conn, d.Id(), v.(string), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "deleting EC2 Internet Gateway (%s): %s", d.Id(), err)
 		}
 	}
@@ -172,7 +173,7 @@ func resourceInternetGatewayDelete(ctx context.Context, d *schema.ResourceData,
 	}
 
 	log.Printf("[INFO] Deleting Internet Gateway: %s", d.Id())
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, d.Timeout(schema.TimeoutDelete), func() (interface{}, error) {
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
 		return conn.DeleteInternetGatewayWithContext(ctx, input)
 	}, errCodeDependencyViolation)
 
@@ -84,7 +84,7 @@ func dataSourceManagedPrefixListRead(ctx context.Context, d *schema.ResourceData
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	input := &ec2.DescribeManagedPrefixListsInput{
 		Filters: newAttributeFilterList(map[string]string{
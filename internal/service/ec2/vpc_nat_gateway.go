@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -160,7 +161,7 @@ func resourceNATGatewayCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.StringValue(output.NatGateway.NatGatewayId))
 
-	if _, err := WaitNATGatewayCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := WaitNATGatewayCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) create: %s", d.Id(), err)
 	}
 
@@ -239,7 +240,7 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 				}
 
 				for _, privateIP := range flex.ExpandStringValueSet(add) {
-					if _, err := WaitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if _, err := WaitNATGatewayAddressAssigned(ctx, conn, d.Id(), privateIP, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) assign: %s", d.Id(), privateIP, err)
 					}
 				}
@@ -258,7 +259,7 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 				}
 
 				for _, privateIP := range flex.ExpandStringValueSet(del) {
-					if _, err := WaitNATGatewayAddressUnassigned(ctx, conn, d.Id(), privateIP, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if _, err := WaitNATGatewayAddressUnassigned(ctx, conn, d.Id(), privateIP, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) private IP address (%s) unassign: %s", d.Id(), privateIP, err)
 					}
 				}
@@ -292,7 +293,7 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 				}
 
 				for _, allocationID := range flex.ExpandStringValueSet(add) {
-					if _, err := WaitNATGatewayAddressAssociated(ctx, conn, d.Id(), allocationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if _, err := WaitNATGatewayAddressAssociated(ctx, conn, d.Id(), allocationID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) allocation ID (%s) associate: %s", d.Id(), allocationID, err)
 					}
 				}
@@ -327,7 +328,7 @@ func resourceNATGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta
 				}
 
 				for _, allocationID := range allocationIDs {
-					if _, err := WaitNATGatewayAddressDisassociated(ctx, conn, d.Id(), allocationID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if _, err := WaitNATGatewayAddressDisassociated(ctx, conn, d.Id(), allocationID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) allocation ID (%s) disassociate: %s", d.Id(), allocationID, err)
 					}
 				}
@@ -356,7 +357,7 @@ func resourceNATGatewayDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting EC2 NAT Gateway (%s): %s", d.Id(), err)
 	}
 
-	if _, err := WaitNATGatewayDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := WaitNATGatewayDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EC2 NAT Gateway (%s) delete: %s", d.Id(), err)
 	}
 
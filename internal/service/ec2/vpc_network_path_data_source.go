@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_network_path", name="Network Path")
+func dataSourceNetworkPath() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceNetworkPathRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"blocking_components": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrARN: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrID: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				names.AttrDestination: {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"destination_ip": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"destination_port": {
+					Type:     schema.TypeInt,
+					Optional: true,
+				},
+				"explanations": networkInsightsAnalysisExplanationsSchema(),
+				"filter_in_arns": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+				"forward_path_components": networkInsightsAnalysisPathComponentsSchema(),
+				"path_found": {
+					Type:     schema.TypeBool,
+					Computed: true,
+				},
+				names.AttrProtocol: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.Protocol](),
+				},
+				"return_path_components": networkInsightsAnalysisPathComponentsSchema(),
+				names.AttrSource: {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"source_ip": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				names.AttrStatus: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				names.AttrStatusMessage: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"warning_message": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			}
+		},
+	}
+}
+
+func dataSourceNetworkPathRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	// The network insights path and analysis are temporary and are deleted once the analysis has completed.
+	pathInput := &ec2.CreateNetworkInsightsPathInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Protocol:    awstypes.Protocol(d.Get(names.AttrProtocol).(string)),
+		Source:      aws.String(d.Get(names.AttrSource).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDestination); ok {
+		pathInput.Destination = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_ip"); ok {
+		pathInput.DestinationIp = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("destination_port"); ok {
+		pathInput.DestinationPort = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("source_ip"); ok {
+		pathInput.SourceIp = aws.String(v.(string))
+	}
+
+	pathOutput, err := conn.CreateNetworkInsightsPath(ctx, pathInput)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Network Insights Path: %s", err)
+	}
+
+	pathID := aws.ToString(pathOutput.NetworkInsightsPath.NetworkInsightsPathId)
+
+	analysisInput := &ec2.StartNetworkInsightsAnalysisInput{
+		NetworkInsightsPathId: aws.String(pathID),
+	}
+
+	if v, ok := d.GetOk("filter_in_arns"); ok && v.(*schema.Set).Len() > 0 {
+		analysisInput.FilterInArns = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	analysisOutput, err := conn.StartNetworkInsightsAnalysis(ctx, analysisInput)
+
+	if err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "creating EC2 Network Insights Analysis (%s): %s", pathID, err)
+
+		return append(diags, deleteNetworkPath(ctx, conn, pathID, "")...)
+	}
+
+	analysisID := aws.ToString(analysisOutput.NetworkInsightsAnalysis.NetworkInsightsAnalysisId)
+
+	output, err := waitNetworkInsightsAnalysisCreated(ctx, conn, analysisID, sdkv2.Timeout(ctx, d, schema.TimeoutRead))
+
+	if err != nil {
+		diags = sdkdiag.AppendErrorf(diags, "waiting for EC2 Network Insights Analysis (%s) create: %s", analysisID, err)
+	}
+
+	diags = append(diags, deleteNetworkPath(ctx, conn, pathID, analysisID)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(analysisID)
+	var blockingComponents []interface{}
+	if !aws.ToBool(output.NetworkPathFound) {
+		blockingComponents = flattenNetworkPathBlockingComponents(output.Explanations)
+	}
+	if err := d.Set("blocking_components", blockingComponents); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting blocking_components: %s", err)
+	}
+	if err := d.Set("explanations", flattenExplanations(output.Explanations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting explanations: %s", err)
+	}
+	if err := d.Set("forward_path_components", flattenPathComponents(output.ForwardPathComponents)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting forward_path_components: %s", err)
+	}
+	d.Set("path_found", output.NetworkPathFound)
+	if err := d.Set("return_path_components", flattenPathComponents(output.ReturnPathComponents)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting return_path_components: %s", err)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+	d.Set("warning_message", output.WarningMessage)
+
+	return diags
+}
+
+// deleteNetworkPath deletes the temporary network insights analysis and path created by the data source.
+func deleteNetworkPath(ctx context.Context, conn *ec2.Client, pathID, analysisID string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if analysisID != "" {
+		log.Printf("[DEBUG] Deleting EC2 Network Insights Analysis: %s", analysisID)
+		_, err := conn.DeleteNetworkInsightsAnalysis(ctx, &ec2.DeleteNetworkInsightsAnalysisInput{
+			NetworkInsightsAnalysisId: aws.String(analysisID),
+		})
+
+		if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsAnalysisIdNotFound) {
+			return sdkdiag.AppendErrorf(diags, "deleting EC2 Network Insights Analysis (%s): %s", analysisID, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Network Insights Path: %s", pathID)
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, ec2PropagationTimeout, func() (interface{}, error) {
+		return conn.DeleteNetworkInsightsPath(ctx, &ec2.DeleteNetworkInsightsPathInput{
+			NetworkInsightsPathId: aws.String(pathID),
+		})
+	}, errCodeAnalysisExistsForNetworkInsightsPath)
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, errCodeInvalidNetworkInsightsPathIdNotFound) {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Network Insights Path (%s): %s", pathID, err)
+	}
+
+	return diags
+}
+
+// flattenNetworkPathBlockingComponents returns the distinct components referenced by an analysis's explanations.
+func flattenNetworkPathBlockingComponents(apiObjects []awstypes.Explanation) []interface{} {
+	var tfList []interface{}
+	seen := make(map[string]bool)
+
+	for _, apiObject := range apiObjects {
+		v := apiObject.Component
+
+		if v == nil || seen[aws.ToString(v.Id)] {
+			continue
+		}
+
+		seen[aws.ToString(v.Id)] = true
+		tfList = append(tfList, flattenAnalysisComponent(v))
+	}
+
+	return tfList
+}
@@ -0,0 +1,119 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCNetworkPathDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_ec2_network_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkPathDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, "blocking_components.#", acctest.Ct0),
+					resource.TestMatchResourceAttr(datasourceName, "forward_path_components.#", regexache.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttr(datasourceName, "path_found", acctest.CtTrue),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrStatus, "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCNetworkPathDataSource_blocked(t *testing.T) {
+	ctx := acctest.Context(t)
+	datasourceName := "data.aws_ec2_network_path.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkPathDataSourceConfig_blocked(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(datasourceName, "blocking_components.#", regexache.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestMatchResourceAttr(datasourceName, "explanations.#", regexache.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttr(datasourceName, "path_found", acctest.CtFalse),
+					resource.TestCheckResourceAttr(datasourceName, names.AttrStatus, "succeeded"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVPCNetworkPathDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_network_interface" "test" {
+  count = 2
+
+  subnet_id = aws_subnet.test[0].id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_network_path" "test" {
+  source      = aws_network_interface.test[0].id
+  destination = aws_network_interface.test[1].id
+  protocol    = "tcp"
+}
+`, rName))
+}
+
+func testAccVPCNetworkPathDataSourceConfig_blocked(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 1), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = aws_vpc.test.id
+
+  egress {
+    from_port   = 0
+    to_port     = 0
+    protocol    = "-1"
+    cidr_blocks = ["0.0.0.0/0"]
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_network_interface" "test" {
+  count = 2
+
+  subnet_id       = aws_subnet.test[0].id
+  security_groups = [aws_security_group.test.id]
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_ec2_network_path" "test" {
+  source           = aws_network_interface.test[0].id
+  destination      = aws_network_interface.test[1].id
+  destination_port = 443
+  protocol         = "tcp"
+}
+`, rName))
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -186,7 +187,7 @@ func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(output.RouteTable.RouteTableId))
 
-	if _, err := waitRouteTableReady(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitRouteTableReady(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route Table (%s) create: %s", d.Id(), err)
 	}
 
@@ -194,7 +195,7 @@ func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta
 		for _, v := range v.(*schema.Set).List() {
 			v := v.(string)
 
-			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
@@ -204,7 +205,7 @@ func resourceRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta
 		for _, v := range v.(*schema.Set).List() {
 			v := v.(map[string]interface{})
 
-			if err := routeTableAddRoute(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if err := routeTableAddRoute(ctx, conn, d.Id(), v, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
@@ -282,7 +283,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 		for _, v := range add {
 			v := v.(string)
 
-			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if err := routeTableEnableVGWRoutePropagation(ctx, conn, d.Id(), v, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
 		}
@@ -309,7 +310,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 					addRoute = false
 
 					if oldTarget != newTarget {
-						if err := routeTableUpdateRoute(ctx, conn, d.Id(), vNew, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if err := routeTableUpdateRoute(ctx, conn, d.Id(), vNew, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 							return sdkdiag.AppendFromErr(diags, err)
 						}
 					}
@@ -317,7 +318,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 			}
 
 			if addRoute {
-				if err := routeTableAddRoute(ctx, conn, d.Id(), vNew, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := routeTableAddRoute(ctx, conn, d.Id(), vNew, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			}
@@ -341,7 +342,7 @@ func resourceRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta
 			}
 
 			if delRoute {
-				if err := routeTableDeleteRoute(ctx, conn, d.Id(), vOld, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := routeTableDeleteRoute(ctx, conn, d.Id(), vOld, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			}
@@ -369,7 +370,7 @@ func resourceRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta
 	for _, v := range routeTable.Associations {
 		v := aws.ToString(v.RouteTableAssociationId)
 
-		if err := routeTableAssociationDelete(ctx, conn, v, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if err := routeTableAssociationDelete(ctx, conn, v, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "deleting Route Table (%s): %s", d.Id(), err)
 		}
 	}
@@ -387,7 +388,7 @@ func resourceRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting Route Table (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitRouteTableDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitRouteTableDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route Table (%s) delete: %s", d.Id(), err)
 	}
 
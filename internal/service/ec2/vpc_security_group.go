@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -27,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -114,7 +116,10 @@ func resourceSecurityGroup() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			verify.SetTagsDiff,
+			customizeDiffDryRun(securityGroupDryRun),
+		),
 	}
 }
 
@@ -186,6 +191,30 @@ var (
 	}
 )
 
+// securityGroupDryRun checks that a security group could be created with the planned name, description and VPC.
+func securityGroupDryRun(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !dryRunConfigKnown(diff, names.AttrName, names.AttrNamePrefix, names.AttrDescription, names.AttrVPCID) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	name := create.Name(diff.Get(names.AttrName).(string), diff.Get(names.AttrNamePrefix).(string))
+	input := &ec2.CreateSecurityGroupInput{
+		Description: aws.String(diff.Get(names.AttrDescription).(string)),
+		DryRun:      aws.Bool(true),
+		GroupName:   aws.String(name),
+	}
+
+	if v, ok := diff.GetOk(names.AttrVPCID); ok {
+		input.VpcId = aws.String(v.(string))
+	}
+
+	_, err := conn.CreateSecurityGroupWithContext(ctx, input)
+
+	return dryRunError(err, fmt.Sprintf("creating Security Group (%s)", name))
+}
+
 func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -214,7 +243,7 @@ func resourceSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, me
 	d.SetId(aws.StringValue(output.GroupId))
 
 	// Wait for the security group to truly exist
-	if _, err := WaitSecurityGroupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := WaitSecurityGroupCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Security Group (%s) create: %s", d.Id(), err)
 	}
 
@@ -355,7 +384,7 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 	ctx = tflog.SetField(ctx, logging.KeyResourceId, d.Id())
 	ctx = tflog.SetField(ctx, names.AttrVPCID, d.Get(names.AttrVPCID))
 
-	if err := deleteLingeringENIs(ctx, meta.(*conns.AWSClient).EC2Client(ctx), "group-id", d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := deleteLingeringENIs(ctx, meta.(*conns.AWSClient).EC2Client(ctx), "group-id", d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting ENIs using Security Group (%s): %s", d.Id(), err)
 	}
 
@@ -369,8 +398,8 @@ func resourceSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	firstShortRetry := 1 * time.Minute
-	remainingRetry := d.Timeout(schema.TimeoutDelete) - firstShortRetry
-	if d.Timeout(schema.TimeoutDelete) < 1*time.Minute {
+	remainingRetry := sdkv2.Timeout(ctx, d, schema.TimeoutDelete) - firstShortRetry
+	if sdkv2.Timeout(ctx, d, schema.TimeoutDelete) < 1*time.Minute {
 		remainingRetry = 30 * time.Second
 	}
 
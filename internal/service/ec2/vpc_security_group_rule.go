@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -221,7 +222,7 @@ information and instructions for recovery. Error: %s`, securityGroupID, err)
 		return sdkdiag.AppendErrorf(diags, "authorizing Security Group (%s) Rule (%s): %s", securityGroupID, id, err)
 	}
 
-	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	_, err = tfresource.RetryWhenNotFound(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() (interface{}, error) {
 		sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
 
 		if err != nil {
@@ -261,7 +262,10 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 	securityGroupID := d.Get("security_group_id").(string)
 	ruleType := securityGroupRuleType(d.Get(names.AttrType).(string))
 
-	sg, err := FindSecurityGroupByID(ctx, conn, securityGroupID)
+	// Rules of the same security group are refreshed from the same API results.
+	sg, err := conns.DescribeCached(ctx, "DescribeSecurityGroups", securityGroupID, func(ctx context.Context) (*ec2.SecurityGroup, error) {
+		return FindSecurityGroupByID(ctx, conn, securityGroupID)
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Security Group (%s) not found, removing from state", securityGroupID)
@@ -307,7 +311,9 @@ func resourceSecurityGroupRuleRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	// Attempt to find the single matching AWS Security Group Rule resource ID.
-	securityGroupRules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+	securityGroupRules, err := conns.DescribeCached(ctx, "DescribeSecurityGroupRules", securityGroupID, func(ctx context.Context) ([]*ec2.SecurityGroupRule, error) {
+		return FindSecurityGroupRulesBySecurityGroupID(ctx, conn, securityGroupID)
+	})
 
 	// Ignore UnsupportedOperation errors for AWS China and GovCloud (US).
 	if tfawserr.ErrCodeEquals(err, errCodeUnsupportedOperation) {
@@ -90,7 +90,7 @@ func (d *securityGroupRuleDataSource) Read(ctx context.Context, request datasour
 	}
 
 	conn := d.Meta().EC2Conn(ctx)
-	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+	ignoreTagsConfig := d.Meta().ResourceIgnoreTagsConfig(ctx)
 
 	input := &ec2.DescribeSecurityGroupRulesInput{
 		Filters: newCustomFilterListFramework(ctx, data.Filters),
@@ -0,0 +1,618 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules", name="Security Group Rules")
+func newSecurityGroupRulesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &securityGroupRulesResource{}
+
+	return r, nil
+}
+
+type securityGroupRulesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*securityGroupRulesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_vpc_security_group_rules"
+}
+
+func (r *securityGroupRulesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	ruleBlock := schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[securityGroupRulesRuleModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"cidr_ipv4": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv4CIDRNetworkAddress(),
+						stringvalidator.ExactlyOneOf(
+							path.MatchRelative().AtParent().AtName("cidr_ipv6"),
+							path.MatchRelative().AtParent().AtName("prefix_list_id"),
+							path.MatchRelative().AtParent().AtName("referenced_security_group_id"),
+						),
+					},
+				},
+				"cidr_ipv6": schema.StringAttribute{
+					Optional: true,
+					Validators: []validator.String{
+						fwvalidators.IPv6CIDRNetworkAddress(),
+					},
+				},
+				names.AttrDescription: schema.StringAttribute{
+					Optional: true,
+				},
+				"from_port": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(-1, 65535),
+					},
+				},
+				"ip_protocol": schema.StringAttribute{
+					CustomType: ipProtocolType{},
+					Required:   true,
+				},
+				"prefix_list_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"referenced_security_group_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"security_group_rule_id": schema.StringAttribute{
+					Computed: true,
+				},
+				"to_port": schema.Int64Attribute{
+					Optional: true,
+					Validators: []validator.Int64{
+						int64validator.Between(-1, 65535),
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"egress":  ruleBlock,
+			"ingress": ruleBlock,
+		},
+	}
+}
+
+func (r *securityGroupRulesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.setID()
+
+	response.Diagnostics.Append(r.reconcile(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	_, err := FindSecurityGroupByID(ctx, conn, data.SecurityGroupID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, data.SecurityGroupID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) rules", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	for _, egress := range []bool{false, true} {
+		prior, diags := data.rules(egress).ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var current []*securityGroupRulesRuleModel
+		for _, apiObject := range output {
+			if aws.BoolValue(apiObject.IsEgress) != egress {
+				continue
+			}
+
+			rule := flattenSecurityGroupRulesRule(ctx, apiObject, r.Meta().AccountID)
+
+			// Keep the configured representation (e.g. protocol number vs. name) of unchanged rules.
+			for _, v := range prior {
+				if v.SecurityGroupRuleID.Equal(rule.SecurityGroupRuleID) && v.key() == rule.key() {
+					v.Description = rule.Description
+					rule = v
+					break
+				}
+			}
+
+			current = append(current, rule)
+		}
+
+		v, diags := fwtypes.NewSetNestedObjectValueOfSlice(ctx, current)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		data.setRules(egress, v)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *securityGroupRulesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(r.reconcile(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *securityGroupRulesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, data.SecurityGroupID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Security Group (%s) rules", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only revoke the rules that are managed by this resource.
+	for _, egress := range []bool{false, true} {
+		rules, diags := data.rules(egress).ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		ids := tfslices.ApplyToAll(rules, func(v *securityGroupRulesRuleModel) string {
+			return v.SecurityGroupRuleID.ValueString()
+		})
+
+		var revoke []string
+		for _, apiObject := range output {
+			if id := aws.StringValue(apiObject.SecurityGroupRuleId); aws.BoolValue(apiObject.IsEgress) == egress && slices.Contains(ids, id) {
+				revoke = append(revoke, id)
+			}
+		}
+
+		if err := r.revoke(ctx, data.SecurityGroupID.ValueString(), egress, revoke); err != nil {
+			if tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+				continue
+			}
+
+			response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Security Group (%s) rules", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *securityGroupRulesResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var old, new securityGroupRulesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Rules whose source, protocol and ports are unchanged keep their security group rule IDs.
+	for _, egress := range []bool{false, true} {
+		if new.rules(egress).IsUnknown() {
+			continue
+		}
+
+		oldRules, diags := old.rules(egress).ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		newRules, diags := new.rules(egress).ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, newRule := range newRules {
+			if !newRule.SecurityGroupRuleID.IsUnknown() || !newRule.isKnown() {
+				continue
+			}
+
+			for _, oldRule := range oldRules {
+				if oldRule.key() == newRule.key() {
+					newRule.SecurityGroupRuleID = oldRule.SecurityGroupRuleID
+					break
+				}
+			}
+		}
+
+		v, diags := fwtypes.NewSetNestedObjectValueOfSlice(ctx, newRules)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		new.setRules(egress, v)
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &new)...)
+}
+
+// reconcile makes the security group's rules match the planned rules.
+// Rules that are not planned are revoked, new rules are authorized and descriptions of existing rules are modified,
+// each with a single API call per direction.
+func (r *securityGroupRulesResource) reconcile(ctx context.Context, data *securityGroupRulesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := r.Meta().EC2Conn(ctx)
+	groupID := data.SecurityGroupID.ValueString()
+
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading VPC Security Group (%s) rules", groupID), err.Error())
+
+		return diags
+	}
+
+	var updates []*ec2.SecurityGroupRuleUpdate
+
+	for _, egress := range []bool{false, true} {
+		rules, d := data.rules(egress).ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		existing := make(map[string]*securityGroupRulesRuleModel)
+		for _, apiObject := range output {
+			if aws.BoolValue(apiObject.IsEgress) != egress {
+				continue
+			}
+
+			rule := flattenSecurityGroupRulesRule(ctx, apiObject, r.Meta().AccountID)
+			existing[rule.key()] = rule
+		}
+
+		var authorize []*securityGroupRulesRuleModel
+		for _, rule := range rules {
+			key := rule.key()
+
+			if v, ok := existing[key]; ok {
+				rule.SecurityGroupRuleID = v.SecurityGroupRuleID
+				delete(existing, key)
+
+				if !rule.Description.Equal(v.Description) {
+					updates = append(updates, &ec2.SecurityGroupRuleUpdate{
+						SecurityGroupRule:   rule.resourceModel().expandSecurityGroupRuleRequest(ctx),
+						SecurityGroupRuleId: fwflex.StringFromFramework(ctx, rule.SecurityGroupRuleID),
+					})
+				}
+
+				continue
+			}
+
+			authorize = append(authorize, rule)
+		}
+
+		var revoke []string
+		for _, v := range existing {
+			revoke = append(revoke, v.SecurityGroupRuleID.ValueString())
+		}
+
+		if err := r.revoke(ctx, groupID, egress, revoke); err != nil {
+			diags.AddError(fmt.Sprintf("revoking VPC Security Group (%s) rules", groupID), err.Error())
+
+			return diags
+		}
+
+		authorized, err := r.authorize(ctx, groupID, egress, authorize)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("authorizing VPC Security Group (%s) rules", groupID), err.Error())
+
+			return diags
+		}
+
+		for _, apiObject := range authorized {
+			rule := flattenSecurityGroupRulesRule(ctx, apiObject, r.Meta().AccountID)
+
+			for _, v := range authorize {
+				if v.key() == rule.key() {
+					v.SecurityGroupRuleID = rule.SecurityGroupRuleID
+				}
+			}
+		}
+
+		v, d := fwtypes.NewSetNestedObjectValueOfSlice(ctx, rules)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		data.setRules(egress, v)
+	}
+
+	if len(updates) > 0 {
+		input := &ec2.ModifySecurityGroupRulesInput{
+			GroupId:            aws.String(groupID),
+			SecurityGroupRules: updates,
+		}
+
+		_, err := conn.ModifySecurityGroupRulesWithContext(ctx, input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("updating VPC Security Group (%s) rules", groupID), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func (r *securityGroupRulesResource) authorize(ctx context.Context, groupID string, egress bool, rules []*securityGroupRulesRuleModel) ([]*ec2.SecurityGroupRule, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	ipPermissions := tfslices.ApplyToAll(rules, func(v *securityGroupRulesRuleModel) *ec2.IpPermission {
+		return v.resourceModel().expandIPPermission(ctx)
+	})
+
+	if egress {
+		output, err := conn.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: ipPermissions,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		return output.SecurityGroupRules, nil
+	}
+
+	output, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+		GroupId:       aws.String(groupID),
+		IpPermissions: ipPermissions,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.SecurityGroupRules, nil
+}
+
+func (r *securityGroupRulesResource) revoke(ctx context.Context, groupID string, egress bool, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	if egress {
+		_, err := conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: aws.StringSlice(ids),
+		})
+
+		return err
+	}
+
+	_, err := conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+		GroupId:              aws.String(groupID),
+		SecurityGroupRuleIds: aws.StringSlice(ids),
+	})
+
+	return err
+}
+
+func flattenSecurityGroupRulesRule(ctx context.Context, apiObject *ec2.SecurityGroupRule, accountID string) *securityGroupRulesRuleModel {
+	rule := &securityGroupRulesRuleModel{
+		CIDRIPv4:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv4),
+		CIDRIPv6:                  fwflex.StringToFramework(ctx, apiObject.CidrIpv6),
+		Description:               fwflex.StringToFramework(ctx, apiObject.Description),
+		FromPort:                  fwflex.Int64ToFramework(ctx, apiObject.FromPort),
+		IPProtocol:                fwflex.StringToFrameworkValuable[ipProtocol](ctx, apiObject.IpProtocol),
+		PrefixListID:              fwflex.StringToFramework(ctx, apiObject.PrefixListId),
+		ReferencedSecurityGroupID: flattenReferencedSecurityGroup(ctx, apiObject.ReferencedGroupInfo, accountID),
+		SecurityGroupRuleID:       fwflex.StringToFramework(ctx, apiObject.SecurityGroupRuleId),
+		ToPort:                    fwflex.Int64ToFramework(ctx, apiObject.ToPort),
+	}
+
+	// Ports of -1 (all ports) are represented as null.
+	if aws.Int64Value(apiObject.FromPort) == -1 {
+		rule.FromPort = types.Int64Null()
+	}
+	if aws.Int64Value(apiObject.ToPort) == -1 {
+		rule.ToPort = types.Int64Null()
+	}
+
+	return rule
+}
+
+type securityGroupRulesResourceModel struct {
+	Egress          fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"egress"`
+	ID              types.String                                                `tfsdk:"id"`
+	Ingress         fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] `tfsdk:"ingress"`
+	SecurityGroupID types.String                                                `tfsdk:"security_group_id"`
+}
+
+func (model *securityGroupRulesResourceModel) InitFromID() error {
+	model.SecurityGroupID = model.ID
+
+	return nil
+}
+
+func (model *securityGroupRulesResourceModel) setID() {
+	model.ID = model.SecurityGroupID
+}
+
+func (model *securityGroupRulesResourceModel) rules(egress bool) fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel] {
+	if egress {
+		return model.Egress
+	}
+
+	return model.Ingress
+}
+
+func (model *securityGroupRulesResourceModel) setRules(egress bool, v fwtypes.SetNestedObjectValueOf[securityGroupRulesRuleModel]) {
+	if egress {
+		model.Egress = v
+	} else {
+		model.Ingress = v
+	}
+}
+
+type securityGroupRulesRuleModel struct {
+	CIDRIPv4                  types.String `tfsdk:"cidr_ipv4"`
+	CIDRIPv6                  types.String `tfsdk:"cidr_ipv6"`
+	Description               types.String `tfsdk:"description"`
+	FromPort                  types.Int64  `tfsdk:"from_port"`
+	IPProtocol                ipProtocol   `tfsdk:"ip_protocol"`
+	PrefixListID              types.String `tfsdk:"prefix_list_id"`
+	ReferencedSecurityGroupID types.String `tfsdk:"referenced_security_group_id"`
+	SecurityGroupRuleID       types.String `tfsdk:"security_group_rule_id"`
+	ToPort                    types.Int64  `tfsdk:"to_port"`
+}
+
+// key identifies a rule by its source, protocol and ports.
+// The description is not part of the key as it can be modified in place.
+func (model *securityGroupRulesRuleModel) key() string {
+	port := func(v types.Int64) int64 {
+		if v.IsNull() {
+			return -1
+		}
+
+		return v.ValueInt64()
+	}
+
+	return fmt.Sprintf("%s|%d|%d|%s|%s|%s|%s",
+		protocolForValue(model.IPProtocol.ValueString()),
+		port(model.FromPort),
+		port(model.ToPort),
+		model.CIDRIPv4.ValueString(),
+		model.CIDRIPv6.ValueString(),
+		model.PrefixListID.ValueString(),
+		model.ReferencedSecurityGroupID.ValueString(),
+	)
+}
+
+func (model *securityGroupRulesRuleModel) isKnown() bool {
+	return !model.CIDRIPv4.IsUnknown() &&
+		!model.CIDRIPv6.IsUnknown() &&
+		!model.FromPort.IsUnknown() &&
+		!model.IPProtocol.IsUnknown() &&
+		!model.PrefixListID.IsUnknown() &&
+		!model.ReferencedSecurityGroupID.IsUnknown() &&
+		!model.ToPort.IsUnknown()
+}
+
+func (model *securityGroupRulesRuleModel) resourceModel() *securityGroupRuleResourceModel {
+	return &securityGroupRuleResourceModel{
+		CIDRIPv4:                  model.CIDRIPv4,
+		CIDRIPv6:                  model.CIDRIPv6,
+		Description:               model.Description,
+		FromPort:                  model.FromPort,
+		IPProtocol:                model.IPProtocol,
+		PrefixListID:              model.PrefixListID,
+		ReferencedSecurityGroupID: model.ReferencedSecurityGroupID,
+		ToPort:                    model.ToPort,
+	}
+}
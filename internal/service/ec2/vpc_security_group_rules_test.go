@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []*ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					testAccCheckSecurityGroupRulesCount(&v, 3),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "egress.*", map[string]string{
+						"cidr_ipv4":   "0.0.0.0/0",
+						"ip_protocol": "-1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "10.0.0.0/8",
+						"from_port":   "443",
+						"ip_protocol": "tcp",
+						"to_port":     "443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						names.AttrDescription: "HTTP",
+						"cidr_ipv6":           "::/0",
+						"from_port":           "80",
+						"ip_protocol":         "tcp",
+						"to_port":             "80",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []*ec2.SecurityGroupRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSecurityGroupRules, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v []*ec2.SecurityGroupRule
+	var ruleID string
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					testAccCheckSecurityGroupRulesCount(&v, 3),
+					testAccCheckSecurityGroupRulesRuleIDUnchanged(&v, "10.0.0.0/8", &ruleID),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesExists(ctx, resourceName, &v),
+					testAccCheckSecurityGroupRulesCount(&v, 3),
+					testAccCheckSecurityGroupRulesRuleIDUnchanged(&v, "10.0.0.0/8", &ruleID),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						names.AttrDescription: "HTTPS",
+						"cidr_ipv4":           "10.0.0.0/8",
+						"from_port":           "443",
+						"ip_protocol":         "tcp",
+						"to_port":             "443",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "ingress.*", map[string]string{
+						"cidr_ipv4":   "192.168.0.0/16",
+						"from_port":   "22",
+						"ip_protocol": "tcp",
+						"to_port":     "22",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "ingress.*.referenced_security_group_id", "aws_security_group.source", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesExists(ctx context.Context, n string, v *[]*ec2.SecurityGroupRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No VPC Security Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = output
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesCount(v *[]*ec2.SecurityGroupRule, expected int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := len(*v); got != expected {
+			return fmt.Errorf("VPC Security Group has %d rules, expected %d", got, expected)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckSecurityGroupRulesRuleIDUnchanged records the ID of the rule with the specified IPv4 CIDR block
+// and verifies that it is unchanged in subsequent steps.
+func testAccCheckSecurityGroupRulesRuleIDUnchanged(v *[]*ec2.SecurityGroupRule, cidrIPv4 string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rule := range *v {
+			if aws.StringValue(rule.CidrIpv4) != cidrIPv4 {
+				continue
+			}
+
+			ruleID := aws.StringValue(rule.SecurityGroupRuleId)
+
+			if *id == "" {
+				*id = ruleID
+
+				return nil
+			}
+
+			if ruleID != *id {
+				return fmt.Errorf("VPC Security Group Rule for %s changed from %s to %s", cidrIPv4, *id, ruleID)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("VPC Security Group Rule for %s not found", cidrIPv4)
+	}
+}
+
+func testAccVPCSecurityGroupRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+
+  ingress {
+    cidr_ipv6   = "::/0"
+    description = "HTTP"
+    from_port   = 80
+    ip_protocol = "tcp"
+    to_port     = 80
+  }
+
+  egress {
+    cidr_ipv4   = "0.0.0.0/0"
+    ip_protocol = "-1"
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "source" {
+  vpc_id = aws_vpc.test.id
+  name   = "%[1]s-source"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = "HTTPS"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+
+  ingress {
+    cidr_ipv4   = "192.168.0.0/16"
+    from_port   = 22
+    ip_protocol = "tcp"
+    to_port     = 22
+  }
+
+  ingress {
+    ip_protocol                  = "-1"
+    referenced_security_group_id = aws_security_group.source.id
+  }
+}
+`, rName))
+}
@@ -935,6 +935,34 @@ func TestAccVPCSecurityGroup_disappears(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_dryRunOnPlan(t *testing.T) {
+	ctx := acctest.Context(t)
+	var group ec2.SecurityGroup
+	resourceName := "aws_security_group.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSecurityGroupConfig_dryRunOnPlanInvalidVPC(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`dry run creating Security Group`),
+			},
+			{
+				Config: acctest.ConfigCompose(testAccVPCSecurityGroupConfig_dryRunOnPlanProvider(), testAccVPCSecurityGroupConfig_name(rName)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupExists(ctx, resourceName, &group),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCSecurityGroup_noVPC(t *testing.T) {
 	ctx := acctest.Context(t)
 	var group ec2.SecurityGroup
@@ -3104,6 +3132,23 @@ resource "aws_security_group" "test" {
 `, rName)
 }
 
+func testAccVPCSecurityGroupConfig_dryRunOnPlanProvider() string {
+	return `
+provider "aws" {
+  ec2_dry_run_on_plan = true
+}
+`
+}
+
+func testAccVPCSecurityGroupConfig_dryRunOnPlanInvalidVPC(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupConfig_dryRunOnPlanProvider(), fmt.Sprintf(`
+resource "aws_security_group" "test" {
+  name   = %[1]q
+  vpc_id = "vpc-00000000000000000"
+}
+`, rName))
+}
+
 func testAccVPCSecurityGroupConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
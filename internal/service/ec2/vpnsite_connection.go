@@ -26,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -125,6 +126,11 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"skip_tunnel_replacement": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"static_routes_only": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -250,6 +256,26 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel1_maintenance_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_maintenance_applied": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_auto_applied_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pending_maintenance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tunnel1_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -315,12 +341,15 @@ func resourceVPNConnection() *schema.Resource {
 				},
 			},
 			"tunnel1_preshared_key": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Sensitive:    true,
-				Computed:     true,
-				ValidateFunc: validVPNConnectionTunnelPreSharedKey(),
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Computed:      true,
+				ConflictsWith: []string{"tunnel1_preshared_key_wo"},
+				ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
 			},
+			"tunnel1_preshared_key_wo":         sdkv2.WriteOnlyStringSchema("tunnel1_preshared_key_wo", validVPNConnectionTunnelPreSharedKey(), "tunnel1_preshared_key"),
+			"tunnel1_preshared_key_wo_version": sdkv2.WriteOnlyVersionSchema("tunnel1_preshared_key_wo"),
 			"tunnel1_rekey_fuzz_percentage": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -466,6 +495,26 @@ func resourceVPNConnection() *schema.Resource {
 					},
 				},
 			},
+			"tunnel2_maintenance_details": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_maintenance_applied": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"maintenance_auto_applied_after": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"pending_maintenance": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"tunnel2_phase1_dh_group_numbers": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -531,12 +580,15 @@ func resourceVPNConnection() *schema.Resource {
 				},
 			},
 			"tunnel2_preshared_key": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Sensitive:    true,
-				Computed:     true,
-				ValidateFunc: validVPNConnectionTunnelPreSharedKey(),
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				Computed:      true,
+				ConflictsWith: []string{"tunnel2_preshared_key_wo"},
+				ValidateFunc:  validVPNConnectionTunnelPreSharedKey(),
 			},
+			"tunnel2_preshared_key_wo":         sdkv2.WriteOnlyStringSchema("tunnel2_preshared_key_wo", validVPNConnectionTunnelPreSharedKey(), "tunnel2_preshared_key"),
+			"tunnel2_preshared_key_wo_version": sdkv2.WriteOnlyVersionSchema("tunnel2_preshared_key_wo"),
 			"tunnel2_rekey_fuzz_percentage": {
 				Type:         schema.TypeInt,
 				Optional:     true,
@@ -806,9 +858,15 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.Set("customer_gateway_configuration", vpnConnection.CustomerGatewayConfiguration)
 
+	tunnel1PreSharedKey := d.Get("tunnel1_preshared_key").(string) // Not currently available during import
+	if v := d.Get("tunnel1_preshared_key_wo").(string); v != "" {
+		// Only available during the apply that sends the write-only pre-shared key.
+		tunnel1PreSharedKey = v
+	}
+
 	tunnelInfo, err := CustomerGatewayConfigurationToTunnelInfo(
 		aws.ToString(vpnConnection.CustomerGatewayConfiguration),
-		d.Get("tunnel1_preshared_key").(string), // Not currently available during import
+		tunnel1PreSharedKey,
 		d.Get("tunnel1_inside_cidr").(string),
 		d.Get("tunnel1_inside_ipv6_cidr").(string),
 	)
@@ -846,6 +904,32 @@ func resourceVPNConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("tunnel2_vgw_inside_address", nil)
 	}
 
+	for _, prefix := range []string{"tunnel1_", "tunnel2_"} {
+		// Write-only pre-shared keys are never persisted.
+		sdkv2.ClearWriteOnly(d, prefix+"preshared_key_wo")
+		if sdkv2.HasWriteOnlyValue(d, prefix+"preshared_key_wo") {
+			d.Set(prefix+"preshared_key", nil)
+		}
+
+		var tfList []interface{}
+
+		if address := d.Get(prefix + names.AttrAddress).(string); address != "" && d.Get(prefix+"enable_tunnel_lifecycle_control").(bool) {
+			maintenanceDetails, err := findVPNTunnelMaintenanceDetailsByTwoPartKey(ctx, conn, d.Id(), address)
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection (%s) tunnel (%s) maintenance details: %s", d.Id(), address, err)
+			default:
+				tfList = []interface{}{flattenMaintenanceDetails(maintenanceDetails)}
+			}
+		}
+
+		if err := d.Set(prefix+"maintenance_details", tfList); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting %s: %s", prefix+"maintenance_details", err)
+		}
+	}
+
 	return diags
 }
 
@@ -921,6 +1005,11 @@ func resourceVPNConnectionUpdate(ctx context.Context, d *schema.ResourceData, me
 				VpnTunnelOutsideIpAddress: aws.String(address),
 			}
 
+			// Only applicable when turning tunnel endpoint lifecycle control on or off.
+			if d.HasChange(prefix + "enable_tunnel_lifecycle_control") {
+				input.SkipTunnelReplacement = aws.Bool(d.Get("skip_tunnel_replacement").(bool))
+			}
+
 			_, err := conn.ModifyVpnTunnelOptions(ctx, input)
 
 			if err != nil {
@@ -1080,6 +1169,10 @@ func expandVPNTunnelOptionsSpecification(d *schema.ResourceData, prefix string)
 		apiObject.PreSharedKey = aws.String(v.(string))
 	}
 
+	if v, ok := sdkv2.WriteOnlyString(d, prefix+"preshared_key_wo"); ok {
+		apiObject.PreSharedKey = aws.String(v)
+	}
+
 	if v, ok := d.GetOk(prefix + "rekey_fuzz_percentage"); ok {
 		apiObject.RekeyFuzzPercentage = aws.Int32(int32(v.(int)))
 	}
@@ -1312,6 +1405,13 @@ func expandModifyVPNTunnelOptionsSpecification(d *schema.ResourceData, prefix st
 		hasChange = true
 	}
 
+	// The write-only pre-shared key is only sent when its version changes.
+	if v, ok := sdkv2.WriteOnlyString(d, prefix+"preshared_key_wo"); ok {
+		apiObject.PreSharedKey = aws.String(v)
+
+		hasChange = true
+	}
+
 	if key := prefix + "rekey_fuzz_percentage"; d.HasChange(key) {
 		if v, ok := d.GetOk(key); ok {
 			apiObject.RekeyFuzzPercentage = aws.Int32(int32(v.(int)))
@@ -1428,6 +1528,28 @@ func flattenTunnelOption(d *schema.ResourceData, prefix string, apiObject awstyp
 	return nil
 }
 
+func flattenMaintenanceDetails(apiObject *awstypes.MaintenanceDetails) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.LastMaintenanceApplied; v != nil {
+		tfMap["last_maintenance_applied"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.MaintenanceAutoAppliedAfter; v != nil {
+		tfMap["maintenance_auto_applied_after"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.PendingMaintenance; v != nil {
+		tfMap["pending_maintenance"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
 func flattenVPNStaticRoute(apiObject awstypes.VpnStaticRoute) map[string]interface{} {
 	tfMap := map[string]interface{}{}
 
@@ -527,6 +527,83 @@ func TestAccSiteVPNConnection_tunnel1PreSharedKey(t *testing.T) {
 	})
 }
 
+func TestAccSiteVPNConnection_tunnelPreSharedKeyWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelPresharedKeyWriteOnly(rName, rBgpAsn, "tunnel1presharedkey", "tunnel2presharedkey", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo_version", "1"),
+				),
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_tunnelPresharedKeyWriteOnly(rName, rBgpAsn, "tunnel1presharedkey2", "tunnel2presharedkey2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_preshared_key_wo_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "tunnel2_preshared_key_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSiteVPNConnection_skipTunnelReplacement(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rBgpAsn := sdkacctest.RandIntRange(64512, 65534)
+	resourceName := "aws_vpn_connection.test"
+	var vpn awstypes.VpnConnection
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPNConnectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionConfig_skipTunnelReplacement(rName, rBgpAsn, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn),
+					resource.TestCheckResourceAttr(resourceName, "skip_tunnel_replacement", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_enable_tunnel_lifecycle_control", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_maintenance_details.#", "0"),
+				),
+			},
+			{
+				Config: testAccSiteVPNConnectionConfig_skipTunnelReplacement(rName, rBgpAsn, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccVPNConnectionExists(ctx, resourceName, &vpn),
+					resource.TestCheckResourceAttr(resourceName, "skip_tunnel_replacement", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_enable_tunnel_lifecycle_control", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "tunnel1_maintenance_details.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "tunnel1_maintenance_details.0.pending_maintenance"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSiteVPNConnection_tunnelOptions(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -2430,6 +2507,73 @@ resource "aws_vpn_connection" "test" {
 `, rName, rBgpAsn, tunnel1PresharedKey, tunnel2PresharedKey)
 }
 
+func testAccSiteVPNConnectionConfig_tunnelPresharedKeyWriteOnly(rName string, rBgpAsn int, tunnel1PresharedKey string, tunnel2PresharedKey string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id              = aws_customer_gateway.test.id
+  tunnel1_inside_cidr              = "169.254.8.0/30"
+  tunnel1_preshared_key_wo         = %[3]q
+  tunnel1_preshared_key_wo_version = %[5]d
+  tunnel2_preshared_key_wo         = %[4]q
+  tunnel2_preshared_key_wo_version = %[5]d
+  type                             = "ipsec.1"
+  vpn_gateway_id                   = aws_vpn_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, tunnel1PresharedKey, tunnel2PresharedKey, version)
+}
+
+func testAccSiteVPNConnectionConfig_skipTunnelReplacement(rName string, rBgpAsn int, enableTunnelLifecycleControl bool) string {
+	return fmt.Sprintf(`
+resource "aws_vpn_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_customer_gateway" "test" {
+  bgp_asn    = %[2]d
+  ip_address = "178.0.0.1"
+  type       = "ipsec.1"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpn_connection" "test" {
+  customer_gateway_id                     = aws_customer_gateway.test.id
+  skip_tunnel_replacement                 = true
+  tunnel1_enable_tunnel_lifecycle_control = %[3]t
+  type                                    = "ipsec.1"
+  vpn_gateway_id                          = aws_vpn_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, rBgpAsn, enableTunnelLifecycleControl)
+}
+
 func testAccSiteVPNConnectionConfig_tunnelOptions(
 	rName string,
 	rBgpAsn int,
@@ -1951,11 +1951,11 @@ const (
 	TransitGatewayPeeringAttachmentUpdatedTimeout = 10 * time.Minute
 )
 
-func waitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayPeeringAttachment, error) {
+func waitTransitGatewayPeeringAttachmentAccepted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayPeeringAttachment, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStatePending, awstypes.TransitGatewayAttachmentStatePendingAcceptance),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable),
-		Timeout: TransitGatewayPeeringAttachmentUpdatedTimeout,
+		Timeout: timeout,
 		Refresh: statusTransitGatewayPeeringAttachment(ctx, conn, id),
 	}
 
@@ -2296,11 +2296,11 @@ const (
 	TransitGatewayVPCAttachmentUpdatedTimeout = 10 * time.Minute
 )
 
-func waitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.Client, id string) (*awstypes.TransitGatewayVpcAttachment, error) {
+func waitTransitGatewayVPCAttachmentAccepted(ctx context.Context, conn *ec2.Client, id string, timeout time.Duration) (*awstypes.TransitGatewayVpcAttachment, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.TransitGatewayAttachmentStatePending, awstypes.TransitGatewayAttachmentStatePendingAcceptance),
 		Target:  enum.Slice(awstypes.TransitGatewayAttachmentStateAvailable),
-		Timeout: TransitGatewayVPCAttachmentUpdatedTimeout,
+		Timeout: timeout,
 		Refresh: statusTransitGatewayVPCAttachment(ctx, conn, id),
 	}
 
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -265,7 +266,7 @@ func resourceRepositoryDelete(ctx context.Context, d *schema.ResourceData, meta
 	input := &ecrpublic.DescribeRepositoriesInput{
 		RepositoryNames: []string{d.Id()},
 	}
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err = retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() *retry.RetryError {
 		_, err = conn.DescribeRepositories(ctx, input)
 		if err != nil {
 			if errs.IsA[*awstypes.RepositoryNotFoundException](err) {
@@ -82,7 +82,7 @@ func dataSourceClusterRead(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	clusterName := d.Get(names.AttrClusterName).(string)
 	cluster, err := FindClusterByNameOrARN(ctx, conn, d.Get(names.AttrClusterName).(string))
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -471,6 +472,7 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			"service_connect_hybrid_discovery": serviceConnectHybridDiscoverySchema(),
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -719,6 +721,16 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 		input.TaskDefinition = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("service_connect_hybrid_discovery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		serviceRegistry, err := createServiceConnectHybridDiscoveryService(ctx, meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx), v.([]interface{})[0].(map[string]interface{}), name)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ECS Service (%s) Service Connect hybrid discovery: %s", name, err)
+		}
+
+		input.ServiceRegistries = []*ecs.ServiceRegistry{serviceRegistry}
+	}
+
 	output, err := serviceCreateWithRetry(ctx, conn, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -729,7 +741,15 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating ECS Service (%s): %s", name, err)
+		diags = sdkdiag.AppendErrorf(diags, "creating ECS Service (%s): %s", name, err)
+
+		if _, ok := d.GetOk("service_connect_hybrid_discovery"); ok && len(input.ServiceRegistries) > 0 {
+			if err := deleteServiceConnectHybridDiscoveryService(ctx, meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx), aws.StringValue(input.ServiceRegistries[0].RegistryArn), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+				diags = sdkdiag.AppendErrorf(diags, "cleaning up ECS Service (%s) Service Connect hybrid discovery: %s", name, err)
+			}
+		}
+
+		return diags
 	}
 
 	d.SetId(aws.StringValue(output.Service.ServiceArn))
@@ -738,7 +758,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 	if d.Get("wait_for_steady_state").(bool) {
 		fn = waitServiceStable
 	}
-	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) create: %s", d.Id(), err)
 	}
 
@@ -886,7 +906,36 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	//	return sdkdiag.AppendErrorf(diags, "setting service_connect_configuration: %s", err)
 	//}
 
-	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
+	serviceRegistries := service.ServiceRegistries
+	if v, ok := d.GetOk("service_connect_hybrid_discovery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		tfMap := v.([]interface{})[0].(map[string]interface{})
+		var hybridDiscovery []interface{}
+
+		// The service registry of Service Connect hybrid discovery is managed by this resource.
+		for i, serviceRegistry := range serviceRegistries {
+			if registryARN := aws.StringValue(serviceRegistry.RegistryArn); registryARN == tfMap["registry_arn"].(string) {
+				cloudMapService, err := findServiceConnectHybridDiscoveryService(ctx, meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx), registryARN)
+
+				switch {
+				case tfresource.NotFound(err):
+					log.Printf("[WARN] ECS Service (%s) Service Connect hybrid discovery Service Discovery Service (%s) not found", d.Id(), registryARN)
+				case err != nil:
+					return sdkdiag.AppendErrorf(diags, "reading ECS Service (%s) Service Connect hybrid discovery: %s", d.Id(), err)
+				default:
+					hybridDiscovery = flattenServiceConnectHybridDiscovery(serviceRegistry, cloudMapService, tfMap["dns_ttl"].(int))
+				}
+
+				serviceRegistries = append(serviceRegistries[:i:i], serviceRegistries[i+1:]...)
+				break
+			}
+		}
+
+		if err := d.Set("service_connect_hybrid_discovery", hybridDiscovery); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting service_connect_hybrid_discovery: %s", err)
+		}
+	}
+
+	if err := d.Set("service_registries", flattenServiceRegistries(serviceRegistries)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
 	}
 
@@ -1035,6 +1084,42 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			input.TaskDefinition = aws.String(d.Get("task_definition").(string))
 		}
 
+		// Replaced Service Connect hybrid discovery Cloud Map services are deleted once the ECS service no longer references them.
+		var oldHybridDiscoveryRegistryARN string
+		if d.HasChange("service_connect_hybrid_discovery") {
+			o, n := d.GetChange("service_connect_hybrid_discovery")
+			var oldTfMap, newTfMap map[string]interface{}
+			if v := o.([]interface{}); len(v) > 0 && v[0] != nil {
+				oldTfMap = v[0].(map[string]interface{})
+			}
+			if v := n.([]interface{}); len(v) > 0 && v[0] != nil {
+				newTfMap = v[0].(map[string]interface{})
+			}
+
+			// To remove all existing service registries, specify an empty array.
+			input.ServiceRegistries = expandServiceRegistries(d.Get("service_registries").([]interface{}))
+
+			switch {
+			case newTfMap == nil:
+				oldHybridDiscoveryRegistryARN = oldTfMap["registry_arn"].(string)
+			case oldTfMap == nil || oldTfMap["registry_arn"].(string) == "" || serviceConnectHybridDiscoveryRequiresNewService(oldTfMap, newTfMap):
+				serviceRegistry, err := createServiceConnectHybridDiscoveryService(ctx, meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx), newTfMap, d.Get(names.AttrName).(string))
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s) Service Connect hybrid discovery: %s", d.Id(), err)
+				}
+
+				input.ServiceRegistries = []*ecs.ServiceRegistry{serviceRegistry}
+
+				if oldTfMap != nil {
+					oldHybridDiscoveryRegistryARN = oldTfMap["registry_arn"].(string)
+				}
+			default:
+				newTfMap["registry_arn"] = oldTfMap["registry_arn"]
+				input.ServiceRegistries = expandServiceRegistries([]interface{}{newTfMap})
+			}
+		}
+
 		// Retry due to IAM eventual consistency
 		err := retry.RetryContext(ctx, propagationTimeout+serviceUpdateTimeout, func() *retry.RetryError {
 			_, err := conn.UpdateServiceWithContext(ctx, input)
@@ -1065,9 +1150,15 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		if d.Get("wait_for_steady_state").(bool) {
 			fn = waitServiceStable
 		}
-		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := fn(ctx, conn, d.Id(), d.Get("cluster").(string), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) update: %s", d.Id(), err)
 		}
+
+		if oldHybridDiscoveryRegistryARN != "" {
+			if err := deleteServiceConnectHybridDiscoveryService(ctx, meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx), oldHybridDiscoveryRegistryARN, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ECS Service (%s) Service Connect hybrid discovery: %s", d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceServiceRead(ctx, d, meta)...)
@@ -1109,7 +1200,7 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		Cluster: aws.String(d.Get("cluster").(string)),
 	}
 	log.Printf("[DEBUG] Deleting ECS Service: %s", d.Id())
-	err = retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err = retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteServiceWithContext(ctx, &input)
 
 		if err != nil {
@@ -1135,10 +1226,18 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "deleting ECS Service (%s): %s", d.Id(), err)
 	}
 
-	if err := waitServiceInactive(ctx, conn, d.Id(), d.Get("cluster").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := waitServiceInactive(ctx, conn, d.Id(), d.Get("cluster").(string), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ECS Service (%s) delete: %s", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("service_connect_hybrid_discovery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if registryARN := v.([]interface{})[0].(map[string]interface{})["registry_arn"].(string); registryARN != "" {
+			if err := deleteServiceConnectHybridDiscoveryService(ctx, meta.(*conns.AWSClient).ServiceDiscoveryClient(ctx), registryARN, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting ECS Service (%s) Service Connect hybrid discovery: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return diags
 }
 
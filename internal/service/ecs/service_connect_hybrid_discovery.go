@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	servicediscoverytypes "github.com/aws/aws-sdk-go-v2/service/servicediscovery/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Service Connect hybrid discovery exports the tasks of an ECS service into an additional Cloud Map service,
+// managed alongside the ECS service, so that clients outside of ECS can discover Service Connect endpoints.
+
+func serviceConnectHybridDiscoverySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"service_registries"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"container_name": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"container_port": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(0, 65535),
+				},
+				"dns_ttl": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      60,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"namespace_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"registry_arn": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// createServiceConnectHybridDiscoveryService creates the Cloud Map service described by the specified
+// service_connect_hybrid_discovery configuration and returns the ECS service registry that references it.
+func createServiceConnectHybridDiscoveryService(ctx context.Context, conn *servicediscovery.Client, tfMap map[string]interface{}, serviceName string) (*ecs.ServiceRegistry, error) {
+	name := serviceName
+	if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+		name = v
+	}
+	namespaceID := tfMap["namespace_id"].(string)
+
+	namespace, err := conn.GetNamespace(ctx, &servicediscovery.GetNamespaceInput{
+		Id: aws_sdkv2.String(namespaceID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Service Discovery Namespace (%s): %w", namespaceID, err)
+	}
+
+	input := &servicediscovery.CreateServiceInput{
+		CreatorRequestId: aws_sdkv2.String(id.UniqueId()),
+		Description:      aws_sdkv2.String(fmt.Sprintf("Service Connect hybrid discovery for ECS service %s", serviceName)),
+		Name:             aws_sdkv2.String(name),
+		NamespaceId:      aws_sdkv2.String(namespaceID),
+	}
+
+	containerPort, _ := tfMap["container_port"].(int)
+
+	// HTTP namespaces are discoverable only through the Cloud Map API and have no DNS records.
+	if namespace.Namespace.Type != servicediscoverytypes.NamespaceTypeHttp {
+		ttl := aws_sdkv2.Int64(int64(tfMap["dns_ttl"].(int)))
+		records := []servicediscoverytypes.DnsRecord{{
+			TTL:  ttl,
+			Type: servicediscoverytypes.RecordTypeA,
+		}}
+
+		if containerPort > 0 {
+			records = append(records, servicediscoverytypes.DnsRecord{
+				TTL:  ttl,
+				Type: servicediscoverytypes.RecordTypeSrv,
+			})
+		}
+
+		input.DnsConfig = &servicediscoverytypes.DnsConfig{
+			DnsRecords:    records,
+			RoutingPolicy: servicediscoverytypes.RoutingPolicyMultivalue,
+		}
+	}
+
+	output, err := conn.CreateService(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("creating Service Discovery Service (%s): %w", name, err)
+	}
+
+	serviceRegistry := &ecs.ServiceRegistry{
+		RegistryArn: aws.String(aws_sdkv2.ToString(output.Service.Arn)),
+	}
+
+	if v, ok := tfMap["container_name"].(string); ok && v != "" {
+		serviceRegistry.ContainerName = aws.String(v)
+	}
+
+	if containerPort > 0 {
+		serviceRegistry.ContainerPort = aws.Int64(int64(containerPort))
+	}
+
+	return serviceRegistry, nil
+}
+
+// deleteServiceConnectHybridDiscoveryService deletes the Cloud Map service with the specified ARN,
+// retrying while ECS deregisters the service's instances.
+func deleteServiceConnectHybridDiscoveryService(ctx context.Context, conn *servicediscovery.Client, registryARN string, timeout time.Duration) error {
+	serviceID, err := serviceConnectHybridDiscoveryServiceIDFromARN(registryARN)
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting Service Discovery Service: %s", serviceID)
+	_, err = tfresource.RetryWhenIsA[*servicediscoverytypes.ResourceInUse](ctx, timeout, func() (interface{}, error) {
+		return conn.DeleteService(ctx, &servicediscovery.DeleteServiceInput{
+			Id: aws_sdkv2.String(serviceID),
+		})
+	})
+
+	if errs.IsA[*servicediscoverytypes.ServiceNotFound](err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting Service Discovery Service (%s): %w", serviceID, err)
+	}
+
+	return nil
+}
+
+func findServiceConnectHybridDiscoveryService(ctx context.Context, conn *servicediscovery.Client, registryARN string) (*servicediscoverytypes.Service, error) {
+	serviceID, err := serviceConnectHybridDiscoveryServiceIDFromARN(registryARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &servicediscovery.GetServiceInput{
+		Id: aws_sdkv2.String(serviceID),
+	}
+
+	output, err := conn.GetService(ctx, input)
+
+	if errs.IsA[*servicediscoverytypes.ServiceNotFound](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Service == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Service, nil
+}
+
+// serviceConnectHybridDiscoveryServiceIDFromARN returns the ID of the Cloud Map service with the specified ARN,
+// for example "srv-abcdef0123456789" for "arn:aws:servicediscovery:us-west-2:123456789012:service/srv-abcdef0123456789".
+func serviceConnectHybridDiscoveryServiceIDFromARN(s string) (string, error) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", err
+	}
+
+	serviceID, ok := strings.CutPrefix(v.Resource, "service/")
+
+	if !ok || serviceID == "" {
+		return "", fmt.Errorf("unexpected format for Service Discovery Service ARN (%s)", s)
+	}
+
+	return serviceID, nil
+}
+
+// serviceConnectHybridDiscoveryRequiresNewService returns whether a change between the specified
+// service_connect_hybrid_discovery configurations requires a new Cloud Map service.
+func serviceConnectHybridDiscoveryRequiresNewService(o, n map[string]interface{}) bool {
+	for _, k := range []string{"container_port", "dns_ttl", names.AttrName, "namespace_id"} {
+		if o[k] != n[k] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenServiceConnectHybridDiscovery flattens the ECS service registry and Cloud Map service of Service Connect hybrid discovery.
+// The specified DNS TTL is used for Cloud Map services without DNS records.
+func flattenServiceConnectHybridDiscovery(serviceRegistry *ecs.ServiceRegistry, service *servicediscoverytypes.Service, dnsTTL int) []interface{} {
+	tfMap := map[string]interface{}{
+		"container_name": aws.StringValue(serviceRegistry.ContainerName),
+		"container_port": int(aws.Int64Value(serviceRegistry.ContainerPort)),
+		"dns_ttl":        dnsTTL,
+		names.AttrName:   aws_sdkv2.ToString(service.Name),
+		"namespace_id":   aws_sdkv2.ToString(service.NamespaceId),
+		"registry_arn":   aws.StringValue(serviceRegistry.RegistryArn),
+	}
+
+	if service.DnsConfig != nil {
+		for _, v := range service.DnsConfig.DnsRecords {
+			tfMap["dns_ttl"] = int(aws_sdkv2.ToInt64(v.TTL))
+			break
+		}
+	}
+
+	return []interface{}{tfMap}
+}
@@ -1403,6 +1403,54 @@ func TestAccECSService_ServiceConnect_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSService_ServiceConnect_hybridDiscovery(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service ecs.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+	namespaceResourceName := "aws_service_discovery_http_namespace.hybrid"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_serviceConnectHybridDiscovery(rName, rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_hybrid_discovery.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_hybrid_discovery.0.container_name", "mongodb"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_hybrid_discovery.0.container_port", "27017"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_hybrid_discovery.0.name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "service_connect_hybrid_discovery.0.namespace_id", namespaceResourceName, names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "service_connect_hybrid_discovery.0.registry_arn", "servicediscovery", regexache.MustCompile(`service/srv-.+`)),
+					resource.TestCheckResourceAttr(resourceName, "service_registries.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccServiceConfig_serviceConnectHybridDiscovery(rName, rName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_hybrid_discovery.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_hybrid_discovery.0.name", rName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "service_registries.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccServiceConfig_serviceConnectBasic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_hybrid_discovery.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "service_registries.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSService_ServiceConnect_full(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service ecs.Service
@@ -4504,6 +4552,68 @@ resource "aws_ecs_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_serviceConnectHybridDiscovery(rName, hybridDiscoveryName string) string {
+	return fmt.Sprintf(`
+resource "aws_service_discovery_http_namespace" "test" {
+  name = %[1]q
+}
+
+resource "aws_service_discovery_http_namespace" "hybrid" {
+  name = "%[1]s-hybrid"
+}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+
+  service_connect_defaults {
+    namespace = aws_service_discovery_http_namespace.test.arn
+  }
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family       = %[1]q
+  network_mode = "bridge"
+
+  container_definitions = <<DEFINITION
+[
+  {
+    "cpu": 128,
+    "essential": true,
+    "image": "mongo:latest",
+    "memory": 128,
+    "name": "mongodb",
+    "portMappings": [
+    {
+      "hostPort": 0,
+      "protocol": "tcp",
+      "containerPort": 27017
+    }
+    ]
+  }
+]
+DEFINITION
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 1
+
+  service_connect_configuration {
+    enabled = true
+  }
+
+  service_connect_hybrid_discovery {
+    container_name = "mongodb"
+    container_port = 27017
+    name           = %[2]q
+    namespace_id   = aws_service_discovery_http_namespace.hybrid.id
+  }
+}
+`, rName, hybridDiscoveryName)
+}
+
 func testAccServiceConfig_serviceConnectAllAttributes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
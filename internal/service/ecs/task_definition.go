@@ -31,6 +31,7 @@ import (
 )
 
 // @SDKResource("aws_ecs_task_definition", name="Task Definition")
+// @SkipDestroy
 // @Tags(identifierAttribute="arn")
 func ResourceTaskDefinition() *schema.Resource {
 	//lintignore:R011
@@ -265,11 +266,6 @@ func ResourceTaskDefinition() *schema.Resource {
 					},
 				},
 			},
-			names.AttrSkipDestroy: {
-				Type:     schema.TypeBool,
-				Default:  false,
-				Optional: true,
-			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"task_role_arn": {
@@ -684,11 +680,6 @@ func resourceTaskDefinitionUpdate(ctx context.Context, d *schema.ResourceData, m
 
 func resourceTaskDefinitionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
-	if v, ok := d.GetOk(names.AttrSkipDestroy); ok && v.(bool) {
-		log.Printf("[DEBUG] Retaining ECS Task Definition Revision %q", d.Id())
-		return diags
-	}
-
 	conn := meta.(*conns.AWSClient).ECSConn(ctx)
 
 	_, err := conn.DeregisterTaskDefinitionWithContext(ctx, &ecs.DeregisterTaskDefinitionInput{
@@ -27,7 +27,7 @@ import (
 )
 
 // @SDKResource("aws_efs_file_system", name="File System")
-// @Tags(identifierAttribute="id")
+// @Tags(identifierAttribute="id", resourceIgnoreTags=true)
 func ResourceFileSystem() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFileSystemCreate,
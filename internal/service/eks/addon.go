@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -181,7 +182,7 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(id)
 
-	if _, err := waitAddonCreated(ctx, conn, clusterName, addonName, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitAddonCreated(ctx, conn, clusterName, addonName, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		// Creating addon w/o setting resolve_conflicts to "OVERWRITE"
 		// might result in a failed creation, if unmanaged version of addon is already deployed
 		// and there are configuration conflicts:
@@ -283,7 +284,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 
 		updateID := aws.ToString(output.Update.Id)
-		if _, err := waitAddonUpdateSuccessful(ctx, conn, clusterName, addonName, updateID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitAddonUpdateSuccessful(ctx, conn, clusterName, addonName, updateID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			if conflictResolution != types.ResolveConflictsOverwrite {
 				// Changing addon version w/o setting resolve_conflicts to "OVERWRITE"
 				// might result in a failed update if there are conflicts:
@@ -323,7 +324,7 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "deleting EKS Add-On (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitAddonDeleted(ctx, conn, clusterName, addonName, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitAddonDeleted(ctx, conn, clusterName, addonName, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Add-On (%s) delete: %s", d.Id(), err)
 	}
 
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -153,7 +154,7 @@ func resourceIdentityProviderConfigCreate(ctx context.Context, d *schema.Resourc
 
 	d.SetId(idpID)
 
-	if _, err := waitOIDCIdentityProviderConfigCreated(ctx, conn, clusterName, configName, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitOIDCIdentityProviderConfigCreated(ctx, conn, clusterName, configName, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Identity Provider Config (%s) create: %s", d.Id(), err)
 	}
 
@@ -230,7 +231,7 @@ func resourceIdentityProviderConfigDelete(ctx context.Context, d *schema.Resourc
 		return sdkdiag.AppendErrorf(diags, "disassociating EKS Identity Provider Config (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitOIDCIdentityProviderConfigDeleted(ctx, conn, clusterName, configName, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitOIDCIdentityProviderConfigDeleted(ctx, conn, clusterName, configName, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for EKS Identity Provider Config (%s) delete: %s", d.Id(), err)
 	}
 
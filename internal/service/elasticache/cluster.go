@@ -41,6 +41,7 @@ const (
 
 // @SDKResource("aws_elasticache_cluster", name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_elasticache_clusters", listOp="DescribeCacheClusters", listOpOutputElem="CacheClusters", nameElem="CacheClusterId", arnElem="ARN", taggingResourceType="elasticache:cluster")
 func resourceCluster() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -114,16 +115,7 @@ func engineVersionIsDowngrade(diff getChangeDiffer) (bool, error) {
 		return nSegments[1] < aSegments[1], nil
 	}
 
-	oVersion, err := normalizeEngineVersion(o.(string))
-	if err != nil {
-		return false, fmt.Errorf("parsing old engine_version: %w", err)
-	}
-	nVersion, err := normalizeEngineVersion(n.(string))
-	if err != nil {
-		return false, fmt.Errorf("parsing new engine_version: %w", err)
-	}
-
-	return nVersion.LessThan(oVersion), nil
+	return semver.EngineVersionIsDowngrade(o.(string), n.(string))
 }
 
 type forceNewDiffer interface {
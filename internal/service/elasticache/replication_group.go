@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -35,10 +36,12 @@ import (
 )
 
 // @SDKResource("aws_elasticache_replication_group", name="Replication Group")
+// @SkipDestroy
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_elasticache_replication_groups", listOp="DescribeReplicationGroups", listOpOutputElem="ReplicationGroups", nameElem="ReplicationGroupId", arnElem="ARN", taggingResourceType="elasticache:replicationgroup")
 func resourceReplicationGroup() *schema.Resource {
 	//lintignore:R011
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateWithoutTimeout: resourceReplicationGroupCreate,
 		ReadWithoutTimeout:   resourceReplicationGroupRead,
 		UpdateWithoutTimeout: resourceReplicationGroupUpdate,
@@ -59,18 +62,21 @@ func resourceReplicationGroup() *schema.Resource {
 				Computed: true,
 			},
 			"at_rest_encryption_enabled": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
+				Type:         nullable.TypeNullableBool,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: nullable.ValidateTypeStringNullableBool,
 			},
 			"auth_token": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
 				ValidateFunc:  validReplicationGroupAuthToken,
-				ConflictsWith: []string{"user_group_ids"},
+				ConflictsWith: []string{"auth_token_wo", "user_group_ids"},
 			},
+			"auth_token_wo":         sdkv2.WriteOnlyStringSchema("auth_token_wo", validReplicationGroupAuthToken, "auth_token", "user_group_ids"),
+			"auth_token_wo_version": sdkv2.WriteOnlyVersionSchema("auth_token_wo"),
 			"auth_token_update_strategy": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -348,11 +354,11 @@ func resourceReplicationGroup() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"auth_token"},
+				ConflictsWith: []string{"auth_token", "auth_token_wo"},
 			},
 		},
 
-		SchemaVersion: 2,
+		SchemaVersion: 3,
 		// SchemaVersion: 1 did not include any state changes via MigrateState.
 		// Perform a no-operation state upgrade for Terraform 0.12 compatibility.
 		// Future state migrations should be performed with StateUpgraders.
@@ -394,6 +400,12 @@ func resourceReplicationGroup() *schema.Resource {
 			verify.SetTagsDiff,
 		),
 	}
+
+	// Schema version 2 stored at_rest_encryption_enabled as a bool, and an explicit false
+	// was never sent to the API. It is now a nullable bool so that false disables encryption.
+	r.StateUpgraders = append(r.StateUpgraders, nullable.BoolStateUpgrader(2, r, "at_rest_encryption_enabled"))
+
+	return r
 }
 
 func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -406,14 +418,20 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 		Tags:               getTagsIn(ctx),
 	}
 
-	if _, ok := d.GetOk("at_rest_encryption_enabled"); ok {
-		input.AtRestEncryptionEnabled = aws.Bool(d.Get("at_rest_encryption_enabled").(bool))
+	if v, ok := d.GetOk("at_rest_encryption_enabled"); ok {
+		if v, null, _ := nullable.Bool(v.(string)).ValueBool(); !null {
+			input.AtRestEncryptionEnabled = aws.Bool(v)
+		}
 	}
 
 	if v, ok := d.GetOk("auth_token"); ok {
 		input.AuthToken = aws.String(v.(string))
 	}
 
+	if v, ok := sdkv2.WriteOnlyString(d, "auth_token_wo"); ok {
+		input.AuthToken = aws.String(v)
+	}
+
 	if v, ok := d.GetOk(names.AttrAutoMinorVersionUpgrade); ok {
 		if v, null, _ := nullable.Bool(v.(string)).ValueBool(); !null {
 			input.AutoMinorVersionUpgrade = aws.Bool(v)
@@ -563,7 +581,7 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 	const (
 		delay = 30 * time.Second
 	)
-	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate), delay); err != nil {
+	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate), delay); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) create: %s", d.Id(), err)
 	}
 
@@ -689,7 +707,7 @@ func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, m
 	const (
 		delay = 0 * time.Second
 	)
-	_, err = waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay)
+	_, err = waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group to be available (%s): %s", aws.StringValue(rgp.ARN), err)
 	}
@@ -718,7 +736,7 @@ func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, m
 			return sdkdiag.AppendErrorf(diags, "reading ElastiCache Replication Group (%s): reading Cache Cluster (%s): %s", d.Id(), aws.StringValue(cacheCluster.CacheClusterId), err)
 		}
 
-		d.Set("at_rest_encryption_enabled", c.AtRestEncryptionEnabled)
+		d.Set("at_rest_encryption_enabled", nullable.FlattenBool(c.AtRestEncryptionEnabled))
 		d.Set("transit_encryption_enabled", c.TransitEncryptionEnabled)
 		d.Set("transit_encryption_mode", c.TransitEncryptionMode)
 
@@ -727,6 +745,9 @@ func resourceReplicationGroupRead(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// Write-only auth tokens are never persisted.
+	sdkv2.ClearWriteOnly(d, "auth_token_wo")
+
 	return diags
 }
 
@@ -744,7 +765,7 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		} else if d.HasChange("num_cache_clusters") {
 			if newCacheClusterCount > oldCacheClusterCount {
-				if err := increaseReplicationGroupReplicaCount(ctx, conn, d.Id(), newCacheClusterCount, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := increaseReplicationGroupReplicaCount(ctx, conn, d.Id(), newCacheClusterCount, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			} // Else defer until after all other modifications are made.
@@ -901,7 +922,7 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			const (
 				delay = 30 * time.Second
 			)
-			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) update: %s", d.Id(), err)
 			}
 
@@ -911,15 +932,21 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 				return sdkdiag.AppendErrorf(diags, "modifying ElastiCache Replication Group (%s): %s", d.Id(), err)
 			}
 
-			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) update: %s", d.Id(), err)
 			}
 		}
 
-		if d.HasChanges("auth_token", "auth_token_update_strategy") {
+		if d.HasChanges("auth_token", "auth_token_update_strategy", "auth_token_wo_version") {
+			authToken := d.Get("auth_token").(string)
+			// The write-only auth token is only sent when its version changes.
+			if v, ok := sdkv2.WriteOnlyString(d, "auth_token_wo"); ok {
+				authToken = v
+			}
+
 			input := &elasticache.ModifyReplicationGroupInput{
 				ApplyImmediately:        aws.Bool(true),
-				AuthToken:               aws.String(d.Get("auth_token").(string)),
+				AuthToken:               aws.String(authToken),
 				AuthTokenUpdateStrategy: aws.String(d.Get("auth_token_update_strategy").(string)),
 				ReplicationGroupId:      aws.String(d.Id()),
 			}
@@ -928,7 +955,7 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			const (
 				delay = 0 * time.Second
 			)
-			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) update: %s", d.Id(), err)
 			}
 
@@ -938,14 +965,14 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 				return sdkdiag.AppendErrorf(diags, "modifying ElastiCache Replication Group (%s) authentication: %s", d.Id(), err)
 			}
 
-			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+			if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) update: %s", d.Id(), err)
 			}
 		}
 
 		if d.HasChange("num_cache_clusters") {
 			if newCacheClusterCount < oldCacheClusterCount {
-				if err := decreaseReplicationGroupReplicaCount(ctx, conn, d.Id(), newCacheClusterCount, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if err := decreaseReplicationGroupReplicaCount(ctx, conn, d.Id(), newCacheClusterCount, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendFromErr(diags, err)
 				}
 			}
@@ -961,7 +988,7 @@ func resourceReplicationGroupDelete(ctx context.Context, d *schema.ResourceData,
 
 	v, hasGlobalReplicationGroupID := d.GetOk("global_replication_group_id")
 	if hasGlobalReplicationGroupID {
-		if err := disassociateReplicationGroup(ctx, conn, v.(string), d.Id(), meta.(*conns.AWSClient).Region, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if err := disassociateReplicationGroup(ctx, conn, v.(string), d.Id(), meta.(*conns.AWSClient).Region, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -989,7 +1016,7 @@ func resourceReplicationGroupDelete(ctx context.Context, d *schema.ResourceData,
 	case err != nil:
 		return sdkdiag.AppendErrorf(diags, "deleting ElastiCache Replication Group (%s): %s", d.Id(), err)
 	default:
-		if _, err := waitReplicationGroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if _, err := waitReplicationGroupDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Replication Group (%s) delete: %s", d.Id(), err)
 		}
 	}
@@ -1081,7 +1108,7 @@ func modifyReplicationGroupShardConfigurationNumNodeGroups(ctx context.Context,
 	const (
 		delay = 30 * time.Second
 	)
-	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+	if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay); err != nil {
 		return fmt.Errorf("waiting for ElastiCache Replication Group (%s) update: %w", d.Id(), err)
 	}
 
@@ -1108,7 +1135,7 @@ func modifyReplicationGroupShardConfigurationReplicasPerNodeGroup(ctx context.Co
 		const (
 			delay = 30 * time.Second
 		)
-		if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+		if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay); err != nil {
 			return fmt.Errorf("waiting for ElastiCache Replication Group (%s) update: %w", d.Id(), err)
 		}
 	} else if newReplicaCount < oldReplicaCount {
@@ -1127,7 +1154,7 @@ func modifyReplicationGroupShardConfigurationReplicasPerNodeGroup(ctx context.Co
 		const (
 			delay = 30 * time.Second
 		)
-		if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate), delay); err != nil {
+		if _, err := waitReplicationGroupAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), delay); err != nil {
 			return fmt.Errorf("waiting for ElastiCache Replication Group (%s) update: %w", d.Id(), err)
 		}
 	}
@@ -38,6 +38,7 @@ import (
 
 // @FrameworkResource(name="Serverless Cache")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_elasticache_serverless_caches", listOp="DescribeServerlessCaches", listOpOutputElem="ServerlessCaches", nameElem="ServerlessCacheName", arnElem="ARN", taggingResourceType="elasticache:serverlesscache")
 func newServerlessCacheResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &serverlessCacheResource{}
 
@@ -195,15 +196,9 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 								Attributes: map[string]schema.Attribute{
 									"maximum": schema.Int64Attribute{
 										Optional: true,
-										PlanModifiers: []planmodifier.Int64{
-											int64planmodifier.RequiresReplace(),
-										},
 									},
 									"minimum": schema.Int64Attribute{
 										Optional: true,
-										PlanModifiers: []planmodifier.Int64{
-											int64planmodifier.RequiresReplace(),
-										},
 									},
 									names.AttrUnit: schema.StringAttribute{
 										CustomType: fwtypes.StringEnumType[awstypes.DataStorageUnit](),
@@ -224,18 +219,12 @@ func (r *serverlessCacheResource) Schema(ctx context.Context, request resource.S
 										Validators: []validator.Int64{
 											int64validator.Between(1000, 15000000),
 										},
-										PlanModifiers: []planmodifier.Int64{
-											int64planmodifier.RequiresReplace(),
-										},
 									},
 									"minimum": schema.Int64Attribute{
 										Optional: true,
 										Validators: []validator.Int64{
 											int64validator.Between(1000, 15000000),
 										},
-										PlanModifiers: []planmodifier.Int64{
-											int64planmodifier.RequiresReplace(),
-										},
 									},
 								},
 							},
@@ -19,7 +19,20 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newClustersDataSource,
+			Name:    "Clusters",
+		},
+		{
+			Factory: newReplicationGroupsDataSource,
+			Name:    "Replication Groups",
+		},
+		{
+			Factory: newServerlessCachesDataSource,
+			Name:    "Serverless Caches",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -89,6 +102,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			SkipDestroy: true,
 		},
 		{
 			Factory:  resourceSubnetGroup,
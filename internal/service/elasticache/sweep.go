@@ -10,12 +10,16 @@ import (
 	"strings"
 	"time"
 
+	elasticache_sdkv2 "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // These timeouts are lower to fail faster during sweepers
@@ -55,12 +59,18 @@ func RegisterSweepers() {
 		},
 	})
 
+	resource.AddTestSweepers("aws_elasticache_serverless_cache", &resource.Sweeper{
+		Name: "aws_elasticache_serverless_cache",
+		F:    sweepServerlessCaches,
+	})
+
 	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
 			"aws_elasticache_cluster",
 			"aws_elasticache_replication_group",
+			"aws_elasticache_serverless_cache",
 		},
 	})
 
@@ -75,6 +85,9 @@ func RegisterSweepers() {
 	resource.AddTestSweepers("aws_elasticache_user_group", &resource.Sweeper{
 		Name: "aws_elasticache_user_group",
 		F:    sweepUserGroups,
+		Dependencies: []string{
+			"aws_elasticache_serverless_cache",
+		},
 	})
 }
 
@@ -275,6 +288,52 @@ func sweepReplicationGroups(region string) error {
 	return errs.ErrorOrNil()
 }
 
+func sweepServerlessCaches(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.ElastiCacheClient(ctx)
+	input := &elasticache_sdkv2.DescribeServerlessCachesInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := elasticache_sdkv2.NewDescribeServerlessCachesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping ElastiCache Serverless Cache sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing ElastiCache Serverless Caches (%s): %w", region, err)
+		}
+
+		for _, v := range page.ServerlessCaches {
+			id := aws.StringValue(v.ServerlessCacheName)
+
+			if status := aws.StringValue(v.Status); status == serverlessCacheStatusDeleting {
+				log.Printf("[INFO] Skipping ElastiCache Serverless Cache %s: Status=%s", id, status)
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newServerlessCacheResource, client,
+				framework.NewAttribute(names.AttrID, id),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping ElastiCache Serverless Caches (%s): %w", region, err)
+	}
+
+	return nil
+}
+
 func sweepSubnetGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
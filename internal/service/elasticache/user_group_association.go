@@ -5,7 +5,10 @@ package elasticache
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,11 +21,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
 	userGroupAssociationResourceIDPartCount = 2
+
+	// userGroupAssociationModifyBatchSize is the maximum number of users added to or removed from
+	// a user group in a single ModifyUserGroup call.
+	userGroupAssociationModifyBatchSize = 100
 )
 
 // @SDKResource("aws_elasticache_user_group_association", name="User Group Association")
@@ -30,12 +39,15 @@ func resourceUserGroupAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserGroupAssociationCreate,
 		ReadWithoutTimeout:   resourceUserGroupAssociationRead,
+		UpdateWithoutTimeout: resourceUserGroupAssociationUpdate,
 		DeleteWithoutTimeout: resourceUserGroupAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceUserGroupAssociationCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Type:     schema.TypeString,
@@ -43,9 +55,17 @@ func resourceUserGroupAssociation() *schema.Resource {
 				ForceNew: true,
 			},
 			"user_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"user_id", "user_ids"},
+			},
+			"user_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"user_id", "user_ids"},
 			},
 		},
 	}
@@ -56,27 +76,24 @@ func resourceUserGroupAssociationCreate(ctx context.Context, d *schema.ResourceD
 	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
 
 	userGroupID := d.Get("user_group_id").(string)
-	userID := d.Get("user_id").(string)
-	id := errs.Must(flex.FlattenResourceId([]string{userGroupID, userID}, userGroupAssociationResourceIDPartCount, true))
-	input := &elasticache.ModifyUserGroupInput{
-		UserGroupId:  aws.String(userGroupID),
-		UserIdsToAdd: aws.StringSlice([]string{userID}),
+	var id string
+	var userIDs []string
+
+	if v, ok := d.GetOk("user_id"); ok {
+		userID := v.(string)
+		id = errs.Must(flex.FlattenResourceId([]string{userGroupID, userID}, userGroupAssociationResourceIDPartCount, true))
+		userIDs = []string{userID}
+	} else {
+		id = userGroupID
+		userIDs = flex.ExpandStringValueSet(d.Get("user_ids").(*schema.Set))
 	}
 
-	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, 10*time.Minute, func() (interface{}, error) {
-		return conn.ModifyUserGroupWithContext(ctx, input)
-	}, elasticache.ErrCodeInvalidUserGroupStateFault)
-
-	if err != nil {
+	if err := modifyUserGroupUsers(ctx, conn, userGroupID, userIDs, nil, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating ElastiCache User Group Association (%s): %s", id, err)
 	}
 
 	d.SetId(id)
 
-	if _, err := waitUserGroupUpdated(ctx, conn, userGroupID, d.Timeout(schema.TimeoutCreate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache User Group Association (%s) create: %s", d.Id(), err)
-	}
-
 	return append(diags, resourceUserGroupAssociationRead(ctx, d, meta)...)
 }
 
@@ -84,13 +101,31 @@ func resourceUserGroupAssociationRead(ctx context.Context, d *schema.ResourceDat
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), userGroupAssociationResourceIDPartCount, true)
+	userGroupID, userID, err := userGroupAssociationParseResourceID(d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	userGroupID, userID := parts[0], parts[1]
-	err = findUserGroupAssociationByTwoPartKey(ctx, conn, userGroupID, userID)
+	if userID != "" {
+		err = findUserGroupAssociationByTwoPartKey(ctx, conn, userGroupID, userID)
+
+		if !d.IsNewResource() && tfresource.NotFound(err) {
+			log.Printf("[WARN] ElastiCache User Group Association (%s) not found, removing from state", d.Id())
+			d.SetId("")
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ElastiCache User Group Association (%s): %s", d.Id(), err)
+		}
+
+		d.Set("user_group_id", userGroupID)
+		d.Set("user_id", userID)
+
+		return diags
+	}
+
+	userGroup, err := findUserGroupByID(ctx, conn, userGroupID)
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] ElastiCache User Group Association (%s) not found, removing from state", d.Id())
@@ -102,31 +137,86 @@ func resourceUserGroupAssociationRead(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "reading ElastiCache User Group Association (%s): %s", d.Id(), err)
 	}
 
-	d.Set("user_group_id", userGroupID)
-	d.Set("user_id", userID)
+	// Only track the users managed by this resource, unless importing.
+	userIDs := aws.StringValueSlice(userGroup.UserIds)
+	if v, ok := d.GetOk("user_ids"); ok {
+		configured := v.(*schema.Set)
+		userIDs = tfslices.Filter(userIDs, func(v string) bool {
+			return configured.Contains(v)
+		})
+	}
+
+	if len(userIDs) == 0 && !d.IsNewResource() {
+		log.Printf("[WARN] ElastiCache User Group Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("user_group_id", userGroup.UserGroupId)
+	d.Set("user_id", nil)
+	d.Set("user_ids", userIDs)
 
 	return diags
 }
 
+func resourceUserGroupAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	if d.HasChange("user_ids") {
+		o, n := d.GetChange("user_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if err := modifyUserGroupUsers(ctx, conn, d.Get("user_group_id").(string), add, del, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating ElastiCache User Group Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserGroupAssociationRead(ctx, d, meta)...)
+}
+
 func resourceUserGroupAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
 
-	parts, err := flex.ExpandResourceId(d.Id(), userGroupAssociationResourceIDPartCount, true)
+	userGroupID, userID, err := userGroupAssociationParseResourceID(d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	var userIDs []string
+	if userID != "" {
+		userIDs = []string{userID}
+	} else {
+		// Only remove the users that are still members of the user group.
+		// A batched ModifyUserGroup call fails as a whole if any of the users is not a member.
+		userGroup, err := findUserGroupByID(ctx, conn, userGroupID)
+
+		if tfresource.NotFound(err) {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading ElastiCache User Group (%s): %s", userGroupID, err)
+		}
+
+		members := aws.StringValueSlice(userGroup.UserIds)
+		for _, v := range flex.ExpandStringValueSet(d.Get("user_ids").(*schema.Set)) {
+			if slices.Contains(members, v) {
+				userIDs = append(userIDs, v)
+			}
+		}
+	}
+
 	log.Printf("[INFO] Deleting ElastiCache User Group Association: %s", d.Id())
-	userGroupID, userID := parts[0], parts[1]
-	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, 10*time.Minute, func() (interface{}, error) {
-		return conn.ModifyUserGroupWithContext(ctx, &elasticache.ModifyUserGroupInput{
-			UserGroupId:     aws.String(userGroupID),
-			UserIdsToRemove: aws.StringSlice([]string{userID}),
-		})
-	}, elasticache.ErrCodeInvalidUserGroupStateFault)
+	err = modifyUserGroupUsers(ctx, conn, userGroupID, nil, userIDs, sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
+
+	if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeUserGroupNotFoundFault) {
+		return diags
+	}
 
-	if tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidParameterValueException, "not a member") {
+	if userID != "" && tfawserr.ErrMessageContains(err, elasticache.ErrCodeInvalidParameterValueException, "not a member") {
 		return diags
 	}
 
@@ -134,11 +224,145 @@ func resourceUserGroupAssociationDelete(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "deleting ElastiCache User Group Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitUserGroupUpdated(ctx, conn, userGroupID, d.Timeout(schema.TimeoutDelete)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache User Group Association (%s) delete: %s", d.Id(), err)
+	return diags
+}
+
+// resourceUserGroupAssociationCustomizeDiff validates that the users being associated can be members
+// of the user group at the same time. Users that do not yet exist are not validated.
+func resourceUserGroupAssociationCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("user_group_id") || !diff.NewValueKnown("user_id") || !diff.NewValueKnown("user_ids") {
+		return nil
 	}
 
-	return diags
+	if diff.Id() != "" && !diff.HasChanges("user_id", "user_ids") {
+		return nil
+	}
+
+	var add, del []string
+	if v, ok := diff.GetOk("user_id"); ok {
+		add = []string{v.(string)}
+		if o, _ := diff.GetChange("user_id"); o.(string) != "" {
+			del = []string{o.(string)}
+		}
+	} else {
+		o, n := diff.GetChange("user_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del = flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+	}
+
+	if len(add) == 0 {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).ElastiCacheConn(ctx)
+
+	users, err := findUsers(ctx, conn, &elasticache.DescribeUsersInput{}, tfslices.PredicateTrue[*elasticache.User]())
+
+	if err != nil {
+		return fmt.Errorf("reading ElastiCache Users: %w", err)
+	}
+
+	usersByID := make(map[string]*elasticache.User)
+	for _, user := range users {
+		usersByID[aws.StringValue(user.UserId)] = user
+	}
+
+	var members []string
+
+	userGroupID := diff.Get("user_group_id").(string)
+	userGroup, err := findUserGroupByID(ctx, conn, userGroupID)
+
+	switch {
+	case tfresource.NotFound(err):
+	case err != nil:
+		return fmt.Errorf("reading ElastiCache User Group (%s): %w", userGroupID, err)
+	default:
+		members = tfslices.RemoveAll(aws.StringValueSlice(userGroup.UserIds), del...)
+	}
+
+	var groupUsers []*elasticache.User
+	for _, userID := range tfslices.AppendUnique(members, add...) {
+		if user, ok := usersByID[userID]; ok {
+			groupUsers = append(groupUsers, user)
+		}
+	}
+
+	return validateUserGroupUsers(groupUsers)
+}
+
+// validateUserGroupUsers validates that the specified users can be members of the same user group.
+// Users with IAM authentication must have a user ID equal to their user name, and a user group cannot contain
+// more than one user with the same user name, e.g. an IAM-authenticated and a password-authenticated user.
+func validateUserGroupUsers(users []*elasticache.User) error {
+	userIDsByName := make(map[string]string)
+
+	for _, user := range users {
+		userID, userName := aws.StringValue(user.UserId), aws.StringValue(user.UserName)
+
+		if v := user.Authentication; v != nil && aws.StringValue(v.Type) == elasticache.AuthenticationTypeIam && userID != userName {
+			return fmt.Errorf("ElastiCache User (%s) uses IAM authentication and its user ID must match its user name (%s)", userID, userName)
+		}
+
+		if v, ok := userIDsByName[userName]; ok {
+			return fmt.Errorf("ElastiCache Users (%s) and (%s) have the same user name (%s) and cannot be members of the same user group", v, userID, userName)
+		}
+
+		userIDsByName[userName] = userID
+	}
+
+	return nil
+}
+
+// modifyUserGroupUsers adds users to and removes users from a user group in batches,
+// waiting for the user group to become active after each ModifyUserGroup call.
+// Users are removed before any are added.
+func modifyUserGroupUsers(ctx context.Context, conn *elasticache.ElastiCache, userGroupID string, add, del []string, timeout time.Duration) error {
+	inputs := make([]*elasticache.ModifyUserGroupInput, 0)
+
+	for _, chunk := range tfslices.Chunks(del, userGroupAssociationModifyBatchSize) {
+		inputs = append(inputs, &elasticache.ModifyUserGroupInput{
+			UserGroupId:     aws.String(userGroupID),
+			UserIdsToRemove: aws.StringSlice(chunk),
+		})
+	}
+
+	for _, chunk := range tfslices.Chunks(add, userGroupAssociationModifyBatchSize) {
+		inputs = append(inputs, &elasticache.ModifyUserGroupInput{
+			UserGroupId:  aws.String(userGroupID),
+			UserIdsToAdd: aws.StringSlice(chunk),
+		})
+	}
+
+	for _, input := range inputs {
+		_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			return conn.ModifyUserGroupWithContext(ctx, input)
+		}, elasticache.ErrCodeInvalidUserGroupStateFault)
+
+		if err != nil {
+			return err
+		}
+
+		if _, err := waitUserGroupUpdated(ctx, conn, userGroupID, timeout); err != nil {
+			return fmt.Errorf("waiting for ElastiCache User Group (%s) update: %w", userGroupID, err)
+		}
+	}
+
+	return nil
+}
+
+// userGroupAssociationParseResourceID parses a resource ID of the form "user-group-id,user-id" or,
+// for associations of multiple users, "user-group-id".
+func userGroupAssociationParseResourceID(id string) (string, string, error) {
+	if !strings.Contains(id, ",") {
+		return id, "", nil
+	}
+
+	parts, err := flex.ExpandResourceId(id, userGroupAssociationResourceIDPartCount, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
 }
 
 func findUserGroupAssociationByTwoPartKey(ctx context.Context, conn *elasticache.ElastiCache, userGroupID, userID string) error {
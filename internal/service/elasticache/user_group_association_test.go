@@ -6,8 +6,11 @@ package elasticache_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -138,6 +141,78 @@ func TestAccElastiCacheUserGroupAssociation_multiple(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheUserGroupAssociation_userIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_user_group_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserGroupAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupAssociationConfig_userIDs1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserGroupAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrID, rName),
+					resource.TestCheckNoResourceAttr(resourceName, "user_id"),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_ids.*", fmt.Sprintf("%s-2", rName)),
+					resource.TestCheckResourceAttr(resourceName, "user_group_id", rName),
+				),
+			},
+			{
+				Config: testAccUserGroupAssociationConfig_userIDs2(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserGroupAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_ids.*", fmt.Sprintf("%s-2", rName)),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_ids.*", fmt.Sprintf("%s-3", rName)),
+				),
+			},
+			{
+				Config: testAccUserGroupAssociationConfig_userIDs1(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserGroupAssociationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "user_ids.*", fmt.Sprintf("%s-2", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccElastiCacheUserGroupAssociation_duplicateUserName(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserGroupAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupAssociationConfig_preDuplicateUserName(rName),
+			},
+			{
+				Config:      testAccUserGroupAssociationConfig_duplicateUserName(rName),
+				ExpectError: regexache.MustCompile(`have the same user name \(username1\)`),
+			},
+		},
+	})
+}
+
 func testAccCheckUserGroupAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn(ctx)
@@ -147,17 +222,19 @@ func testAccCheckUserGroupAssociationDestroy(ctx context.Context) resource.TestC
 				continue
 			}
 
-			err := tfelasticache.FindUserGroupAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["user_group_id"], rs.Primary.Attributes["user_id"])
+			for _, userID := range testAccUserGroupAssociationUserIDs(rs) {
+				err := tfelasticache.FindUserGroupAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["user_group_id"], userID)
 
-			if tfresource.NotFound(err) {
-				continue
-			}
+				if tfresource.NotFound(err) {
+					continue
+				}
 
-			if err != nil {
-				return err
-			}
+				if err != nil {
+					return err
+				}
 
-			return fmt.Errorf("ElastiCache User Group Association (%s) still exists", rs.Primary.ID)
+				return fmt.Errorf("ElastiCache User Group Association (%s) still exists", rs.Primary.ID)
+			}
 		}
 
 		return nil
@@ -173,10 +250,30 @@ func testAccCheckUserGroupAssociationExists(ctx context.Context, n string) resou
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheConn(ctx)
 
-		err := tfelasticache.FindUserGroupAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["user_group_id"], rs.Primary.Attributes["user_id"])
+		for _, userID := range testAccUserGroupAssociationUserIDs(rs) {
+			if err := tfelasticache.FindUserGroupAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["user_group_id"], userID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
 
-		return err
+func testAccUserGroupAssociationUserIDs(rs *terraform.ResourceState) []string {
+	if v := rs.Primary.Attributes["user_id"]; v != "" {
+		return []string{v}
 	}
+
+	var userIDs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "user_ids.") && k != "user_ids.#" {
+			userIDs = append(userIDs, v)
+		}
+	}
+
+	return userIDs
 }
 
 func testAccUserGroupAssociationConfig_base(rName string) string {
@@ -287,3 +384,42 @@ resource "aws_elasticache_user_group_association" "test2" {
 }
 `, rName))
 }
+
+func testAccUserGroupAssociationConfig_userIDs1(rName string) string {
+	return acctest.ConfigCompose(testAccUserGroupAssociationConfig_preMultiple(rName), `
+resource "aws_elasticache_user_group_association" "test" {
+  user_group_id = aws_elasticache_user_group.test.user_group_id
+  user_ids      = [aws_elasticache_user.test2.user_id]
+}
+`)
+}
+
+func testAccUserGroupAssociationConfig_userIDs2(rName string) string {
+	return acctest.ConfigCompose(testAccUserGroupAssociationConfig_preMultiple(rName), `
+resource "aws_elasticache_user_group_association" "test" {
+  user_group_id = aws_elasticache_user_group.test.user_group_id
+  user_ids      = [aws_elasticache_user.test2.user_id, aws_elasticache_user.test3.user_id]
+}
+`)
+}
+
+func testAccUserGroupAssociationConfig_preDuplicateUserName(rName string) string {
+	return acctest.ConfigCompose(testAccUserGroupAssociationConfig_base(rName), fmt.Sprintf(`
+resource "aws_elasticache_user" "test3" {
+  user_id       = "%[1]s-3"
+  user_name     = "username1"
+  access_string = "on ~app::* -@all +@read +@hash +@bitmap +@geo -setbit -bitfield -hset -hsetnx -hmset -hincrby -hincrbyfloat -hdel -bitop -geoadd -georadius -georadiusbymember"
+  engine        = "REDIS"
+  passwords     = ["password123456789"]
+}
+`, rName))
+}
+
+func testAccUserGroupAssociationConfig_duplicateUserName(rName string) string {
+	return acctest.ConfigCompose(testAccUserGroupAssociationConfig_preDuplicateUserName(rName), `
+resource "aws_elasticache_user_group_association" "test" {
+  user_group_id = aws_elasticache_user_group.test.user_group_id
+  user_ids      = [aws_elasticache_user.test2.user_id, aws_elasticache_user.test3.user_id]
+}
+`)
+}
@@ -0,0 +1,230 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticbeanstalk
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	environmentCNAMESwapResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_elastic_beanstalk_environment_cname_swap", name="Environment CNAME Swap")
+func resourceEnvironmentCNAMESwap() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceEnvironmentCNAMESwapCreate,
+		ReadWithoutTimeout:   resourceEnvironmentCNAMESwapRead,
+		DeleteWithoutTimeout: resourceEnvironmentCNAMESwapDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_environment_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destination_environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"required_health": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      awstypes.EnvironmentHealthGreen,
+				ValidateFunc: validation.StringInSlice(enum.Slice(awstypes.EnvironmentHealthGreen, awstypes.EnvironmentHealthYellow), false),
+			},
+			"source_environment_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEnvironmentCNAMESwapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkClient(ctx)
+
+	sourceEnvironmentID := d.Get("source_environment_id").(string)
+	destinationEnvironmentID := d.Get("destination_environment_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{sourceEnvironmentID, destinationEnvironmentID}, environmentCNAMESwapResourceIDPartCount, false))
+	timeout := sdkv2.Timeout(ctx, d, schema.TimeoutCreate)
+	const (
+		pollInterval = 20 * time.Second
+	)
+
+	source, err := waitEnvironmentReady(ctx, conn, sourceEnvironmentID, pollInterval, timeout)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) ready: %s", sourceEnvironmentID, err)
+	}
+
+	if _, err := waitEnvironmentReady(ctx, conn, destinationEnvironmentID, pollInterval, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) ready: %s", destinationEnvironmentID, err)
+	}
+
+	// The destination environment receives the source environment's CNAME and so must be healthy before the swap.
+	requiredHealth := awstypes.EnvironmentHealth(d.Get("required_health").(string))
+	if _, err := waitEnvironmentHealthy(ctx, conn, destinationEnvironmentID, requiredHealth, pollInterval, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment (%s) health: %s", destinationEnvironmentID, err)
+	}
+
+	input := &elasticbeanstalk.SwapEnvironmentCNAMEsInput{
+		DestinationEnvironmentId: aws.String(destinationEnvironmentID),
+		SourceEnvironmentId:      aws.String(sourceEnvironmentID),
+	}
+
+	_, err = conn.SwapEnvironmentCNAMEs(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk Environment CNAME Swap (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitEnvironmentReady(ctx, conn, sourceEnvironmentID, pollInterval, timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment CNAME Swap (%s) create: %s", d.Id(), err)
+	}
+
+	destination, err := waitEnvironmentReady(ctx, conn, destinationEnvironmentID, pollInterval, timeout)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Elastic Beanstalk Environment CNAME Swap (%s) create: %s", d.Id(), err)
+	}
+
+	if got, want := aws.ToString(destination.CNAME), aws.ToString(source.CNAME); got != want {
+		return sdkdiag.AppendErrorf(diags, "creating Elastic Beanstalk Environment CNAME Swap (%s): destination environment CNAME (%s) is not the source environment's previous CNAME (%s)", d.Id(), got, want)
+	}
+
+	return append(diags, resourceEnvironmentCNAMESwapRead(ctx, d, meta)...)
+}
+
+func resourceEnvironmentCNAMESwapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), environmentCNAMESwapResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	sourceEnvironmentID, destinationEnvironmentID := parts[0], parts[1]
+
+	source, err := FindEnvironmentByID(ctx, conn, sourceEnvironmentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elastic Beanstalk Environment CNAME Swap (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment CNAME Swap (%s): %s", d.Id(), err)
+	}
+
+	destination, err := FindEnvironmentByID(ctx, conn, destinationEnvironmentID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Elastic Beanstalk Environment CNAME Swap (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment CNAME Swap (%s): %s", d.Id(), err)
+	}
+
+	d.Set("destination_environment_cname", destination.CNAME)
+	d.Set("destination_environment_id", destination.EnvironmentId)
+	d.Set("source_environment_cname", source.CNAME)
+	d.Set("source_environment_id", source.EnvironmentId)
+
+	return diags
+}
+
+func resourceEnvironmentCNAMESwapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	log.Printf("[WARN] Elastic Beanstalk Environment CNAME Swap (%s) removed from state; environment CNAMEs are not swapped back", d.Id())
+
+	return diags
+}
+
+func statusEnvironmentHealth(ctx context.Context, conn *elasticbeanstalk.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindEnvironmentByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Health), nil
+	}
+}
+
+// waitEnvironmentHealthy waits for an environment's health to reach at least the specified health.
+// Environment health is coarse-grained: Green is better than Yellow, which is better than Red. Grey is unknown.
+func waitEnvironmentHealthy(ctx context.Context, conn *elasticbeanstalk.Client, id string, health awstypes.EnvironmentHealth, pollInterval, timeout time.Duration) (*awstypes.EnvironmentDescription, error) {
+	var pending, target []string
+
+	switch health {
+	case awstypes.EnvironmentHealthGreen:
+		pending = enum.Slice(awstypes.EnvironmentHealthGrey, awstypes.EnvironmentHealthRed, awstypes.EnvironmentHealthYellow)
+		target = enum.Slice(awstypes.EnvironmentHealthGreen)
+	case awstypes.EnvironmentHealthYellow:
+		pending = enum.Slice(awstypes.EnvironmentHealthGrey, awstypes.EnvironmentHealthRed)
+		target = enum.Slice(awstypes.EnvironmentHealthGreen, awstypes.EnvironmentHealthYellow)
+	default:
+		return nil, fmt.Errorf("unsupported required health: %s", health)
+	}
+
+	stateConf := &retry.StateChangeConf{
+		Pending:                   pending,
+		Target:                    target,
+		Refresh:                   statusEnvironmentHealth(ctx, conn, id),
+		Timeout:                   timeout,
+		PollInterval:              pollInterval,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.EnvironmentDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
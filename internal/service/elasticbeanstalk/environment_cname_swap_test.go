@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticbeanstalk_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticBeanstalkEnvironmentCNAMESwap_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elastic_beanstalk_environment_cname_swap.test"
+	blueResourceName := "aws_elastic_beanstalk_environment.test"
+	greenResourceName := "aws_elastic_beanstalk_environment.green"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentCNAMESwapConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "source_environment_id", blueResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_environment_id", greenResourceName, names.AttrID),
+					// Environment resource state is from before the swap.
+					resource.TestCheckResourceAttrPair(resourceName, "destination_environment_cname", blueResourceName, "cname"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_environment_cname", greenResourceName, "cname"),
+					resource.TestCheckResourceAttr(resourceName, "required_health", "Green"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentCNAMESwapConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), fmt.Sprintf(`
+resource "aws_elastic_beanstalk_environment" "green" {
+  application         = aws_elastic_beanstalk_application.test.name
+  name                = "%[1]s-green"
+  solution_stack_name = data.aws_elastic_beanstalk_solution_stack.test.name
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "VPCId"
+    value     = aws_vpc.test.id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "Subnets"
+    value     = aws_subnet.test[0].id
+  }
+
+  setting {
+    namespace = "aws:ec2:vpc"
+    name      = "AssociatePublicIpAddress"
+    value     = "true"
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "SecurityGroups"
+    value     = aws_security_group.test.id
+  }
+
+  setting {
+    namespace = "aws:autoscaling:launchconfiguration"
+    name      = "IamInstanceProfile"
+    value     = aws_iam_instance_profile.test.name
+  }
+
+  setting {
+    namespace = "aws:elasticbeanstalk:environment"
+    name      = "ServiceRole"
+    value     = aws_iam_role.service_role.name
+  }
+}
+
+resource "aws_elastic_beanstalk_environment_cname_swap" "test" {
+  source_environment_id      = aws_elastic_beanstalk_environment.test.id
+  destination_environment_id = aws_elastic_beanstalk_environment.green.id
+}
+`, rName))
+}
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticbeanstalk
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_elastic_beanstalk_environment_managed_actions", name="Environment Managed Actions")
+func dataSourceEnvironmentManagedActions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEnvironmentManagedActionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"environment_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"managed_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"action_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"window_start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ActionStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceEnvironmentManagedActionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElasticBeanstalkClient(ctx)
+
+	environmentID := d.Get("environment_id").(string)
+	input := &elasticbeanstalk.DescribeEnvironmentManagedActionsInput{
+		EnvironmentId: aws.String(environmentID),
+	}
+
+	if v, ok := d.GetOk(names.AttrStatus); ok {
+		input.Status = awstypes.ActionStatus(v.(string))
+	}
+
+	output, err := findEnvironmentManagedActions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Elastic Beanstalk Environment (%s) managed actions: %s", environmentID, err)
+	}
+
+	d.SetId(environmentID)
+	if err := d.Set("managed_actions", flattenManagedActions(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting managed_actions: %s", err)
+	}
+
+	return diags
+}
+
+func findEnvironmentManagedActions(ctx context.Context, conn *elasticbeanstalk.Client, input *elasticbeanstalk.DescribeEnvironmentManagedActionsInput) ([]awstypes.ManagedAction, error) {
+	output, err := conn.DescribeEnvironmentManagedActions(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, nil
+	}
+
+	return output.ManagedActions, nil
+}
+
+func flattenManagedActions(apiObjects []awstypes.ManagedAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"action_description": aws.ToString(apiObject.ActionDescription),
+			"action_id":          aws.ToString(apiObject.ActionId),
+			"action_type":        string(apiObject.ActionType),
+			names.AttrStatus:     string(apiObject.Status),
+		}
+
+		if v := apiObject.WindowStartTime; v != nil {
+			tfMap["window_start_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticbeanstalk_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElasticBeanstalkEnvironmentManagedActionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_elastic_beanstalk_environment_managed_actions.test"
+	resourceName := "aws_elastic_beanstalk_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElasticBeanstalkServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentManagedActionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "environment_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "managed_actions.#"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "Scheduled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentManagedActionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), `
+data "aws_elastic_beanstalk_environment_managed_actions" "test" {
+  environment_id = aws_elastic_beanstalk_environment.test.id
+  status         = "Scheduled"
+}
+`)
+}
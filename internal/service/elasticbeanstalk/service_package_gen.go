@@ -28,6 +28,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceApplication,
 			TypeName: "aws_elastic_beanstalk_application",
 		},
+		{
+			Factory:  dataSourceEnvironmentManagedActions,
+			TypeName: "aws_elastic_beanstalk_environment_managed_actions",
+			Name:     "Environment Managed Actions",
+		},
 		{
 			Factory:  DataSourceHostedZone,
 			TypeName: "aws_elastic_beanstalk_hosted_zone",
@@ -69,6 +74,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceEnvironmentCNAMESwap,
+			TypeName: "aws_elastic_beanstalk_environment_cname_swap",
+			Name:     "Environment CNAME Swap",
+		},
 	}
 }
 
@@ -348,7 +348,7 @@ func DataSourceDomain() *schema.Resource {
 func dataSourceDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ElasticsearchConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	ds, err := FindDomainByName(ctx, conn, d.Get(names.AttrDomainName).(string))
 	if err != nil {
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -460,13 +461,13 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.SslPolicy = aws.String(v.(string))
 	}
 
-	output, err := retryListenerCreate(ctx, conn, input, d.Timeout(schema.TimeoutCreate))
+	output, err := retryListenerCreate(ctx, conn, input, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
 	if input.Tags != nil && errs.IsUnsupportedOperationInPartitionError(meta.(*conns.AWSClient).Partition, err) {
 		input.Tags = nil
 
-		output, err = retryListenerCreate(ctx, conn, input, d.Timeout(schema.TimeoutCreate))
+		output, err = retryListenerCreate(ctx, conn, input, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 	}
 
 	// Tags are not supported on creation with some load balancer types (i.e. Gateway)
@@ -474,7 +475,7 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 	if input.Tags != nil && tfawserr.ErrMessageContains(err, errCodeValidationError, tagsOnCreationErrMessage) {
 		input.Tags = nil
 
-		output, err = retryListenerCreate(ctx, conn, input, d.Timeout(schema.TimeoutCreate))
+		output, err = retryListenerCreate(ctx, conn, input, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 	}
 
 	if err != nil {
@@ -483,7 +484,7 @@ func resourceListenerCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.ToString(output.Listeners[0].ListenerArn))
 
-	_, err = tfresource.RetryWhenNotFound(ctx, d.Timeout(schema.TimeoutCreate), func() (interface{}, error) {
+	_, err = tfresource.RetryWhenNotFound(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() (interface{}, error) {
 		return findListenerByARN(ctx, conn, d.Id())
 	})
 
@@ -590,7 +591,7 @@ func resourceListenerUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			input.SslPolicy = aws.String(v.(string))
 		}
 
-		_, err := tfresource.RetryWhenIsA[*awstypes.CertificateNotFoundException](ctx, d.Timeout(schema.TimeoutUpdate), func() (interface{}, error) {
+		_, err := tfresource.RetryWhenIsA[*awstypes.CertificateNotFoundException](ctx, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), func() (interface{}, error) {
 			return conn.ModifyListener(ctx, input)
 		})
 
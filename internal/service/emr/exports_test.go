@@ -12,6 +12,7 @@ var (
 	ResourceManagedScalingPolicy           = resourceManagedScalingPolicy
 	ResourceSecurityConfiguration          = resourceSecurityConfiguration
 	ResourceStudio                         = resourceStudio
+	ResourceStudioGroupSessionMappings     = resourceStudioGroupSessionMappings
 	ResourceStudioSessionMapping           = resourceStudioSessionMapping
 
 	FetchInstanceGroup                 = fetchInstanceGroup
@@ -21,4 +22,5 @@ var (
 	FindSecurityConfigurationByName    = findSecurityConfigurationByName
 	FindStudioByID                     = findStudioByID
 	FindStudioSessionMappingByIDOrName = findStudioSessionMappingByIDOrName
+	FindStudioUserSessionMapping       = findStudioUserSessionMapping
 )
@@ -84,6 +84,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceStudioGroupSessionMappings,
+			TypeName: "aws_emr_studio_group_session_mappings",
+			Name:     "Studio Group Session Mappings",
+		},
 		{
 			Factory:  resourceStudioSessionMapping,
 			TypeName: "aws_emr_studio_session_mapping",
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/service/identitystore"
+	identitystoretypes "github.com/aws/aws-sdk-go-v2/service/identitystore/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/emr"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_emr_studio_group_session_mappings", name="Studio Group Session Mappings")
+func resourceStudioGroupSessionMappings() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStudioGroupSessionMappingsCreate,
+		ReadWithoutTimeout:   resourceStudioGroupSessionMappingsRead,
+		UpdateWithoutTimeout: resourceStudioGroupSessionMappingsUpdate,
+		DeleteWithoutTimeout: resourceStudioGroupSessionMappingsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceStudioGroupSessionMappingsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"identity_store_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"session_policy_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"studio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"user_ids": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceStudioGroupSessionMappingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	studioID := d.Get("studio_id").(string)
+	identityStoreID := d.Get("identity_store_id").(string)
+	groupID := d.Get("group_id").(string)
+	id, err := flex.FlattenResourceId([]string{studioID, identityStoreID, groupID}, studioGroupSessionMappingsResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	userIDs, err := findIdentityStoreGroupUserIDs(ctx, meta.(*conns.AWSClient).IdentityStoreClient(ctx), identityStoreID, groupID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EMR Studio Group Session Mappings (%s): reading Identity Store Group members: %s", id, err)
+	}
+
+	sessionPolicyARN := d.Get("session_policy_arn").(string)
+
+	for _, userID := range userIDs {
+		if err := putStudioUserSessionMapping(ctx, conn, studioID, userID, sessionPolicyARN); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EMR Studio Group Session Mappings (%s): %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceStudioGroupSessionMappingsRead(ctx, d, meta)...)
+}
+
+func resourceStudioGroupSessionMappingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), studioGroupSessionMappingsResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	studioID, identityStoreID, groupID := parts[0], parts[1], parts[2]
+	_, err = findStudioByID(ctx, conn, studioID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Studio Group Session Mappings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Studio Group Session Mappings (%s): %s", d.Id(), err)
+	}
+
+	members, err := findIdentityStoreGroupUserIDs(ctx, meta.(*conns.AWSClient).IdentityStoreClient(ctx), identityStoreID, groupID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Studio Group Session Mappings (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Studio Group Session Mappings (%s): reading Identity Store Group members: %s", d.Id(), err)
+	}
+
+	// Users removed from the group keep their session mappings until the next apply.
+	candidates := d.Get("user_ids").(*schema.Set)
+	for _, v := range members {
+		candidates.Add(v)
+	}
+
+	sessionPolicyARN := d.Get("session_policy_arn").(string)
+	var userIDs []string
+
+	for _, userID := range flex.ExpandStringValueSet(candidates) {
+		mapping, err := findStudioUserSessionMapping(ctx, conn, studioID, userID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading EMR Studio Group Session Mappings (%s): %s", d.Id(), err)
+		}
+
+		// On import, adopt the session policy of the first mapping found.
+		if sessionPolicyARN == "" {
+			sessionPolicyARN = aws.StringValue(mapping.SessionPolicyArn)
+		}
+
+		// Mappings with a different session policy show up as drift.
+		if aws.StringValue(mapping.SessionPolicyArn) == sessionPolicyARN {
+			userIDs = append(userIDs, userID)
+		}
+	}
+
+	d.Set("group_id", groupID)
+	d.Set("identity_store_id", identityStoreID)
+	d.Set("session_policy_arn", sessionPolicyARN)
+	d.Set("studio_id", studioID)
+	d.Set("user_ids", userIDs)
+
+	return diags
+}
+
+func resourceStudioGroupSessionMappingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	studioID := d.Get("studio_id").(string)
+	sessionPolicyARN := d.Get("session_policy_arn").(string)
+	o, n := d.GetChange("user_ids")
+	os, ns := o.(*schema.Set), n.(*schema.Set)
+
+	put := ns.Difference(os)
+	if d.HasChange("session_policy_arn") {
+		put = ns
+	}
+
+	for _, userID := range flex.ExpandStringValueSet(put) {
+		if err := putStudioUserSessionMapping(ctx, conn, studioID, userID, sessionPolicyARN); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Studio Group Session Mappings (%s): %s", d.Id(), err)
+		}
+	}
+
+	for _, userID := range flex.ExpandStringValueSet(os.Difference(ns)) {
+		if err := deleteStudioUserSessionMapping(ctx, conn, studioID, userID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EMR Studio Group Session Mappings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceStudioGroupSessionMappingsRead(ctx, d, meta)...)
+}
+
+func resourceStudioGroupSessionMappingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRConn(ctx)
+
+	studioID := d.Get("studio_id").(string)
+
+	log.Printf("[INFO] Deleting EMR Studio Group Session Mappings: %s", d.Id())
+	for _, userID := range flex.ExpandStringValueSet(d.Get("user_ids").(*schema.Set)) {
+		err := deleteStudioUserSessionMapping(ctx, conn, studioID, userID)
+
+		if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio does not exist") {
+			return diags
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting EMR Studio Group Session Mappings (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+// resourceStudioGroupSessionMappingsCustomizeDiff plans session mappings for the current members of the Identity Store group.
+func resourceStudioGroupSessionMappingsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || d.HasChanges("group_id", "identity_store_id", "studio_id") {
+		return nil
+	}
+
+	members, err := findIdentityStoreGroupUserIDs(ctx, meta.(*conns.AWSClient).IdentityStoreClient(ctx), d.Get("identity_store_id").(string), d.Get("group_id").(string))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading Identity Store Group members: %w", err)
+	}
+
+	if ns := flex.FlattenStringValueSet(members); !ns.Equal(d.Get("user_ids").(*schema.Set)) {
+		return d.SetNew("user_ids", ns)
+	}
+
+	return nil
+}
+
+const (
+	studioGroupSessionMappingsResourceIDPartCount = 3
+)
+
+// putStudioUserSessionMapping creates or updates the specified user's session mapping.
+func putStudioUserSessionMapping(ctx context.Context, conn *emr.EMR, studioID, userID, sessionPolicyARN string) error {
+	mapping, err := findStudioUserSessionMapping(ctx, conn, studioID, userID)
+
+	switch {
+	case tfresource.NotFound(err):
+		input := &emr.CreateStudioSessionMappingInput{
+			IdentityId:       aws.String(userID),
+			IdentityType:     aws.String(emr.IdentityTypeUser),
+			SessionPolicyArn: aws.String(sessionPolicyARN),
+			StudioId:         aws.String(studioID),
+		}
+
+		if _, err := conn.CreateStudioSessionMappingWithContext(ctx, input); err != nil {
+			return fmt.Errorf("creating session mapping for user (%s): %w", userID, err)
+		}
+	case err != nil:
+		return fmt.Errorf("reading session mapping for user (%s): %w", userID, err)
+	case aws.StringValue(mapping.SessionPolicyArn) != sessionPolicyARN:
+		input := &emr.UpdateStudioSessionMappingInput{
+			IdentityId:       aws.String(userID),
+			IdentityType:     aws.String(emr.IdentityTypeUser),
+			SessionPolicyArn: aws.String(sessionPolicyARN),
+			StudioId:         aws.String(studioID),
+		}
+
+		if _, err := conn.UpdateStudioSessionMappingWithContext(ctx, input); err != nil {
+			return fmt.Errorf("updating session mapping for user (%s): %w", userID, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteStudioUserSessionMapping(ctx context.Context, conn *emr.EMR, studioID, userID string) error {
+	input := &emr.DeleteStudioSessionMappingInput{
+		IdentityId:   aws.String(userID),
+		IdentityType: aws.String(emr.IdentityTypeUser),
+		StudioId:     aws.String(studioID),
+	}
+
+	_, err := conn.DeleteStudioSessionMappingWithContext(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, emr.ErrCodeInvalidRequestException, "Studio session mapping does not exist") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("deleting session mapping for user (%s): %w", userID, err)
+	}
+
+	return nil
+}
+
+func findStudioUserSessionMapping(ctx context.Context, conn *emr.EMR, studioID, userID string) (*emr.SessionMappingDetail, error) {
+	return findStudioSessionMappingByIDOrName(ctx, conn, fmt.Sprintf("%s:%s:%s", studioID, emr.IdentityTypeUser, userID))
+}
+
+// findIdentityStoreGroupUserIDs returns the IDs of the users that are members of the specified Identity Store group.
+func findIdentityStoreGroupUserIDs(ctx context.Context, conn *identitystore.Client, identityStoreID, groupID string) ([]string, error) {
+	input := &identitystore.ListGroupMembershipsInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+	}
+	var output []string
+
+	pages := identitystore.NewListGroupMembershipsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*identitystoretypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GroupMemberships {
+			if v, ok := v.MemberId.(*identitystoretypes.MemberIdMemberUserId); ok {
+				output = append(output, v.Value)
+			}
+		}
+	}
+
+	return output, nil
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emr_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfemr "github.com/hashicorp/terraform-provider-aws/internal/service/emr"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRStudioGroupSessionMappings_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_emr_studio_group_session_mappings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioGroupSessionMappingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioGroupSessionMappingsConfig_basic(rName, 1, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioGroupSessionMappingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "aws_identitystore_group.test", "group_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "session_policy_arn", "aws_iam_policy.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_id", "aws_emr_studio.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_ids.*", "aws_identitystore_user.test.0", "user_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// Group membership changes are planned on the next run.
+				Config: testAccStudioGroupSessionMappingsConfig_basic(rName, 2, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioGroupSessionMappingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "session_policy_arn", "aws_iam_policy.test2", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", acctest.Ct1),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStudioGroupSessionMappingsConfig_basic(rName, 2, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioGroupSessionMappingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_ids.*", "aws_identitystore_user.test.0", "user_id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "user_ids.*", "aws_identitystore_user.test.1", "user_id"),
+				),
+			},
+			{
+				Config: testAccStudioGroupSessionMappingsConfig_basic(rName, 1, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioGroupSessionMappingsExists(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccStudioGroupSessionMappingsConfig_basic(rName, 1, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioGroupSessionMappingsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "user_ids.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRStudioGroupSessionMappings_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_emr_studio_group_session_mappings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.IdentityStoreEndpointID)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioGroupSessionMappingsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioGroupSessionMappingsConfig_basic(rName, 1, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioGroupSessionMappingsExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfemr.ResourceStudio(), "aws_emr_studio.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStudioGroupSessionMappingsExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn(ctx)
+
+		for k, v := range rs.Primary.Attributes {
+			if !strings.HasPrefix(k, "user_ids.") || k == "user_ids.#" {
+				continue
+			}
+
+			if _, err := tfemr.FindStudioUserSessionMapping(ctx, conn, rs.Primary.Attributes["studio_id"], v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckStudioGroupSessionMappingsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_emr_studio_group_session_mappings" {
+				continue
+			}
+
+			for k, v := range rs.Primary.Attributes {
+				if !strings.HasPrefix(k, "user_ids.") || k == "user_ids.#" {
+					continue
+				}
+
+				_, err := tfemr.FindStudioUserSessionMapping(ctx, conn, rs.Primary.Attributes["studio_id"], v)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("EMR Studio Group Session Mappings %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccStudioGroupSessionMappingsConfig_basic(rName string, userCount int, policy string) string {
+	return acctest.ConfigCompose(testAccStudioSessionMappingConfigBase(rName), fmt.Sprintf(`
+data "aws_ssoadmin_instances" "test" {}
+
+resource "aws_identitystore_group" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  display_name      = %[1]q
+  description       = "Acceptance Test"
+}
+
+resource "aws_identitystore_user" "test" {
+  count = %[2]d
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  display_name = "Acceptance Test"
+  user_name    = "%[1]s-${count.index}"
+
+  name {
+    family_name = "Doe"
+    given_name  = "John"
+  }
+}
+
+resource "aws_identitystore_group_membership" "test" {
+  count = %[2]d
+
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  group_id  = aws_identitystore_group.test.group_id
+  member_id = aws_identitystore_user.test[count.index].user_id
+}
+
+resource "aws_iam_policy" "test2" {
+  name   = "%[1]s-2"
+  policy = aws_iam_policy.test.policy
+}
+
+resource "aws_emr_studio_group_session_mappings" "test" {
+  studio_id          = aws_emr_studio.test.id
+  identity_store_id  = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+  group_id           = aws_identitystore_group.test.group_id
+  session_policy_arn = aws_iam_policy.%[3]s.arn
+
+  depends_on = [aws_identitystore_group_membership.test]
+}
+`, rName, userCount, policy))
+}
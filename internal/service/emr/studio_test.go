@@ -112,6 +112,41 @@ func TestAccEMRStudio_iam(t *testing.T) {
 	})
 }
 
+func TestAccEMRStudio_encryptionKeyARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var studio emr.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStudioDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStudioConfig_encryptionKeyARN(rName, "test"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioExists(ctx, resourceName, &studio),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key_arn", "aws_kms_key.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStudioConfig_encryptionKeyARN(rName, "test2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStudioExists(ctx, resourceName, &studio),
+					resource.TestCheckResourceAttrPair(resourceName, "encryption_key_arn", "aws_kms_key.test2", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEMRStudio_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var studio emr.Studio
@@ -322,6 +357,34 @@ resource "aws_emr_studio" "test" {
 `, rName))
 }
 
+func testAccStudioConfig_encryptionKeyARN(rName, key string) string {
+	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_kms_key" "test2" {
+  description             = "%[1]s-2"
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "IAM"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/test"
+  encryption_key_arn          = aws_kms_key.%[2]s.arn
+  engine_security_group_id    = aws_security_group.test.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = aws_subnet.test[*].id
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.test.id
+}
+`, rName, key))
+}
+
 func testAccStudioConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccStudioConfig_base(rName), fmt.Sprintf(`
 resource "aws_emr_studio" "test" {
@@ -86,7 +86,7 @@ func dataSourceVirtualClusterRead(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).EMRContainersConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	id := d.Get("virtual_cluster_id").(string)
 	vc, err := FindVirtualClusterByID(ctx, conn, id)
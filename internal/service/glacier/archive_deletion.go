@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKResource("aws_glacier_archive_deletion", name="Archive Deletion")
+func resourceArchiveDeletion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceArchiveDeletionCreate,
+		ReadWithoutTimeout:   schema.NoopContext,
+		DeleteWithoutTimeout: resourceArchiveDeletionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"archive_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceArchiveDeletionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName, archiveID := d.Get("vault_name").(string), d.Get("archive_id").(string)
+	id := archiveDeletionCreateResourceID(vaultName, archiveID)
+	input := &glacier.DeleteArchiveInput{
+		AccountId: aws.String("-"),
+		ArchiveId: aws.String(archiveID),
+		VaultName: aws.String(vaultName),
+	}
+
+	log.Printf("[DEBUG] Deleting Glacier Archive: %s", id)
+	_, err := conn.DeleteArchive(ctx, input)
+
+	// An archive that has already been deleted is treated as a successful deletion.
+	if err != nil && !errs.IsA[*types.ResourceNotFoundException](err) {
+		return sdkdiag.AppendErrorf(diags, "deleting Glacier Archive (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return diags
+}
+
+func resourceArchiveDeletionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Deleted archives cannot be restored.
+	log.Printf("[DEBUG] Glacier Archive Deletion (%s) cannot be undone, removing from state", d.Id())
+
+	return diags
+}
+
+const archiveDeletionResourceIDSeparator = ","
+
+func archiveDeletionCreateResourceID(vaultName, archiveID string) string {
+	parts := []string{vaultName, archiveID}
+	id := strings.Join(parts, archiveDeletionResourceIDSeparator)
+
+	return id
+}
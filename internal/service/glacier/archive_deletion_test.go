@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlacierArchiveDeletion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// The archive is permanently deleted by this test.
+	vaultName := acctest.SkipIfEnvVarNotSet(t, "GLACIER_VAULT_NAME")
+	archiveID := acctest.SkipIfEnvVarNotSet(t, "GLACIER_ARCHIVE_ID")
+	resourceName := "aws_glacier_archive_deletion.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccArchiveDeletionConfig_basic(vaultName, archiveID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "archive_id", archiveID),
+					resource.TestCheckResourceAttr(resourceName, "vault_name", vaultName),
+				),
+			},
+		},
+	})
+}
+
+func testAccArchiveDeletionConfig_basic(vaultName, archiveID string) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_archive_deletion" "test" {
+  vault_name = %[1]q
+  archive_id = %[2]q
+}
+`, vaultName, archiveID)
+}
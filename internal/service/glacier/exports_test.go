@@ -5,9 +5,13 @@ package glacier
 
 // Exports for use in tests only.
 var (
-	ResourceVault     = resourceVault
-	ResourceVaultLock = resourceVaultLock
+	ResourceArchiveDeletion = resourceArchiveDeletion
+	ResourceJob             = resourceJob
+	ResourceVault           = resourceVault
+	ResourceVaultLock       = resourceVaultLock
 
+	FindJobByTwoPartKey = findJobByTwoPartKey
 	FindVaultByName     = findVaultByName
 	FindVaultLockByName = findVaultLockByName
+	JobParseResourceID  = jobParseResourceID
 )
@@ -0,0 +1,404 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_glacier_job", name="Job")
+func resourceJob() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobCreate,
+		ReadWithoutTimeout:   resourceJobRead,
+		UpdateWithoutTimeout: schema.NoopContext, // Allow wait_for_completion update.
+		DeleteWithoutTimeout: resourceJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(12 * time.Hour),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"action": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"archive_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"archive_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"completed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrFormat: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(jobFormat_Values(), false),
+			},
+			"inventory_size_in_bytes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"retrieval_byte_range": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"sns_topic": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatusCode: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(jobTier_Values(), false),
+			},
+			names.AttrType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(jobType_Values(), false),
+			},
+			"vault_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: resourceJobCustomizeDiff,
+	}
+}
+
+const (
+	jobTypeArchiveRetrieval   = "archive-retrieval"
+	jobTypeInventoryRetrieval = "inventory-retrieval"
+)
+
+func jobType_Values() []string {
+	return []string{
+		jobTypeArchiveRetrieval,
+		jobTypeInventoryRetrieval,
+	}
+}
+
+const (
+	jobTierBulk      = "Bulk"
+	jobTierExpedited = "Expedited"
+	jobTierStandard  = "Standard"
+)
+
+func jobTier_Values() []string {
+	return []string{
+		jobTierBulk,
+		jobTierExpedited,
+		jobTierStandard,
+	}
+}
+
+const (
+	jobFormatCSV  = "CSV"
+	jobFormatJSON = "JSON"
+)
+
+func jobFormat_Values() []string {
+	return []string{
+		jobFormatCSV,
+		jobFormatJSON,
+	}
+}
+
+func resourceJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName := d.Get("vault_name").(string)
+	input := &glacier.InitiateJobInput{
+		AccountId: aws.String("-"),
+		JobParameters: &types.JobParameters{
+			Type: aws.String(d.Get(names.AttrType).(string)),
+		},
+		VaultName: aws.String(vaultName),
+	}
+
+	if v, ok := d.GetOk("archive_id"); ok {
+		input.JobParameters.ArchiveId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.JobParameters.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrFormat); ok {
+		input.JobParameters.Format = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retrieval_byte_range"); ok {
+		input.JobParameters.RetrievalByteRange = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sns_topic"); ok {
+		input.JobParameters.SNSTopic = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("tier"); ok {
+		input.JobParameters.Tier = aws.String(v.(string))
+	}
+
+	output, err := conn.InitiateJob(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Glacier Job (%s): %s", vaultName, err)
+	}
+
+	d.SetId(jobCreateResourceID(vaultName, aws.ToString(output.JobId)))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitJobCompleted(ctx, conn, vaultName, aws.ToString(output.JobId), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Glacier Job (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobRead(ctx, d, meta)...)
+}
+
+func resourceJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName, jobID, err := jobParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findJobByTwoPartKey(ctx, conn, vaultName, jobID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		// Glacier only retains jobs for a limited time after they complete.
+		// Keep completed jobs in state so that they are not initiated again.
+		if d.Get("completed").(bool) {
+			log.Printf("[WARN] Glacier Job (%s) has expired, keeping completed job in state", d.Id())
+			return diags
+		}
+
+		log.Printf("[WARN] Glacier Job (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glacier Job (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action", output.Action)
+	d.Set("archive_id", output.ArchiveId)
+	d.Set("archive_size_in_bytes", output.ArchiveSizeInBytes)
+	d.Set("completed", output.Completed)
+	d.Set("completion_date", output.CompletionDate)
+	d.Set(names.AttrCreationDate, output.CreationDate)
+	d.Set(names.AttrDescription, output.JobDescription)
+	if v := output.InventoryRetrievalParameters; v != nil {
+		d.Set(names.AttrFormat, v.Format)
+	}
+	d.Set("inventory_size_in_bytes", output.InventorySizeInBytes)
+	d.Set("job_id", output.JobId)
+	d.Set("retrieval_byte_range", output.RetrievalByteRange)
+	d.Set("sns_topic", output.SNSTopic)
+	d.Set(names.AttrStatusCode, output.StatusCode)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+	d.Set("tier", output.Tier)
+	d.Set(names.AttrType, jobTypeFromActionCode(output.Action))
+	d.Set("vault_name", vaultName)
+
+	return diags
+}
+
+func resourceJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Glacier jobs cannot be cancelled and expire automatically.
+	log.Printf("[DEBUG] Glacier Job (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func resourceJobCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	switch jobType := diff.Get(names.AttrType).(string); jobType {
+	case jobTypeArchiveRetrieval:
+		if _, ok := diff.GetOk("archive_id"); !ok && diff.NewValueKnown("archive_id") {
+			return fmt.Errorf(`"archive_id" is required when "type" is %q`, jobType)
+		}
+		if _, ok := diff.GetOk(names.AttrFormat); ok {
+			return fmt.Errorf(`"format" cannot be specified when "type" is %q`, jobType)
+		}
+	case jobTypeInventoryRetrieval:
+		if _, ok := diff.GetOk("archive_id"); ok {
+			return fmt.Errorf(`"archive_id" cannot be specified when "type" is %q`, jobType)
+		}
+	}
+
+	return nil
+}
+
+const jobResourceIDSeparator = ","
+
+func jobCreateResourceID(vaultName, jobID string) string {
+	parts := []string{vaultName, jobID}
+	id := strings.Join(parts, jobResourceIDSeparator)
+
+	return id
+}
+
+func jobParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, jobResourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected VAULT-NAME%[2]sJOB-ID", id, jobResourceIDSeparator)
+}
+
+// jobTypeFromActionCode returns the job type that corresponds to the specified action code.
+func jobTypeFromActionCode(action types.ActionCode) string {
+	switch action {
+	case types.ActionCodeArchiveRetrieval:
+		return jobTypeArchiveRetrieval
+	case types.ActionCodeInventoryRetrieval:
+		return jobTypeInventoryRetrieval
+	default:
+		return string(action)
+	}
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *glacier.Client, vaultName, jobID string) (*glacier.DescribeJobOutput, error) {
+	input := &glacier.DescribeJobInput{
+		AccountId: aws.String("-"),
+		JobId:     aws.String(jobID),
+		VaultName: aws.String(vaultName),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusJob(ctx context.Context, conn *glacier.Client, vaultName, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findJobByTwoPartKey(ctx, conn, vaultName, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.StatusCode), nil
+	}
+}
+
+func waitJobCompleted(ctx context.Context, conn *glacier.Client, vaultName, jobID string, timeout time.Duration) (*glacier.DescribeJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(types.StatusCodeInProgress),
+		Target:     enum.Slice(types.StatusCodeSucceeded),
+		Refresh:    statusJob(ctx, conn, vaultName, jobID),
+		Timeout:    timeout,
+		MinTimeout: 1 * time.Minute,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*glacier.DescribeJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
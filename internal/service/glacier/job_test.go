@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglacier "github.com/hashicorp/terraform-provider-aws/internal/service/glacier"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlacierJob_inventoryRetrieval(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Inventory retrieval requires a vault for which Glacier has already generated an inventory.
+	vaultName := acctest.SkipIfEnvVarNotSet(t, "GLACIER_VAULT_NAME")
+	var v glacier.DescribeJobOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_job.test"
+	topicResourceName := "aws_sns_topic.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobConfig_inventoryRetrieval(rName, vaultName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "action", "InventoryRetrieval"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, "JSON"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "sns_topic", topicResourceName, names.AttrARN),
+					resource.TestMatchResourceAttr(resourceName, names.AttrStatusCode, regexache.MustCompile(`^(InProgress|Succeeded)$`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "inventory-retrieval"),
+					resource.TestCheckResourceAttr(resourceName, "vault_name", vaultName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccGlacierJob_archiveRetrievalRequiresArchiveID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccJobConfig_archiveRetrievalNoArchiveID(rName),
+				ExpectError: regexache.MustCompile(`"archive_id" is required when "type" is "archive-retrieval"`),
+			},
+		},
+	})
+}
+
+func testAccCheckJobExists(ctx context.Context, n string, v *glacier.DescribeJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		vaultName, jobID, err := tfglacier.JobParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierClient(ctx)
+
+		output, err := tfglacier.FindJobByTwoPartKey(ctx, conn, vaultName, jobID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccJobConfig_inventoryRetrieval(rName, vaultName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_glacier_job" "test" {
+  vault_name  = %[2]q
+  type        = "inventory-retrieval"
+  description = %[1]q
+  format      = "JSON"
+  sns_topic   = aws_sns_topic.test.arn
+}
+`, rName, vaultName)
+}
+
+func testAccJobConfig_archiveRetrievalNoArchiveID(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_vault" "test" {
+  name = %[1]q
+}
+
+resource "aws_glacier_job" "test" {
+  vault_name = aws_glacier_vault.test.name
+  type       = "archive-retrieval"
+}
+`, rName)
+}
@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newVaultsDataSource,
+			Name:    "Vaults",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -28,6 +33,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
 	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceArchiveDeletion,
+			TypeName: "aws_glacier_archive_deletion",
+			Name:     "Archive Deletion",
+		},
+		{
+			Factory:  resourceJob,
+			TypeName: "aws_glacier_job",
+			Name:     "Job",
+		},
 		{
 			Factory:  resourceVault,
 			TypeName: "aws_glacier_vault",
@@ -35,6 +50,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			SkipDestroy: true,
 		},
 		{
 			Factory:  resourceVaultLock,
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -28,7 +29,9 @@ import (
 )
 
 // @SDKResource("aws_glacier_vault", name="Vault")
+// @SkipDestroy
 // @Tags(identifierAttribute="id")
+// @PluralDataSource("aws_glacier_vaults", listOp="ListVaults", listOpOutputElem="VaultList", nameElem="VaultName", arnElem="VaultARN", taggingResourceType="glacier")
 func resourceVault() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVaultCreate,
@@ -41,17 +44,7 @@ func resourceVault() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"access_policy": {
-				Type:                  schema.TypeString,
-				Optional:              true,
-				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
-				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
-			},
+			"access_policy": sdkv2.IAMResourcePolicyDocumentSchemaOptional(),
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -244,6 +244,36 @@ func TestAccGlacierVault_ignoreEquivalent(t *testing.T) {
 	})
 }
 
+func TestAccGlacierVault_skipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var vault glacier.DescribeVaultOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glacier_vault.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultNoDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultExists(ctx, resourceName, &vault),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccVaultConfig_skipDestroy(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVaultExists(ctx, resourceName, &vault),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckVaultExists(ctx context.Context, n string, v *glacier.DescribeVaultOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -294,6 +324,23 @@ func testAccCheckVaultDestroy(ctx context.Context) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckVaultNoDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlacierClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_glacier_vault" {
+				continue
+			}
+
+			_, err := tfglacier.FindVaultByName(ctx, conn, rs.Primary.ID)
+
+			return err
+		}
+		return nil
+	}
+}
+
 func testAccVaultConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_glacier_vault" "test" {
@@ -302,6 +349,15 @@ resource "aws_glacier_vault" "test" {
 `, rName)
 }
 
+func testAccVaultConfig_skipDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_vault" "test" {
+  name         = %[1]q
+  skip_destroy = true
+}
+`, rName)
+}
+
 func testAccVaultConfig_notification(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
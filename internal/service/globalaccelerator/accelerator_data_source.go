@@ -98,7 +98,7 @@ func (d *acceleratorDataSource) Read(ctx context.Context, request datasource.Rea
 	}
 
 	conn := d.Meta().GlobalAcceleratorClient(ctx)
-	ignoreTagsConfig := d.Meta().IgnoreTagsConfig
+	ignoreTagsConfig := d.Meta().ResourceIgnoreTagsConfig(ctx)
 
 	var results []awstypes.Accelerator
 	pages := globalaccelerator.NewListAcceleratorsPaginator(conn, &globalaccelerator.ListAcceleratorsInput{})
@@ -0,0 +1,364 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	customRoutingEndpointTrafficResourceIDPartCount = 5
+	// Separates the elements of destination address and port lists in the resource ID.
+	customRoutingEndpointTrafficResourceIDListSeparator = "+"
+)
+
+// @SDKResource("aws_globalaccelerator_custom_routing_endpoint_traffic", name="Custom Routing Endpoint Traffic")
+func resourceCustomRoutingEndpointTraffic() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceCustomRoutingEndpointTrafficCreate,
+		ReadWithoutTimeout:   resourceCustomRoutingEndpointTrafficRead,
+		DeleteWithoutTimeout: resourceCustomRoutingEndpointTrafficDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPv4Address,
+				},
+			},
+			"destination_ports": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 100,
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IsPortNumber,
+				},
+			},
+			"endpoint_group_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			"traffic_state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.CustomRoutingDestinationTrafficStateAllow,
+				ValidateDiagFunc: enum.Validate[awstypes.CustomRoutingDestinationTrafficState](),
+			},
+		},
+	}
+}
+
+func resourceCustomRoutingEndpointTrafficCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN := d.Get("endpoint_group_arn").(string)
+	endpointID := d.Get("endpoint_id").(string)
+	state := awstypes.CustomRoutingDestinationTrafficState(d.Get("traffic_state").(string))
+	addresses := flex.ExpandStringValueSet(d.Get("destination_addresses").(*schema.Set))
+	ports := flex.ExpandInt32ValueSet(d.Get("destination_ports").(*schema.Set))
+	id := customRoutingEndpointTrafficCreateResourceID(endpointGroupARN, endpointID, state, addresses, ports)
+
+	if err := updateCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, state, addresses, ports); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Global Accelerator Custom Routing Endpoint Traffic (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
+	}
+
+	return append(diags, resourceCustomRoutingEndpointTrafficRead(ctx, d, meta)...)
+}
+
+func resourceCustomRoutingEndpointTrafficRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN, endpointID, state, addresses, ports, err := customRoutingEndpointTrafficParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	_, err = findCustomRoutingEndpointTraffic(ctx, conn, endpointGroupARN, endpointID, state, addresses, ports)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Global Accelerator Custom Routing Endpoint Traffic (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+	}
+
+	d.Set("destination_addresses", addresses)
+	d.Set("destination_ports", ports)
+	d.Set("endpoint_group_arn", endpointGroupARN)
+	d.Set("endpoint_id", endpointID)
+	d.Set("traffic_state", state)
+
+	return diags
+}
+
+func resourceCustomRoutingEndpointTrafficDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+	endpointGroupARN, endpointID, state, addresses, ports, err := customRoutingEndpointTrafficParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// Revert the destinations to the opposite traffic state.
+	revert := awstypes.CustomRoutingDestinationTrafficStateDeny
+	if state == awstypes.CustomRoutingDestinationTrafficStateDeny {
+		revert = awstypes.CustomRoutingDestinationTrafficStateAllow
+	}
+
+	log.Printf("[DEBUG] Deleting Global Accelerator Custom Routing Endpoint Traffic: %s", d.Id())
+	err = updateCustomRoutingTraffic(ctx, conn, endpointGroupARN, endpointID, revert, addresses, ports)
+
+	if errs.IsA[*awstypes.EndpointGroupNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Global Accelerator Custom Routing Endpoint Traffic (%s): %s", d.Id(), err)
+	}
+
+	acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
+	}
+
+	return diags
+}
+
+// updateCustomRoutingTraffic allows or denies traffic to the specified destinations in a subnet endpoint.
+// If no destination addresses or ports are specified, traffic to all destinations in the subnet is allowed or denied.
+func updateCustomRoutingTraffic(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string, state awstypes.CustomRoutingDestinationTrafficState, addresses []string, ports []int32) error {
+	all := len(addresses) == 0 && len(ports) == 0
+
+	switch state {
+	case awstypes.CustomRoutingDestinationTrafficStateAllow:
+		input := &globalaccelerator.AllowCustomRoutingTrafficInput{
+			EndpointGroupArn: aws.String(endpointGroupARN),
+			EndpointId:       aws.String(endpointID),
+		}
+
+		if all {
+			input.AllowAllTrafficToEndpoint = aws.Bool(true)
+		} else {
+			input.DestinationAddresses = addresses
+			input.DestinationPorts = ports
+		}
+
+		_, err := conn.AllowCustomRoutingTraffic(ctx, input)
+
+		return err
+	case awstypes.CustomRoutingDestinationTrafficStateDeny:
+		input := &globalaccelerator.DenyCustomRoutingTrafficInput{
+			EndpointGroupArn: aws.String(endpointGroupARN),
+			EndpointId:       aws.String(endpointID),
+		}
+
+		if all {
+			input.DenyAllTrafficToEndpoint = aws.Bool(true)
+		} else {
+			input.DestinationAddresses = addresses
+			input.DestinationPorts = ports
+		}
+
+		_, err := conn.DenyCustomRoutingTraffic(ctx, input)
+
+		return err
+	default:
+		return fmt.Errorf("unsupported traffic state: %s", state)
+	}
+}
+
+// findCustomRoutingEndpointTraffic returns the port mappings for the specified destinations in a subnet endpoint.
+// A NotFoundError is returned if there are no port mappings for the destinations or if any of them are not in the specified traffic state.
+func findCustomRoutingEndpointTraffic(ctx context.Context, conn *globalaccelerator.Client, endpointGroupARN, endpointID string, state awstypes.CustomRoutingDestinationTrafficState, addresses []string, ports []int32) ([]awstypes.PortMapping, error) {
+	acceleratorARN, err := listenerOrEndpointGroupARNToAcceleratorARN(endpointGroupARN)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &globalaccelerator.ListCustomRoutingPortMappingsInput{
+		AcceleratorArn:   aws.String(acceleratorARN),
+		EndpointGroupArn: aws.String(endpointGroupARN),
+	}
+	filter := func(v awstypes.PortMapping) bool {
+		if aws.ToString(v.EndpointId) != endpointID {
+			return false
+		}
+
+		if v := v.DestinationSocketAddress; v != nil {
+			if len(addresses) > 0 && !slices.Contains(addresses, aws.ToString(v.IpAddress)) {
+				return false
+			}
+
+			if len(ports) > 0 && !slices.Contains(ports, aws.ToInt32(v.Port)) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	output, err := findCustomRoutingPortMappings(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if !tfslices.All(output, func(v awstypes.PortMapping) bool { return v.DestinationTrafficState == state }) {
+		return nil, &retry.NotFoundError{
+			Message:     fmt.Sprintf("destination traffic state is not %s", state),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findCustomRoutingPortMappings(ctx context.Context, conn *globalaccelerator.Client, input *globalaccelerator.ListCustomRoutingPortMappingsInput, filter tfslices.Predicate[awstypes.PortMapping]) ([]awstypes.PortMapping, error) {
+	var output []awstypes.PortMapping
+
+	pages := globalaccelerator.NewListCustomRoutingPortMappingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.AcceleratorNotFoundException](err) || errs.IsA[*awstypes.EndpointGroupNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PortMappings {
+			if filter(v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func customRoutingEndpointTrafficCreateResourceID(endpointGroupARN, endpointID string, state awstypes.CustomRoutingDestinationTrafficState, addresses []string, ports []int32) string {
+	addresses = slices.Clone(addresses)
+	slices.Sort(addresses)
+	ports = slices.Clone(ports)
+	slices.Sort(ports)
+
+	parts := []string{
+		endpointGroupARN,
+		endpointID,
+		string(state),
+		strings.Join(addresses, customRoutingEndpointTrafficResourceIDListSeparator),
+		strings.Join(tfslices.ApplyToAll(ports, func(v int32) string { return strconv.Itoa(int(v)) }), customRoutingEndpointTrafficResourceIDListSeparator),
+	}
+
+	return errs.Must(flex.FlattenResourceId(parts, customRoutingEndpointTrafficResourceIDPartCount, true))
+}
+
+func customRoutingEndpointTrafficParseResourceID(id string) (string, string, awstypes.CustomRoutingDestinationTrafficState, []string, []int32, error) {
+	parts, err := flex.ExpandResourceId(id, customRoutingEndpointTrafficResourceIDPartCount, true)
+	if err != nil {
+		return "", "", "", nil, nil, err
+	}
+
+	endpointGroupARN, endpointID, state := parts[0], parts[1], awstypes.CustomRoutingDestinationTrafficState(parts[2])
+
+	if endpointGroupARN == "" || endpointID == "" || !slices.Contains(enum.EnumValues[awstypes.CustomRoutingDestinationTrafficState](), state) {
+		return "", "", "", nil, nil, fmt.Errorf("unexpected format for ID (%[1]s), expected endpoint-group-arn%[2]sendpoint-id%[2]straffic-state%[2]saddresses%[2]sports", id, flex.ResourceIdSeparator)
+	}
+
+	var addresses []string
+	if v := parts[3]; v != "" {
+		addresses = strings.Split(v, customRoutingEndpointTrafficResourceIDListSeparator)
+	}
+
+	var ports []int32
+	if v := parts[4]; v != "" {
+		for _, v := range strings.Split(v, customRoutingEndpointTrafficResourceIDListSeparator) {
+			port, err := strconv.Atoi(v)
+			if err != nil {
+				return "", "", "", nil, nil, fmt.Errorf("parsing port (%s) in ID (%s): %w", v, id, err)
+			}
+
+			ports = append(ports, int32(port))
+		}
+	}
+
+	return endpointGroupARN, endpointID, state, addresses, ports, nil
+}
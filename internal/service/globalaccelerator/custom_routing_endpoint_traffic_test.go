@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfglobalaccelerator "github.com/hashicorp/terraform-provider-aws/internal/service/globalaccelerator"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	endpointGroupResourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	subnetResourceName := "aws_subnet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_group_arn", endpointGroupResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_id", subnetResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "traffic_state", "ALLOW"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfglobalaccelerator.ResourceCustomRoutingEndpointTraffic(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGlobalAcceleratorCustomRoutingEndpointTraffic_destinations(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_globalaccelerator_custom_routing_endpoint_traffic.test"
+	dataSourceName := "data.aws_globalaccelerator_custom_routing_port_mappings.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCustomRoutingEndpointTrafficDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingEndpointTrafficConfig_destinations(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCustomRoutingEndpointTrafficExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_addresses.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_addresses.*", "10.0.0.4"),
+					resource.TestCheckResourceAttr(resourceName, "destination_ports.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "destination_ports.*", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_state", "ALLOW"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "port_mappings.*", map[string]string{
+						"destination_socket_address.0.ip_address": "10.0.0.4",
+						"destination_socket_address.0.port":       "8080",
+						"destination_traffic_state":               "ALLOW",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "port_mappings.*", map[string]string{
+						"destination_socket_address.0.ip_address": "10.0.0.4",
+						"destination_socket_address.0.port":       "8081",
+						"destination_traffic_state":               "DENY",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckCustomRoutingEndpointTrafficExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		endpointGroupARN, endpointID, state, addresses, ports, err := tfglobalaccelerator.CustomRoutingEndpointTrafficParseResourceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+		_, err = tfglobalaccelerator.FindCustomRoutingEndpointTraffic(ctx, conn, endpointGroupARN, endpointID, state, addresses, ports)
+
+		return err
+	}
+}
+
+func testAccCheckCustomRoutingEndpointTrafficDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GlobalAcceleratorClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_globalaccelerator_custom_routing_endpoint_traffic" {
+				continue
+			}
+
+			endpointGroupARN, endpointID, state, addresses, ports, err := tfglobalaccelerator.CustomRoutingEndpointTrafficParseResourceID(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfglobalaccelerator.FindCustomRoutingEndpointTraffic(ctx, conn, endpointGroupARN, endpointID, state, addresses, ports)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Global Accelerator Custom Routing Endpoint Traffic %s still exists", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptInDefaultExclude(), fmt.Sprintf(`
+resource "aws_globalaccelerator_custom_routing_accelerator" "test" {
+  name = %[1]q
+}
+
+resource "aws_globalaccelerator_custom_routing_listener" "test" {
+  accelerator_arn = aws_globalaccelerator_custom_routing_accelerator.test.id
+
+  port_range {
+    from_port = 1
+    to_port   = 65534
+  }
+}
+
+resource "aws_globalaccelerator_custom_routing_endpoint_group" "test" {
+  listener_arn = aws_globalaccelerator_custom_routing_listener.test.id
+
+  destination_configuration {
+    from_port = 8080
+    to_port   = 8081
+    protocols = ["TCP"]
+  }
+
+  endpoint_configuration {
+    endpoint_id = aws_subnet.test.id
+  }
+
+  depends_on = [aws_internet_gateway.test]
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_subnet" "test" {
+  vpc_id            = aws_vpc.test.id
+  availability_zone = data.aws_availability_zones.available.names[0]
+  cidr_block        = "10.0.0.0/28"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_internet_gateway" "test" {
+  vpc_id = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointTrafficConfig_base(rName), `
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id        = aws_subnet.test.id
+}
+`)
+}
+
+func testAccCustomRoutingEndpointTrafficConfig_destinations(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointTrafficConfig_base(rName), `
+resource "aws_globalaccelerator_custom_routing_endpoint_traffic" "test" {
+  endpoint_group_arn    = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id           = aws_subnet.test.id
+  destination_addresses = ["10.0.0.4"]
+  destination_ports     = [8080]
+}
+
+data "aws_globalaccelerator_custom_routing_port_mappings" "test" {
+  accelerator_arn    = aws_globalaccelerator_custom_routing_accelerator.test.id
+  endpoint_group_arn = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+  endpoint_id        = aws_subnet.test.id
+
+  depends_on = [aws_globalaccelerator_custom_routing_endpoint_traffic.test]
+}
+`)
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -90,7 +91,7 @@ func resourceCustomRoutingListenerCreate(ctx context.Context, d *schema.Resource
 	d.SetId(aws.ToString(output.Listener.ListenerArn))
 
 	// Creating a listener triggers the accelerator to change status to InPending.
-	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
 	}
 
@@ -143,7 +144,7 @@ func resourceCustomRoutingListenerUpdate(ctx context.Context, d *schema.Resource
 	}
 
 	// Updating a listener triggers the accelerator to change status to InPending.
-	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
 	}
 
@@ -169,7 +170,7 @@ func resourceCustomRoutingListenerDelete(ctx context.Context, d *schema.Resource
 
 	// Deleting a listener triggers the accelerator to change status to InPending.
 	acceleratorARN := d.Get("accelerator_arn").(string)
-	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitCustomRoutingAcceleratorDeployed(ctx, conn, acceleratorARN, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Global Accelerator Custom Routing Accelerator (%s) deploy: %s", acceleratorARN, err)
 	}
 
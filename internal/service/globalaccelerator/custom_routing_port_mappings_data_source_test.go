@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package globalaccelerator_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlobalAcceleratorCustomRoutingPortMappingsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_globalaccelerator_custom_routing_port_mappings.test"
+	endpointGroupResourceName := "aws_globalaccelerator_custom_routing_endpoint_group.test"
+	subnetResourceName := "aws_subnet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlobalAcceleratorServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomRoutingPortMappingsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// 2 destination ports for each of the 11 usable addresses in a /28 subnet.
+					resource.TestCheckResourceAttr(dataSourceName, "port_mappings.#", "22"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "port_mappings.0.accelerator_port"),
+					resource.TestCheckResourceAttr(dataSourceName, "port_mappings.0.destination_socket_address.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "port_mappings.0.destination_traffic_state", "DENY"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "port_mappings.0.endpoint_group_arn", endpointGroupResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "port_mappings.0.endpoint_id", subnetResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "port_mappings.0.protocols.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomRoutingPortMappingsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccCustomRoutingEndpointTrafficConfig_base(rName), `
+data "aws_globalaccelerator_custom_routing_port_mappings" "test" {
+  accelerator_arn    = aws_globalaccelerator_custom_routing_accelerator.test.id
+  endpoint_group_arn = aws_globalaccelerator_custom_routing_endpoint_group.test.id
+}
+`)
+}
@@ -5,22 +5,25 @@ package globalaccelerator
 
 // Exports for use in tests only.
 var (
-	ResourceAccelerator                = resourceAccelerator
-	ResourceCrossAccountAttachment     = newCrossAccountAttachmentResource
-	ResourceCustomRoutingAccelerator   = resourceCustomRoutingAccelerator
-	ResourceCustomRoutingEndpointGroup = resourceCustomRoutingEndpointGroup
-	ResourceCustomRoutingListener      = resourceCustomRoutingListener
-	ResourceEndpointGroup              = resourceEndpointGroup
-	ResourceListener                   = resourceListener
+	ResourceAccelerator                  = resourceAccelerator
+	ResourceCrossAccountAttachment       = newCrossAccountAttachmentResource
+	ResourceCustomRoutingAccelerator     = resourceCustomRoutingAccelerator
+	ResourceCustomRoutingEndpointGroup   = resourceCustomRoutingEndpointGroup
+	ResourceCustomRoutingEndpointTraffic = resourceCustomRoutingEndpointTraffic
+	ResourceCustomRoutingListener        = resourceCustomRoutingListener
+	ResourceEndpointGroup                = resourceEndpointGroup
+	ResourceListener                     = resourceListener
 
 	FindAcceleratorByARN                = findAcceleratorByARN
 	FindCrossAccountAttachmentByARN     = findCrossAccountAttachmentByARN
 	FindCustomRoutingAcceleratorByARN   = findCustomRoutingAcceleratorByARN
 	FindCustomRoutingEndpointGroupByARN = findCustomRoutingEndpointGroupByARN
+	FindCustomRoutingEndpointTraffic    = findCustomRoutingEndpointTraffic
 	FindCustomRoutingListenerByARN      = findCustomRoutingListenerByARN
 	FindEndpointGroupByARN              = findEndpointGroupByARN
 	FindListenerByARN                   = findListenerByARN
 
-	ListenerOrEndpointGroupARNToAcceleratorARN = listenerOrEndpointGroupARNToAcceleratorARN
-	EndpointGroupARNToListenerARN              = endpointGroupARNToListenerARN
+	ListenerOrEndpointGroupARNToAcceleratorARN  = listenerOrEndpointGroupARNToAcceleratorARN
+	EndpointGroupARNToListenerARN               = endpointGroupARNToListenerARN
+	CustomRoutingEndpointTrafficParseResourceID = customRoutingEndpointTrafficParseResourceID
 )
@@ -40,6 +40,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_globalaccelerator_custom_routing_accelerator",
 			Name:     "Custom Routing Accelerator",
 		},
+		{
+			Factory:  dataSourceCustomRoutingPortMappings,
+			TypeName: "aws_globalaccelerator_custom_routing_port_mappings",
+			Name:     "Custom Routing Port Mappings",
+		},
 	}
 }
 
@@ -66,6 +71,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_globalaccelerator_custom_routing_endpoint_group",
 			Name:     "Custom Routing Endpoint Group",
 		},
+		{
+			Factory:  resourceCustomRoutingEndpointTraffic,
+			TypeName: "aws_globalaccelerator_custom_routing_endpoint_traffic",
+			Name:     "Custom Routing Endpoint Traffic",
+		},
 		{
 			Factory:  resourceCustomRoutingListener,
 			TypeName: "aws_globalaccelerator_custom_routing_listener",
@@ -93,7 +93,7 @@ func dataSourceConnectionRead(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 
 	conn := meta.(*conns.AWSClient).GlueConn(ctx)
-	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 
 	id := d.Get(names.AttrID).(string)
 	catalogID, connectionName, err := DecodeConnectionID(id)
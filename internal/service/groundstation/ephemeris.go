@@ -0,0 +1,584 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Ephemeris")
+// @Tags(identifierAttribute="arn")
+func newEphemerisResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &ephemerisResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type ephemerisResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (*ephemerisResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_ephemeris"
+}
+
+func (r *ephemerisResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s3ObjectBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[s3ObjectModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrBucket: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrVersion: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrPriority: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 99999),
+				},
+			},
+			"satellite_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EphemerisStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"ephemeris": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[ephemerisDataModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"oem": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[oemEphemerisModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("tle"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"oem_data": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"s3_object": s3ObjectBlock,
+								},
+							},
+						},
+						"tle": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[tleEphemerisModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"s3_object": s3ObjectBlock,
+									"tle_data": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[tleDataModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(500),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"tle_line1": schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(69, 69),
+													},
+												},
+												"tle_line2": schema.StringAttribute{
+													Required: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(69, 69),
+													},
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"valid_time_range": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[timeRangeModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"end_time": schema.StringAttribute{
+																CustomType: timetypes.RFC3339Type{},
+																Required:   true,
+															},
+															names.AttrStartTime: schema.StringAttribute{
+																CustomType: timetypes.RFC3339Type{},
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *ephemerisResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data ephemerisResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	input := &groundstation.CreateEphemerisInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	ephemeris, diags := expandEphemerisData(ctx, data.Ephemeris)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Ephemeris = ephemeris
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateEphemeris(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Ephemeris (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.EphemerisId)
+	data.ARN = types.StringValue(r.ephemerisARN(data.ID.ValueString()))
+
+	ephemerisOutput, err := waitEphemerisValidated(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Ground Station Ephemeris (%s) validate", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Enabled = fwflex.BoolToFramework(ctx, ephemerisOutput.Enabled)
+	data.Priority = fwflex.Int32ToFramework(ctx, ephemerisOutput.Priority)
+	data.Status = fwtypes.StringEnumValue(ephemerisOutput.Status)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *ephemerisResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data ephemerisResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findEphemerisByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Ephemeris (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// The ephemeris data, expiration time and KMS key are not returned.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = types.StringValue(r.ephemerisARN(data.ID.ValueString()))
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *ephemerisResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new ephemerisResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if !new.Enabled.Equal(old.Enabled) ||
+		!new.Name.Equal(old.Name) ||
+		!new.Priority.Equal(old.Priority) {
+		input := &groundstation.UpdateEphemerisInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.EphemerisId = aws.String(new.ID.ValueString())
+
+		_, err := conn.UpdateEphemeris(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Ephemeris (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		output, err := findEphemerisByID(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Ephemeris (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(output.Status)
+	} else {
+		new.Status = old.Status
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *ephemerisResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data ephemerisResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteEphemeris(ctx, &groundstation.DeleteEphemerisInput{
+		EphemerisId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Ephemeris (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *ephemerisResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var old, new ephemerisResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Enabling or disabling the ephemeris changes its status.
+		if !new.Enabled.IsUnknown() && !new.Enabled.Equal(old.Enabled) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrStatus), fwtypes.StringEnumUnknown[awstypes.EphemerisStatus]())...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	r.SetTagsAll(ctx, request, response)
+}
+
+// ephemerisARN returns the ARN of the ephemeris with the specified ID.
+// The ARN is not returned by the API.
+func (r *ephemerisResource) ephemerisARN(id string) string {
+	return arn.ARN{
+		Partition: r.Meta().Partition,
+		Service:   "groundstation",
+		Region:    r.Meta().Region,
+		AccountID: r.Meta().AccountID,
+		Resource:  "ephemeris/" + id,
+	}.String()
+}
+
+func findEphemerisByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.DescribeEphemerisOutput, error) {
+	input := &groundstation.DescribeEphemerisInput{
+		EphemerisId: aws.String(id),
+	}
+
+	output, err := conn.DescribeEphemeris(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEphemeris(ctx context.Context, conn *groundstation.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findEphemerisByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEphemerisValidated(ctx context.Context, conn *groundstation.Client, id string, timeout time.Duration) (*groundstation.DescribeEphemerisOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EphemerisStatusValidating),
+		Target:  enum.Slice(awstypes.EphemerisStatusEnabled, awstypes.EphemerisStatusDisabled),
+		Refresh: statusEphemeris(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*groundstation.DescribeEphemerisOutput); ok {
+		if reason := output.InvalidReason; reason != "" {
+			tfresource.SetLastError(err, errors.New(string(reason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type ephemerisResourceModel struct {
+	ARN            types.String                                        `tfsdk:"arn"`
+	Enabled        types.Bool                                          `tfsdk:"enabled"`
+	Ephemeris      fwtypes.ListNestedObjectValueOf[ephemerisDataModel] `tfsdk:"ephemeris"`
+	ExpirationTime timetypes.RFC3339                                   `tfsdk:"expiration_time"`
+	ID             types.String                                        `tfsdk:"id"`
+	KMSKeyARN      fwtypes.ARN                                         `tfsdk:"kms_key_arn"`
+	Name           types.String                                        `tfsdk:"name"`
+	Priority       types.Int64                                         `tfsdk:"priority"`
+	SatelliteID    types.String                                        `tfsdk:"satellite_id"`
+	Status         fwtypes.StringEnum[awstypes.EphemerisStatus]        `tfsdk:"status"`
+	Tags           types.Map                                           `tfsdk:"tags"`
+	TagsAll        types.Map                                           `tfsdk:"tags_all"`
+	Timeouts       timeouts.Value                                      `tfsdk:"timeouts"`
+}
+
+type ephemerisDataModel struct {
+	OEM fwtypes.ListNestedObjectValueOf[oemEphemerisModel] `tfsdk:"oem"`
+	TLE fwtypes.ListNestedObjectValueOf[tleEphemerisModel] `tfsdk:"tle"`
+}
+
+type oemEphemerisModel struct {
+	OEMData  types.String                                   `tfsdk:"oem_data"`
+	S3Object fwtypes.ListNestedObjectValueOf[s3ObjectModel] `tfsdk:"s3_object"`
+}
+
+type tleEphemerisModel struct {
+	S3Object fwtypes.ListNestedObjectValueOf[s3ObjectModel] `tfsdk:"s3_object"`
+	TLEData  fwtypes.ListNestedObjectValueOf[tleDataModel]  `tfsdk:"tle_data"`
+}
+
+type s3ObjectModel struct {
+	Bucket  types.String `tfsdk:"bucket"`
+	Key     types.String `tfsdk:"key"`
+	Version types.String `tfsdk:"version"`
+}
+
+type tleDataModel struct {
+	TLELine1       types.String                                    `tfsdk:"tle_line1"`
+	TLELine2       types.String                                    `tfsdk:"tle_line2"`
+	ValidTimeRange fwtypes.ListNestedObjectValueOf[timeRangeModel] `tfsdk:"valid_time_range"`
+}
+
+type timeRangeModel struct {
+	EndTime   timetypes.RFC3339 `tfsdk:"end_time"`
+	StartTime timetypes.RFC3339 `tfsdk:"start_time"`
+}
+
+func expandEphemerisData(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[ephemerisDataModel]) (awstypes.EphemerisData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ephemerisDataData, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || ephemerisDataData == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !ephemerisDataData.OEM.IsNull():
+		data, d := ephemerisDataData.OEM.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.EphemerisDataMemberOem{}
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return apiObject, diags
+
+	case !ephemerisDataData.TLE.IsNull():
+		data, d := ephemerisDataData.TLE.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.EphemerisDataMemberTle{}
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return apiObject, diags
+	}
+
+	return nil, diags
+}
@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Ephemerides can only be uploaded for satellites onboarded to the account.
+const (
+	envVarSatelliteID = "GROUNDSTATION_SATELLITE_ID"
+)
+
+func TestAccGroundStationEphemeris_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	satelliteID := acctest.SkipIfEnvVarNotSet(t, envVarSatelliteID)
+	var v groundstation.DescribeEphemerisOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_ephemeris.test"
+	startTime := time.Now().UTC().Format(time.RFC3339)
+	endTime := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEphemerisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemerisConfig_basic(rName, satelliteID, startTime, endTime, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "satellite_id", satelliteID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.EphemerisStatusEnabled)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ephemeris", "expiration_time", names.AttrKMSKeyARN},
+			},
+			{
+				Config: testAccEphemerisConfig_basic(rName, satelliteID, startTime, endTime, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.EphemerisStatusDisabled)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationEphemeris_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	satelliteID := acctest.SkipIfEnvVarNotSet(t, envVarSatelliteID)
+	var v groundstation.DescribeEphemerisOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_ephemeris.test"
+	startTime := time.Now().UTC().Format(time.RFC3339)
+	endTime := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEphemerisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemerisConfig_basic(rName, satelliteID, startTime, endTime, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceEphemeris, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEphemerisDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_ephemeris" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindEphemerisByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Ephemeris %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEphemerisExists(ctx context.Context, n string, v *groundstation.DescribeEphemerisOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		output, err := tfgroundstation.FindEphemerisByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEphemerisConfig_basic(rName, satelliteID, startTime, endTime string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_ephemeris" "test" {
+  name         = %[1]q
+  satellite_id = %[2]q
+  enabled      = %[5]t
+
+  ephemeris {
+    tle {
+      tle_data {
+        tle_line1 = "1 25544U 98067A   08264.51782528 -.00002182  00000-0 -11606-4 0  2927"
+        tle_line2 = "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.72125391563537"
+
+        valid_time_range {
+          start_time = %[3]q
+          end_time   = %[4]q
+        }
+      }
+    }
+  }
+}
+`, rName, satelliteID, startTime, endTime, enabled)
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+// Exports for use in tests only.
+var (
+	ResourceConfig                = newConfigResource
+	ResourceDataflowEndpointGroup = newDataflowEndpointGroupResource
+	ResourceEphemeris             = newEphemerisResource
+	ResourceMissionProfile        = newMissionProfileResource
+
+	FindConfigByTwoPartKey        = findConfigByTwoPartKey
+	FindDataflowEndpointGroupByID = findDataflowEndpointGroupByID
+	FindEphemerisByID             = findEphemerisByID
+	FindMissionProfileByID        = findMissionProfileByID
+)
@@ -0,0 +1,468 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Mission Profile")
+// @Tags(identifierAttribute="arn")
+func newMissionProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &missionProfileResource{}
+
+	return r, nil
+}
+
+type missionProfileResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*missionProfileResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_mission_profile"
+}
+
+func (r *missionProfileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	durationValidators := []validator.Int64{
+		int64validator.Between(1, 21600),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"contact_post_pass_duration_seconds": schema.Int64Attribute{
+				Optional:   true,
+				Validators: durationValidators,
+			},
+			"contact_pre_pass_duration_seconds": schema.Int64Attribute{
+				Optional:   true,
+				Validators: durationValidators,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"minimum_viable_contact_duration_seconds": schema.Int64Attribute{
+				Required:   true,
+				Validators: durationValidators,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[ a-zA-Z0-9_:-]{1,256}$`), "must be between 1 and 256 characters and contain only alphanumeric characters, spaces, hyphens, underscores and colons"),
+				},
+			},
+			"streams_kms_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tracking_config_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"dataflow_edge": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataflowEdgeModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(500),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						names.AttrSource: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"streams_kms_key": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[kmsKeyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"kms_alias_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+							Validators: []validator.String{
+								stringvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kms_alias_name"),
+									path.MatchRelative().AtParent().AtName(names.AttrKMSKeyARN),
+								),
+							},
+						},
+						"kms_alias_name": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *missionProfileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	input := &groundstation.CreateMissionProfileInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input, missionProfileFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	streamsKMSKey, diags := expandKMSKey(ctx, data.StreamsKMSKey)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.DataflowEdges = expandDataflowEdges(ctx, data.DataflowEdge)
+	input.StreamsKmsKey = streamsKMSKey
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateMissionProfile(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Mission Profile (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.MissionProfileId)
+
+	missionProfile, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, missionProfile.MissionProfileArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *missionProfileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findMissionProfileByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, missionProfileFlexOptions)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	streamsKMSKey, diags := flattenKMSKey(ctx, output.StreamsKmsKey)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.MissionProfileArn)
+	data.DataflowEdge = flattenDataflowEdges(ctx, output.DataflowEdges)
+	data.StreamsKMSKey = streamsKMSKey
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *missionProfileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new missionProfileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if !new.ContactPostPassDurationSeconds.Equal(old.ContactPostPassDurationSeconds) ||
+		!new.ContactPrePassDurationSeconds.Equal(old.ContactPrePassDurationSeconds) ||
+		!new.DataflowEdge.Equal(old.DataflowEdge) ||
+		!new.MinimumViableContactDurationSeconds.Equal(old.MinimumViableContactDurationSeconds) ||
+		!new.Name.Equal(old.Name) ||
+		!new.StreamsKMSKey.Equal(old.StreamsKMSKey) ||
+		!new.StreamsKMSRole.Equal(old.StreamsKMSRole) ||
+		!new.TrackingConfigARN.Equal(old.TrackingConfigARN) {
+		input := &groundstation.UpdateMissionProfileInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input, missionProfileFlexOptions)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// AutoFlEx doesn't yet handle union types.
+		streamsKMSKey, diags := expandKMSKey(ctx, new.StreamsKMSKey)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.DataflowEdges = expandDataflowEdges(ctx, new.DataflowEdge)
+		input.MissionProfileId = aws.String(new.ID.ValueString())
+		input.StreamsKmsKey = streamsKMSKey
+
+		_, err := conn.UpdateMissionProfile(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Mission Profile (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *missionProfileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data missionProfileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteMissionProfile(ctx, &groundstation.DeleteMissionProfileInput{
+		MissionProfileId: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Mission Profile (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *missionProfileResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("streams_kms_key"),
+			path.MatchRoot("streams_kms_role"),
+		),
+	}
+}
+
+func (r *missionProfileResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findMissionProfileByID(ctx context.Context, conn *groundstation.Client, id string) (*groundstation.GetMissionProfileOutput, error) {
+	input := &groundstation.GetMissionProfileInput{
+		MissionProfileId: aws.String(id),
+	}
+
+	output, err := conn.GetMissionProfile(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+// The dataflow edges are modeled as a list of source/destination blocks rather than as a list of pairs.
+var missionProfileFlexOptions = func(opts *fwflex.AutoFlexOptions) {
+	opts.AddIgnoredField("DataflowEdge")
+	opts.AddIgnoredField("DataflowEdges")
+}
+
+type missionProfileResourceModel struct {
+	ARN                                 types.String                                       `tfsdk:"arn"`
+	ContactPostPassDurationSeconds      types.Int64                                        `tfsdk:"contact_post_pass_duration_seconds"`
+	ContactPrePassDurationSeconds       types.Int64                                        `tfsdk:"contact_pre_pass_duration_seconds"`
+	DataflowEdge                        fwtypes.ListNestedObjectValueOf[dataflowEdgeModel] `tfsdk:"dataflow_edge"`
+	ID                                  types.String                                       `tfsdk:"id"`
+	MinimumViableContactDurationSeconds types.Int64                                        `tfsdk:"minimum_viable_contact_duration_seconds"`
+	Name                                types.String                                       `tfsdk:"name"`
+	StreamsKMSKey                       fwtypes.ListNestedObjectValueOf[kmsKeyModel]       `tfsdk:"streams_kms_key"`
+	StreamsKMSRole                      fwtypes.ARN                                        `tfsdk:"streams_kms_role"`
+	Tags                                types.Map                                          `tfsdk:"tags"`
+	TagsAll                             types.Map                                          `tfsdk:"tags_all"`
+	TrackingConfigARN                   fwtypes.ARN                                        `tfsdk:"tracking_config_arn"`
+}
+
+type dataflowEdgeModel struct {
+	Destination fwtypes.ARN `tfsdk:"destination"`
+	Source      fwtypes.ARN `tfsdk:"source"`
+}
+
+type kmsKeyModel struct {
+	KMSAliasARN  fwtypes.ARN  `tfsdk:"kms_alias_arn"`
+	KMSAliasName types.String `tfsdk:"kms_alias_name"`
+	KMSKeyARN    fwtypes.ARN  `tfsdk:"kms_key_arn"`
+}
+
+func expandDataflowEdges(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[dataflowEdgeModel]) [][]string {
+	data, _ := tfList.ToSlice(ctx)
+
+	apiObjects := make([][]string, 0, len(data))
+
+	for _, v := range data {
+		apiObjects = append(apiObjects, []string{v.Source.ValueString(), v.Destination.ValueString()})
+	}
+
+	return apiObjects
+}
+
+func flattenDataflowEdges(ctx context.Context, apiObjects [][]string) fwtypes.ListNestedObjectValueOf[dataflowEdgeModel] {
+	if len(apiObjects) == 0 {
+		return fwtypes.NewListNestedObjectValueOfNull[dataflowEdgeModel](ctx)
+	}
+
+	data := make([]*dataflowEdgeModel, 0, len(apiObjects))
+
+	for _, v := range apiObjects {
+		if len(v) != 2 {
+			continue
+		}
+
+		data = append(data, &dataflowEdgeModel{
+			Destination: fwtypes.ARNValue(v[1]),
+			Source:      fwtypes.ARNValue(v[0]),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfSliceMust(ctx, data)
+}
+
+func expandKMSKey(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[kmsKeyModel]) (awstypes.KmsKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.KMSAliasARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasArn{
+			Value: data.KMSAliasARN.ValueString(),
+		}, diags
+
+	case !data.KMSAliasName.IsNull():
+		return &awstypes.KmsKeyMemberKmsAliasName{
+			Value: data.KMSAliasName.ValueString(),
+		}, diags
+
+	case !data.KMSKeyARN.IsNull():
+		return &awstypes.KmsKeyMemberKmsKeyArn{
+			Value: data.KMSKeyARN.ValueString(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenKMSKey(ctx context.Context, apiObject awstypes.KmsKey) (fwtypes.ListNestedObjectValueOf[kmsKeyModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[kmsKeyModel](ctx), diags
+	}
+
+	data := &kmsKeyModel{
+		KMSAliasARN:  fwtypes.ARNNull(),
+		KMSAliasName: types.StringNull(),
+		KMSKeyARN:    fwtypes.ARNNull(),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.KmsKeyMemberKmsAliasArn:
+		data.KMSAliasARN = fwtypes.ARNValue(v.Value)
+
+	case *awstypes.KmsKeyMemberKmsAliasName:
+		data.KMSAliasName = types.StringValue(v.Value)
+
+	case *awstypes.KmsKeyMemberKmsKeyArn:
+		data.KMSKeyARN = fwtypes.ARNValue(v.Value)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, data), diags
+}
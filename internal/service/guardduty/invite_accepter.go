@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -61,7 +62,7 @@ func resourceInviteAccepterCreate(ctx context.Context, d *schema.ResourceData, m
 
 	listInvitationsInput := &guardduty.ListInvitationsInput{}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() *retry.RetryError {
 		log.Printf("[DEBUG] Listing GuardDuty Invitations: %s", listInvitationsInput)
 		err := conn.ListInvitationsPagesWithContext(ctx, listInvitationsInput, func(page *guardduty.ListInvitationsOutput, lastPage bool) bool {
 			for _, invitation := range page.Invitations {
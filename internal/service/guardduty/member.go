@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -70,6 +71,11 @@ func ResourceMember() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"wait_for_acceptance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Second),
@@ -117,11 +123,18 @@ func resourceMemberCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "inviting GuardDuty Member %q: %s", d.Id(), err)
 	}
 
-	err = inviteMemberWaiter(ctx, accountID, detectorID, d.Timeout(schema.TimeoutUpdate), conn)
+	err = inviteMemberWaiter(ctx, accountID, detectorID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), conn)
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Member %q invite: %s", d.Id(), err)
 	}
 
+	// The member account must accept the invitation, e.g. using aws_guardduty_invite_accepter.
+	if d.Get("wait_for_acceptance").(bool) {
+		if _, err := waitMemberAccepted(ctx, conn, accountID, detectorID, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Member %q invitation acceptance: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceMemberRead(ctx, d, meta)...)
 }
 
@@ -169,6 +182,8 @@ func resourceMemberRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if status == "Disabled" || status == "Enabled" || status == "Invited" || status == "EmailVerificationInProgress" {
 		d.Set("invite", true)
 	}
+	// Support in-place update of non-refreshable attribute.
+	d.Set("wait_for_acceptance", d.Get("wait_for_acceptance"))
 
 	return diags
 }
@@ -202,10 +217,16 @@ func resourceMemberUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 				return sdkdiag.AppendErrorf(diags, "inviting GuardDuty Member %q: %s", d.Id(), aws.StringValue(output.UnprocessedAccounts[0].Result))
 			}
 
-			err = inviteMemberWaiter(ctx, accountID, detectorID, d.Timeout(schema.TimeoutUpdate), conn)
+			err = inviteMemberWaiter(ctx, accountID, detectorID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), conn)
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Member %q invite: %s", d.Id(), err)
 			}
+
+			if d.Get("wait_for_acceptance").(bool) {
+				if _, err := waitMemberAccepted(ctx, conn, accountID, detectorID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+					return sdkdiag.AppendErrorf(diags, "waiting for GuardDuty Member %q invitation acceptance: %s", d.Id(), err)
+				}
+			}
 		} else {
 			input := &guardduty.DisassociateMembersInput{
 				AccountIds: []*string{aws.String(accountID)},
@@ -41,6 +41,7 @@ func testAccMember_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(resourceName, "detector_id"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrEmail, acctest.DefaultEmailAddress),
 					resource.TestCheckResourceAttr(resourceName, "relationship_status", "Created"),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_acceptance", acctest.CtFalse),
 				),
 			},
 			{
@@ -21,6 +21,13 @@ const (
 	// Constants not currently provided by the AWS Go SDK
 	publishingStatusFailed  = "Failed"
 	publishingStatusUnknown = "Unknown"
+
+	// Member RelationshipStatus
+	memberRelationshipStatusCreated                     = "Created"
+	memberRelationshipStatusEmailVerificationInProgress = "EmailVerificationInProgress"
+	memberRelationshipStatusEnabled                     = "Enabled"
+	memberRelationshipStatusInvited                     = "Invited"
+	memberRelationshipStatusNotFound                    = "NotFound"
 )
 
 // statusAdminAccountAdmin fetches the AdminAccount and its AdminStatus
@@ -62,6 +69,30 @@ func statusPublishingDestination(ctx context.Context, conn *guardduty.GuardDuty,
 	}
 }
 
+// statusMember fetches the Member and its RelationshipStatus
+func statusMember(ctx context.Context, conn *guardduty.GuardDuty, accountID, detectorID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &guardduty.GetMembersInput{
+			AccountIds: aws.StringSlice([]string{accountID}),
+			DetectorId: aws.String(detectorID),
+		}
+
+		output, err := conn.GetMembersWithContext(ctx, input)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output == nil || len(output.Members) == 0 || output.Members[0] == nil {
+			return nil, memberRelationshipStatusNotFound, nil
+		}
+
+		member := output.Members[0]
+
+		return member, aws.StringValue(member.RelationshipStatus), nil
+	}
+}
+
 // TODO: Migrate to shared internal package guardduty
 func getOrganizationAdminAccount(ctx context.Context, conn *guardduty.GuardDuty, adminAccountID string) (*guardduty.AdminAccount, error) {
 	input := &guardduty.ListOrganizationAdminAccountsInput{}
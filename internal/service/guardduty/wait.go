@@ -82,3 +82,21 @@ func waitPublishingDestinationCreated(ctx context.Context, conn *guardduty.Guard
 
 	return nil, err
 }
+
+// waitMemberAccepted waits for a Member to accept the invitation and return Enabled
+func waitMemberAccepted(ctx context.Context, conn *guardduty.GuardDuty, accountID, detectorID string, timeout time.Duration) (*guardduty.Member, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{memberRelationshipStatusCreated, memberRelationshipStatusEmailVerificationInProgress, memberRelationshipStatusInvited},
+		Target:  []string{memberRelationshipStatusEnabled},
+		Refresh: statusMember(ctx, conn, accountID, detectorID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*guardduty.Member); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -5,12 +5,14 @@ package imagebuilder
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -94,6 +96,12 @@ func ResourceComponent() *schema.Resource {
 				Default:  false,
 				Optional: true,
 			},
+			"source_hash": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"data"},
+			},
 			"supported_os_versions": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -125,7 +133,10 @@ func ResourceComponent() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceComponentCustomizeDiff,
+		),
 	}
 }
 
@@ -269,3 +280,14 @@ func resourceComponentDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 	return diags
 }
+
+func resourceComponentCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The new content is published as a new component version, so a change to the content
+	// must be accompanied by a change to the semantic version. Otherwise the replacement
+	// fails if the old version is retained or the resource is created before it is destroyed.
+	if diff.Id() != "" && diff.HasChange("source_hash") && !diff.HasChange(names.AttrVersion) {
+		return fmt.Errorf("%s must be changed when source_hash changes", names.AttrVersion)
+	}
+
+	return nil
+}
@@ -299,6 +299,63 @@ func TestAccImageBuilderComponent_uri(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderComponent_sourceHash(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_component.test"
+	var arn1, arn2 string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfig_sourceHash(rName, "hello world", "1.0.0", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_hash", "aws_s3_object.test", "etag"),
+					resource.TestCheckResourceAttrWith(resourceName, names.AttrARN, func(value string) error {
+						arn1 = value
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy, "source_hash", names.AttrURI},
+			},
+			{
+				Config:      testAccComponentConfig_sourceHash(rName, "hello world updated", "1.0.0", false),
+				ExpectError: regexache.MustCompile(`version must be changed when source_hash changes`),
+			},
+			{
+				Config: testAccComponentConfig_sourceHash(rName, "hello world updated", "1.0.1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "source_hash", "aws_s3_object.test", "etag"),
+					resource.TestMatchResourceAttr(resourceName, "data", regexache.MustCompile(`hello world updated`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0.1"),
+					resource.TestCheckResourceAttrWith(resourceName, names.AttrARN, func(value string) error {
+						arn2 = value
+						if arn1 == arn2 {
+							return fmt.Errorf("expected component build version to change, got %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				Config:      testAccComponentConfig_sourceHash(rName, "hello world again", "1.0.1", true),
+				ExpectError: regexache.MustCompile(`version must be changed when source_hash changes`),
+			},
+		},
+	})
+}
+
 func testAccCheckComponentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).ImageBuilderConn(ctx)
@@ -596,3 +653,39 @@ resource "aws_imagebuilder_component" "test" {
 }
 `, rName)
 }
+
+func testAccComponentConfig_sourceHash(rName, message, version string, skipDestroy bool) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  content = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = ["echo '%[2]s'"]
+        }
+        name      = "example"
+        onFailure = "Continue"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  key = "test.yml"
+}
+
+resource "aws_imagebuilder_component" "test" {
+  name         = %[1]q
+  platform     = "Linux"
+  uri          = "s3://${aws_s3_bucket.test.bucket}/${aws_s3_object.test.key}"
+  source_hash  = aws_s3_object.test.etag
+  version      = %[3]q
+  skip_destroy = %[4]t
+}
+`, rName, message, version, skipDestroy)
+}
@@ -5,6 +5,8 @@ package imagebuilder
 
 import (
 	"context"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
@@ -14,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -27,7 +31,32 @@ func DataSourceComponents() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"component_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrFilter: namevaluesfilters.Schema(),
+			"latest_version_per_name": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			names.AttrNames: {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -38,6 +67,11 @@ func DataSourceComponents() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringInSlice(imagebuilder.Ownership_Values(), false),
 			},
+			"version_constraint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidVersionConstraints,
+			},
 		},
 	}
 }
@@ -52,8 +86,17 @@ func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, meta
 		input.Owner = aws.String(v.(string))
 	}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).ImagebuilderFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.ImagebuilderFilters()
+		clientSideFilters = clientSide
 	}
 
 	var results []*imagebuilder.ComponentVersion
@@ -68,6 +111,16 @@ func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, meta
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(componentVersion.Name), map[string][]string{
+				names.AttrDescription: {aws.StringValue(componentVersion.Description)},
+				"platform":            {aws.StringValue(componentVersion.Platform)},
+				"supportedOsVersion":  aws.StringValueSlice(componentVersion.SupportedOsVersions),
+				names.AttrType:        {aws.StringValue(componentVersion.Type)},
+				names.AttrVersion:     {aws.StringValue(componentVersion.Version)},
+			}) {
+				continue
+			}
+
 			results = append(results, componentVersion)
 		}
 
@@ -78,16 +131,71 @@ func dataSourceComponentsRead(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "reading Image Builder Components: %s", err)
 	}
 
+	if v, ok := d.GetOk("version_constraint"); ok {
+		constraint := v.(string)
+		var matched []*imagebuilder.ComponentVersion
+
+		for _, r := range results {
+			ok, err := semver.MatchesConstraints(aws.StringValue(r.Version), constraint)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading Image Builder Components: version_constraint: %s", err)
+			}
+
+			if ok {
+				matched = append(matched, r)
+			}
+		}
+
+		results = matched
+	}
+
+	// Sort by name and then by ascending semantic version.
+	slices.SortStableFunc(results, func(a, b *imagebuilder.ComponentVersion) int {
+		if v := strings.Compare(aws.StringValue(a.Name), aws.StringValue(b.Name)); v != 0 {
+			return v
+		}
+
+		return semver.Compare(aws.StringValue(a.Version), aws.StringValue(b.Version))
+	})
+
+	if d.Get("latest_version_per_name").(bool) {
+		results = latestComponentVersionPerName(results)
+	}
+
 	var arns, nms []string
+	var componentVersions []interface{}
 
 	for _, r := range results {
 		arns = append(arns, aws.StringValue(r.Arn))
 		nms = append(nms, aws.StringValue(r.Name))
+		componentVersions = append(componentVersions, map[string]interface{}{
+			names.AttrARN:     aws.StringValue(r.Arn),
+			names.AttrName:    aws.StringValue(r.Name),
+			names.AttrVersion: aws.StringValue(r.Version),
+		})
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
 	d.Set(names.AttrARNs, arns)
+	d.Set("component_versions", componentVersions)
 	d.Set(names.AttrNames, nms)
 
 	return diags
 }
+
+// latestComponentVersionPerName returns the last component version for each name.
+// The input must be sorted by name and then by ascending semantic version.
+func latestComponentVersionPerName(apiObjects []*imagebuilder.ComponentVersion) []*imagebuilder.ComponentVersion {
+	var latest []*imagebuilder.ComponentVersion
+
+	for i, apiObject := range apiObjects {
+		if i+1 < len(apiObjects) && aws.StringValue(apiObjects[i+1].Name) == aws.StringValue(apiObject.Name) {
+			continue
+		}
+
+		latest = append(latest, apiObject)
+	}
+
+	return latest
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
@@ -38,6 +39,100 @@ func TestAccImageBuilderComponentsDataSource_filter(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderComponentsDataSource_latestVersionPerName(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_imagebuilder_components.test"
+	resourceName := "aws_imagebuilder_component.test.2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentsDataSourceConfig_versions(rName),
+			},
+			{
+				Config: testAccComponentsDataSourceConfig_latestVersionPerName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "component_versions.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "component_versions.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "component_versions.0.version", "1.10.0"),
+				),
+			},
+			{
+				Config: testAccComponentsDataSourceConfig_versionConstraint(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "component_versions.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(dataSourceName, "component_versions.0.version", "1.2.0"),
+					resource.TestCheckResourceAttr(dataSourceName, "component_versions.1.version", "1.10.0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderComponentsDataSource_clientSideFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_imagebuilder_components.test"
+	resourceName := "aws_imagebuilder_component.test.0"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentsDataSourceConfig_names(rName),
+			},
+			{
+				Config: testAccComponentsDataSourceConfig_clientSideFilter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "names.0", resourceName, names.AttrName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderComponentsDataSource_invalidNameRegex(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComponentsDataSourceConfig_invalidNameRegex,
+				ExpectError: regexache.MustCompile(`name-regex filter value`),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderComponentsDataSource_invalidVersionConstraint(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccComponentsDataSourceConfig_invalidVersionConstraint,
+				ExpectError: regexache.MustCompile(`invalid version constraint`),
+			},
+		},
+	})
+}
+
 func testAccComponentsDataSourceConfig_component(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_imagebuilder_component" "test" {
@@ -74,3 +169,129 @@ data "aws_imagebuilder_components" "test" {
 }
 `)
 }
+
+func testAccComponentsDataSourceConfig_versions(rName string) string {
+	return fmt.Sprintf(`
+locals {
+  versions = ["1.0.0", "1.2.0", "1.10.0"]
+}
+
+resource "aws_imagebuilder_component" "test" {
+  count = length(local.versions)
+
+  data = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = ["echo 'hello world'"]
+        }
+        name      = "example"
+        onFailure = "Continue"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  name     = %[1]q
+  platform = "Linux"
+  version  = local.versions[count.index]
+}
+`, rName)
+}
+
+func testAccComponentsDataSourceConfig_names(rName string) string {
+	return fmt.Sprintf(`
+locals {
+  suffixes = ["keep", "skip"]
+}
+
+resource "aws_imagebuilder_component" "test" {
+  count = length(local.suffixes)
+
+  data = yamlencode({
+    phases = [{
+      name = "build"
+      steps = [{
+        action = "ExecuteBash"
+        inputs = {
+          commands = ["echo 'hello world'"]
+        }
+        name      = "example"
+        onFailure = "Continue"
+      }]
+    }]
+    schemaVersion = 1.0
+  })
+  name     = "%[1]s-${local.suffixes[count.index]}"
+  platform = "Linux"
+  version  = "1.0.0"
+}
+`, rName)
+}
+
+func testAccComponentsDataSourceConfig_clientSideFilter(rName string) string {
+	return acctest.ConfigCompose(
+		testAccComponentsDataSourceConfig_names(rName),
+		fmt.Sprintf(`
+data "aws_imagebuilder_components" "test" {
+  owner = "Self"
+
+  filter {
+    name   = "name-regex"
+    values = ["^%[1]s-"]
+  }
+
+  filter {
+    name   = "name"
+    negate = true
+    values = [aws_imagebuilder_component.test[1].name]
+  }
+}
+`, rName))
+}
+
+func testAccComponentsDataSourceConfig_latestVersionPerName(rName string) string {
+	return acctest.ConfigCompose(
+		testAccComponentsDataSourceConfig_versions(rName),
+		`
+data "aws_imagebuilder_components" "test" {
+  latest_version_per_name = true
+
+  filter {
+    name   = "name"
+    values = [aws_imagebuilder_component.test[0].name]
+  }
+}
+`)
+}
+
+func testAccComponentsDataSourceConfig_versionConstraint(rName string) string {
+	return acctest.ConfigCompose(
+		testAccComponentsDataSourceConfig_versions(rName),
+		`
+data "aws_imagebuilder_components" "test" {
+  version_constraint = ">= 1.1.0, < 2.0.0"
+
+  filter {
+    name   = "name"
+    values = [aws_imagebuilder_component.test[0].name]
+  }
+}
+`)
+}
+
+const testAccComponentsDataSourceConfig_invalidVersionConstraint = `
+data "aws_imagebuilder_components" "test" {
+  version_constraint = "~> one"
+}
+`
+
+const testAccComponentsDataSourceConfig_invalidNameRegex = `
+data "aws_imagebuilder_components" "test" {
+  filter {
+    name   = "name-regex"
+    values = ["("]
+  }
+}
+`
@@ -52,8 +52,17 @@ func dataSourceContainerRecipesRead(ctx context.Context, d *schema.ResourceData,
 		input.Owner = aws.String(v.(string))
 	}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).ImagebuilderFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.ImagebuilderFilters()
+		clientSideFilters = clientSide
 	}
 
 	var results []*imagebuilder.ContainerRecipeSummary
@@ -68,6 +77,14 @@ func dataSourceContainerRecipesRead(ctx context.Context, d *schema.ResourceData,
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(containerRecipeSummary.Name), map[string][]string{
+				"containerType": {aws.StringValue(containerRecipeSummary.ContainerType)},
+				"parentImage":   {aws.StringValue(containerRecipeSummary.ParentImage)},
+				"platform":      {aws.StringValue(containerRecipeSummary.Platform)},
+			}) {
+				continue
+			}
+
 			results = append(results, containerRecipeSummary)
 		}
 
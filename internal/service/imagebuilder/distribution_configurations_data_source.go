@@ -42,8 +42,17 @@ func dataSourceDistributionConfigurationsRead(ctx context.Context, d *schema.Res
 
 	input := &imagebuilder.ListDistributionConfigurationsInput{}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).ImagebuilderFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.ImagebuilderFilters()
+		clientSideFilters = clientSide
 	}
 
 	var results []*imagebuilder.DistributionConfigurationSummary
@@ -58,6 +67,10 @@ func dataSourceDistributionConfigurationsRead(ctx context.Context, d *schema.Res
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(distributionConfigurationSummary.Name), nil) {
+				continue
+			}
+
 			results = append(results, distributionConfigurationSummary)
 		}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -81,6 +82,46 @@ func ResourceImage() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 				ExactlyOneOf: []string{"container_recipe_arn", "image_recipe_arn"},
 			},
+			"image_scan_findings_severity_counts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"all": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"critical": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"high": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"medium": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"image_scan_state": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"image_scanning_configuration": {
 				Type:     schema.TypeList,
 				MaxItems: 1,
@@ -218,6 +259,11 @@ func ResourceImage() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"wait_for_scan_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"workflow": {
 				Type:     schema.TypeSet,
 				Computed: true,
@@ -326,10 +372,16 @@ func resourceImageCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.StringValue(output.ImageBuildVersionArn))
 
-	if _, err := waitImageStatusAvailable(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitImageStatusAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Image Builder Image (%s) to become available: %s", d.Id(), err)
 	}
 
+	if d.Get("wait_for_scan_completion").(bool) && input.ImageScanningConfiguration != nil && aws.BoolValue(input.ImageScanningConfiguration.ImageScanningEnabled) {
+		if _, err := waitImageScanStatusCompleted(ctx, conn, d.Id(), imageScanCompletedTimeout); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Image Builder Image (%s) scan to complete: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceImageRead(ctx, d, meta)...)
 }
 
@@ -372,6 +424,23 @@ func resourceImageRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if image.ImageRecipe != nil {
 		d.Set("image_recipe_arn", image.ImageRecipe.Arn)
 	}
+	if image.ScanState != nil {
+		d.Set("image_scan_state", []interface{}{flattenImageScanState(image.ScanState)})
+	} else {
+		d.Set("image_scan_state", nil)
+	}
+	// Only fetch scan findings when requested, as doing so requires an additional IAM permission.
+	if d.Get("wait_for_scan_completion").(bool) && image.ScanState != nil && aws.StringValue(image.ScanState.Status) == imagebuilder.ImageScanStatusCompleted {
+		severityCounts, err := findImageScanFindingsSeverityCounts(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Image Builder Image (%s) scan findings: %s", d.Id(), err)
+		}
+
+		d.Set("image_scan_findings_severity_counts", []interface{}{flattenSeverityCounts(severityCounts)})
+	} else {
+		d.Set("image_scan_findings_severity_counts", nil)
+	}
 	if image.ImageScanningConfiguration != nil {
 		d.Set("image_scanning_configuration", []interface{}{flattenImageScanningConfiguration(image.ImageScanningConfiguration)})
 	} else {
@@ -434,6 +503,81 @@ func resourceImageDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+// findImageScanFindingsSeverityCounts returns the total number of vulnerability scan findings, by severity, for the specified Image.
+func findImageScanFindingsSeverityCounts(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) (*imagebuilder.SeverityCounts, error) {
+	input := &imagebuilder.ListImageScanFindingAggregationsInput{
+		Filter: &imagebuilder.Filter{
+			Name:   aws.String("imageBuildVersionArn"),
+			Values: aws.StringSlice([]string{imageBuildVersionArn}),
+		},
+	}
+	output := &imagebuilder.SeverityCounts{
+		All:      aws.Int64(0),
+		Critical: aws.Int64(0),
+		High:     aws.Int64(0),
+		Medium:   aws.Int64(0),
+	}
+
+	err := conn.ListImageScanFindingAggregationsPagesWithContext(ctx, input, func(page *imagebuilder.ListImageScanFindingAggregationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Responses {
+			if v == nil || v.VulnerabilityIdAggregation == nil || v.VulnerabilityIdAggregation.SeverityCounts == nil {
+				continue
+			}
+
+			counts := v.VulnerabilityIdAggregation.SeverityCounts
+			output.All = aws.Int64(aws.Int64Value(output.All) + aws.Int64Value(counts.All))
+			output.Critical = aws.Int64(aws.Int64Value(output.Critical) + aws.Int64Value(counts.Critical))
+			output.High = aws.Int64(aws.Int64Value(output.High) + aws.Int64Value(counts.High))
+			output.Medium = aws.Int64(aws.Int64Value(output.Medium) + aws.Int64Value(counts.Medium))
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func flattenImageScanState(apiObject *imagebuilder.ImageScanState) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Reason; v != nil {
+		tfMap["reason"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Status; v != nil {
+		tfMap[names.AttrStatus] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSeverityCounts(apiObject *imagebuilder.SeverityCounts) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"all":      aws.Int64Value(apiObject.All),
+		"critical": aws.Int64Value(apiObject.Critical),
+		"high":     aws.Int64Value(apiObject.High),
+		"medium":   aws.Int64Value(apiObject.Medium),
+	}
+
+	return tfMap
+}
+
 func flattenOutputResources(apiObject *imagebuilder.OutputResources) map[string]interface{} {
 	if apiObject == nil {
 		return nil
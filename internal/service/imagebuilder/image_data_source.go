@@ -251,7 +251,7 @@ func dataSourceImageRead(ctx context.Context, d *schema.ResourceData, meta inter
 		d.Set("output_resources", nil)
 	}
 
-	d.Set(names.AttrTags, KeyValueTags(ctx, image.Tags).IgnoreAWS().IgnoreConfig(meta.(*conns.AWSClient).IgnoreTagsConfig).Map())
+	d.Set(names.AttrTags, KeyValueTags(ctx, image.Tags).IgnoreAWS().IgnoreConfig(meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)).Map())
 	d.Set(names.AttrVersion, image.Version)
 
 	return diags
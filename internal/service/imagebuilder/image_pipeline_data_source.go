@@ -201,7 +201,7 @@ func dataSourceImagePipelineRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set(names.AttrStatus, imagePipeline.Status)
-	d.Set(names.AttrTags, KeyValueTags(ctx, imagePipeline.Tags).IgnoreAWS().IgnoreConfig(meta.(*conns.AWSClient).IgnoreTagsConfig).Map())
+	d.Set(names.AttrTags, KeyValueTags(ctx, imagePipeline.Tags).IgnoreAWS().IgnoreConfig(meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)).Map())
 
 	return diags
 }
@@ -42,8 +42,17 @@ func dataSourceImagePipelinesRead(ctx context.Context, d *schema.ResourceData, m
 
 	input := &imagebuilder.ListImagePipelinesInput{}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).ImagebuilderFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.ImagebuilderFilters()
+		clientSideFilters = clientSide
 	}
 
 	var results []*imagebuilder.ImagePipeline
@@ -58,6 +67,16 @@ func dataSourceImagePipelinesRead(ctx context.Context, d *schema.ResourceData, m
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(imagePipeline.Name), map[string][]string{
+				names.AttrDescription:            {aws.StringValue(imagePipeline.Description)},
+				"distributionConfigurationArn":   {aws.StringValue(imagePipeline.DistributionConfigurationArn)},
+				"imageRecipeArn":                 {aws.StringValue(imagePipeline.ImageRecipeArn)},
+				"infrastructureConfigurationArn": {aws.StringValue(imagePipeline.InfrastructureConfigurationArn)},
+				names.AttrStatus:                 {aws.StringValue(imagePipeline.Status)},
+			}) {
+				continue
+			}
+
 			results = append(results, imagePipeline)
 		}
 
@@ -7,8 +7,10 @@ import (
 	"context"
 	"log"
 	"strconv"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/imagebuilder"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -18,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
+	"github.com/hashicorp/terraform-provider-aws/internal/semver"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -137,10 +140,11 @@ func ResourceImageRecipe() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"component_arn": {
-							Type:         schema.TypeString,
-							Required:     true,
-							ForceNew:     true,
-							ValidateFunc: verify.ValidARN,
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateFunc:     verify.ValidARN,
+							DiffSuppressFunc: suppressEquivalentComponentARN,
 						},
 						names.AttrParameter: {
 							Type:     schema.TypeSet,
@@ -210,6 +214,11 @@ func ResourceImageRecipe() *schema.Resource {
 					},
 				},
 			},
+			"resolved_component_versions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"user_data_base64": {
@@ -340,6 +349,14 @@ func resourceImageRecipeRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set("parent_image", imageRecipe.ParentImage)
 	d.Set("platform", imageRecipe.Platform)
 
+	resolvedComponentVersions, err := resolveComponentVersions(ctx, conn, meta.(*conns.AWSClient).AccountID, imageRecipe.Components)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "resolving Image Builder Image Recipe (%s) component versions: %s", d.Id(), err)
+	}
+
+	d.Set("resolved_component_versions", resolvedComponentVersions)
+
 	setTagsOut(ctx, imageRecipe.Tags)
 
 	if imageRecipe.AdditionalInstanceConfiguration != nil {
@@ -382,6 +399,162 @@ func resourceImageRecipeDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+// suppressEquivalentComponentARN suppresses the difference between a configured component ARN
+// whose semantic version contains "x" wildcards and a component version ARN that it matches.
+func suppressEquivalentComponentARN(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	oldARN, oldVersion, ok := parseComponentARN(old)
+	if !ok {
+		return false
+	}
+
+	// Only a concrete version resolved from a wildcard pattern is equivalent to that pattern.
+	// Changes between wildcard patterns (e.g. "1.x.x" -> "x.x.x") are compared literally.
+	if strings.Contains(oldVersion, "x") {
+		return false
+	}
+
+	newARN, newVersion, ok := parseComponentARN(new)
+	if !ok {
+		return false
+	}
+
+	return oldARN == newARN && componentVersionMatches(newVersion, oldVersion)
+}
+
+// parseComponentARN splits a component, component version or component build version ARN
+// into the ARN of the component (without any version) and its semantic version.
+func parseComponentARN(s string) (string, string, bool) {
+	v, err := arn.Parse(s)
+
+	if err != nil {
+		return "", "", false
+	}
+
+	parts := strings.Split(v.Resource, "/")
+
+	if len(parts) < 3 || len(parts) > 4 || parts[0] != "component" {
+		return "", "", false
+	}
+
+	v.Resource = strings.Join(parts[:2], "/")
+
+	return v.String(), parts[2], true
+}
+
+// componentVersionMatches returns whether the semantic version matches the pattern,
+// in which any of the major, minor or patch elements may be the "x" wildcard.
+func componentVersionMatches(pattern, version string) bool {
+	patternParts, versionParts := strings.Split(pattern, "."), strings.Split(version, ".")
+
+	if len(patternParts) != 3 || len(versionParts) != 3 {
+		return false
+	}
+
+	for i, v := range patternParts {
+		if v != "x" && v != versionParts[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// resolveComponentVersions returns a map of each component ARN to the semantic version it resolves to.
+// Component ARNs containing wildcards are resolved to the latest matching component version.
+func resolveComponentVersions(ctx context.Context, conn *imagebuilder.Imagebuilder, accountID string, apiObjects []*imagebuilder.ComponentConfiguration) (map[string]string, error) {
+	resolved := make(map[string]string)
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		componentARN := aws.StringValue(apiObject.ComponentArn)
+		baseARN, version, ok := parseComponentARN(componentARN)
+
+		if !ok {
+			continue
+		}
+
+		if !strings.Contains(version, "x") {
+			resolved[componentARN] = version
+
+			continue
+		}
+
+		componentVersion, err := findLatestComponentVersionMatching(ctx, conn, accountID, baseARN, version)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if componentVersion != nil {
+			resolved[componentARN] = aws.StringValue(componentVersion.Version)
+		}
+	}
+
+	return resolved, nil
+}
+
+func findLatestComponentVersionMatching(ctx context.Context, conn *imagebuilder.Imagebuilder, accountID, baseARN, pattern string) (*imagebuilder.ComponentVersion, error) {
+	v, err := arn.Parse(baseARN)
+
+	if err != nil {
+		return nil, err
+	}
+
+	input := &imagebuilder.ListComponentsInput{
+		ByName: aws.Bool(true),
+		Filters: []*imagebuilder.Filter{{
+			Name:   aws.String("name"),
+			Values: aws.StringSlice([]string{strings.TrimPrefix(v.Resource, "component/")}),
+		}},
+	}
+
+	switch v.AccountID {
+	case "aws":
+		input.Owner = aws.String(imagebuilder.OwnershipAmazon)
+	case accountID:
+		input.Owner = aws.String(imagebuilder.OwnershipSelf)
+	default:
+		input.Owner = aws.String(imagebuilder.OwnershipShared)
+	}
+
+	var latest *imagebuilder.ComponentVersion
+
+	err = conn.ListComponentsPagesWithContext(ctx, input, func(page *imagebuilder.ListComponentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, componentVersion := range page.ComponentVersionList {
+			if componentVersion == nil {
+				continue
+			}
+
+			if componentARN, version, ok := parseComponentARN(aws.StringValue(componentVersion.Arn)); !ok || componentARN != baseARN || !componentVersionMatches(pattern, version) {
+				continue
+			}
+
+			if latest == nil || semver.Compare(aws.StringValue(componentVersion.Version), aws.StringValue(latest.Version)) > 0 {
+				latest = componentVersion
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return latest, nil
+}
+
 func expandComponentConfiguration(tfMap map[string]interface{}) *imagebuilder.ComponentConfiguration {
 	if tfMap == nil {
 		return nil
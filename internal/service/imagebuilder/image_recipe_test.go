@@ -22,6 +22,90 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestSuppressEquivalentComponentARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			TestName: "identical",
+			Old:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x", //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			TestName: "all wildcards",
+			Old:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/1.0.2/1", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x",   //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			TestName: "patch wildcard",
+			Old:      "arn:aws:imagebuilder:us-west-2:123456789012:component/test/1.2.3", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:123456789012:component/test/1.2.x", //lintignore:AWSAT003,AWSAT005
+			Expected: true,
+		},
+		{
+			TestName: "minor mismatch",
+			Old:      "arn:aws:imagebuilder:us-west-2:123456789012:component/test/1.3.0", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:123456789012:component/test/1.2.x", //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			TestName: "different component",
+			Old:      "arn:aws:imagebuilder:us-west-2:aws:component/update-windows/1.0.2", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x",   //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			TestName: "pinned version",
+			Old:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/1.0.2", //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			TestName: "wildcard broadened",
+			Old:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/1.x.x", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x", //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			TestName: "wildcard narrowed",
+			Old:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/1.x.x", //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			TestName: "resolved version does not match narrowed wildcard",
+			Old:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/2.0.1/1", //lintignore:AWSAT003,AWSAT005
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/1.x.x",   //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+		{
+			TestName: "invalid ARN",
+			Old:      "test",
+			New:      "arn:aws:imagebuilder:us-west-2:aws:component/update-linux/x.x.x", //lintignore:AWSAT003,AWSAT005
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfimagebuilder.SuppressEquivalentComponentARN("", testCase.Old, testCase.New, nil), testCase.Expected; got != want {
+				t.Errorf("SuppressEquivalentComponentARN(%q, %q) = %t, want %t", testCase.Old, testCase.New, got, want)
+			}
+		})
+	}
+}
+
 func TestAccImageBuilderImageRecipe_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -470,6 +554,40 @@ func TestAccImageBuilderImageRecipe_component(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderImageRecipe_componentWildcard(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image_recipe.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageRecipeConfig_componentWildcard(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageRecipeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "component.#", acctest.Ct2),
+					acctest.CheckResourceAttrRegionalARNAccountID(resourceName, "component.0.component_arn", "imagebuilder", "aws", "component/update-linux/x.x.x"),
+					resource.TestCheckResourceAttr(resourceName, "resolved_component_versions.%", acctest.Ct2),
+					resource.TestCheckResourceAttrPair(resourceName, "component.1.component_arn", "aws_imagebuilder_component.test", names.AttrARN),
+				),
+			},
+			{
+				Config:   testAccImageRecipeConfig_componentWildcard(rName),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderImageRecipe_componentParameter(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1106,6 +1224,26 @@ resource "aws_imagebuilder_image_recipe" "test" {
 `, rName))
 }
 
+func testAccImageRecipeConfig_componentWildcard(rName string) string {
+	return acctest.ConfigCompose(
+		testAccImageRecipeBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_image_recipe" "test" {
+  component {
+    component_arn = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:component/update-linux/x.x.x"
+  }
+
+  component {
+    component_arn = aws_imagebuilder_component.test.arn
+  }
+
+  name         = %[1]q
+  parent_image = "arn:${data.aws_partition.current.partition}:imagebuilder:${data.aws_region.current.name}:aws:image/amazon-linux-2-x86/x.x.x"
+  version      = "1.0.0"
+}
+`, rName))
+}
+
 func testAccImageRecipeConfig_componentUpdate(rName, command string) string {
 	return acctest.ConfigCompose(
 		fmt.Sprintf(`
@@ -52,8 +52,17 @@ func dataSourceImageRecipesRead(ctx context.Context, d *schema.ResourceData, met
 		input.Owner = aws.String(v.(string))
 	}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).ImagebuilderFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.ImagebuilderFilters()
+		clientSideFilters = clientSide
 	}
 
 	var results []*imagebuilder.ImageRecipeSummary
@@ -68,6 +77,13 @@ func dataSourceImageRecipesRead(ctx context.Context, d *schema.ResourceData, met
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(imageRecipeSummary.Name), map[string][]string{
+				"parentImage": {aws.StringValue(imageRecipeSummary.ParentImage)},
+				"platform":    {aws.StringValue(imageRecipeSummary.Platform)},
+			}) {
+				continue
+			}
+
 			results = append(results, imageRecipeSummary)
 		}
 
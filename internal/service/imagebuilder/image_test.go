@@ -294,6 +294,44 @@ func TestAccImageBuilderImage_imageScanningConfiguration(t *testing.T) {
 	})
 }
 
+func TestAccImageBuilderImage_waitForScanCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.Inspector2EndpointID)
+			acctest.PreCheckInspector2(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImageConfig_waitForScanCompletion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_scan_completion", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "image_scan_state.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "image_scan_state.0.status", imagebuilder.ImageScanStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "image_scan_findings_severity_counts.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "image_scan_findings_severity_counts.0.all"),
+					resource.TestCheckResourceAttrSet(resourceName, "image_scan_findings_severity_counts.0.critical"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"image_scan_findings_severity_counts", "wait_for_scan_completion"},
+			},
+		},
+	})
+}
+
 func TestAccImageBuilderImage_outputResources_containers(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1012,3 +1050,32 @@ resource "aws_imagebuilder_image" "test" {
 }
 `)
 }
+
+func testAccImageConfig_waitForScanCompletion(rName string) string {
+	return acctest.ConfigCompose(
+		testAccImageConfig_containerRecipeBase(rName),
+		`
+data "aws_caller_identity" "current" {}
+
+resource "aws_inspector2_enabler" "test" {
+  account_ids    = [data.aws_caller_identity.current.account_id]
+  resource_types = ["ECR"]
+}
+
+resource "aws_imagebuilder_image" "test" {
+  container_recipe_arn             = aws_imagebuilder_container_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  wait_for_scan_completion         = true
+
+  image_scanning_configuration {
+    image_scanning_enabled = true
+
+    ecr_configuration {
+      repository_name = aws_ecr_repository.test.name
+    }
+  }
+
+  depends_on = [aws_inspector2_enabler.test]
+}
+`)
+}
@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_imagebuilder_images", name="Images")
+func DataSourceImages() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceImagesRead,
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  verify.ValidARN,
+				ConflictsWith: []string{names.AttrOwner},
+			},
+			names.AttrARNs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			names.AttrFilter: namevaluesfilters.Schema(),
+			"images": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"build_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output_resources": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"amis": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrAccountID: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrDescription: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"image": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrName: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												names.AttrRegion: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+									"containers": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"image_uris": {
+													Type:     schema.TypeSet,
+													Computed: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												names.AttrRegion: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrOwner: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringInSlice(imagebuilder.Ownership_Values(), false),
+				ConflictsWith: []string{names.AttrARN},
+			},
+		},
+	}
+}
+
+func dataSourceImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	var filters []*imagebuilder.Filter
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		serverSide, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		filters = serverSide.ImagebuilderFilters()
+		clientSideFilters = clientSide
+	}
+
+	var imageVersionARNs []string
+
+	if v, ok := d.GetOk(names.AttrARN); ok {
+		imageVersionARNs = append(imageVersionARNs, v.(string))
+	} else {
+		input := &imagebuilder.ListImagesInput{
+			Filters: filters,
+		}
+
+		if v, ok := d.GetOk(names.AttrOwner); ok {
+			input.Owner = aws.String(v.(string))
+		}
+
+		err := conn.ListImagesPagesWithContext(ctx, input, func(page *imagebuilder.ListImagesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, imageVersion := range page.ImageVersionList {
+				if imageVersion == nil {
+					continue
+				}
+
+				imageVersionARNs = append(imageVersionARNs, aws.StringValue(imageVersion.Arn))
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Image Builder Images: %s", err)
+		}
+	}
+
+	var results []*imagebuilder.ImageSummary
+
+	for _, imageVersionARN := range imageVersionARNs {
+		input := &imagebuilder.ListImageBuildVersionsInput{
+			Filters:         filters,
+			ImageVersionArn: aws.String(imageVersionARN),
+		}
+
+		err := conn.ListImageBuildVersionsPagesWithContext(ctx, input, func(page *imagebuilder.ListImageBuildVersionsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, imageSummary := range page.ImageSummaryList {
+				if imageSummary == nil {
+					continue
+				}
+
+				if !clientSideFilters.Match(aws.StringValue(imageSummary.Name), map[string][]string{
+					"osVersion":       {aws.StringValue(imageSummary.OsVersion)},
+					"platform":        {aws.StringValue(imageSummary.Platform)},
+					names.AttrType:    {aws.StringValue(imageSummary.Type)},
+					names.AttrVersion: {aws.StringValue(imageSummary.Version)},
+				}) {
+					continue
+				}
+
+				results = append(results, imageSummary)
+			}
+
+			return !lastPage
+		})
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Image Builder Image (%s) build versions: %s", imageVersionARN, err)
+		}
+	}
+
+	// Oldest build versions first.
+	sort.SliceStable(results, func(i, j int) bool {
+		return aws.StringValue(results[i].DateCreated) < aws.StringValue(results[j].DateCreated)
+	})
+
+	var arns []string
+
+	for _, r := range results {
+		arns = append(arns, aws.StringValue(r.Arn))
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	d.Set(names.AttrARNs, arns)
+	if err := d.Set("images", flattenImageSummaries(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting images: %s", err)
+	}
+
+	return diags
+}
+
+func flattenImageSummary(apiObject *imagebuilder.ImageSummary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Arn; v != nil {
+		tfMap[names.AttrARN] = aws.StringValue(v)
+	}
+
+	if v := apiObject.BuildType; v != nil {
+		tfMap["build_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.DateCreated; v != nil {
+		tfMap["date_created"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Name; v != nil {
+		tfMap[names.AttrName] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OsVersion; v != nil {
+		tfMap["os_version"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.OutputResources; v != nil {
+		tfMap["output_resources"] = []interface{}{flattenOutputResources(v)}
+	}
+
+	if v := apiObject.Platform; v != nil {
+		tfMap["platform"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.State; v != nil {
+		tfMap[names.AttrStatus] = aws.StringValue(v.Status)
+		tfMap[names.AttrStatusReason] = aws.StringValue(v.Reason)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap[names.AttrType] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Version; v != nil {
+		tfMap[names.AttrVersion] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenImageSummaries(apiObjects []*imagebuilder.ImageSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, flattenImageSummary(apiObject))
+	}
+
+	return tfList
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccImageBuilderImagesDataSource_arn(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_imagebuilder_images.test"
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_arn(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.date_created", resourceName, "date_created"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.os_version", resourceName, "os_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.output_resources.#", resourceName, "output_resources.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.output_resources.0.amis.#", resourceName, "output_resources.0.amis.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.platform", resourceName, "platform"),
+					resource.TestCheckResourceAttr(dataSourceName, "images.0.status", "AVAILABLE"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.version", resourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderImagesDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_imagebuilder_images.test"
+	resourceName := "aws_imagebuilder_image.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckImageDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccImagesDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "images.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "images.0.arn", resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccImagesDataSourceConfig_arn(rName string) string {
+	return acctest.ConfigCompose(
+		testAccImageConfig_required(rName),
+		`
+data "aws_imagebuilder_images" "test" {
+  # Strip the build version from the image build version ARN.
+  arn = replace(aws_imagebuilder_image.test.arn, "/\\/[0-9]+$/", "")
+}
+`)
+}
+
+func testAccImagesDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(
+		testAccImageConfig_required(rName),
+		`
+data "aws_imagebuilder_images" "test" {
+  filter {
+    name   = "name"
+    values = [aws_imagebuilder_image.test.name]
+  }
+}
+`)
+}
@@ -42,8 +42,17 @@ func dataSourceInfrastructureConfigurationsRead(ctx context.Context, d *schema.R
 
 	input := &imagebuilder.ListInfrastructureConfigurationsInput{}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).ImagebuilderFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.ImagebuilderFilters()
+		clientSideFilters = clientSide
 	}
 
 	var results []*imagebuilder.InfrastructureConfigurationSummary
@@ -58,6 +67,10 @@ func dataSourceInfrastructureConfigurationsRead(ctx context.Context, d *schema.R
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(infrastructureConfigurationSummary.Name), nil) {
+				continue
+			}
+
 			results = append(results, infrastructureConfigurationSummary)
 		}
 
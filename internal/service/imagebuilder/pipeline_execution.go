@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_imagebuilder_pipeline_execution", name="Pipeline Execution")
+func ResourcePipelineExecution() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePipelineExecutionCreate,
+		ReadWithoutTimeout:   resourcePipelineExecutionRead,
+		UpdateWithoutTimeout: resourcePipelineExecutionUpdate,
+		DeleteWithoutTimeout: resourcePipelineExecutionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ami_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"image_build_version_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_exists": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"image_pipeline_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"output_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"amis": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrAccountID: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrDescription: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"image": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"containers": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"image_uris": {
+										Type:     schema.TypeSet,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									names.AttrRegion: {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusReason: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTriggers: {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourcePipelineExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	imagePipelineARN := d.Get("image_pipeline_arn").(string)
+	input := &imagebuilder.StartImagePipelineExecutionInput{
+		ClientToken:      aws.String(id.UniqueId()),
+		ImagePipelineArn: aws.String(imagePipelineARN),
+	}
+
+	output, err := conn.StartImagePipelineExecutionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting Image Builder Image Pipeline (%s) execution: %s", imagePipelineARN, err)
+	}
+
+	if output == nil {
+		return sdkdiag.AppendErrorf(diags, "starting Image Builder Image Pipeline (%s) execution: empty response", imagePipelineARN)
+	}
+
+	d.SetId(aws.StringValue(output.ImageBuildVersionArn))
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitImageStatusAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Image Builder Image (%s) to become available: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePipelineExecutionRead(ctx, d, meta)...)
+}
+
+func resourcePipelineExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ImageBuilderConn(ctx)
+
+	input := &imagebuilder.GetImageInput{
+		ImageBuildVersionArn: aws.String(d.Id()),
+	}
+
+	output, err := conn.GetImageWithContext(ctx, input)
+
+	// The execution is one-shot: once the image has been removed (e.g. by a lifecycle policy)
+	// keep the recorded state so that no new build is started.
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, imagebuilder.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Image Builder Image (%s) not found, keeping recorded state", d.Id())
+		d.Set("image_exists", false)
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Image (%s): %s", d.Id(), err)
+	}
+
+	if output == nil || output.Image == nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Image (%s): empty response", d.Id())
+	}
+
+	image := output.Image
+
+	var amiIDs []string
+	if image.OutputResources != nil {
+		for _, ami := range image.OutputResources.Amis {
+			if ami != nil && ami.Image != nil {
+				amiIDs = append(amiIDs, aws.StringValue(ami.Image))
+			}
+		}
+	}
+	d.Set("ami_ids", amiIDs)
+	d.Set("image_build_version_arn", image.Arn)
+	d.Set("image_exists", true)
+	d.Set("image_pipeline_arn", image.SourcePipelineArn)
+	if image.OutputResources != nil {
+		d.Set("output_resources", []interface{}{flattenOutputResources(image.OutputResources)})
+	} else {
+		d.Set("output_resources", nil)
+	}
+	if image.State != nil {
+		d.Set(names.AttrStatus, image.State.Status)
+		d.Set(names.AttrStatusReason, image.State.Reason)
+	} else {
+		d.Set(names.AttrStatus, nil)
+		d.Set(names.AttrStatusReason, nil)
+	}
+
+	return diags
+}
+
+func resourcePipelineExecutionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// "wait_for_completion" only.
+
+	return append(diags, resourcePipelineExecutionRead(ctx, d, meta)...)
+}
+
+func resourcePipelineExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The image produced by the execution is owned by the pipeline's lifecycle, not by this resource.
+	log.Printf("[DEBUG] Removing Image Builder Pipeline Execution (%s) from state", d.Id())
+
+	return diags
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package imagebuilder_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/imagebuilder"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfimagebuilder "github.com/hashicorp/terraform-provider-aws/internal/service/imagebuilder"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccImageBuilderPipelineExecution_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	imagePipelineResourceName := "aws_imagebuilder_image_pipeline.test"
+	resourceName := "aws_imagebuilder_pipeline_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineExecutionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ami_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "ami_ids.0", resourceName, "output_resources.0.amis.0.image"),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "image_build_version_arn", "imagebuilder", regexache.MustCompile(fmt.Sprintf("image/%s/1.0.0/[1-9][0-9]*", rName))),
+					resource.TestCheckResourceAttrPair(resourceName, "image_pipeline_arn", imagePipelineResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output_resources.0.amis.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, imagebuilder.ImageStatusAvailable),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatusReason, ""),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "image_exists", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTriggers, "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderPipelineExecution_imageDeleted(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_pipeline_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineExecutionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfimagebuilder.ResourceImage(), resourceName),
+				),
+			},
+			{
+				Config: testAccPipelineExecutionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "image_exists", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderPipelineExecution_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_pipeline_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineExecutionConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", acctest.Ct1),
+					resource.TestMatchResourceAttr(resourceName, "image_build_version_arn", regexache.MustCompile(`/1.0.0/1$`)),
+				),
+			},
+			{
+				Config: testAccPipelineExecutionConfig_triggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", acctest.Ct2),
+					resource.TestMatchResourceAttr(resourceName, "image_build_version_arn", regexache.MustCompile(`/1.0.0/2$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImageBuilderPipelineExecution_waitForCompletion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_imagebuilder_pipeline_execution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ImageBuilderServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineExecutionConfig_waitForCompletion(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImageExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func testAccPipelineExecutionConfig_base(rName string) string {
+	return acctest.ConfigCompose(
+		testAccImageBaseConfig(rName),
+		`
+resource "aws_imagebuilder_image_pipeline" "test" {
+  image_recipe_arn                 = aws_imagebuilder_image_recipe.test.arn
+  infrastructure_configuration_arn = aws_imagebuilder_infrastructure_configuration.test.arn
+  name                             = aws_imagebuilder_image_recipe.test.name
+}
+`)
+}
+
+func testAccPipelineExecutionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineExecutionConfig_base(rName),
+		`
+resource "aws_imagebuilder_pipeline_execution" "test" {
+  image_pipeline_arn = aws_imagebuilder_image_pipeline.test.arn
+}
+`)
+}
+
+func testAccPipelineExecutionConfig_triggers(rName, release string) string {
+	return acctest.ConfigCompose(
+		testAccPipelineExecutionConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_pipeline_execution" "test" {
+  image_pipeline_arn = aws_imagebuilder_image_pipeline.test.arn
+
+  triggers = {
+    release = %[1]q
+  }
+}
+`, release))
+}
+
+func testAccPipelineExecutionConfig_waitForCompletion(rName string, waitForCompletion bool) string {
+	return acctest.ConfigCompose(
+		testAccPipelineExecutionConfig_base(rName),
+		fmt.Sprintf(`
+resource "aws_imagebuilder_pipeline_execution" "test" {
+  image_pipeline_arn  = aws_imagebuilder_image_pipeline.test.arn
+  wait_for_completion = %[1]t
+}
+`, waitForCompletion))
+}
@@ -73,6 +73,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_imagebuilder_image_recipes",
 			Name:     "Image Recipes",
 		},
+		{
+			Factory:  DataSourceImages,
+			TypeName: "aws_imagebuilder_images",
+			Name:     "Images",
+		},
 		{
 			Factory:  DataSourceInfrastructureConfiguration,
 			TypeName: "aws_imagebuilder_infrastructure_configuration",
@@ -142,6 +147,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceLifecyclePolicy,
+			TypeName: "aws_imagebuilder_lifecycle_policy",
+			Name:     "Lifecycle Policy",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
+		{
+			Factory:  ResourcePipelineExecution,
+			TypeName: "aws_imagebuilder_pipeline_execution",
+			Name:     "Pipeline Execution",
+		},
 		{
 			Factory:  ResourceWorkflow,
 			TypeName: "aws_imagebuilder_workflow",
@@ -38,3 +38,31 @@ func statusImage(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuil
 		return output.Image, status, nil
 	}
 }
+
+// statusImageScan fetches the Image and its scan Status
+func statusImageScan(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		input := &imagebuilder.GetImageInput{
+			ImageBuildVersionArn: aws.String(imageBuildVersionArn),
+		}
+
+		output, err := conn.GetImageWithContext(ctx, input)
+
+		if err != nil {
+			return nil, imagebuilder.ImageScanStatusPending, err
+		}
+
+		if output == nil || output.Image == nil || output.Image.ScanState == nil {
+			return nil, imagebuilder.ImageScanStatusPending, nil
+		}
+
+		status := aws.StringValue(output.Image.ScanState.Status)
+
+		switch status {
+		case imagebuilder.ImageScanStatusAbandoned, imagebuilder.ImageScanStatusFailed, imagebuilder.ImageScanStatusTimedOut:
+			return output.Image, status, fmt.Errorf("%s", aws.StringValue(output.Image.ScanState.Reason))
+		}
+
+		return output.Image, status, nil
+	}
+}
@@ -35,3 +35,25 @@ func waitImageStatusAvailable(ctx context.Context, conn *imagebuilder.Imagebuild
 
 	return nil, err
 }
+
+// waitImageScanStatusCompleted waits for an Image's vulnerability scan to return Completed
+func waitImageScanStatusCompleted(ctx context.Context, conn *imagebuilder.Imagebuilder, imageBuildVersionArn string, timeout time.Duration) (*imagebuilder.Image, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{
+			imagebuilder.ImageScanStatusCollecting,
+			imagebuilder.ImageScanStatusPending,
+			imagebuilder.ImageScanStatusScanning,
+		},
+		Target:  []string{imagebuilder.ImageScanStatusCompleted},
+		Refresh: statusImageScan(ctx, conn, imageBuildVersionArn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if v, ok := outputRaw.(*imagebuilder.Image); ok {
+		return v, err
+	}
+
+	return nil, err
+}
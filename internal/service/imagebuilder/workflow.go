@@ -168,6 +168,10 @@ func resourceWorkflowRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "getting Image Builder Workflow (%s): %s", d.Id(), err)
+	}
+
 	if output == nil || output.Workflow == nil {
 		return sdkdiag.AppendErrorf(diags, "getting Image Builder Workflow (%s): empty response", d.Id())
 	}
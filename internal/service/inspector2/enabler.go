@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -114,7 +115,7 @@ func resourceEnablerCreate(ctx context.Context, d *schema.ResourceData, meta int
 	id := enablerID(accountIDs, typeEnable)
 
 	var out *inspector2.EnableOutput
-	err := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := tfresource.Retry(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 		out, err = conn.Enable(ctx, in)
 		if err != nil {
@@ -160,7 +161,7 @@ func resourceEnablerCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(id)
 
-	st, err := waitEnabled(ctx, conn, accountIDs, d.Timeout(schema.TimeoutCreate))
+	st, err := waitEnabled(ctx, conn, accountIDs, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 	if err != nil {
 		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionWaitingForCreation, ResNameEnabler, d.Id(), err)
 	}
@@ -186,7 +187,7 @@ func resourceEnablerCreate(ctx context.Context, d *schema.ResourceData, meta int
 	}
 
 	if len(disableAccountIDs) > 0 {
-		if _, err := waitEnabled(ctx, conn, disableAccountIDs, d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitEnabled(ctx, conn, disableAccountIDs, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return create.AppendDiagError(diags, names.Inspector2, create.ErrActionWaitingForUpdate, ResNameEnabler, id, err)
 		}
 	}
@@ -289,7 +290,7 @@ func resourceEnablerUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				return create.AppendDiagError(diags, names.Inspector2, create.ErrActionUpdating, ResNameEnabler, id, errors.New("failed accounts"))
 			}
 
-			if _, err := waitEnabled(ctx, conn, acctEnable, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if _, err := waitEnabled(ctx, conn, acctEnable, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return create.AppendDiagError(diags, names.Inspector2, create.ErrActionWaitingForUpdate, ResNameEnabler, id, err)
 			}
 		}
@@ -305,7 +306,7 @@ func resourceEnablerUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				return create.AppendDiagError(diags, names.Inspector2, create.ErrActionUpdating, ResNameEnabler, id, err)
 			}
 
-			if _, err := waitEnabled(ctx, conn, acctEnable, d.Timeout(schema.TimeoutCreate)); err != nil {
+			if _, err := waitEnabled(ctx, conn, acctEnable, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 				return create.AppendDiagError(diags, names.Inspector2, create.ErrActionWaitingForUpdate, ResNameEnabler, id, err)
 			}
 		}
@@ -375,7 +376,7 @@ func disableAccounts(ctx context.Context, conn *inspector2.Client, d *schema.Res
 		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionDeleting, ResNameEnabler, d.Id(), err)
 	}
 
-	if err := waitDisabled(ctx, conn, accountIDs, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := waitDisabled(ctx, conn, accountIDs, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.Inspector2, create.ErrActionWaitingForDeletion, ResNameEnabler, d.Id(), err)
 	}
 
@@ -1,6 +1,7 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
+//go:generate go run ../../generate/pluraldatasource/main.go -TypeName=aws_inspector2_filters -HumanName=Filters -ListOp=ListFilters -ListOpOutputElem=Filters -NameElem=Name -ARNElem=Arn -Tags -TaggingResourceType=inspector2
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
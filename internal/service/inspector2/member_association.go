@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -79,7 +80,7 @@ func resourceMemberAssociationCreate(ctx context.Context, d *schema.ResourceData
 
 	d.SetId(accountID)
 
-	if err := waitMemberAssociationCreated(ctx, conn, accountID, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err := waitMemberAssociationCreated(ctx, conn, accountID, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating Amazon Inspector Member Association (%s): waiting for completion: %s", accountID, err)
 	}
 
@@ -130,7 +131,7 @@ func resourceMemberAssociationDelete(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector Member Association (%s): %s", d.Id(), err)
 	}
 
-	if err := waitMemberAssociationDeleted(ctx, conn, accountID, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := waitMemberAssociationDeleted(ctx, conn, accountID, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Amazon Inspector Member Association (%s): waiting for completion: %s", accountID, err)
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/iot"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -52,8 +53,10 @@ func ResourceProvisioningTemplate() *schema.Resource {
 				Computed: true,
 			},
 			"default_version_id": {
-				Type:     schema.TypeInt,
-				Computed: true,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
@@ -118,7 +121,10 @@ func ResourceProvisioningTemplate() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceProvisioningTemplateCustomizeDiff,
+		),
 	}
 }
 
@@ -199,9 +205,27 @@ func resourceProvisioningTemplateRead(ctx context.Context, d *schema.ResourceDat
 		d.Set("pre_provisioning_hook", nil)
 	}
 	d.Set("provisioning_role_arn", output.ProvisioningRoleArn)
-	d.Set("template_body", output.TemplateBody)
 	d.Set(names.AttrType, output.Type)
 
+	// When the default version is set explicitly, the latest version may not be the default.
+	versions, err := findProvisioningTemplateVersionsByName(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Provisioning Template (%s) versions: %s", d.Id(), err)
+	}
+
+	templateBody := aws.StringValue(output.TemplateBody)
+	if latestVersionID := latestProvisioningTemplateVersionID(versions); latestVersionID > aws.Int64Value(output.DefaultVersionId) {
+		version, err := findProvisioningTemplateVersionByTwoPartKey(ctx, conn, d.Id(), latestVersionID)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT Provisioning Template (%s) version (%d): %s", d.Id(), latestVersionID, err)
+		}
+
+		templateBody = aws.StringValue(version.TemplateBody)
+	}
+	d.Set("template_body", templateBody)
+
 	return diags
 }
 
@@ -210,9 +234,12 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 
 	conn := meta.(*conns.AWSClient).IoTConn(ctx)
 
+	// A configured default version is not replaced by new versions.
+	defaultVersionIDConfigured := !d.GetRawConfig().GetAttr("default_version_id").IsNull()
+
 	if d.HasChange("template_body") {
 		input := &iot.CreateProvisioningTemplateVersionInput{
-			SetAsDefault: aws.Bool(true),
+			SetAsDefault: aws.Bool(!defaultVersionIDConfigured),
 			TemplateBody: aws.String(d.Get("template_body").(string)),
 			TemplateName: aws.String(d.Id()),
 		}
@@ -225,7 +252,7 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 		}
 	}
 
-	if d.HasChanges(names.AttrDescription, names.AttrEnabled, "provisioning_role_arn") {
+	if d.HasChanges("default_version_id", names.AttrDescription, names.AttrEnabled, "pre_provisioning_hook", "provisioning_role_arn") {
 		input := &iot.UpdateProvisioningTemplateInput{
 			Description:         aws.String(d.Get(names.AttrDescription).(string)),
 			Enabled:             aws.Bool(d.Get(names.AttrEnabled).(bool)),
@@ -233,6 +260,18 @@ func resourceProvisioningTemplateUpdate(ctx context.Context, d *schema.ResourceD
 			TemplateName:        aws.String(d.Id()),
 		}
 
+		if d.HasChange("default_version_id") && defaultVersionIDConfigured {
+			input.DefaultVersionId = aws.Int64(int64(d.Get("default_version_id").(int)))
+		}
+
+		if d.HasChange("pre_provisioning_hook") {
+			if v, ok := d.GetOk("pre_provisioning_hook"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+				input.PreProvisioningHook = expandProvisioningHook(v.([]interface{})[0].(map[string]interface{}))
+			} else {
+				input.RemovePreProvisioningHook = aws.Bool(true)
+			}
+		}
+
 		log.Printf("[DEBUG] Updating IoT Provisioning Template: %s", input)
 		_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout,
 			func() (interface{}, error) {
@@ -269,6 +308,15 @@ func resourceProvisioningTemplateDelete(ctx context.Context, d *schema.ResourceD
 	return diags
 }
 
+func resourceProvisioningTemplateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// A new template body becomes the default version unless the default version is configured.
+	if d.Id() != "" && d.HasChange("template_body") && d.GetRawConfig().GetAttr("default_version_id").IsNull() {
+		return d.SetNewComputed("default_version_id")
+	}
+
+	return nil
+}
+
 func flattenProvisioningHook(apiObject *iot.ProvisioningHook) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -329,3 +377,75 @@ func FindProvisioningTemplateByName(ctx context.Context, conn *iot.IoT, name str
 
 	return output, nil
 }
+
+func findProvisioningTemplateVersionsByName(ctx context.Context, conn *iot.IoT, name string) ([]*iot.ProvisioningTemplateVersionSummary, error) {
+	input := &iot.ListProvisioningTemplateVersionsInput{
+		TemplateName: aws.String(name),
+	}
+	var output []*iot.ProvisioningTemplateVersionSummary
+
+	err := conn.ListProvisioningTemplateVersionsPagesWithContext(ctx, input, func(page *iot.ListProvisioningTemplateVersionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Versions {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func findProvisioningTemplateVersionByTwoPartKey(ctx context.Context, conn *iot.IoT, name string, versionID int64) (*iot.DescribeProvisioningTemplateVersionOutput, error) {
+	input := &iot.DescribeProvisioningTemplateVersionInput{
+		TemplateName: aws.String(name),
+		VersionId:    aws.Int64(versionID),
+	}
+
+	output, err := conn.DescribeProvisioningTemplateVersionWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iot.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func latestProvisioningTemplateVersionID(versions []*iot.ProvisioningTemplateVersionSummary) int64 {
+	var latest int64
+
+	for _, v := range versions {
+		if versionID := aws.Int64Value(v.VersionId); versionID > latest {
+			latest = versionID
+		}
+	}
+
+	return latest
+}
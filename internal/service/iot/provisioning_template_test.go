@@ -177,6 +177,95 @@ func TestAccIoTProvisioningTemplate_update(t *testing.T) {
 	})
 }
 
+func TestAccIoTProvisioningTemplate_defaultVersionID(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 1),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_defaultVersionID(rName, "Inactive", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "template_body"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_defaultVersionID(rName, "Inactive", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					testAccCheckProvisioningTemplateNumVersions(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_id", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTProvisioningTemplate_preProvisioningHook(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_provisioning_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProvisioningTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProvisioningTemplateConfig_preProvisioningHook(rName, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.0.payload_version", "2020-04-01"),
+					resource.TestCheckResourceAttrPair(resourceName, "pre_provisioning_hook.0.target_arn", "aws_lambda_function.test.0", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_preProvisioningHook(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "pre_provisioning_hook.0.target_arn", "aws_lambda_function.test.1", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccProvisioningTemplateConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckProvisioningTemplateExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "pre_provisioning_hook.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckProvisioningTemplateExists(ctx context.Context, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -428,3 +517,106 @@ resource "aws_iot_provisioning_template" "test" {
 }
 `, rName))
 }
+
+func testAccProvisioningTemplateConfig_defaultVersionID(rName, certificateStatus string, defaultVersionID int) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+  default_version_id    = %[3]d
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String" }
+    }
+
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = %[2]q
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+}
+`, rName, certificateStatus, defaultVersionID))
+}
+
+func testAccProvisioningTemplateConfig_preProvisioningHook(rName string, hookIndex int) string {
+	return acctest.ConfigCompose(testAccProvisioningTemplateBaseConfig(rName), fmt.Sprintf(`
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "lambda.amazonaws.com" }
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  count = 2
+
+  filename         = "test-fixtures/lambdatest.zip"
+  source_code_hash = filebase64sha256("test-fixtures/lambdatest.zip")
+  function_name    = "%[1]s-${count.index}"
+  role             = aws_iam_role.lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs16.x"
+}
+
+resource "aws_lambda_permission" "test" {
+  count = 2
+
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test[count.index].function_name
+  principal     = "iot.amazonaws.com"
+}
+
+resource "aws_iot_provisioning_template" "test" {
+  name                  = %[1]q
+  provisioning_role_arn = aws_iam_role.test.arn
+
+  pre_provisioning_hook {
+    target_arn = aws_lambda_function.test[%[2]d].arn
+  }
+
+  template_body = jsonencode({
+    Parameters = {
+      SerialNumber = { Type = "String" }
+    }
+
+    Resources = {
+      certificate = {
+        Properties = {
+          CertificateId = { Ref = "AWS::IoT::Certificate::Id" }
+          Status        = "Active"
+        }
+        Type = "AWS::IoT::Certificate"
+      }
+
+      policy = {
+        Properties = {
+          PolicyName = aws_iot_policy.test.name
+        }
+        Type = "AWS::IoT::Policy"
+      }
+    }
+  })
+
+  depends_on = [aws_lambda_permission.test]
+}
+`, rName, hookIndex))
+}
@@ -0,0 +1,664 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Detector model events and alarm models share the same set of actions that send data to other AWS services.
+// Detector model events can additionally set variables and manage timers.
+
+func payloadSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"content_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenAtLeast(1),
+				},
+				names.AttrType: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(iotevents.PayloadType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func timerNameSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+}
+
+func targetActionsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"dynamodb_v2": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					names.AttrTableName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+			},
+		},
+		"firehose": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"delivery_stream_name": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"payload": payloadSchema(),
+					"separator": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringInSlice([]string{"\n", "\t", "\r\n", ","}, false),
+					},
+				},
+			},
+		},
+		"iot_events": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"input_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"iot_topic_publish": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"mqtt_topic": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 128),
+					},
+					"payload": payloadSchema(),
+				},
+			},
+		},
+		"lambda": lambdaActionSchema(),
+		"sns": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					names.AttrTargetARN: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+				},
+			},
+		},
+		"sqs": {
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"payload": payloadSchema(),
+					"queue_url": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"use_base64": {
+						Type:     schema.TypeBool,
+						Optional: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func lambdaActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrFunctionARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: verify.ValidARN,
+				},
+				"payload": payloadSchema(),
+			},
+		},
+	}
+}
+
+func detectorModelActionSchema() *schema.Schema {
+	s := targetActionsSchema()
+
+	s["clear_timer"] = timerNameSchema()
+	s["reset_timer"] = timerNameSchema()
+	s["set_timer"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"duration_expression": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"timer_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+	s["set_variable"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrValue: {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 1024),
+				},
+				"variable_name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 128),
+				},
+			},
+		},
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: s,
+		},
+	}
+}
+
+func alarmModelActionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: targetActionsSchema(),
+		},
+	}
+}
+
+func expandActionDatas(tfList []interface{}) []*iotevents.ActionData {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.ActionData
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.ActionData{}
+
+		if v, ok := tfMap["clear_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ClearTimer = &iotevents.ClearTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["dynamodb_v2"].([]interface{}); ok {
+			apiObject.DynamoDBv2 = expandDynamoDBv2Action(v)
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok {
+			apiObject.Firehose = expandFirehoseAction(v)
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok {
+			apiObject.IotEvents = expandIoTEventsAction(v)
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok {
+			apiObject.IotTopicPublish = expandIoTTopicPublishAction(v)
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok {
+			apiObject.Lambda = expandLambdaAction(v)
+		}
+
+		if v, ok := tfMap["reset_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.ResetTimer = &iotevents.ResetTimerAction{
+				TimerName: aws.String(v[0].(map[string]interface{})["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_timer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SetTimer = &iotevents.SetTimerAction{
+				DurationExpression: aws.String(tfMap["duration_expression"].(string)),
+				TimerName:          aws.String(tfMap["timer_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["set_variable"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			apiObject.SetVariable = &iotevents.SetVariableAction{
+				Value:        aws.String(tfMap[names.AttrValue].(string)),
+				VariableName: aws.String(tfMap["variable_name"].(string)),
+			}
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok {
+			apiObject.Sns = expandSNSTopicPublishAction(v)
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok {
+			apiObject.Sqs = expandSQSAction(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandAlarmActions(tfList []interface{}) []*iotevents.AlarmAction {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*iotevents.AlarmAction
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotevents.AlarmAction{}
+
+		if v, ok := tfMap["dynamodb_v2"].([]interface{}); ok {
+			apiObject.DynamoDBv2 = expandDynamoDBv2Action(v)
+		}
+
+		if v, ok := tfMap["firehose"].([]interface{}); ok {
+			apiObject.Firehose = expandFirehoseAction(v)
+		}
+
+		if v, ok := tfMap["iot_events"].([]interface{}); ok {
+			apiObject.IotEvents = expandIoTEventsAction(v)
+		}
+
+		if v, ok := tfMap["iot_topic_publish"].([]interface{}); ok {
+			apiObject.IotTopicPublish = expandIoTTopicPublishAction(v)
+		}
+
+		if v, ok := tfMap["lambda"].([]interface{}); ok {
+			apiObject.Lambda = expandLambdaAction(v)
+		}
+
+		if v, ok := tfMap["sns"].([]interface{}); ok {
+			apiObject.Sns = expandSNSTopicPublishAction(v)
+		}
+
+		if v, ok := tfMap["sqs"].([]interface{}); ok {
+			apiObject.Sqs = expandSQSAction(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPayload(tfList []interface{}) *iotevents.Payload {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.Payload{
+		ContentExpression: aws.String(tfMap["content_expression"].(string)),
+		Type:              aws.String(tfMap[names.AttrType].(string)),
+	}
+}
+
+func expandDynamoDBv2Action(tfList []interface{}) *iotevents.DynamoDBv2Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.DynamoDBv2Action{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		TableName: aws.String(tfMap[names.AttrTableName].(string)),
+	}
+}
+
+func expandFirehoseAction(tfList []interface{}) *iotevents.FirehoseAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.FirehoseAction{
+		DeliveryStreamName: aws.String(tfMap["delivery_stream_name"].(string)),
+		Payload:            expandPayload(tfMap["payload"].([]interface{})),
+	}
+
+	if v, ok := tfMap["separator"].(string); ok && v != "" {
+		apiObject.Separator = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandIoTEventsAction(tfList []interface{}) *iotevents.Action {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.Action{
+		InputName: aws.String(tfMap["input_name"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandIoTTopicPublishAction(tfList []interface{}) *iotevents.IotTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.IotTopicPublishAction{
+		MqttTopic: aws.String(tfMap["mqtt_topic"].(string)),
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandLambdaAction(tfList []interface{}) *iotevents.LambdaAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.LambdaAction{
+		FunctionArn: aws.String(tfMap[names.AttrFunctionARN].(string)),
+		Payload:     expandPayload(tfMap["payload"].([]interface{})),
+	}
+}
+
+func expandSNSTopicPublishAction(tfList []interface{}) *iotevents.SNSTopicPublishAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.SNSTopicPublishAction{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		TargetArn: aws.String(tfMap[names.AttrTargetARN].(string)),
+	}
+}
+
+func expandSQSAction(tfList []interface{}) *iotevents.SqsAction {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.SqsAction{
+		Payload:   expandPayload(tfMap["payload"].([]interface{})),
+		QueueUrl:  aws.String(tfMap["queue_url"].(string)),
+		UseBase64: aws.Bool(tfMap["use_base64"].(bool)),
+	}
+}
+
+func flattenActionDatas(apiObjects []*iotevents.ActionData) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"dynamodb_v2":       flattenDynamoDBv2Action(apiObject.DynamoDBv2),
+			"firehose":          flattenFirehoseAction(apiObject.Firehose),
+			"iot_events":        flattenIoTEventsAction(apiObject.IotEvents),
+			"iot_topic_publish": flattenIoTTopicPublishAction(apiObject.IotTopicPublish),
+			"lambda":            flattenLambdaAction(apiObject.Lambda),
+			"sns":               flattenSNSTopicPublishAction(apiObject.Sns),
+			"sqs":               flattenSQSAction(apiObject.Sqs),
+		}
+
+		if v := apiObject.ClearTimer; v != nil {
+			tfMap["clear_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.ResetTimer; v != nil {
+			tfMap["reset_timer"] = []interface{}{map[string]interface{}{
+				"timer_name": aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetTimer; v != nil {
+			tfMap["set_timer"] = []interface{}{map[string]interface{}{
+				"duration_expression": aws.StringValue(v.DurationExpression),
+				"timer_name":          aws.StringValue(v.TimerName),
+			}}
+		}
+
+		if v := apiObject.SetVariable; v != nil {
+			tfMap["set_variable"] = []interface{}{map[string]interface{}{
+				names.AttrValue: aws.StringValue(v.Value),
+				"variable_name": aws.StringValue(v.VariableName),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenAlarmActions(apiObjects []*iotevents.AlarmAction) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"dynamodb_v2":       flattenDynamoDBv2Action(apiObject.DynamoDBv2),
+			"firehose":          flattenFirehoseAction(apiObject.Firehose),
+			"iot_events":        flattenIoTEventsAction(apiObject.IotEvents),
+			"iot_topic_publish": flattenIoTTopicPublishAction(apiObject.IotTopicPublish),
+			"lambda":            flattenLambdaAction(apiObject.Lambda),
+			"sns":               flattenSNSTopicPublishAction(apiObject.Sns),
+			"sqs":               flattenSQSAction(apiObject.Sqs),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPayload(apiObject *iotevents.Payload) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"content_expression": aws.StringValue(apiObject.ContentExpression),
+		names.AttrType:       aws.StringValue(apiObject.Type),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenDynamoDBv2Action(apiObject *iotevents.DynamoDBv2Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":           flattenPayload(apiObject.Payload),
+		names.AttrTableName: aws.StringValue(apiObject.TableName),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFirehoseAction(apiObject *iotevents.FirehoseAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"delivery_stream_name": aws.StringValue(apiObject.DeliveryStreamName),
+		"payload":              flattenPayload(apiObject.Payload),
+		"separator":            aws.StringValue(apiObject.Separator),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTEventsAction(apiObject *iotevents.Action) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_name": aws.StringValue(apiObject.InputName),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenIoTTopicPublishAction(apiObject *iotevents.IotTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"mqtt_topic": aws.StringValue(apiObject.MqttTopic),
+		"payload":    flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenLambdaAction(apiObject *iotevents.LambdaAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrFunctionARN: aws.StringValue(apiObject.FunctionArn),
+		"payload":             flattenPayload(apiObject.Payload),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSNSTopicPublishAction(apiObject *iotevents.SNSTopicPublishAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":           flattenPayload(apiObject.Payload),
+		names.AttrTargetARN: aws.StringValue(apiObject.TargetArn),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenSQSAction(apiObject *iotevents.SqsAction) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"payload":    flattenPayload(apiObject.Payload),
+		"queue_url":  aws.StringValue(apiObject.QueueUrl),
+		"use_base64": aws.BoolValue(apiObject.UseBase64),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotevents"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotevents_alarm_model", name="Alarm Model")
+// @Tags(identifierAttribute="arn")
+func resourceAlarmModel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAlarmModelCreate,
+		ReadWithoutTimeout:   resourceAlarmModelRead,
+		UpdateWithoutTimeout: resourceAlarmModelUpdate,
+		DeleteWithoutTimeout: resourceAlarmModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarm_capabilities": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acknowledge_flow_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"disabled_on_initialization": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"alarm_event_action": alarmModelActionSchema(),
+			"alarm_notification": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda_action": func() *schema.Schema {
+							s := lambdaActionSchema()
+							s.Optional = false
+							s.Required = true
+							return s
+						}(),
+					},
+				},
+			},
+			"alarm_rule": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"simple_rule": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparison_operator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(iotevents.ComparisonOperator_Values(), false),
+									},
+									"input_property": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+									"threshold": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+			},
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"severity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAlarmModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotevents.CreateAlarmModelInput{
+		AlarmModelName: aws.String(name),
+		AlarmRule:      expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+		RoleArn:        aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("alarm_capabilities"); ok {
+		input.AlarmCapabilities = expandAlarmCapabilities(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("alarm_event_action"); ok {
+		input.AlarmEventActions = &iotevents.AlarmEventActions{
+			AlarmActions: expandAlarmActions(v.([]interface{})),
+		}
+	}
+
+	if v, ok := d.GetOk("alarm_notification"); ok {
+		input.AlarmNotification = expandAlarmNotification(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.AlarmModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrKey); ok {
+		input.Key = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("severity"); ok {
+		input.Severity = aws.Int64(int64(v.(int)))
+	}
+
+	_, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateAlarmModelWithContext(ctx, input)
+	}, iotevents.ErrCodeInvalidRequestException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT Events Alarm Model (%s): %s", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitAlarmModelActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	output, err := findAlarmModelByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT Events Alarm Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("alarm_capabilities", flattenAlarmCapabilities(output.AlarmCapabilities)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_capabilities: %s", err)
+	}
+	if output.AlarmEventActions != nil {
+		if err := d.Set("alarm_event_action", flattenAlarmActions(output.AlarmEventActions.AlarmActions)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting alarm_event_action: %s", err)
+		}
+	} else {
+		d.Set("alarm_event_action", nil)
+	}
+	if err := d.Set("alarm_notification", flattenAlarmNotification(output.AlarmNotification)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_notification: %s", err)
+	}
+	if err := d.Set("alarm_rule", flattenAlarmRule(output.AlarmRule)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarm_rule: %s", err)
+	}
+	d.Set(names.AttrARN, output.AlarmModelArn)
+	d.Set(names.AttrDescription, output.AlarmModelDescription)
+	d.Set(names.AttrKey, output.Key)
+	d.Set(names.AttrName, output.AlarmModelName)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("severity", output.Severity)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrVersion, output.AlarmModelVersion)
+
+	return diags
+}
+
+func resourceAlarmModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// Each update creates a new version of the alarm model.
+		input := &iotevents.UpdateAlarmModelInput{
+			AlarmCapabilities: expandAlarmCapabilities(d.Get("alarm_capabilities").([]interface{})),
+			AlarmEventActions: &iotevents.AlarmEventActions{
+				AlarmActions: expandAlarmActions(d.Get("alarm_event_action").([]interface{})),
+			},
+			AlarmModelName:    aws.String(d.Id()),
+			AlarmNotification: expandAlarmNotification(d.Get("alarm_notification").([]interface{})),
+			AlarmRule:         expandAlarmRule(d.Get("alarm_rule").([]interface{})),
+			RoleArn:           aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.AlarmModelDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("severity"); ok {
+			input.Severity = aws.Int64(int64(v.(int)))
+		}
+
+		_, err := conn.UpdateAlarmModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT Events Alarm Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAlarmModelActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAlarmModelRead(ctx, d, meta)...)
+}
+
+func resourceAlarmModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTEventsConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT Events Alarm Model: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAlarmModelWithContext(ctx, &iotevents.DeleteAlarmModelInput{
+			AlarmModelName: aws.String(d.Id()),
+		})
+	}, iotevents.ErrCodeResourceInUseException)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT Events Alarm Model (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
+		return findAlarmModelByName(ctx, conn, d.Id())
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT Events Alarm Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAlarmModelByName(ctx context.Context, conn *iotevents.IoTEvents, name string) (*iotevents.DescribeAlarmModelOutput, error) {
+	input := &iotevents.DescribeAlarmModelInput{
+		AlarmModelName: aws.String(name),
+	}
+
+	output, err := conn.DescribeAlarmModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotevents.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAlarmModel(ctx context.Context, conn *iotevents.IoTEvents, name string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAlarmModelByName(ctx, conn, name)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitAlarmModelActive(ctx context.Context, conn *iotevents.IoTEvents, name string, timeout time.Duration) (*iotevents.DescribeAlarmModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotevents.AlarmModelVersionStatusActivating},
+		Target:  []string{iotevents.AlarmModelVersionStatusActive},
+		Refresh: statusAlarmModel(ctx, conn, name),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotevents.DescribeAlarmModelOutput); ok {
+		if status := aws.StringValue(output.Status); status == iotevents.AlarmModelVersionStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlarmCapabilities(tfList []interface{}) *iotevents.AlarmCapabilities {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotevents.AlarmCapabilities{
+		AcknowledgeFlow: &iotevents.AcknowledgeFlow{
+			Enabled: aws.Bool(tfMap["acknowledge_flow_enabled"].(bool)),
+		},
+		InitializationConfiguration: &iotevents.InitializationConfiguration{
+			DisabledOnInitialization: aws.Bool(tfMap["disabled_on_initialization"].(bool)),
+		},
+	}
+}
+
+func expandAlarmNotification(tfList []interface{}) *iotevents.AlarmNotification {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &iotevents.AlarmNotification{}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject.NotificationActions = append(apiObject.NotificationActions, &iotevents.NotificationAction{
+			Action: &iotevents.NotificationTargetActions{
+				LambdaAction: expandLambdaAction(tfMap["lambda_action"].([]interface{})),
+			},
+		})
+	}
+
+	return apiObject
+}
+
+func expandAlarmRule(tfList []interface{}) *iotevents.AlarmRule {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotevents.AlarmRule{}
+
+	if v, ok := tfMap["simple_rule"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.SimpleRule = &iotevents.SimpleRule{
+			ComparisonOperator: aws.String(tfMap["comparison_operator"].(string)),
+			InputProperty:      aws.String(tfMap["input_property"].(string)),
+			Threshold:          aws.String(tfMap["threshold"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenAlarmCapabilities(apiObject *iotevents.AlarmCapabilities) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.AcknowledgeFlow; v != nil {
+		tfMap["acknowledge_flow_enabled"] = aws.BoolValue(v.Enabled)
+	}
+
+	if v := apiObject.InitializationConfiguration; v != nil {
+		tfMap["disabled_on_initialization"] = aws.BoolValue(v.DisabledOnInitialization)
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAlarmNotification(apiObject *iotevents.AlarmNotification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObject.NotificationActions {
+		if apiObject == nil || apiObject.Action == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"lambda_action": flattenLambdaAction(apiObject.Action.LambdaAction),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAlarmRule(apiObject *iotevents.AlarmRule) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.SimpleRule; v != nil {
+		tfMap["simple_rule"] = []interface{}{map[string]interface{}{
+			"comparison_operator": aws.StringValue(v.ComparisonOperator),
+			"input_property":      aws.StringValue(v.InputProperty),
+			"threshold":           aws.StringValue(v.Threshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
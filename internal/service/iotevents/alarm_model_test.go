@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotevents "github.com/hashicorp/terraform-provider-aws/internal/service/iotevents"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTEventsAlarmModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "GREATER", "30"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_action.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "alarm_notification.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "30"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "iotevents", fmt.Sprintf("alarmModel/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "GREATER_OR_EQUAL", "40"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.comparison_operator", "GREATER_OR_EQUAL"),
+					resource.TestCheckResourceAttr(resourceName, "alarm_rule.0.simple_rule.0.threshold", "40"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_basic(rName, "GREATER", "30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotevents.ResourceAlarmModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTEventsAlarmModel_alarmEventAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotevents_alarm_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTEventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAlarmModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAlarmModelConfig_alarmEventAction(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAlarmModelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.acknowledge_flow_enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "alarm_capabilities.0.disabled_on_initialization", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_action.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_action.0.sns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "alarm_event_action.0.sns.0.target_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "alarm_event_action.0.sns.0.payload.0.type", "JSON"),
+					resource.TestCheckResourceAttr(resourceName, "severity", acctest.Ct2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAlarmModelExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		_, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAlarmModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTEventsConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotevents_alarm_model" {
+				continue
+			}
+
+			_, err := tfiotevents.FindAlarmModelByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT Events Alarm Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAlarmModelConfig_basic(rName, comparisonOperator, threshold string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = %[2]q
+      input_property      = "$input.temperature.value"
+      threshold           = %[3]q
+    }
+  }
+}
+`, rName, comparisonOperator, threshold))
+}
+
+func testAccAlarmModelConfig_alarmEventAction(rName string) string {
+	return acctest.ConfigCompose(testAccModelConfig_base(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "sns:Publish"
+      Effect   = "Allow"
+      Resource = aws_sns_topic.test.arn
+    }]
+  })
+}
+
+resource "aws_iotevents_alarm_model" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+  severity = 2
+
+  alarm_capabilities {
+    acknowledge_flow_enabled   = true
+    disabled_on_initialization = false
+  }
+
+  alarm_event_action {
+    sns {
+      target_arn = aws_sns_topic.test.arn
+
+      payload {
+        content_expression = "'{\"alarm\": \"$${$variable.state}\"}'"
+        type               = "JSON"
+      }
+    }
+  }
+
+  alarm_rule {
+    simple_rule {
+      comparison_operator = "GREATER"
+      input_property      = "$input.temperature.value"
+      threshold           = "30"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
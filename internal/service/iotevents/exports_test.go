@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotevents
+
+// Exports for use in tests only.
+var (
+	ResourceAlarmModel    = resourceAlarmModel
+	ResourceDetectorModel = resourceDetectorModel
+
+	FindAlarmModelByName    = findAlarmModelByName
+	FindDetectorModelByName = findDetectorModelByName
+)
@@ -0,0 +1,351 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_asset", name="Asset")
+// @Tags(identifierAttribute="arn")
+func resourceAsset() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetCreate,
+		ReadWithoutTimeout:   resourceAssetRead,
+		UpdateWithoutTimeout: resourceAssetUpdate,
+		DeleteWithoutTimeout: resourceAssetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"asset_model_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"hierarchy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"property": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAlias: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"data_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrUnit: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotsitewise.CreateAssetInput{
+		AssetModelId: aws.String(d.Get("asset_model_id").(string)),
+		AssetName:    aws.String(name),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.AssetDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateAssetWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetId))
+
+	if _, err := waitAssetActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAssetRead(ctx, d, meta)...)
+}
+
+func resourceAssetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := findAssetByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.AssetArn)
+	d.Set("asset_model_id", output.AssetModelId)
+	d.Set(names.AttrDescription, output.AssetDescription)
+	if err := d.Set("hierarchy", flattenAssetHierarchies(output.AssetHierarchies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hierarchy: %s", err)
+	}
+	d.Set(names.AttrName, output.AssetName)
+	if err := d.Set("property", flattenAssetProperties(output.AssetProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting property: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAssetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotsitewise.UpdateAssetInput{
+			AssetId:   aws.String(d.Id()),
+			AssetName: aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.AssetDescription = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateAssetWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetRead(ctx, d, meta)...)
+}
+
+func resourceAssetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAssetWithContext(ctx, &iotsitewise.DeleteAssetInput{
+			AssetId: aws.String(d.Id()),
+		})
+	}, iotsitewise.ErrCodeConflictingOperationException)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAssetByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeAssetOutput, error) {
+	input := &iotsitewise.DescribeAssetInput{
+		AssetId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAssetWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AssetStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAsset(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAssetByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssetStatus.State), nil
+	}
+}
+
+func waitAssetActive(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateCreating, iotsitewise.AssetStateUpdating},
+		Target:  []string{iotsitewise.AssetStateActive},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		if v := output.AssetStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetStateDeleting},
+		Target:  []string{},
+		Refresh: statusAsset(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetOutput); ok {
+		if v := output.AssetStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenAssetHierarchies(apiObjects []*iotsitewise.AssetHierarchy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID:   aws.StringValue(apiObject.Id),
+			names.AttrName: aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+func flattenAssetProperties(apiObjects []*iotsitewise.AssetProperty) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAlias: aws.StringValue(apiObject.Alias),
+			"data_type":     aws.StringValue(apiObject.DataType),
+			names.AttrID:    aws.StringValue(apiObject.Id),
+			names.AttrName:  aws.StringValue(apiObject.Name),
+			names.AttrUnit:  aws.StringValue(apiObject.Unit),
+		})
+	}
+
+	return tfList
+}
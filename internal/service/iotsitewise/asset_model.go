@@ -0,0 +1,850 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_asset_model", name="Asset Model")
+// @Tags(identifierAttribute="arn")
+func resourceAssetModel() *schema.Resource {
+	variableSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Required: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					names.AttrValue: {
+						Type:     schema.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"hierarchy_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+								"property_id": {
+									Type:     schema.TypeString,
+									Optional: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	forwardingConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrState: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(iotsitewise.ForwardingConfigState_Values(), false),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceAssetModelCreate,
+		ReadWithoutTimeout:   resourceAssetModelRead,
+		UpdateWithoutTimeout: resourceAssetModelUpdate,
+		DeleteWithoutTimeout: resourceAssetModelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			"hierarchy": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      sdkv2.SimpleSchemaSetFunc(names.AttrName),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"child_asset_model_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"property": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      sdkv2.SimpleSchemaSetFunc(names.AttrName),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(iotsitewise.PropertyDataType_Values(), false),
+						},
+						"data_type_spec": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+						names.AttrType: {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"attribute": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrDefaultValue: {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+									"measurement": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"forwarding_config": forwardingConfigSchema(),
+											},
+										},
+									},
+									"metric": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_location": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+												},
+												names.AttrExpression: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"variable": variableSchema(),
+												"window": {
+													Type:     schema.TypeList,
+													Required: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"tumbling": {
+																Type:     schema.TypeList,
+																Required: true,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		names.AttrInterval: {
+																			Type:     schema.TypeString,
+																			Required: true,
+																		},
+																		"offset": {
+																			Type:     schema.TypeString,
+																			Optional: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"transform": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"compute_location": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validation.StringInSlice(iotsitewise.ComputeLocation_Values(), false),
+												},
+												names.AttrExpression: {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validation.StringLenBetween(1, 1024),
+												},
+												"forwarding_config": forwardingConfigSchema(),
+												"variable":          variableSchema(),
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrUnit: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 256),
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceAssetModelCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotsitewise.CreateAssetModelInput{
+		AssetModelName: aws.String(name),
+		Tags:           getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.AssetModelDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("hierarchy"); ok && v.(*schema.Set).Len() > 0 {
+		for _, v := range expandAssetModelHierarchies(v.(*schema.Set).List(), nil) {
+			input.AssetModelHierarchies = append(input.AssetModelHierarchies, &iotsitewise.AssetModelHierarchyDefinition{
+				ChildAssetModelId: v.ChildAssetModelId,
+				Name:              v.Name,
+			})
+		}
+	}
+
+	if v, ok := d.GetOk("property"); ok && v.(*schema.Set).Len() > 0 {
+		for _, v := range expandAssetModelProperties(v.(*schema.Set).List(), nil) {
+			input.AssetModelProperties = append(input.AssetModelProperties, &iotsitewise.AssetModelPropertyDefinition{
+				DataType:     v.DataType,
+				DataTypeSpec: v.DataTypeSpec,
+				Name:         v.Name,
+				Type:         v.Type,
+				Unit:         v.Unit,
+			})
+		}
+	}
+
+	output, err := conn.CreateAssetModelWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Asset Model (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.AssetModelId))
+
+	if _, err := waitAssetModelActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := findAssetModelByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Asset Model (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.AssetModelArn)
+	d.Set(names.AttrDescription, output.AssetModelDescription)
+	if err := d.Set("hierarchy", flattenAssetModelHierarchies(output.AssetModelHierarchies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting hierarchy: %s", err)
+	}
+	d.Set(names.AttrName, output.AssetModelName)
+	if err := d.Set("property", flattenAssetModelProperties(output.AssetModelProperties, output.AssetModelHierarchies)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting property: %s", err)
+	}
+
+	return diags
+}
+
+func resourceAssetModelUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		output, err := findAssetModelByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		// Existing properties and hierarchies must be passed with their IDs, otherwise they are deleted and recreated.
+		hierarchyIDs := make(map[string]string)
+		for _, v := range output.AssetModelHierarchies {
+			hierarchyIDs[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+		}
+		propertyIDs := make(map[string]string)
+		for _, v := range output.AssetModelProperties {
+			propertyIDs[aws.StringValue(v.Name)] = aws.StringValue(v.Id)
+		}
+
+		input := &iotsitewise.UpdateAssetModelInput{
+			AssetModelHierarchies: expandAssetModelHierarchies(d.Get("hierarchy").(*schema.Set).List(), hierarchyIDs),
+			AssetModelId:          aws.String(d.Id()),
+			AssetModelName:        aws.String(d.Get(names.AttrName).(string)),
+			AssetModelProperties:  expandAssetModelProperties(d.Get("property").(*schema.Set).List(), propertyIDs),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.AssetModelDescription = aws.String(v.(string))
+		}
+
+		_, err = conn.UpdateAssetModelWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitAssetModelActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAssetModelRead(ctx, d, meta)...)
+}
+
+func resourceAssetModelDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Asset Model: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeleteAssetModelWithContext(ctx, &iotsitewise.DeleteAssetModelInput{
+			AssetModelId: aws.String(d.Id()),
+		})
+	}, iotsitewise.ErrCodeConflictingOperationException)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Asset Model (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitAssetModelDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Asset Model (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAssetModelByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeAssetModelOutput, error) {
+	input := &iotsitewise.DescribeAssetModelInput{
+		AssetModelId: aws.String(id),
+	}
+
+	output, err := conn.DescribeAssetModelWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AssetModelStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAssetModel(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findAssetModelByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.AssetModelStatus.State), nil
+	}
+}
+
+func waitAssetModelActive(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateCreating, iotsitewise.AssetModelStatePropagating, iotsitewise.AssetModelStateUpdating},
+		Target:  []string{iotsitewise.AssetModelStateActive},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if v := output.AssetModelStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAssetModelDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribeAssetModelOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.AssetModelStateDeleting},
+		Target:  []string{},
+		Refresh: statusAssetModel(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribeAssetModelOutput); ok {
+		if v := output.AssetModelStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// expandAssetModelHierarchies expands the configured hierarchies. IDs of existing hierarchies are looked up by name.
+func expandAssetModelHierarchies(tfList []interface{}, ids map[string]string) []*iotsitewise.AssetModelHierarchy {
+	var apiObjects []*iotsitewise.AssetModelHierarchy
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		apiObject := &iotsitewise.AssetModelHierarchy{
+			ChildAssetModelId: aws.String(tfMap["child_asset_model_id"].(string)),
+			Name:              aws.String(name),
+		}
+
+		if id, ok := ids[name]; ok {
+			apiObject.Id = aws.String(id)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// expandAssetModelProperties expands the configured properties. IDs of existing properties are looked up by name.
+func expandAssetModelProperties(tfList []interface{}, ids map[string]string) []*iotsitewise.AssetModelProperty {
+	var apiObjects []*iotsitewise.AssetModelProperty
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		apiObject := &iotsitewise.AssetModelProperty{
+			DataType: aws.String(tfMap["data_type"].(string)),
+			Name:     aws.String(name),
+			Type:     expandPropertyType(tfMap[names.AttrType].([]interface{})),
+		}
+
+		if v, ok := tfMap["data_type_spec"].(string); ok && v != "" {
+			apiObject.DataTypeSpec = aws.String(v)
+		}
+
+		if id, ok := ids[name]; ok {
+			apiObject.Id = aws.String(id)
+		}
+
+		if v, ok := tfMap[names.AttrUnit].(string); ok && v != "" {
+			apiObject.Unit = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandPropertyType(tfList []interface{}) *iotsitewise.PropertyType {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotsitewise.PropertyType{}
+
+	if v, ok := tfMap["attribute"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Attribute = &iotsitewise.Attribute{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v, ok := tfMap[names.AttrDefaultValue].(string); ok && v != "" {
+				apiObject.Attribute.DefaultValue = aws.String(v)
+			}
+		}
+	}
+
+	if v, ok := tfMap["measurement"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Measurement = &iotsitewise.Measurement{}
+
+		if tfMap, ok := v[0].(map[string]interface{}); ok {
+			if v := expandForwardingConfig(tfMap["forwarding_config"].([]interface{})); v != nil {
+				apiObject.Measurement.ProcessingConfig = &iotsitewise.MeasurementProcessingConfig{
+					ForwardingConfig: v,
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Metric = &iotsitewise.Metric{
+			Expression: aws.String(tfMap[names.AttrExpression].(string)),
+			Variables:  expandExpressionVariables(tfMap["variable"].([]interface{})),
+		}
+
+		if v, ok := tfMap["compute_location"].(string); ok && v != "" {
+			apiObject.Metric.ProcessingConfig = &iotsitewise.MetricProcessingConfig{
+				ComputeLocation: aws.String(v),
+			}
+		}
+
+		if v, ok := tfMap["window"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.Metric.Window = &iotsitewise.MetricWindow{}
+
+			if v, ok := v[0].(map[string]interface{})["tumbling"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				tfMap := v[0].(map[string]interface{})
+				apiObject.Metric.Window.Tumbling = &iotsitewise.TumblingWindow{
+					Interval: aws.String(tfMap[names.AttrInterval].(string)),
+				}
+
+				if v, ok := tfMap["offset"].(string); ok && v != "" {
+					apiObject.Metric.Window.Tumbling.Offset = aws.String(v)
+				}
+			}
+		}
+	}
+
+	if v, ok := tfMap["transform"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.Transform = &iotsitewise.Transform{
+			Expression: aws.String(tfMap[names.AttrExpression].(string)),
+			Variables:  expandExpressionVariables(tfMap["variable"].([]interface{})),
+		}
+
+		if v, ok := tfMap["compute_location"].(string); ok && v != "" {
+			apiObject.Transform.ProcessingConfig = &iotsitewise.TransformProcessingConfig{
+				ComputeLocation: aws.String(v),
+			}
+
+			if v := expandForwardingConfig(tfMap["forwarding_config"].([]interface{})); v != nil {
+				apiObject.Transform.ProcessingConfig.ForwardingConfig = v
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func expandForwardingConfig(tfList []interface{}) *iotsitewise.ForwardingConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &iotsitewise.ForwardingConfig{
+		State: aws.String(tfMap[names.AttrState].(string)),
+	}
+}
+
+func expandExpressionVariables(tfList []interface{}) []*iotsitewise.ExpressionVariable {
+	var apiObjects []*iotsitewise.ExpressionVariable
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &iotsitewise.ExpressionVariable{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: &iotsitewise.VariableValue{},
+		}
+
+		if v, ok := tfMap[names.AttrValue].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+
+			if v, ok := tfMap["hierarchy_id"].(string); ok && v != "" {
+				apiObject.Value.HierarchyId = aws.String(v)
+			}
+
+			if v, ok := tfMap["property_id"].(string); ok && v != "" {
+				apiObject.Value.PropertyId = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAssetModelHierarchies(apiObjects []*iotsitewise.AssetModelHierarchy) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"child_asset_model_id": aws.StringValue(apiObject.ChildAssetModelId),
+			names.AttrID:           aws.StringValue(apiObject.Id),
+			names.AttrName:         aws.StringValue(apiObject.Name),
+		})
+	}
+
+	return tfList
+}
+
+// flattenAssetModelProperties flattens the asset model's properties.
+// Expression variables that reference a property or hierarchy of the same asset model are flattened to that property's or hierarchy's name,
+// which is how they are specified in configuration as the IDs are not known until the asset model is created.
+func flattenAssetModelProperties(apiObjects []*iotsitewise.AssetModelProperty, hierarchies []*iotsitewise.AssetModelHierarchy) []interface{} {
+	hierarchyNames := make(map[string]string)
+	for _, v := range hierarchies {
+		hierarchyNames[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+	propertyNames := make(map[string]string)
+	for _, v := range apiObjects {
+		propertyNames[aws.StringValue(v.Id)] = aws.StringValue(v.Name)
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"data_type":      aws.StringValue(apiObject.DataType),
+			"data_type_spec": aws.StringValue(apiObject.DataTypeSpec),
+			names.AttrID:     aws.StringValue(apiObject.Id),
+			names.AttrName:   aws.StringValue(apiObject.Name),
+			names.AttrUnit:   aws.StringValue(apiObject.Unit),
+		}
+
+		if v := apiObject.Type; v != nil {
+			tfMap[names.AttrType] = flattenPropertyType(v, propertyNames, hierarchyNames)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenPropertyType(apiObject *iotsitewise.PropertyType, propertyNames, hierarchyNames map[string]string) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Attribute; v != nil {
+		tfMap["attribute"] = []interface{}{map[string]interface{}{
+			names.AttrDefaultValue: aws.StringValue(v.DefaultValue),
+		}}
+	}
+
+	if v := apiObject.Measurement; v != nil {
+		tfMapMeasurement := map[string]interface{}{}
+
+		if v := v.ProcessingConfig; v != nil {
+			tfMapMeasurement["forwarding_config"] = flattenForwardingConfig(v.ForwardingConfig)
+		}
+
+		tfMap["measurement"] = []interface{}{tfMapMeasurement}
+	}
+
+	if v := apiObject.Metric; v != nil {
+		tfMapMetric := map[string]interface{}{
+			names.AttrExpression: aws.StringValue(v.Expression),
+			"variable":           flattenExpressionVariables(v.Variables, propertyNames, hierarchyNames),
+		}
+
+		if v := v.ProcessingConfig; v != nil {
+			tfMapMetric["compute_location"] = aws.StringValue(v.ComputeLocation)
+		}
+
+		if v := v.Window; v != nil && v.Tumbling != nil {
+			tfMapMetric["window"] = []interface{}{map[string]interface{}{
+				"tumbling": []interface{}{map[string]interface{}{
+					names.AttrInterval: aws.StringValue(v.Tumbling.Interval),
+					"offset":           aws.StringValue(v.Tumbling.Offset),
+				}},
+			}}
+		}
+
+		tfMap["metric"] = []interface{}{tfMapMetric}
+	}
+
+	if v := apiObject.Transform; v != nil {
+		tfMapTransform := map[string]interface{}{
+			names.AttrExpression: aws.StringValue(v.Expression),
+			"variable":           flattenExpressionVariables(v.Variables, propertyNames, hierarchyNames),
+		}
+
+		if v := v.ProcessingConfig; v != nil {
+			tfMapTransform["compute_location"] = aws.StringValue(v.ComputeLocation)
+			tfMapTransform["forwarding_config"] = flattenForwardingConfig(v.ForwardingConfig)
+		}
+
+		tfMap["transform"] = []interface{}{tfMapTransform}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenForwardingConfig(apiObject *iotsitewise.ForwardingConfig) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		names.AttrState: aws.StringValue(apiObject.State),
+	}}
+}
+
+func flattenExpressionVariables(apiObjects []*iotsitewise.ExpressionVariable, propertyNames, hierarchyNames map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMapValue := map[string]interface{}{}
+
+		if v := apiObject.Value; v != nil {
+			hierarchyID := aws.StringValue(v.HierarchyId)
+			propertyID := aws.StringValue(v.PropertyId)
+
+			if name, ok := hierarchyNames[hierarchyID]; ok {
+				// The property belongs to the child asset model.
+				tfMapValue["hierarchy_id"] = name
+				tfMapValue["property_id"] = propertyID
+			} else {
+				tfMapValue["hierarchy_id"] = hierarchyID
+				if name, ok := propertyNames[propertyID]; ok && hierarchyID == "" {
+					propertyID = name
+				}
+				tfMapValue["property_id"] = propertyID
+			}
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName:  aws.StringValue(apiObject.Name),
+			names.AttrValue: []interface{}{tfMapValue},
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,332 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTSiteWiseAssetModel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotsitewise", regexache.MustCompile(`asset-model/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property.*", map[string]string{
+						"data_type":            "DOUBLE",
+						names.AttrName:         "Temperature",
+						"type.0.measurement.#": acctest.Ct1,
+						names.AttrUnit:         "Celsius",
+					}),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAssetModel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetModelConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAssetModel_formulas(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset_model.test"
+	childResourceName := "aws_iotsitewise_asset_model.child"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetModelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetModelConfig_formulas(rName, "5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hierarchy.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "hierarchy.*.child_asset_model_id", childResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "property.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property.*", map[string]string{
+						names.AttrName:                                      "Fahrenheit",
+						"type.0.transform.0.expression":                     "temp * 9 / 5 + 32",
+						"type.0.transform.0.variable.0.name":                "temp",
+						"type.0.transform.0.variable.0.value.0.property_id": "Temperature",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property.*", map[string]string{
+						names.AttrName:                                 "AverageTemperature",
+						"type.0.metric.0.expression":                   "avg(temp)",
+						"type.0.metric.0.window.0.tumbling.0.interval": "5m",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetModelConfig_formulas(rName, "1h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetModelExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "property.*", map[string]string{
+						names.AttrName: "AverageTemperature",
+						"type.0.metric.0.window.0.tumbling.0.interval": "1h",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAssetModelExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		_, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAssetModelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset_model" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetModelByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset Model %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccAssetModelConfig_property = `
+  property {
+    name      = "Temperature"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      measurement {}
+    }
+  }
+`
+
+func testAccAssetModelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+%[2]s
+}
+`, rName, testAccAssetModelConfig_property)
+}
+
+func testAccAssetModelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, testAccAssetModelConfig_property, tagKey1, tagValue1)
+}
+
+func testAccAssetModelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+%[2]s
+  tags = {
+    %[3]q = %[4]q
+    %[5]q = %[6]q
+  }
+}
+`, rName, testAccAssetModelConfig_property, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
+func testAccAssetModelConfig_formulas(rName, interval string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "child" {
+  name = "%[1]s-child"
+%[2]s
+}
+
+resource "aws_iotsitewise_asset_model" "test" {
+  name        = %[1]q
+  description = "Formulas over child models"
+
+  hierarchy {
+    name                 = "Sensors"
+    child_asset_model_id = aws_iotsitewise_asset_model.child.id
+  }
+
+  property {
+    name      = "Location"
+    data_type = "STRING"
+
+    type {
+      attribute {
+        default_value = "Line 1"
+      }
+    }
+  }
+%[2]s
+  property {
+    name      = "Fahrenheit"
+    data_type = "DOUBLE"
+    unit      = "Fahrenheit"
+
+    type {
+      transform {
+        expression = "temp * 9 / 5 + 32"
+
+        variable {
+          name = "temp"
+
+          value {
+            property_id = "Temperature"
+          }
+        }
+      }
+    }
+  }
+
+  property {
+    name      = "AverageTemperature"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      metric {
+        expression = "avg(temp)"
+
+        variable {
+          name = "temp"
+
+          value {
+            property_id = "Temperature"
+          }
+        }
+
+        window {
+          tumbling {
+            interval = %[3]q
+          }
+        }
+      }
+    }
+  }
+}
+`, rName, testAccAssetModelConfig_property, interval)
+}
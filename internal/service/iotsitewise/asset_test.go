@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiotsitewise "github.com/hashicorp/terraform-provider-aws/internal/service/iotsitewise"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIoTSiteWiseAsset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "iotsitewise", regexache.MustCompile(`asset/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "asset_model_id", "aws_iotsitewise_asset_model.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "property.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "property.0.name", "Temperature"),
+					resource.TestCheckResourceAttr(resourceName, "property.0.unit", "Celsius"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccAssetConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "Updated"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName+"-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIoTSiteWiseAsset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iotsitewise_asset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IoTSiteWiseServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAssetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAssetExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiotsitewise.ResourceAsset(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckAssetExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		_, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAssetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iotsitewise_asset" {
+				continue
+			}
+
+			_, err := tfiotsitewise.FindAssetByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("IoT SiteWise Asset %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccAssetConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iotsitewise_asset_model" "test" {
+  name = %[1]q
+
+  property {
+    name      = "Temperature"
+    data_type = "DOUBLE"
+    unit      = "Celsius"
+
+    type {
+      measurement {}
+    }
+  }
+}
+`, rName)
+}
+
+func testAccAssetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = %[1]q
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+}
+`, rName))
+}
+
+func testAccAssetConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccAssetConfig_base(rName), fmt.Sprintf(`
+resource "aws_iotsitewise_asset" "test" {
+  name           = "%[1]s-updated"
+  description    = "Updated"
+  asset_model_id = aws_iotsitewise_asset_model.test.id
+}
+`, rName))
+}
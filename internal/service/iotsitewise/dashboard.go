@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_dashboard", name="Dashboard")
+// @Tags(identifierAttribute="arn")
+func resourceDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceDashboardCreate,
+		ReadWithoutTimeout:   resourceDashboardRead,
+		UpdateWithoutTimeout: resourceDashboardUpdate,
+		DeleteWithoutTimeout: resourceDashboardDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"dashboard_definition": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"project_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotsitewise.CreateDashboardInput{
+		DashboardDefinition: aws.String(d.Get("dashboard_definition").(string)),
+		DashboardName:       aws.String(name),
+		ProjectId:           aws.String(d.Get("project_id").(string)),
+		Tags:                getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.DashboardDescription = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateDashboardWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Dashboard (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.DashboardId))
+
+	return append(diags, resourceDashboardRead(ctx, d, meta)...)
+}
+
+func resourceDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := findDashboardByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Dashboard (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Dashboard (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.DashboardArn)
+	d.Set("dashboard_definition", output.DashboardDefinition)
+	d.Set(names.AttrDescription, output.DashboardDescription)
+	d.Set(names.AttrName, output.DashboardName)
+	d.Set("project_id", output.ProjectId)
+
+	return diags
+}
+
+func resourceDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotsitewise.UpdateDashboardInput{
+			DashboardDefinition: aws.String(d.Get("dashboard_definition").(string)),
+			DashboardId:         aws.String(d.Id()),
+			DashboardName:       aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.DashboardDescription = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdateDashboardWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Dashboard (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceDashboardRead(ctx, d, meta)...)
+}
+
+func resourceDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Dashboard: %s", d.Id())
+	_, err := conn.DeleteDashboardWithContext(ctx, &iotsitewise.DeleteDashboardInput{
+		DashboardId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Dashboard (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findDashboardByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeDashboardOutput, error) {
+	input := &iotsitewise.DescribeDashboardInput{
+		DashboardId: aws.String(id),
+	}
+
+	output, err := conn.DescribeDashboardWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
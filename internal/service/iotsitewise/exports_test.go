@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+// Exports for use in tests only.
+var (
+	ResourceAsset      = resourceAsset
+	ResourceAssetModel = resourceAssetModel
+	ResourceDashboard  = resourceDashboard
+	ResourceGateway    = resourceGateway
+	ResourcePortal     = resourcePortal
+	ResourceProject    = resourceProject
+
+	FindAssetByID      = findAssetByID
+	FindAssetModelByID = findAssetModelByID
+	FindDashboardByID  = findDashboardByID
+	FindGatewayByID    = findGatewayByID
+	FindPortalByID     = findPortalByID
+	FindProjectByID    = findProjectByID
+)
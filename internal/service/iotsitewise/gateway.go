@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_gateway", name="Gateway")
+// @Tags(identifierAttribute="arn")
+func resourceGateway() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceGatewayCreate,
+		ReadWithoutTimeout:   resourceGatewayRead,
+		UpdateWithoutTimeout: resourceGatewayUpdate,
+		DeleteWithoutTimeout: resourceGatewayDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"gateway_platform": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"greengrass": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"gateway_platform.0.greengrass", "gateway_platform.0.greengrass_v2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"greengrass_v2": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"gateway_platform.0.greengrass", "gateway_platform.0.greengrass_v2"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"core_device_thing_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotsitewise.CreateGatewayInput{
+		GatewayName:     aws.String(name),
+		GatewayPlatform: expandGatewayPlatform(d.Get("gateway_platform").([]interface{})),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateGatewayWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Gateway (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.GatewayId))
+
+	return append(diags, resourceGatewayRead(ctx, d, meta)...)
+}
+
+func resourceGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := findGatewayByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Gateway (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Gateway (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.GatewayArn)
+	if err := d.Set("gateway_platform", flattenGatewayPlatform(output.GatewayPlatform)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting gateway_platform: %s", err)
+	}
+	d.Set(names.AttrName, output.GatewayName)
+
+	return diags
+}
+
+func resourceGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChange(names.AttrName) {
+		input := &iotsitewise.UpdateGatewayInput{
+			GatewayId:   aws.String(d.Id()),
+			GatewayName: aws.String(d.Get(names.AttrName).(string)),
+		}
+
+		_, err := conn.UpdateGatewayWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Gateway (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceGatewayRead(ctx, d, meta)...)
+}
+
+func resourceGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Gateway: %s", d.Id())
+	_, err := conn.DeleteGatewayWithContext(ctx, &iotsitewise.DeleteGatewayInput{
+		GatewayId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Gateway (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findGatewayByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribeGatewayOutput, error) {
+	input := &iotsitewise.DescribeGatewayInput{
+		GatewayId: aws.String(id),
+	}
+
+	output, err := conn.DescribeGatewayWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func expandGatewayPlatform(tfList []interface{}) *iotsitewise.GatewayPlatform {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotsitewise.GatewayPlatform{}
+
+	if v, ok := tfMap["greengrass"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Greengrass = &iotsitewise.Greengrass{
+			GroupArn: aws.String(v[0].(map[string]interface{})["group_arn"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["greengrass_v2"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.GreengrassV2 = &iotsitewise.GreengrassV2{
+			CoreDeviceThingName: aws.String(v[0].(map[string]interface{})["core_device_thing_name"].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenGatewayPlatform(apiObject *iotsitewise.GatewayPlatform) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Greengrass; v != nil {
+		tfMap["greengrass"] = []interface{}{map[string]interface{}{
+			"group_arn": aws.StringValue(v.GroupArn),
+		}}
+	}
+
+	if v := apiObject.GreengrassV2; v != nil {
+		tfMap["greengrass_v2"] = []interface{}{map[string]interface{}{
+			"core_device_thing_name": aws.StringValue(v.CoreDeviceThingName),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
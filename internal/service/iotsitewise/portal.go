@@ -0,0 +1,363 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iotsitewise
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/iotsitewise"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iotsitewise_portal", name="Portal")
+// @Tags(identifierAttribute="arn")
+func resourcePortal() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortalCreate,
+		ReadWithoutTimeout:   resourcePortalRead,
+		UpdateWithoutTimeout: resourcePortalUpdate,
+		DeleteWithoutTimeout: resourcePortalDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"alarms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_role_arn": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"notification_lambda_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+					},
+				},
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auth_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      iotsitewise.AuthModeSso,
+				ValidateFunc: validation.StringInSlice(iotsitewise.AuthMode_Values(), false),
+			},
+			names.AttrClientID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_email": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 2048),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"notification_sender_email": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"start_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourcePortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &iotsitewise.CreatePortalInput{
+		PortalAuthMode:     aws.String(d.Get("auth_mode").(string)),
+		PortalContactEmail: aws.String(d.Get("contact_email").(string)),
+		PortalName:         aws.String(name),
+		RoleArn:            aws.String(d.Get(names.AttrRoleARN).(string)),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("alarms"); ok {
+		input.Alarms = expandAlarms(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.PortalDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("notification_sender_email"); ok {
+		input.NotificationSenderEmail = aws.String(v.(string))
+	}
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreatePortalWithContext(ctx, input)
+	}, iotsitewise.ErrCodeInvalidRequestException, "role")
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IoT SiteWise Portal (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(outputRaw.(*iotsitewise.CreatePortalOutput).PortalId))
+
+	if _, err := waitPortalActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Portal (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	output, err := findPortalByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IoT SiteWise Portal (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IoT SiteWise Portal (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("alarms", flattenAlarms(output.Alarms)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting alarms: %s", err)
+	}
+	d.Set(names.AttrARN, output.PortalArn)
+	d.Set("auth_mode", output.PortalAuthMode)
+	d.Set(names.AttrClientID, output.PortalClientId)
+	d.Set("contact_email", output.PortalContactEmail)
+	d.Set(names.AttrDescription, output.PortalDescription)
+	d.Set(names.AttrName, output.PortalName)
+	d.Set("notification_sender_email", output.NotificationSenderEmail)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+	d.Set("start_url", output.PortalStartUrl)
+
+	return diags
+}
+
+func resourcePortalUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &iotsitewise.UpdatePortalInput{
+			Alarms:             expandAlarms(d.Get("alarms").([]interface{})),
+			PortalContactEmail: aws.String(d.Get("contact_email").(string)),
+			PortalId:           aws.String(d.Id()),
+			PortalName:         aws.String(d.Get(names.AttrName).(string)),
+			RoleArn:            aws.String(d.Get(names.AttrRoleARN).(string)),
+		}
+
+		if v, ok := d.GetOk(names.AttrDescription); ok {
+			input.PortalDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("notification_sender_email"); ok {
+			input.NotificationSenderEmail = aws.String(v.(string))
+		}
+
+		_, err := conn.UpdatePortalWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IoT SiteWise Portal (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitPortalActive(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Portal (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePortalRead(ctx, d, meta)...)
+}
+
+func resourcePortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IoTSiteWiseConn(ctx)
+
+	log.Printf("[DEBUG] Deleting IoT SiteWise Portal: %s", d.Id())
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
+		return conn.DeletePortalWithContext(ctx, &iotsitewise.DeletePortalInput{
+			PortalId: aws.String(d.Id()),
+		})
+	}, iotsitewise.ErrCodeConflictingOperationException)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IoT SiteWise Portal (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitPortalDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IoT SiteWise Portal (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPortalByID(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) (*iotsitewise.DescribePortalOutput, error) {
+	input := &iotsitewise.DescribePortalInput{
+		PortalId: aws.String(id),
+	}
+
+	output, err := conn.DescribePortalWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, iotsitewise.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PortalStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPortal(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPortalByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PortalStatus.State), nil
+	}
+}
+
+func waitPortalActive(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribePortalOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.PortalStateCreating, iotsitewise.PortalStateUpdating},
+		Target:  []string{iotsitewise.PortalStateActive},
+		Refresh: statusPortal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribePortalOutput); ok {
+		if v := output.PortalStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPortalDeleted(ctx context.Context, conn *iotsitewise.IoTSiteWise, id string, timeout time.Duration) (*iotsitewise.DescribePortalOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{iotsitewise.PortalStateDeleting},
+		Target:  []string{},
+		Refresh: statusPortal(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*iotsitewise.DescribePortalOutput); ok {
+		if v := output.PortalStatus.Error; v != nil {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(v.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandAlarms(tfList []interface{}) *iotsitewise.Alarms {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &iotsitewise.Alarms{
+		AlarmRoleArn: aws.String(tfMap["alarm_role_arn"].(string)),
+	}
+
+	if v, ok := tfMap["notification_lambda_arn"].(string); ok && v != "" {
+		apiObject.NotificationLambdaArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAlarms(apiObject *iotsitewise.Alarms) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"alarm_role_arn":          aws.StringValue(apiObject.AlarmRoleArn),
+		"notification_lambda_arn": aws.StringValue(apiObject.NotificationLambdaArn),
+	}}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -137,7 +138,7 @@ func resourceChannelCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(out.Channel.Arn))
 
-	if _, err := waitChannelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitChannelCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return create.AppendDiagError(diags, names.IVS, create.ErrActionWaitingForCreation, ResNameChannel, d.Id(), err)
 	}
 
@@ -221,7 +222,7 @@ func resourceChannelUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		return create.AppendDiagError(diags, names.IVS, create.ErrActionUpdating, ResNameChannel, d.Id(), err)
 	}
 
-	if _, err := waitChannelUpdated(ctx, conn, *out.Channel.Arn, d.Timeout(schema.TimeoutUpdate), in); err != nil {
+	if _, err := waitChannelUpdated(ctx, conn, *out.Channel.Arn, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), in); err != nil {
 		return create.AppendDiagError(diags, names.IVS, create.ErrActionWaitingForUpdate, ResNameChannel, d.Id(), err)
 	}
 
@@ -247,7 +248,7 @@ func resourceChannelDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return create.AppendDiagError(diags, names.IVS, create.ErrActionDeleting, ResNameChannel, d.Id(), err)
 	}
 
-	if _, err := waitChannelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitChannelDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.IVS, create.ErrActionWaitingForDeletion, ResNameChannel, d.Id(), err)
 	}
 
@@ -2,6 +2,8 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -ServiceTagsMap -UpdateTags -ServiceTagsMap -KVTValues -SkipTypesImp
+//go:generate go run ../../generate/pluraldatasource/main.go
+//go:generate go run ../../generate/pluraldatasource/main.go -TypeName=aws_msk_configurations -HumanName=Configurations -ListOp=ListConfigurations -ListOpOutputElem=Configurations -NameElem=Name -ARNElem=Arn -Tags -TaggingResourceType=kafka:configuration -- configurations_data_source_gen.go
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
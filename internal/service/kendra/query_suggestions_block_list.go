@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -150,7 +151,7 @@ func resourceQuerySuggestionsBlockListCreate(ctx context.Context, d *schema.Reso
 
 	d.SetId(fmt.Sprintf("%s/%s", id, indexId))
 
-	if _, err := waitQuerySuggestionsBlockListCreated(ctx, conn, id, indexId, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitQuerySuggestionsBlockListCreated(ctx, conn, id, indexId, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Amazon Kendra QuerySuggestionsBlockList (%s) create: %s", d.Id(), err)
 	}
 
@@ -255,7 +256,7 @@ func resourceQuerySuggestionsBlockListUpdate(ctx context.Context, d *schema.Reso
 			return sdkdiag.AppendErrorf(diags, "updating Kendra QuerySuggestionsBlockList (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitQuerySuggestionsBlockListUpdated(ctx, conn, id, indexId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitQuerySuggestionsBlockListUpdated(ctx, conn, id, indexId, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Kendra QuerySuggestionsBlockList (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -290,7 +291,7 @@ func resourceQuerySuggestionsBlockListDelete(ctx context.Context, d *schema.Reso
 		return sdkdiag.AppendErrorf(diags, "deleting Kendra QuerySuggestionsBlockList (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitQuerySuggestionsBlockListDeleted(ctx, conn, id, indexId, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitQuerySuggestionsBlockListDeleted(ctx, conn, id, indexId, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Kendra QuerySuggestionsBlockList (%s) to be deleted: %s", d.Id(), err)
 	}
 
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -976,7 +977,7 @@ func resourceApplicationCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("create_timestamp", aws.TimeValue(output.ApplicationDetail.CreateTimestamp).Format(time.RFC3339))
 
 	if _, ok := d.GetOk("start_application"); ok {
-		if err := startApplication(ctx, conn, expandStartApplicationInput(d), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if err := startApplication(ctx, conn, expandStartApplicationInput(d), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "creating Kinesis Analytics v2 Application (%s): %s", applicationName, err)
 		}
 	}
@@ -1089,7 +1090,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationInputOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1128,7 +1129,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 								output := outputRaw.(*kinesisanalyticsv2.AddApplicationInputProcessingConfigurationOutput)
 
-								if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+								if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 									return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 								}
 
@@ -1153,7 +1154,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 								output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationInputProcessingConfigurationOutput)
 
-								if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+								if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 									return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 								}
 
@@ -1215,7 +1216,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationOutputOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1242,7 +1243,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationOutputOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1273,7 +1274,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.AddApplicationReferenceDataSourceOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1300,7 +1301,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 						output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationReferenceDataSourceOutput)
 
-						if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+						if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 							return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 						}
 
@@ -1341,7 +1342,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 					output := outputRaw.(*kinesisanalyticsv2.AddApplicationVpcConfigurationOutput)
 
-					if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 					}
 
@@ -1368,7 +1369,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 					output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationVpcConfigurationOutput)
 
-					if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+					if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 						return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 					}
 
@@ -1427,7 +1428,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 				output := outputRaw.(*kinesisanalyticsv2.AddApplicationCloudWatchLoggingOptionOutput)
 
-				if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 				}
 
@@ -1454,7 +1455,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 				output := outputRaw.(*kinesisanalyticsv2.DeleteApplicationCloudWatchLoggingOptionOutput)
 
-				if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 					return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 				}
 
@@ -1494,7 +1495,7 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 				return sdkdiag.AppendErrorf(diags, "updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
 			}
 
-			if _, err := waitApplicationUpdated(ctx, conn, applicationName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitApplicationUpdated(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application (%s) to update: %s", d.Id(), err)
 			}
 		}
@@ -1502,11 +1503,11 @@ func resourceApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	if d.HasChange("start_application") {
 		if _, ok := d.GetOk("start_application"); ok {
-			if err := startApplication(ctx, conn, expandStartApplicationInput(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := startApplication(ctx, conn, expandStartApplicationInput(d), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
 			}
 		} else {
-			if err := stopApplication(ctx, conn, expandStopApplicationInput(d), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if err := stopApplication(ctx, conn, expandStopApplicationInput(d), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
 			}
 		}
@@ -1540,7 +1541,7 @@ func resourceApplicationDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Analytics v2 Application (%s): %s", d.Id(), err)
 	}
 
-	_, err = waitApplicationDeleted(ctx, conn, applicationName, d.Timeout(schema.TimeoutDelete))
+	_, err = waitApplicationDeleted(ctx, conn, applicationName, sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Analytics v2 Application (%s): waiting for completion: %s", d.Id(), err)
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -91,7 +92,7 @@ func resourceApplicationSnapshotCreate(ctx context.Context, d *schema.ResourceDa
 
 	d.SetId(applicationSnapshotCreateID(applicationName, snapshotName))
 
-	_, err = waitSnapshotCreated(ctx, conn, applicationName, snapshotName, d.Timeout(schema.TimeoutCreate))
+	_, err = waitSnapshotCreated(ctx, conn, applicationName, snapshotName, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Analytics v2 Application Snapshot (%s) creation: %s", d.Id(), err)
@@ -164,7 +165,7 @@ func resourceApplicationSnapshotDelete(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Analytics v2 Application Snapshot (%s): %s", d.Id(), err)
 	}
 
-	_, err = waitSnapshotDeleted(ctx, conn, applicationName, snapshotName, d.Timeout(schema.TimeoutDelete))
+	_, err = waitSnapshotDeleted(ctx, conn, applicationName, snapshotName, sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Analytics v2 Application Snapshot (%s): waiting for completion: %s", d.Id(), err)
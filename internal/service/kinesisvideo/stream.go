@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -133,7 +134,7 @@ func resourceStreamCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Pending:    []string{kinesisvideo.StatusCreating},
 		Target:     []string{kinesisvideo.StatusActive},
 		Refresh:    StreamStateRefresh(ctx, conn, arn),
-		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Timeout:    sdkv2.Timeout(ctx, d, schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -202,7 +203,7 @@ func resourceStreamUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		Pending:    []string{kinesisvideo.StatusUpdating},
 		Target:     []string{kinesisvideo.StatusActive},
 		Refresh:    StreamStateRefresh(ctx, conn, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Timeout:    sdkv2.Timeout(ctx, d, schema.TimeoutUpdate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
@@ -232,7 +233,7 @@ func resourceStreamDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		Pending:    []string{kinesisvideo.StatusDeleting},
 		Target:     []string{"DELETED"},
 		Refresh:    StreamStateRefresh(ctx, conn, d.Id()),
-		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Timeout:    sdkv2.Timeout(ctx, d, schema.TimeoutDelete),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
 	}
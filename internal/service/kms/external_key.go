@@ -27,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -109,7 +110,7 @@ func resourceExternalKey() *schema.Resource {
 				DiffSuppressOnRefresh: true,
 				ValidateFunc: validation.All(
 					validation.StringLenBetween(0, 32768),
-					validation.StringIsJSON,
+					sdkv2.ValidIAMResourcePolicyDocumentOrEmpty,
 				),
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
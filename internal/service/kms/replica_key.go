@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -89,13 +90,7 @@ func resourceReplicaKey() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			names.AttrPolicy: {
-				Type:             schema.TypeString,
-				Optional:         true,
-				Computed:         true,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
-				ValidateFunc:     validation.StringIsJSON,
-			},
+			names.AttrPolicy: sdkv2.IAMResourcePolicyDocumentSchemaOptionalComputed(),
 			"primary_key_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -137,11 +132,16 @@ func resourceReplicaKeyCreate(ctx context.Context, d *schema.ResourceData, meta
 		input.Policy = aws.String(v.(string))
 	}
 
+	// Replication is initiated in the primary key's Region.
+	primaryClient, err := meta.(*conns.AWSClient).RegionalClient(ctx, primaryKeyARN.Region)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating KMS Replica Key: %s", err)
+	}
+
+	primaryConn := primaryClient.KMSClient(ctx)
 	output, err := waitIAMPropagation(ctx, iamPropagationTimeout, func() (*kms.ReplicateKeyOutput, error) {
-		// Replication is initiated in the primary key's Region.
-		return conn.ReplicateKey(ctx, input, func(o *kms.Options) {
-			o.Region = primaryKeyARN.Region
-		})
+		return primaryConn.ReplicateKey(ctx, input)
 	})
 
 	if err != nil {
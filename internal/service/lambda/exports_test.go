@@ -11,6 +11,7 @@ var (
 	ResourceFunction                     = resourceFunction
 	ResourceFunctionEventInvokeConfig    = resourceFunctionEventInvokeConfig
 	ResourceFunctionURL                  = resourceFunctionURL
+	ResourceFunctionURLPermission        = resourceFunctionURLPermission
 	ResourceInvocation                   = resourceInvocation
 	ResourceLayerVersion                 = resourceLayerVersion
 	ResourceLayerVersionPermission       = resourceLayerVersionPermission
@@ -43,6 +43,8 @@ func resourceFunctionURL() *schema.Resource {
 			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffFunctionURLCors,
+
 		Schema: map[string]*schema.Schema{
 			"authorization_type": {
 				Type:             schema.TypeString,
@@ -67,7 +69,10 @@ func resourceFunctionURL() *schema.Resource {
 						"allow_methods": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validFunctionURLCorsMethod(),
+							},
 						},
 						"allow_origins": {
 							Type:     schema.TypeSet,
@@ -156,7 +161,7 @@ func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta
 			FunctionName:        aws.String(name),
 			FunctionUrlAuthType: authorizationType,
 			Principal:           aws.String("*"),
-			StatementId:         aws.String("FunctionURLAllowPublicAccess"),
+			StatementId:         aws.String(functionURLPublicAccessStatementID),
 		}
 
 		if qualifier != "" {
@@ -299,6 +304,20 @@ func resourceFunctionURLDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func customizeDiffFunctionURLCors(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cors") {
+		return nil
+	}
+
+	if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if err := validateFunctionURLCors(expandCors(v.([]interface{})[0].(map[string]interface{}))); err != nil {
+			return fmt.Errorf("invalid cors configuration: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func findFunctionURLByTwoPartKey(ctx context.Context, conn *lambda.Client, name, qualifier string) (*lambda.GetFunctionUrlConfigOutput, error) {
 	input := &lambda.GetFunctionUrlConfigInput{
 		FunctionName: aws.String(name),
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	functionURLPermissionResourceIDPartCount = 3
+	// The statement ID used by aws_lambda_function_url for the public access statement of function URLs with NONE authorization.
+	functionURLPublicAccessStatementID = "FunctionURLAllowPublicAccess"
+)
+
+// @SDKResource("aws_lambda_function_url_permission", name="Function URL Permission")
+func resourceFunctionURLPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFunctionURLPermissionCreate,
+		ReadWithoutTimeout:   resourceFunctionURLPermissionRead,
+		DeleteWithoutTimeout: resourceFunctionURLPermissionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"function_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validFunctionName(),
+			},
+			"function_url_auth_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.FunctionUrlAuthType](),
+			},
+			names.AttrPrincipal: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "*",
+			},
+			"principal_org_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"qualifier": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validQualifier(),
+			},
+			"source_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"statement_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      functionURLPublicAccessStatementID,
+				ValidateFunc: validPolicyStatementID(),
+			},
+		},
+
+		CustomizeDiff: customizeDiffFunctionURLPermissionPrincipal,
+	}
+}
+
+func resourceFunctionURLPermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	functionName := d.Get("function_name").(string)
+	qualifier := d.Get("qualifier").(string)
+	statementID := d.Get("statement_id").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{functionName, qualifier, statementID}, functionURLPermissionResourceIDPartCount, true))
+
+	// There is a bug in the API (reported and acknowledged by AWS)
+	// which causes some permissions to be ignored when API calls are sent in parallel
+	// We work around this bug via mutex
+	conns.GlobalMutexKV.Lock(functionName)
+	defer conns.GlobalMutexKV.Unlock(functionName)
+
+	// Adopt the public access statement added by aws_lambda_function_url so that its conditions can be managed.
+	if statementID == functionURLPublicAccessStatementID {
+		_, err := findPolicyStatementByTwoPartKey(ctx, conn, functionName, statementID, qualifier)
+
+		switch {
+		case err == nil:
+			if err := removeFunctionURLPermission(ctx, conn, functionName, statementID, qualifier); err != nil {
+				return sdkdiag.AppendErrorf(diags, "replacing Lambda Function URL Permission (%s): %s", id, err)
+			}
+		case !tfresource.NotFound(err):
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function URL Permission (%s): %s", id, err)
+		}
+	}
+
+	input := &lambda.AddPermissionInput{
+		Action:              aws.String("lambda:InvokeFunctionUrl"),
+		FunctionName:        aws.String(functionName),
+		FunctionUrlAuthType: awstypes.FunctionUrlAuthType(d.Get("function_url_auth_type").(string)),
+		Principal:           aws.String(d.Get(names.AttrPrincipal).(string)),
+		StatementId:         aws.String(statementID),
+	}
+
+	if v, ok := d.GetOk("principal_org_id"); ok {
+		input.PrincipalOrgID = aws.String(v.(string))
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	if v, ok := d.GetOk("source_account"); ok {
+		input.SourceAccount = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("source_arn"); ok {
+		input.SourceArn = aws.String(v.(string))
+	}
+
+	// Retry for IAM and Lambda eventual consistency.
+	_, err := tfresource.RetryWhenIsOneOf2[*awstypes.ResourceConflictException, *awstypes.ResourceNotFoundException](ctx, lambdaPropagationTimeout,
+		func() (interface{}, error) {
+			return conn.AddPermission(ctx, input)
+		})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "adding Lambda Function URL Permission (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceFunctionURLPermissionRead(ctx, d, meta)...)
+}
+
+func resourceFunctionURLPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), functionURLPermissionResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	functionName, qualifier, statementID := parts[0], parts[1], parts[2]
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, lambdaPropagationTimeout, func() (interface{}, error) {
+		return findPolicyStatementByTwoPartKey(ctx, conn, functionName, statementID, qualifier)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Lambda Function URL Permission (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Function URL Permission (%s): %s", d.Id(), err)
+	}
+
+	statement := outputRaw.(*PolicyStatement)
+
+	d.Set("function_name", functionName)
+	if v, ok := statement.Principal.(map[string]interface{}); ok {
+		d.Set(names.AttrPrincipal, v["AWS"])
+	} else if v, ok := statement.Principal.(string); ok {
+		d.Set(names.AttrPrincipal, v)
+	}
+	if v, ok := statement.Condition["StringEquals"]; ok {
+		d.Set("function_url_auth_type", v["lambda:FunctionUrlAuthType"])
+		d.Set("principal_org_id", v["aws:PrincipalOrgID"])
+		d.Set("source_account", v["AWS:SourceAccount"])
+	}
+	if v, ok := statement.Condition["ArnLike"]; ok {
+		d.Set("source_arn", v["AWS:SourceArn"])
+	}
+	d.Set("qualifier", qualifier)
+	d.Set("statement_id", statement.Sid)
+
+	return diags
+}
+
+func resourceFunctionURLPermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), functionURLPermissionResourceIDPartCount, true)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	functionName, qualifier, statementID := parts[0], parts[1], parts[2]
+
+	conns.GlobalMutexKV.Lock(functionName)
+	defer conns.GlobalMutexKV.Unlock(functionName)
+
+	log.Printf("[INFO] Deleting Lambda Function URL Permission: %s", d.Id())
+	if err := removeFunctionURLPermission(ctx, conn, functionName, statementID, qualifier); err != nil {
+		return sdkdiag.AppendErrorf(diags, "removing Lambda Function URL Permission (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// removeFunctionURLPermission removes the specified policy statement and waits for the removal to propagate.
+func removeFunctionURLPermission(ctx context.Context, conn *lambda.Client, functionName, statementID, qualifier string) error {
+	input := &lambda.RemovePermissionInput{
+		FunctionName: aws.String(functionName),
+		StatementId:  aws.String(statementID),
+	}
+
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	_, err := conn.RemovePermission(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, lambdaPropagationTimeout, func() (interface{}, error) {
+		return findPolicyStatementByTwoPartKey(ctx, conn, functionName, statementID, qualifier)
+	})
+
+	if err != nil {
+		return fmt.Errorf("waiting for delete: %w", err)
+	}
+
+	return nil
+}
+
+// customizeDiffFunctionURLPermissionPrincipal ensures that function URLs with NONE authorization are made public,
+// as required by Lambda.
+func customizeDiffFunctionURLPermissionPrincipal(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("function_url_auth_type") || !d.NewValueKnown(names.AttrPrincipal) {
+		return nil
+	}
+
+	if awstypes.FunctionUrlAuthType(d.Get("function_url_auth_type").(string)) == awstypes.FunctionUrlAuthTypeNone && d.Get(names.AttrPrincipal).(string) != "*" {
+		return errors.New(`principal must be "*" when function_url_auth_type is "NONE"`)
+	}
+
+	return nil
+}
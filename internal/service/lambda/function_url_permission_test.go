@@ -0,0 +1,227 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionURLPermission_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var statement tflambda.PolicyStatement
+	resourceName := "aws_lambda_function_url_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLPermissionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLPermissionExists(ctx, resourceName, &statement),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", "aws_lambda_function.test", "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "function_url_auth_type", "AWS_IAM"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrPrincipal, "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "qualifier", ""),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "AllowAccountInvoke"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURLPermission_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var statement tflambda.PolicyStatement
+	resourceName := "aws_lambda_function_url_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLPermissionConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLPermissionExists(ctx, resourceName, &statement),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflambda.ResourceFunctionURLPermission(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURLPermission_publicAccess(t *testing.T) {
+	ctx := acctest.Context(t)
+	var statement tflambda.PolicyStatement
+	resourceName := "aws_lambda_function_url_permission.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLPermissionConfig_publicAccess(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionURLPermissionExists(ctx, resourceName, &statement),
+					resource.TestCheckResourceAttr(resourceName, "function_url_auth_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPrincipal, "*"),
+					resource.TestCheckResourceAttrPair(resourceName, "source_account", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "statement_id", "FunctionURLAllowPublicAccess"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURLPermission_publicAccessPrincipal(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionURLPermissionConfig_publicAccessPrincipal(rName),
+				ExpectError: regexache.MustCompile(`principal must be "\*" when function_url_auth_type is "NONE"`),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionURLPermissionExists(ctx context.Context, n string, v *tflambda.PolicyStatement) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["statement_id"], rs.Primary.Attributes["qualifier"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionURLPermissionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_function_url_permission" {
+				continue
+			}
+
+			_, err := tflambda.FindPolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["statement_id"], rs.Primary.Attributes["qualifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Lambda Function URL Permission %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccFunctionURLPermissionConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(rName, rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+`, rName))
+}
+
+func testAccFunctionURLPermissionConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLPermissionConfig_base(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+}
+
+resource "aws_lambda_function_url_permission" "test" {
+  function_name          = aws_lambda_function_url.test.function_name
+  function_url_auth_type = aws_lambda_function_url.test.authorization_type
+  principal              = data.aws_caller_identity.current.account_id
+  statement_id           = "AllowAccountInvoke"
+}
+`)
+}
+
+func testAccFunctionURLPermissionConfig_publicAccess(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLPermissionConfig_base(rName), `
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+}
+
+resource "aws_lambda_function_url_permission" "test" {
+  function_name          = aws_lambda_function_url.test.function_name
+  function_url_auth_type = aws_lambda_function_url.test.authorization_type
+  source_account         = data.aws_caller_identity.current.account_id
+}
+`)
+}
+
+func testAccFunctionURLPermissionConfig_publicAccessPrincipal(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLPermissionConfig_base(rName), `
+resource "aws_lambda_function_url_permission" "test" {
+  function_name          = aws_lambda_function.test.function_name
+  function_url_auth_type = "NONE"
+  principal              = data.aws_caller_identity.current.account_id
+}
+`)
+}
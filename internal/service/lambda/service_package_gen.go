@@ -102,6 +102,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_lambda_function_url",
 			Name:     "Function URL",
 		},
+		{
+			Factory:  resourceFunctionURLPermission,
+			TypeName: "aws_lambda_function_url_permission",
+			Name:     "Function URL Permission",
+		},
 		{
 			Factory:  resourceInvocation,
 			TypeName: "aws_lambda_invocation",
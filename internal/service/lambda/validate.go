@@ -4,7 +4,13 @@
 package lambda
 
 import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
 	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -56,3 +62,43 @@ func validLogGroupName() schema.SchemaValidateFunc {
 		validation.StringLenBetween(1, 512),
 	)
 }
+
+func validFunctionURLCorsMethod() schema.SchemaValidateFunc {
+	// https://docs.aws.amazon.com/lambda/latest/dg/API_Cors.html
+	return validation.StringInSlice([]string{"*", "DELETE", "GET", "HEAD", "PATCH", "POST", "PUT"}, true)
+}
+
+// validateFunctionURLCors checks a function URL's CORS configuration for combinations that are accepted by the API
+// but that are redundant or never match a browser's request.
+func validateFunctionURLCors(apiObject *awstypes.Cors) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if len(apiObject.AllowOrigins) == 0 && (len(apiObject.AllowHeaders) > 0 || len(apiObject.AllowMethods) > 0 || len(apiObject.ExposeHeaders) > 0) {
+		errs = append(errs, errors.New("allow_origins must be specified when allow_headers, allow_methods or expose_headers is specified"))
+	}
+
+	if slices.Contains(apiObject.AllowOrigins, "*") && len(apiObject.AllowOrigins) > 1 {
+		errs = append(errs, errors.New(`allow_origins must not contain other origins when "*" is specified`))
+	}
+
+	if slices.Contains(apiObject.AllowMethods, "*") && len(apiObject.AllowMethods) > 1 {
+		errs = append(errs, errors.New(`allow_methods must not contain other methods when "*" is specified`))
+	}
+
+	for _, origin := range apiObject.AllowOrigins {
+		if origin == "*" {
+			continue
+		}
+
+		// Browsers send the Origin header as <scheme>://<host>[:<port>], without a path.
+		if u, err := url.Parse(origin); err != nil || u.Scheme == "" || u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+			errs = append(errs, fmt.Errorf("allow_origins value (%s) must be \"*\" or an origin of the form <scheme>://<host>[:<port>]", origin))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -7,6 +7,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -164,3 +166,75 @@ func TestValidPolicyStatementID(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateFunctionURLCors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		cors    *awstypes.Cors
+		wantErr bool
+	}{
+		"nil": {},
+		"empty": {
+			cors: &awstypes.Cors{},
+		},
+		"wildcards": {
+			cors: &awstypes.Cors{
+				AllowMethods: []string{"*"},
+				AllowOrigins: []string{"*"},
+			},
+		},
+		"origins with credentials": {
+			cors: &awstypes.Cors{
+				AllowCredentials: aws.Bool(true),
+				AllowMethods:     []string{"GET", "POST"},
+				AllowOrigins:     []string{"https://example.com", "http://localhost:8080"},
+			},
+		},
+		"methods without origins": {
+			cors: &awstypes.Cors{
+				AllowMethods: []string{"GET"},
+			},
+			wantErr: true,
+		},
+		"wildcard origin with other origins": {
+			cors: &awstypes.Cors{
+				AllowOrigins: []string{"*", "https://example.com"},
+			},
+			wantErr: true,
+		},
+		"wildcard method with other methods": {
+			cors: &awstypes.Cors{
+				AllowMethods: []string{"*", "GET"},
+				AllowOrigins: []string{"*"},
+			},
+			wantErr: true,
+		},
+		"origin without scheme": {
+			cors: &awstypes.Cors{
+				AllowOrigins: []string{"example.com"},
+			},
+			wantErr: true,
+		},
+		"origin with path": {
+			cors: &awstypes.Cors{
+				AllowOrigins: []string{"https://example.com/app"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateFunctionURLCors(testCase.cors)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("validateFunctionURLCors() error = %v, wantErr %t", err, want)
+			}
+		})
+	}
+}
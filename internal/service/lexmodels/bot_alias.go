@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -146,7 +147,7 @@ func resourceBotAliasCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() *retry.RetryError {
 		output, err := conn.PutBotAliasWithContext(ctx, input)
 
 		input.Checksum = output.Checksum
@@ -240,7 +241,7 @@ func resourceBotAliasUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		input.ConversationLogs = conversationLogs
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
+	err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), func() *retry.RetryError {
 		_, err := conn.PutBotAliasWithContext(ctx, input)
 
 		// IAM eventual consistency
@@ -280,7 +281,7 @@ func resourceBotAliasDelete(ctx context.Context, d *schema.ResourceData, meta in
 		Name:    aws.String(botAliasName),
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *retry.RetryError {
+	err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() *retry.RetryError {
 		_, err := conn.DeleteBotAliasWithContext(ctx, input)
 
 		if tfawserr.ErrCodeEquals(err, lexmodelbuildingservice.ErrCodeConflictException) {
@@ -5,16 +5,24 @@ package licensemanager
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -28,18 +36,33 @@ func ResourceGrantAccepter() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantAccepterCreate,
 		ReadWithoutTimeout:   resourceGrantAccepterRead,
+		UpdateWithoutTimeout: resourceGrantAccepterUpdate,
 		DeleteWithoutTimeout: resourceGrantAccepterDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
+			"activation_override_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(licensemanager.ActivationOverrideBehavior_Values(), false),
+				Description:  "Activation option for a grant of a license sourced from AWS Marketplace.",
+			},
 			"allowed_operations": {
 				Type:     schema.TypeSet,
+				Optional: true,
 				Computed: true,
+				MinItems: 1,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(licensemanager.AllowedOperation_Values(), false),
 				},
 				Description: "Allowed operations for the grant.",
 			},
@@ -76,9 +99,11 @@ func ResourceGrantAccepter() *schema.Resource {
 				Description: "The grantee principal ARN.",
 			},
 			names.AttrStatus: {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Description: "GrantAccepter status.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(grantAccepterStatus_Values(), false),
+				Description:  "GrantAccepter status.",
 			},
 			names.AttrVersion: {
 				Type:        schema.TypeString,
@@ -106,6 +131,19 @@ func resourceGrantAccepterCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(aws.StringValue(out.GrantArn))
 
+	grant, err := waitGrantAccepterSettled(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionWaitingForCreation, ResGrantAccepter, d.Id(), err)
+	}
+
+	// An accepted grant must be activated before the grantee can use it.
+	if in := expandGrantAccepterVersion(d, grant); in != nil {
+		if err := createGrantAccepterVersion(ctx, conn, in, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionCreating, ResGrantAccepter, d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceGrantAccepterRead(ctx, d, meta)...)
 }
 
@@ -139,6 +177,28 @@ func resourceGrantAccepterRead(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func resourceGrantAccepterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	if d.HasChanges("activation_override_behavior", "allowed_operations", names.AttrStatus) {
+		grant, err := FindGrantAccepterByGrantARN(ctx, conn, d.Id())
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionUpdating, ResGrantAccepter, d.Id(), err)
+		}
+
+		if in := expandGrantAccepterVersion(d, grant); in != nil {
+			if err := createGrantAccepterVersion(ctx, conn, in, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+				return create.AppendDiagError(diags, names.LicenseManager, create.ErrActionUpdating, ResGrantAccepter, d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceGrantAccepterRead(ctx, d, meta)...)
+}
+
 func resourceGrantAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -158,6 +218,22 @@ func resourceGrantAccepterDelete(ctx context.Context, d *schema.ResourceData, me
 }
 
 func FindGrantAccepterByGrantARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
+	grant, err := findReceivedGrantByARN(ctx, conn, arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := aws.StringValue(grant.GrantStatus); status != licensemanager.GrantStatusActive && status != licensemanager.GrantStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message: status,
+		}
+	}
+
+	return grant, nil
+}
+
+func findReceivedGrantByARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.Grant, error) {
 	in := &licensemanager.ListReceivedGrantsInput{
 		GrantArns: aws.StringSlice([]string{arn}),
 	}
@@ -171,20 +247,120 @@ func FindGrantAccepterByGrantARN(ctx context.Context, conn *licensemanager.Licen
 		}
 	}
 
-	var entry *licensemanager.Grant
-	entryExists := false
+	if err != nil {
+		return nil, err
+	}
 
 	for _, grant := range out.Grants {
-		if arn == aws.StringValue(grant.GrantArn) && (licensemanager.GrantStatusActive == aws.StringValue(grant.GrantStatus) || licensemanager.GrantStatusDisabled == aws.StringValue(grant.GrantStatus)) {
-			entry = grant
-			entryExists = true
-			break
+		if arn == aws.StringValue(grant.GrantArn) {
+			return grant, nil
 		}
 	}
 
-	if !entryExists {
-		return nil, tfresource.NewEmptyResultError(in)
+	return nil, tfresource.NewEmptyResultError(in)
+}
+
+func statusGrantAccepter(ctx context.Context, conn *licensemanager.LicenseManager, arn string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReceivedGrantByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.GrantStatus), nil
+	}
+}
+
+// waitGrantAccepterSettled waits for any workflow on a received grant to complete.
+func waitGrantAccepterSettled(ctx context.Context, conn *licensemanager.LicenseManager, arn string, timeout time.Duration) (*licensemanager.Grant, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{licensemanager.GrantStatusPendingAccept, licensemanager.GrantStatusPendingWorkflow, licensemanager.GrantStatusWorkflowCompleted},
+		Target:  []string{licensemanager.GrantStatusActive, licensemanager.GrantStatusDisabled},
+		Refresh: statusGrantAccepter(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.Grant); ok {
+		tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// expandGrantAccepterVersion returns the input for a new version of the received grant,
+// or nil if the grant already matches the configuration.
+func expandGrantAccepterVersion(d *schema.ResourceData, grant *licensemanager.Grant) *licensemanager.CreateGrantVersionInput {
+	in := &licensemanager.CreateGrantVersionInput{
+		ClientToken:   aws.String(id.UniqueId()),
+		GrantArn:      grant.GrantArn,
+		SourceVersion: grant.Version,
+	}
+	update := false
+
+	if v, ok := d.GetOk("allowed_operations"); ok && d.HasChange("allowed_operations") {
+		operations := itypes.Set[string](flex.ExpandStringValueSet(v.(*schema.Set)))
+		granted := itypes.Set[string](aws.StringValueSlice(grant.GrantedOperations))
+
+		if len(operations.Difference(granted)) > 0 || len(granted.Difference(operations)) > 0 {
+			in.AllowedOperations = aws.StringSlice(operations)
+			update = true
+		}
+	}
+
+	status := aws.StringValue(grant.GrantStatus)
+	if v, ok := d.GetOk(names.AttrStatus); ok && d.HasChange(names.AttrStatus) {
+		status = v.(string)
 	}
 
-	return entry, nil
+	if status != aws.StringValue(grant.GrantStatus) || update || d.HasChange("activation_override_behavior") {
+		in.Status = aws.String(status)
+		update = true
+	}
+
+	if !update {
+		return nil
+	}
+
+	// Activation options can only be specified when activating a grant.
+	if v, ok := d.GetOk("activation_override_behavior"); ok && status == licensemanager.GrantStatusActive {
+		in.Options = &licensemanager.Options{
+			ActivationOverrideBehavior: aws.String(v.(string)),
+		}
+	}
+
+	return in
+}
+
+func createGrantAccepterVersion(ctx context.Context, conn *licensemanager.LicenseManager, in *licensemanager.CreateGrantVersionInput, timeout time.Duration) error {
+	if _, err := conn.CreateGrantVersionWithContext(ctx, in); err != nil {
+		return err
+	}
+
+	grant, err := waitGrantAccepterSettled(ctx, conn, aws.StringValue(in.GrantArn), timeout)
+
+	if err != nil {
+		return err
+	}
+
+	if in.Status != nil && aws.StringValue(grant.GrantStatus) != aws.StringValue(in.Status) {
+		return fmt.Errorf("unexpected status %s: %s", aws.StringValue(grant.GrantStatus), aws.StringValue(grant.StatusReason))
+	}
+
+	return nil
+}
+
+func grantAccepterStatus_Values() []string {
+	return []string{
+		licensemanager.GrantStatusActive,
+		licensemanager.GrantStatusDisabled,
+	}
 }
@@ -95,6 +95,51 @@ func testAccGrantAccepter_disappears(t *testing.T) {
 	})
 }
 
+func testAccGrantAccepter_status(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	licenseARN := envvar.SkipIfEmpty(t, licenseARNKey, envVarLicenseARNKeyError)
+	principal := envvar.SkipIfEmpty(t, principalKey, envVarPrincipalKeyError)
+	homeRegion := envvar.SkipIfEmpty(t, homeRegionKey, envVarHomeRegionError)
+	resourceName := "aws_licensemanager_grant_accepter.test"
+
+	providers := make(map[string]*schema.Provider)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesNamedAlternate(ctx, t, providers),
+		CheckDestroy:             acctest.CheckWithNamedProviders(testAccCheckGrantAccepterDestroyWithProvider(ctx), providers),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantAccepterConfig_status(licenseARN, rName, principal, homeRegion, "ACTIVE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, "allowed_operations.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "allowed_operations.*", "CheckoutLicense"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ACTIVE"),
+				),
+			},
+			{
+				Config:                  testAccGrantAccepterConfig_status(licenseARN, rName, principal, homeRegion, "ACTIVE"),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"activation_override_behavior"},
+			},
+			{
+				Config: testAccGrantAccepterConfig_status(licenseARN, rName, principal, homeRegion, "DISABLED"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckGrantAccepterExists(ctx, resourceName, acctest.NamedProviderFunc(acctest.ProviderName, providers)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGrantAccepterExists(ctx context.Context, n string, providerF func() *schema.Provider) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -189,3 +234,47 @@ resource "aws_licensemanager_grant" "test" {
 `, licenseARN, rName, principal),
 	)
 }
+
+func testAccGrantAccepterConfig_status(licenseARN, rName, principal, homeRegion, status string) string {
+	principalArn, _ := arn.Parse(principal)
+	roleARN := arn.ARN{
+		Partition: principalArn.Partition,
+		Service:   "iam",
+		AccountID: principalArn.AccountID,
+		Resource:  "role/OrganizationAccountAccessRole",
+	}
+	return acctest.ConfigCompose(
+		acctest.ConfigNamedRegionalProvider(acctest.ProviderNameAlternate, homeRegion),
+		fmt.Sprintf(`
+provider %[1]q {
+	assume_role {
+		role_arn = %[2]q
+	}
+}`, acctest.ProviderName, roleARN),
+		fmt.Sprintf(`
+resource "aws_licensemanager_grant_accepter" "test" {
+  grant_arn          = aws_licensemanager_grant.test.arn
+  allowed_operations = ["CheckoutLicense"]
+  status             = %[4]q
+}
+
+data "aws_licensemanager_received_license" "test" {
+  provider    = awsalternate
+  license_arn = %[1]q
+}
+
+locals {
+  allowed_operations = [for i in data.aws_licensemanager_received_license.test.received_metadata[0].allowed_operations : i if i != "CreateGrant"]
+}
+
+resource "aws_licensemanager_grant" "test" {
+  provider = awsalternate
+
+  name               = %[2]q
+  allowed_operations = local.allowed_operations
+  license_arn        = data.aws_licensemanager_received_license.test.license_arn
+  principal          = %[3]q
+}
+`, licenseARN, rName, principal, status),
+	)
+}
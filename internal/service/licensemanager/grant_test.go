@@ -44,6 +44,7 @@ func TestAccLicenseManagerGrant_serial(t *testing.T) {
 		"grant_accepter": {
 			acctest.CtBasic:      testAccGrantAccepter_basic,
 			acctest.CtDisappears: testAccGrantAccepter_disappears,
+			names.AttrStatus:     testAccGrantAccepter_status,
 		},
 		"grant_data_source": {
 			acctest.CtBasic: testAccGrantsDataSource_basic,
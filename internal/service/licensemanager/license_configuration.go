@@ -6,6 +6,7 @@ package licensemanager
 import (
 	"context"
 	"log"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -42,10 +43,19 @@ func ResourceLicenseConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"automated_discovery_last_run_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"disassociate_when_not_found": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"license_count": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -78,6 +88,42 @@ func ResourceLicenseConfiguration() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"product_information": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_information_filter": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"comparator": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(productInformationFilterComparator_Values(), false),
+									},
+									names.AttrName: {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									names.AttrValues: {
+										Type:     schema.TypeSet,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrResourceType: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(productInformationResourceType_Values(), false),
+						},
+					},
+				},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -102,6 +148,10 @@ func resourceLicenseConfigurationCreate(ctx context.Context, d *schema.ResourceD
 		input.Description = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("disassociate_when_not_found"); ok {
+		input.DisassociateWhenNotFound = aws.Bool(v.(bool))
+	}
+
 	if v, ok := d.GetOk("license_count"); ok {
 		input.LicenseCount = aws.Int64(int64(v.(int)))
 	}
@@ -114,6 +164,10 @@ func resourceLicenseConfigurationCreate(ctx context.Context, d *schema.ResourceD
 		input.LicenseRules = flex.ExpandStringList(v.([]interface{}))
 	}
 
+	if v, ok := d.GetOk("product_information"); ok && len(v.([]interface{})) > 0 {
+		input.ProductInformationList = expandProductInformations(v.([]interface{}))
+	}
+
 	output, err := conn.CreateLicenseConfigurationWithContext(ctx, input)
 
 	if err != nil {
@@ -143,13 +197,22 @@ func resourceLicenseConfigurationRead(ctx context.Context, d *schema.ResourceDat
 	}
 
 	d.Set(names.AttrARN, output.LicenseConfigurationArn)
+	if output.AutomatedDiscoveryInformation != nil && output.AutomatedDiscoveryInformation.LastRunTime != nil {
+		d.Set("automated_discovery_last_run_time", aws.TimeValue(output.AutomatedDiscoveryInformation.LastRunTime).Format(time.RFC3339))
+	} else {
+		d.Set("automated_discovery_last_run_time", nil)
+	}
 	d.Set(names.AttrDescription, output.Description)
+	d.Set("disassociate_when_not_found", output.DisassociateWhenNotFound)
 	d.Set("license_count", output.LicenseCount)
 	d.Set("license_count_hard_limit", output.LicenseCountHardLimit)
 	d.Set("license_counting_type", output.LicenseCountingType)
 	d.Set("license_rules", aws.StringValueSlice(output.LicenseRules))
 	d.Set(names.AttrName, output.Name)
 	d.Set(names.AttrOwnerAccountID, output.OwnerAccountId)
+	if err := d.Set("product_information", flattenProductInformations(output.ProductInformationList)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting product_information: %s", err)
+	}
 
 	setTagsOut(ctx, output.Tags)
 
@@ -163,16 +226,22 @@ func resourceLicenseConfigurationUpdate(ctx context.Context, d *schema.ResourceD
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
 		input := &licensemanager.UpdateLicenseConfigurationInput{
-			Description:             aws.String(d.Get(names.AttrDescription).(string)),
-			LicenseConfigurationArn: aws.String(d.Id()),
-			LicenseCountHardLimit:   aws.Bool(d.Get("license_count_hard_limit").(bool)),
-			Name:                    aws.String(d.Get(names.AttrName).(string)),
+			Description:              aws.String(d.Get(names.AttrDescription).(string)),
+			DisassociateWhenNotFound: aws.Bool(d.Get("disassociate_when_not_found").(bool)),
+			LicenseConfigurationArn:  aws.String(d.Id()),
+			LicenseCountHardLimit:    aws.Bool(d.Get("license_count_hard_limit").(bool)),
+			Name:                     aws.String(d.Get(names.AttrName).(string)),
 		}
 
 		if v, ok := d.GetOk("license_count"); ok {
 			input.LicenseCount = aws.Int64(int64(v.(int)))
 		}
 
+		if d.HasChange("product_information") {
+			// An empty list removes all automated discovery rules.
+			input.ProductInformationList = expandProductInformations(d.Get("product_information").([]interface{}))
+		}
+
 		_, err := conn.UpdateLicenseConfigurationWithContext(ctx, input)
 
 		if err != nil {
@@ -228,3 +297,99 @@ func FindLicenseConfigurationByARN(ctx context.Context, conn *licensemanager.Lic
 
 	return output, nil
 }
+
+func expandProductInformations(tfList []interface{}) []*licensemanager.ProductInformation {
+	apiObjects := make([]*licensemanager.ProductInformation, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &licensemanager.ProductInformation{
+			ResourceType: aws.String(tfMap[names.AttrResourceType].(string)),
+		}
+
+		if v, ok := tfMap["product_information_filter"].([]interface{}); ok {
+			apiObject.ProductInformationFilterList = expandProductInformationFilters(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandProductInformationFilters(tfList []interface{}) []*licensemanager.ProductInformationFilter {
+	var apiObjects []*licensemanager.ProductInformationFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := &licensemanager.ProductInformationFilter{
+			ProductInformationFilterComparator: aws.String(tfMap["comparator"].(string)),
+			ProductInformationFilterName:       aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap[names.AttrValues].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ProductInformationFilterValue = flex.ExpandStringSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenProductInformations(apiObjects []*licensemanager.ProductInformation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"product_information_filter": flattenProductInformationFilters(apiObject.ProductInformationFilterList),
+			names.AttrResourceType:       aws.StringValue(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
+
+func flattenProductInformationFilters(apiObjects []*licensemanager.ProductInformationFilter) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"comparator":     aws.StringValue(apiObject.ProductInformationFilterComparator),
+			names.AttrName:   aws.StringValue(apiObject.ProductInformationFilterName),
+			names.AttrValues: aws.StringValueSlice(apiObject.ProductInformationFilterValue),
+		})
+	}
+
+	return tfList
+}
+
+func productInformationFilterComparator_Values() []string {
+	return []string{
+		"EQUALS",
+		"NOT_EQUALS",
+	}
+}
+
+func productInformationResourceType_Values() []string {
+	return []string{
+		"RDS",
+		"SSM_MANAGED",
+	}
+}
@@ -190,6 +190,58 @@ func TestAccLicenseManagerLicenseConfiguration_update(t *testing.T) {
 	})
 }
 
+func TestAccLicenseManagerLicenseConfiguration_productInformation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var licenseConfiguration licensemanager.GetLicenseConfigurationOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_license_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLicenseConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLicenseConfigurationConfig_productInformation(rName, "Application Name", "Microsoft SQL Server"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "product_information.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "product_information.0.resource_type", "SSM_MANAGED"),
+					resource.TestCheckResourceAttr(resourceName, "product_information.0.product_information_filter.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "product_information.0.product_information_filter.0.comparator", "EQUALS"),
+					resource.TestCheckResourceAttr(resourceName, "product_information.0.product_information_filter.0.name", "Application Name"),
+					resource.TestCheckResourceAttr(resourceName, "product_information.0.product_information_filter.0.values.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "product_information.0.product_information_filter.0.values.*", "Microsoft SQL Server"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLicenseConfigurationConfig_productInformation(rName, "Application Publisher", "Microsoft Corporation"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
+					resource.TestCheckResourceAttr(resourceName, "product_information.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "product_information.0.product_information_filter.0.name", "Application Publisher"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "product_information.0.product_information_filter.0.values.*", "Microsoft Corporation"),
+				),
+			},
+			{
+				Config: testAccLicenseConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLicenseConfigurationExists(ctx, resourceName, &licenseConfiguration),
+					resource.TestCheckResourceAttr(resourceName, "disassociate_when_not_found", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "product_information.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLicenseConfigurationExists(ctx context.Context, n string, v *licensemanager.GetLicenseConfigurationOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -307,3 +359,23 @@ resource "aws_licensemanager_license_configuration" "test" {
 }
 `, rName)
 }
+
+func testAccLicenseConfigurationConfig_productInformation(rName, filterName, filterValue string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_configuration" "test" {
+  name                        = %[1]q
+  license_counting_type       = "vCPU"
+  disassociate_when_not_found = true
+
+  product_information {
+    resource_type = "SSM_MANAGED"
+
+    product_information_filter {
+      name       = %[2]q
+      comparator = "EQUALS"
+      values     = [%[3]q]
+    }
+  }
+}
+`, rName, filterName, filterValue)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -204,7 +205,7 @@ func resourceContainerServiceDeploymentVersionCreate(ctx context.Context, d *sch
 
 	d.SetId(fmt.Sprintf("%s/%d", serviceName, version))
 
-	if err := waitContainerServiceDeploymentVersionActive(ctx, conn, serviceName, version, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err := waitContainerServiceDeploymentVersionActive(ctx, conn, serviceName, version, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Lightsail Container Service (%s) Deployment Version (%d): %s", serviceName, version, err)
 	}
 
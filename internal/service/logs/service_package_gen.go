@@ -68,6 +68,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			SkipDestroy: true,
 		},
 		{
 			Factory:  resourceMetricFilter,
@@ -7,7 +7,7 @@ import (
 	"fmt"
 
 	"github.com/YakDriver/regexache"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 )
 
 func validResourcePolicyDocument(v interface{}, k string) (ws []string, errors []error) {
@@ -16,9 +16,8 @@ func validResourcePolicyDocument(v interface{}, k string) (ws []string, errors [
 	if len(value) > 5120 || (len(value) == 0) {
 		errors = append(errors, fmt.Errorf("CloudWatch log resource policy document must be between 1 and 5120 characters."))
 	}
-	if _, err := structure.NormalizeJsonString(v); err != nil {
-		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
-	}
+	_, es := sdkv2.ValidIAMResourcePolicyDocument(v, k)
+	errors = append(errors, es...)
 	return
 }
 
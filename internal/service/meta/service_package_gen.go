@@ -38,6 +38,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourceService,
 		},
+		{
+			Factory: newDataSourceServicePrincipal,
+			Name:    "Service Principal",
+		},
 	}
 }
 
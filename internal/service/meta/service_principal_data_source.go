@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Service Principal")
+func newDataSourceServicePrincipal(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceServicePrincipal{}
+
+	return d, nil
+}
+
+type dataSourceServicePrincipal struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceServicePrincipal) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_service_principal"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceServicePrincipal) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"partition": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+			"regional_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrServiceName: schema.StringAttribute{
+				Required: true,
+			},
+			"suffix": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceServicePrincipal) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceServicePrincipalData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	region := d.Meta().Region
+	if !data.Region.IsNull() {
+		region = data.Region.ValueString()
+	}
+
+	if region == "" {
+		response.Diagnostics.AddError("Region not provided directly or through the provider configuration", "")
+
+		return
+	}
+
+	serviceName := data.ServiceName.ValueString()
+	partition := names.PartitionForRegion(region)
+	suffix := names.ServicePrincipalSuffixForPartition(serviceName, partition)
+
+	data.ID = types.StringValue(fmt.Sprintf("%s.%s.%s", serviceName, region, suffix))
+	data.Name = types.StringValue(names.ServicePrincipalNameForPartition(serviceName, partition))
+	data.Partition = types.StringValue(partition)
+	data.Region = types.StringValue(region)
+	data.RegionalName = types.StringValue(names.RegionalServicePrincipalName(serviceName, region))
+	data.Suffix = types.StringValue(suffix)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceServicePrincipalData struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Partition    types.String `tfsdk:"partition"`
+	Region       types.String `tfsdk:"region"`
+	RegionalName types.String `tfsdk:"regional_name"`
+	ServiceName  types.String `tfsdk:"service_name"`
+	Suffix       types.String `tfsdk:"suffix"`
+}
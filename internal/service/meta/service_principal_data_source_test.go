@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaServicePrincipal_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalDataSourceConfig_basic("s3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, fmt.Sprintf("s3.%s.%s", acctest.Region(), names.ServicePrincipalSuffixForPartition("s3", acctest.Partition()))),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, "s3."+names.ServicePrincipalSuffixForPartition("s3", acctest.Partition())),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrServiceName, "s3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaServicePrincipal_regionChina(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalDataSourceConfig_region("logs", names.CNNorth1RegionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, "logs.cn-north-1.amazonaws.com.cn"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, "logs.amazonaws.com.cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", names.ChinaPartitionID),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, names.CNNorth1RegionID),
+					resource.TestCheckResourceAttr(dataSourceName, "regional_name", "logs.cn-north-1.amazonaws.com.cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", "amazonaws.com.cn"),
+				),
+			},
+			{
+				Config: testAccServicePrincipalDataSourceConfig_region("s3", names.CNNorth1RegionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, "s3.amazonaws.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "regional_name", "s3.cn-north-1.amazonaws.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "suffix", "amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaServicePrincipal_regionGovCloud(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_principal.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServicePrincipalDataSourceConfig_region("logs", names.USGovWest1RegionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, "logs.amazonaws.com"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", names.USGovCloudPartitionID),
+					resource.TestCheckResourceAttr(dataSourceName, "regional_name", "logs.us-gov-west-1.amazonaws.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServicePrincipalDataSourceConfig_basic(serviceName string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+}
+`, serviceName)
+}
+
+func testAccServicePrincipalDataSourceConfig_region(serviceName, region string) string {
+	return fmt.Sprintf(`
+data "aws_service_principal" "test" {
+  service_name = %[1]q
+  region       = %[2]q
+}
+`, serviceName, region)
+}
@@ -10,7 +10,6 @@ import (
 	"fmt"
 	"log"
 	"reflect"
-	"strconv"
 	"strings"
 	"time"
 
@@ -30,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -40,6 +40,7 @@ import (
 
 // @SDKResource("aws_mq_broker", name="Broker")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_mq_brokers", listOp="ListBrokers", listOpOutputElem="BrokerSummaries", nameElem="BrokerName", arnElem="BrokerArn", taggingResourceType="mq:broker")
 func resourceBroker() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBrokerCreate,
@@ -164,8 +165,9 @@ func resourceBroker() *schema.Resource {
 				ValidateDiagFunc: enum.ValidateIgnoreCase[types.EngineType](),
 			},
 			names.AttrEngineVersion: {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: sdkv2.SuppressEquivalentEngineVersions,
 			},
 			"host_instance_type": {
 				Type:     schema.TypeString,
@@ -375,6 +377,7 @@ func resourceBroker() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			sdkv2.ForceNewIfEngineVersionDowngrade(names.AttrEngineVersion),
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if strings.EqualFold(diff.Get("engine_type").(string), string(types.EngineTypeRabbitmq)) {
 					if v, ok := diff.GetOk("logs.0.audit"); ok {
@@ -455,7 +458,7 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	d.SetId(aws.ToString(output.BrokerId))
 	d.Set(names.AttrARN, output.BrokerArn)
 
-	if _, err := waitBrokerCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitBrokerCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) create: %s", d.Id(), err)
 	}
 
@@ -654,7 +657,7 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "rebooting MQ Broker (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitBrokerRebooted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitBrokerRebooted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) reboot: %s", d.Id(), err)
 		}
 	}
@@ -680,7 +683,7 @@ func resourceBrokerDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "deleting MQ Broker (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitBrokerDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitBrokerDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for MQ Broker (%s) delete: %s", d.Id(), err)
 	}
 
@@ -1144,7 +1147,7 @@ func flattenLogs(logs *types.LogsSummary) []interface{} {
 	}
 
 	if logs.Audit != nil {
-		m["audit"] = strconv.FormatBool(aws.ToBool(logs.Audit))
+		m["audit"] = nullable.FlattenBool(logs.Audit)
 	}
 
 	return []interface{}{m}
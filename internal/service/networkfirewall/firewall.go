@@ -5,6 +5,7 @@ package networkfirewall
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -20,6 +21,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -28,6 +31,7 @@ import (
 
 // @SDKResource("aws_networkfirewall_firewall", name="Firewall")
 // @Tags(identifierAttribute="id")
+// @PluralDataSource("aws_networkfirewall_firewalls", listOp="ListFirewalls", listOpOutputElem="Firewalls", nameElem="FirewallName", arnElem="FirewallArn", taggingResourceType="network-firewall:firewall")
 func resourceFirewall() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFirewallCreate,
@@ -197,7 +201,7 @@ func resourceFirewallCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.ToString(output.Firewall.FirewallArn))
 
-	if _, err := waitFirewallCreated(ctx, conn, d.Timeout(schema.TimeoutCreate), d.Id()); err != nil {
+	if _, err := waitFirewallCreated(ctx, conn, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) create: %s", d.Id(), err)
 	}
 
@@ -354,52 +358,139 @@ func resourceFirewallUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		o, n := d.GetChange("subnet_mapping")
 		subnetsToRemove, subnetsToAdd := subnetMappingsDiff(o.(*schema.Set), n.(*schema.Set))
 
-		if len(subnetsToAdd) > 0 {
-			input := &networkfirewall.AssociateSubnetsInput{
-				FirewallArn:    aws.String(d.Id()),
-				SubnetMappings: subnetsToAdd,
-				UpdateToken:    aws.String(updateToken),
-			}
+		// A firewall can only have one subnet per Availability Zone, so subnets being replaced
+		// in the same Availability Zone are disassociated before the new subnets are associated.
+		// The remaining subnets are only disassociated once the new subnets are in sync.
+		subnetsToReplace, subnetsToRemove, err := partitionSubnetsToRemove(ctx, meta.(*conns.AWSClient), d.Get("firewall_status").([]interface{}), subnetsToRemove, subnetsToAdd)
 
-			_, err := conn.AssociateSubnets(ctx, input)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
+		}
 
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "associating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
-			}
+		updateToken, err = disassociateFirewallSubnets(ctx, conn, d.Id(), updateToken, subnetsToReplace, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
 
-			output, err := waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
-			}
+		updateToken, err = associateFirewallSubnets(ctx, conn, d.Id(), updateToken, subnetsToAdd, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
 
-			updateToken = aws.ToString(output.UpdateToken)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
 		}
 
-		if len(subnetsToRemove) > 0 {
-			input := &networkfirewall.DisassociateSubnetsInput{
-				FirewallArn: aws.String(d.Id()),
-				SubnetIds:   subnetsToRemove,
-				UpdateToken: aws.String(updateToken),
-			}
+		if _, err := disassociateFirewallSubnets(ctx, conn, d.Id(), updateToken, subnetsToRemove, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
 
-			_, err := conn.DisassociateSubnets(ctx, input)
+	return append(diags, resourceFirewallRead(ctx, d, meta)...)
+}
 
-			if err == nil {
-				/*output*/ _, err := waitFirewallUpdated(ctx, conn, d.Timeout(schema.TimeoutUpdate), d.Id())
+func associateFirewallSubnets(ctx context.Context, conn *networkfirewall.Client, arn, updateToken string, subnetMappings []awstypes.SubnetMapping, timeout time.Duration) (string, error) {
+	if len(subnetMappings) == 0 {
+		return updateToken, nil
+	}
 
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) update: %s", d.Id(), err)
-				}
+	input := &networkfirewall.AssociateSubnetsInput{
+		FirewallArn:    aws.String(arn),
+		SubnetMappings: subnetMappings,
+		UpdateToken:    aws.String(updateToken),
+	}
+
+	_, err := conn.AssociateSubnets(ctx, input)
+
+	if err != nil {
+		return "", fmt.Errorf("associating NetworkFirewall Firewall (%s) subnets: %w", arn, err)
+	}
+
+	output, err := waitFirewallSubnetsSynced(ctx, conn, timeout, arn, tfslices.ApplyToAll(subnetMappings, func(v awstypes.SubnetMapping) string {
+		return aws.ToString(v.SubnetId)
+	}), nil)
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for NetworkFirewall Firewall (%s) subnets associate: %w", arn, err)
+	}
+
+	return aws.ToString(output.UpdateToken), nil
+}
+
+func disassociateFirewallSubnets(ctx context.Context, conn *networkfirewall.Client, arn, updateToken string, subnetIDs []string, timeout time.Duration) (string, error) {
+	if len(subnetIDs) == 0 {
+		return updateToken, nil
+	}
+
+	input := &networkfirewall.DisassociateSubnetsInput{
+		FirewallArn: aws.String(arn),
+		SubnetIds:   subnetIDs,
+		UpdateToken: aws.String(updateToken),
+	}
+
+	_, err := conn.DisassociateSubnets(ctx, input)
+
+	if errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "inaccessible") {
+		return updateToken, nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("disassociating NetworkFirewall Firewall (%s) subnets: %w", arn, err)
+	}
+
+	output, err := waitFirewallSubnetsSynced(ctx, conn, timeout, arn, nil, subnetIDs)
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for NetworkFirewall Firewall (%s) subnets disassociate: %w", arn, err)
+	}
+
+	return aws.ToString(output.UpdateToken), nil
+}
+
+// partitionSubnetsToRemove splits the IDs of the subnets to remove from a firewall into those in an
+// Availability Zone which a subnet being added also uses and the remainder.
+// The Availability Zones of the current subnets are taken from the firewall's sync states.
+func partitionSubnetsToRemove(ctx context.Context, client *conns.AWSClient, firewallStatus []interface{}, subnetsToRemove []string, subnetsToAdd []awstypes.SubnetMapping) ([]string, []string, error) {
+	if len(subnetsToRemove) == 0 || len(subnetsToAdd) == 0 {
+		return nil, subnetsToRemove, nil
+	}
+
+	subnetAZs := make(map[string]string)
 
-				// updateToken = aws.ToString(output.UpdateToken)
-			} else if !errs.IsAErrorMessageContains[*awstypes.InvalidRequestException](err, "inaccessible") {
-				return sdkdiag.AppendErrorf(diags, "disassociating NetworkFirewall Firewall (%s) subnets: %s", d.Id(), err)
+	if len(firewallStatus) > 0 && firewallStatus[0] != nil {
+		for _, tfMapRaw := range firewallStatus[0].(map[string]interface{})["sync_states"].(*schema.Set).List() {
+			tfMap := tfMapRaw.(map[string]interface{})
+
+			for _, v := range tfMap["attachment"].([]interface{}) {
+				if v, ok := v.(map[string]interface{}); ok {
+					subnetAZs[v[names.AttrSubnetID].(string)] = tfMap[names.AttrAvailabilityZone].(string)
+				}
 			}
 		}
 	}
 
-	return append(diags, resourceFirewallRead(ctx, d, meta)...)
+	azsToAdd := make(map[string]struct{})
+	conn := client.EC2Conn(ctx)
+
+	for _, v := range subnetsToAdd {
+		subnet, err := tfec2.FindSubnetByID(ctx, conn, aws.ToString(v.SubnetId))
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("reading EC2 Subnet (%s): %w", aws.ToString(v.SubnetId), err)
+		}
+
+		azsToAdd[aws.ToString(subnet.AvailabilityZone)] = struct{}{}
+	}
+
+	var replace, remove []string
+
+	for _, id := range subnetsToRemove {
+		if _, ok := azsToAdd[subnetAZs[id]]; ok {
+			replace = append(replace, id)
+		} else {
+			remove = append(remove, id)
+		}
+	}
+
+	return replace, remove, nil
 }
 
 func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -419,7 +510,7 @@ func resourceFirewallDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "deleting NetworkFirewall Firewall (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitFirewallDeleted(ctx, conn, d.Timeout(schema.TimeoutDelete), d.Id()); err != nil {
+	if _, err := waitFirewallDeleted(ctx, conn, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), d.Id()); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for NetworkFirewall Firewall (%s) delete: %s", d.Id(), err)
 	}
 
@@ -488,16 +579,66 @@ func waitFirewallCreated(ctx context.Context, conn *networkfirewall.Client, time
 	return nil, err
 }
 
-func waitFirewallUpdated(ctx context.Context, conn *networkfirewall.Client, timeout time.Duration, arn string) (*networkfirewall.DescribeFirewallOutput, error) {
+// statusFirewallSubnets returns READY once the firewall is ready, every attachment is ready,
+// the specified subnets are attached and the specified subnets are no longer attached.
+func statusFirewallSubnets(ctx context.Context, conn *networkfirewall.Client, arn string, associated, disassociated []string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFirewallByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if status := output.FirewallStatus.Status; status != awstypes.FirewallStatusValueReady {
+			return output, string(status), nil
+		}
+
+		attached := make(map[string]struct{})
+
+		for az, v := range output.FirewallStatus.SyncStates {
+			attachment := v.Attachment
+
+			if attachment == nil {
+				continue
+			}
+
+			switch status := attachment.Status; status {
+			case awstypes.AttachmentStatusFailed, awstypes.AttachmentStatusError:
+				return output, string(status), fmt.Errorf("subnet (%s) in Availability Zone (%s): %s", aws.ToString(attachment.SubnetId), az, aws.ToString(attachment.StatusMessage))
+			case awstypes.AttachmentStatusReady:
+				attached[aws.ToString(attachment.SubnetId)] = struct{}{}
+			default:
+				return output, string(status), nil
+			}
+		}
+
+		for _, id := range associated {
+			if _, ok := attached[id]; !ok {
+				return output, string(awstypes.AttachmentStatusCreating), nil
+			}
+		}
+
+		for _, id := range disassociated {
+			if _, ok := attached[id]; ok {
+				return output, string(awstypes.AttachmentStatusDeleting), nil
+			}
+		}
+
+		return output, string(awstypes.AttachmentStatusReady), nil
+	}
+}
+
+// waitFirewallSubnetsSynced waits for subnet associations and disassociations to be reflected in the firewall's sync states.
+func waitFirewallSubnetsSynced(ctx context.Context, conn *networkfirewall.Client, timeout time.Duration, arn string, associated, disassociated []string) (*networkfirewall.DescribeFirewallOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(awstypes.FirewallStatusValueProvisioning),
-		Target:  enum.Slice(awstypes.FirewallStatusValueReady),
-		Refresh: statusFirewall(ctx, conn, arn),
+		Pending: append(enum.Slice(awstypes.FirewallStatusValueProvisioning), enum.Slice(awstypes.AttachmentStatusCreating, awstypes.AttachmentStatusDeleting, awstypes.AttachmentStatusScaling)...),
+		Target:  enum.Slice(awstypes.AttachmentStatusReady),
+		Refresh: statusFirewallSubnets(ctx, conn, arn, associated, disassociated),
 		Timeout: timeout,
-		// Delay added to account for Associate/DisassociateSubnet calls that return
-		// a READY status immediately after the method is called instead of immediately
-		// returning PROVISIONING.
-		Delay: 30 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
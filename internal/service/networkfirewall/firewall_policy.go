@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_networkfirewall_firewall_policy", name="Firewall Policy")
 // @Tags(identifierAttribute="id")
+// @PluralDataSource("aws_networkfirewall_firewall_policies", listOp="ListFirewallPolicies", listOpOutputElem="FirewallPolicies", nameElem="Name", arnElem="Arn", taggingResourceType="network-firewall:firewall-policy")
 func resourceFirewallPolicy() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFirewallPolicyCreate,
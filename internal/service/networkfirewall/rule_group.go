@@ -30,6 +30,7 @@ import (
 
 // @SDKResource("aws_networkfirewall_rule_group", name="Rule Group")
 // @Tags(identifierAttribute="id")
+// @PluralDataSource("aws_networkfirewall_rule_groups", listOp="ListRuleGroups", listOpOutputElem="RuleGroups", nameElem="Name", arnElem="Arn", taggingResourceType="network-firewall")
 func resourceRuleGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRuleGroupCreate,
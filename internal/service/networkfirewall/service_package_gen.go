@@ -15,7 +15,20 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newFirewallPoliciesDataSource,
+			Name:    "Firewall Policies",
+		},
+		{
+			Factory: newFirewallsDataSource,
+			Name:    "Firewalls",
+		},
+		{
+			Factory: newRuleGroupsDataSource,
+			Name:    "Rule Groups",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
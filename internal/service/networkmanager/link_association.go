@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -81,7 +82,7 @@ func resourceLinkAssociationCreate(ctx context.Context, d *schema.ResourceData,
 
 	d.SetId(id)
 
-	if _, err := waitLinkAssociationCreated(ctx, conn, globalNetworkID, linkID, deviceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitLinkAssociationCreated(ctx, conn, globalNetworkID, linkID, deviceID, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Link Association (%s) create: %s", d.Id(), err)
 	}
 
@@ -144,7 +145,7 @@ func resourceLinkAssociationDelete(ctx context.Context, d *schema.ResourceData,
 		return sdkdiag.AppendErrorf(diags, "deleting Network Manager Link Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitLinkAssociationDeleted(ctx, conn, globalNetworkID, linkID, deviceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitLinkAssociationDeleted(ctx, conn, globalNetworkID, linkID, deviceID, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Network Manager Link Association (%s) delete: %s", d.Id(), err)
 	}
 
@@ -5,7 +5,9 @@ package opensearch
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/opensearchservice"
@@ -16,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -32,11 +35,54 @@ func ResourcePackage() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: customizeDiffPackageVersion,
+
 		Schema: map[string]*schema.Schema{
 			"available_package_version": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"available_plugin_properties": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"class_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"uncompressed_size_in_bytes": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"commit_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrEngineVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"package_description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -54,19 +100,16 @@ func ResourcePackage() *schema.Resource {
 			"package_source": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrS3BucketName: {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 						"s3_key": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -77,6 +120,26 @@ func ResourcePackage() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(opensearchservice.PackageType_Values(), false),
 			},
+			"package_version_history": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"commit_message": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"package_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -104,6 +167,10 @@ func resourcePackageCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.PackageDetails.PackageID))
 
+	if _, err := waitPackageAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) create: %s", d.Id(), err)
+	}
+
 	return append(diags, resourcePackageRead(ctx, d, meta)...)
 }
 
@@ -124,11 +191,25 @@ func resourcePackageRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.Set("available_package_version", pkg.AvailablePackageVersion)
+	if err := d.Set("available_plugin_properties", flattenPluginProperties(pkg.AvailablePluginProperties)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting available_plugin_properties: %s", err)
+	}
+	d.Set(names.AttrEngineVersion, pkg.EngineVersion)
 	d.Set("package_description", pkg.PackageDescription)
 	d.Set("package_id", pkg.PackageID)
 	d.Set("package_name", pkg.PackageName)
 	d.Set("package_type", pkg.PackageType)
 
+	history, err := findPackageVersionHistoryByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading OpenSearch Package (%s) version history: %s", d.Id(), err)
+	}
+
+	if err := d.Set("package_version_history", flattenPackageVersionHistories(history)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting package_version_history: %s", err)
+	}
+
 	return diags
 }
 
@@ -142,12 +223,20 @@ func resourcePackageUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		PackageSource:      expandPackageSource(d.Get("package_source").([]interface{})[0].(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("commit_message"); ok {
+		input.CommitMessage = aws.String(v.(string))
+	}
+
 	_, err := conn.UpdatePackageWithContext(ctx, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package (%s): %s", d.Id(), err)
 	}
 
+	if _, err := waitPackageAvailable(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package (%s) update: %s", d.Id(), err)
+	}
+
 	return append(diags, resourcePackageRead(ctx, d, meta)...)
 }
 
@@ -225,6 +314,96 @@ func findPackages(ctx context.Context, conn *opensearchservice.OpenSearchService
 	return output, nil
 }
 
+// customizeDiffPackageVersion marks the package's version attributes as changing when a new version is vended from S3,
+// so that dependent package associations are updated in the same apply.
+func customizeDiffPackageVersion(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("package_source") {
+		return nil
+	}
+
+	if err := d.SetNewComputed("available_package_version"); err != nil {
+		return err
+	}
+
+	if err := d.SetNewComputed("available_plugin_properties"); err != nil {
+		return err
+	}
+
+	return d.SetNewComputed("package_version_history")
+}
+
+func findPackageVersionHistoryByID(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) ([]*opensearchservice.PackageVersionHistory, error) {
+	input := &opensearchservice.GetPackageVersionHistoryInput{
+		PackageID: aws.String(id),
+	}
+	var output []*opensearchservice.PackageVersionHistory
+
+	err := conn.GetPackageVersionHistoryPagesWithContext(ctx, input, func(page *opensearchservice.GetPackageVersionHistoryOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PackageVersionHistoryList {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, opensearchservice.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+func statusPackage(ctx context.Context, conn *opensearchservice.OpenSearchService, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindPackageByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.PackageStatus), nil
+	}
+}
+
+func waitPackageAvailable(ctx context.Context, conn *opensearchservice.OpenSearchService, id string, timeout time.Duration) (*opensearchservice.PackageDetails, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{opensearchservice.PackageStatusCopying, opensearchservice.PackageStatusValidating},
+		Target:  []string{opensearchservice.PackageStatusAvailable},
+		Refresh: statusPackage(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*opensearchservice.PackageDetails); ok {
+		if status, details := aws.StringValue(output.PackageStatus), output.ErrorDetails; (status == opensearchservice.PackageStatusCopyFailed || status == opensearchservice.PackageStatusValidationFailed) && details != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.StringValue(details.ErrorType), aws.StringValue(details.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func expandPackageSource(v interface{}) *opensearchservice.PackageSource {
 	if v == nil {
 		return nil
@@ -235,3 +414,37 @@ func expandPackageSource(v interface{}) *opensearchservice.PackageSource {
 		S3Key:        aws.String(v.(map[string]interface{})["s3_key"].(string)),
 	}
 }
+
+func flattenPluginProperties(apiObject *opensearchservice.PluginProperties) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"class_name":                 aws.StringValue(apiObject.ClassName),
+		names.AttrDescription:        aws.StringValue(apiObject.Description),
+		names.AttrName:               aws.StringValue(apiObject.Name),
+		"uncompressed_size_in_bytes": aws.Int64Value(apiObject.UncompressedSizeInBytes),
+		names.AttrVersion:            aws.StringValue(apiObject.Version),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenPackageVersionHistories(apiObjects []*opensearchservice.PackageVersionHistory) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"commit_message":    aws.StringValue(apiObject.CommitMessage),
+			names.AttrCreatedAt: aws.TimeValue(apiObject.CreatedAt).Format(time.RFC3339),
+			"package_version":   aws.StringValue(apiObject.PackageVersion),
+		})
+	}
+
+	return tfList
+}
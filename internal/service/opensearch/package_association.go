@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -27,6 +28,7 @@ func ResourcePackageAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePackageAssociationCreate,
 		ReadWithoutTimeout:   resourcePackageAssociationRead,
+		UpdateWithoutTimeout: resourcePackageAssociationUpdate,
 		DeleteWithoutTimeout: resourcePackageAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -35,6 +37,7 @@ func ResourcePackageAssociation() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -49,6 +52,11 @@ func ResourcePackageAssociation() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
+			"package_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"reference_path": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -77,10 +85,16 @@ func resourcePackageAssociationCreate(ctx context.Context, d *schema.ResourceDat
 
 	d.SetId(id)
 
-	if _, err := waitPackageAssociationCreated(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutCreate)); err != nil {
+	output, err := waitPackageAssociationCreated(ctx, conn, domainName, packageID, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) create: %s", d.Id(), err)
 	}
 
+	if err := checkPackageAssociationVersion(d, output); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating OpenSearch Package Association (%s): %s", d.Id(), err)
+	}
+
 	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
 }
 
@@ -104,11 +118,45 @@ func resourcePackageAssociationRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set(names.AttrDomainName, pkgAssociation.DomainName)
 	d.Set("package_id", pkgAssociation.PackageID)
+	d.Set("package_version", pkgAssociation.PackageVersion)
 	d.Set("reference_path", pkgAssociation.ReferencePath)
 
 	return diags
 }
 
+func resourcePackageAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
+
+	// Associating an already associated package updates the domain to the package's latest available version.
+	if d.HasChange("package_version") {
+		domainName := d.Get(names.AttrDomainName).(string)
+		packageID := d.Get("package_id").(string)
+		input := &opensearchservice.AssociatePackageInput{
+			DomainName: aws.String(domainName),
+			PackageID:  aws.String(packageID),
+		}
+
+		_, err := conn.AssociatePackageWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): %s", d.Id(), err)
+		}
+
+		output, err := waitPackageAssociationCreated(ctx, conn, domainName, packageID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) update: %s", d.Id(), err)
+		}
+
+		if err := checkPackageAssociationVersion(d, output); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating OpenSearch Package Association (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageAssociationRead(ctx, d, meta)...)
+}
+
 func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OpenSearchConn(ctx)
@@ -129,13 +177,28 @@ func resourcePackageAssociationDelete(ctx context.Context, d *schema.ResourceDat
 		return sdkdiag.AppendErrorf(diags, "deleting OpenSearch Package Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitPackageAssociationDeleted(ctx, conn, domainName, packageID, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitPackageAssociationDeleted(ctx, conn, domainName, packageID, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for OpenSearch Package Association (%s) delete: %s", d.Id(), err)
 	}
 
 	return diags
 }
 
+// checkPackageAssociationVersion returns an error if a package version is configured and the domain is associated with a different version.
+func checkPackageAssociationVersion(d *schema.ResourceData, apiObject *opensearchservice.DomainPackageDetails) error {
+	want := d.Get("package_version").(string)
+
+	if want == "" {
+		return nil
+	}
+
+	if got := aws.StringValue(apiObject.PackageVersion); got != want {
+		return fmt.Errorf("associated package version (%s) does not match configured package version (%s); only the latest available package version can be associated", got, want)
+	}
+
+	return nil
+}
+
 func FindPackageAssociationByTwoPartKey(ctx context.Context, conn *opensearchservice.OpenSearchService, domainName, packageID string) (*opensearchservice.DomainPackageDetails, error) {
 	input := &opensearchservice.ListPackagesForDomainInput{
 		DomainName: aws.String(domainName),
@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -44,18 +45,19 @@ func newResourceCollection(_ context.Context) (resource.ResourceWithConfigure, e
 }
 
 type resourceCollectionData struct {
-	ARN                types.String   `tfsdk:"arn"`
-	CollectionEndpoint types.String   `tfsdk:"collection_endpoint"`
-	DashboardEndpoint  types.String   `tfsdk:"dashboard_endpoint"`
-	Description        types.String   `tfsdk:"description"`
-	ID                 types.String   `tfsdk:"id"`
-	KmsKeyARN          types.String   `tfsdk:"kms_key_arn"`
-	Name               types.String   `tfsdk:"name"`
-	StandbyReplicas    types.String   `tfsdk:"standby_replicas"`
-	Tags               types.Map      `tfsdk:"tags"`
-	TagsAll            types.Map      `tfsdk:"tags_all"`
-	Timeouts           timeouts.Value `tfsdk:"timeouts"`
-	Type               types.String   `tfsdk:"type"`
+	ARN                types.String     `tfsdk:"arn"`
+	CollectionEndpoint types.String     `tfsdk:"collection_endpoint"`
+	DashboardEndpoint  types.String     `tfsdk:"dashboard_endpoint"`
+	Description        types.String     `tfsdk:"description"`
+	ID                 types.String     `tfsdk:"id"`
+	KmsKeyARN          types.String     `tfsdk:"kms_key_arn"`
+	Name               types.String     `tfsdk:"name"`
+	PollInterval       fwtypes.Duration `tfsdk:"poll_interval"`
+	StandbyReplicas    types.String     `tfsdk:"standby_replicas"`
+	Tags               types.Map        `tfsdk:"tags"`
+	TagsAll            types.Map        `tfsdk:"tags_all"`
+	Timeouts           timeouts.Value   `tfsdk:"timeouts"`
+	Type               types.String     `tfsdk:"type"`
 }
 
 const (
@@ -64,6 +66,7 @@ const (
 
 type resourceCollection struct {
 	framework.ResourceWithConfigure
+	framework.WithPollInterval
 	framework.WithTimeouts
 }
 
@@ -111,6 +114,7 @@ func (r *resourceCollection) Schema(ctx context.Context, req resource.SchemaRequ
 						`must start with any lower case letter and can can include any lower case letter, number, or "-"`),
 				},
 			},
+			"poll_interval": framework.PollIntervalAttribute(),
 			"standby_replicas": schema.StringAttribute{
 				Optional: true,
 				Computed: true,
@@ -181,7 +185,7 @@ func (r *resourceCollection) Create(ctx context.Context, req resource.CreateRequ
 	state.ID = flex.StringToFramework(ctx, out.CreateCollectionDetail.Id)
 
 	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
-	collection, err := waitCollectionCreated(ctx, conn, aws.ToString(out.CreateCollectionDetail.Id), createTimeout)
+	collection, err := waitCollectionCreated(ctx, conn, aws.ToString(out.CreateCollectionDetail.Id), createTimeout, r.PollInterval(plan.PollInterval))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			create.ProblemStandardMessage(names.OpenSearchServerless, create.ErrActionWaitingForCreation, ResNameCollection, plan.Name.ValueString(), err),
@@ -290,7 +294,7 @@ func (r *resourceCollection) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
-	_, err = waitCollectionDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout)
+	_, err = waitCollectionDeleted(ctx, conn, state.ID.ValueString(), deleteTimeout, r.PollInterval(state.PollInterval))
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -309,14 +313,15 @@ func (r *resourceCollection) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
-func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*awstypes.CollectionDetail, error) {
+func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Client, id string, timeout, pollInterval time.Duration) (*awstypes.CollectionDetail, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.CollectionStatusCreating),
-		Target:     enum.Slice(awstypes.CollectionStatusActive),
-		Refresh:    statusCollection(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Pending:      enum.Slice(awstypes.CollectionStatusCreating),
+		Target:       enum.Slice(awstypes.CollectionStatusActive),
+		Refresh:      statusCollection(ctx, conn, id),
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -328,14 +333,15 @@ func waitCollectionCreated(ctx context.Context, conn *opensearchserverless.Clien
 	return nil, err
 }
 
-func waitCollectionDeleted(ctx context.Context, conn *opensearchserverless.Client, id string, timeout time.Duration) (*awstypes.CollectionDetail, error) {
+func waitCollectionDeleted(ctx context.Context, conn *opensearchserverless.Client, id string, timeout, pollInterval time.Duration) (*awstypes.CollectionDetail, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.CollectionStatusDeleting),
-		Target:     []string{},
-		Refresh:    statusCollection(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Pending:      enum.Slice(awstypes.CollectionStatusDeleting),
+		Target:       []string{},
+		Refresh:      statusCollection(ctx, conn, id),
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
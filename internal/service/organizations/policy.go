@@ -26,6 +26,7 @@ import (
 )
 
 // @SDKResource("aws_organizations_policy", name="Policy")
+// @SkipDestroy
 // @Tags(identifierAttribute="id")
 func resourcePolicy() *schema.Resource {
 	return &schema.Resource{
@@ -58,10 +59,6 @@ func resourcePolicy() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
-			names.AttrSkipDestroy: {
-				Type:     schema.TypeBool,
-				Optional: true,
-			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			names.AttrType: {
@@ -174,11 +171,6 @@ func resourcePolicyDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).OrganizationsClient(ctx)
 
-	if v, ok := d.GetOk(names.AttrSkipDestroy); ok && v.(bool) {
-		log.Printf("[DEBUG] Retaining Organizations Policy: %s", d.Id())
-		return nil
-	}
-
 	log.Printf("[DEBUG] Deleting Organizations Policy: %s", d.Id())
 	_, err := conn.DeletePolicy(ctx, &organizations.DeletePolicyInput{
 		PolicyId: aws.String(d.Id()),
@@ -115,6 +115,7 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			SkipDestroy: true,
 		},
 		{
 			Factory:  resourcePolicyAttachment,
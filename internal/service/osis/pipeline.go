@@ -52,6 +52,7 @@ func newPipelineResource(_ context.Context) (resource.ResourceWithConfigure, err
 type pipelineResource struct {
 	framework.ResourceWithConfigure
 	framework.WithImportByID
+	framework.WithPollInterval
 	framework.WithTimeouts
 }
 
@@ -99,6 +100,7 @@ func (r *pipelineResource) Schema(ctx context.Context, request resource.SchemaRe
 					stringvalidator.LengthBetween(3, 28),
 				},
 			},
+			"poll_interval":   framework.PollIntervalAttribute(),
 			names.AttrTags:    tftags.TagsAttribute(),
 			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
 		},
@@ -237,7 +239,7 @@ func (r *pipelineResource) Create(ctx context.Context, request resource.CreateRe
 
 	data.setID()
 
-	pipeline, err := waitPipelineCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts))
+	pipeline, err := waitPipelineCreated(ctx, conn, name, r.CreateTimeout(ctx, data.Timeouts), r.PollInterval(data.PollInterval))
 
 	if err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) create", name), err.Error())
@@ -325,7 +327,7 @@ func (r *pipelineResource) Update(ctx context.Context, request resource.UpdateRe
 			return
 		}
 
-		if _, err := waitPipelineUpdated(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		if _, err := waitPipelineUpdated(ctx, conn, name, r.UpdateTimeout(ctx, new.Timeouts), r.PollInterval(new.PollInterval)); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) update", name), err.Error())
 
 			return
@@ -361,7 +363,7 @@ func (r *pipelineResource) Delete(ctx context.Context, request resource.DeleteRe
 		return
 	}
 
-	if _, err := waitPipelineDeleted(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+	if _, err := waitPipelineDeleted(ctx, conn, name, r.DeleteTimeout(ctx, data.Timeouts), r.PollInterval(data.PollInterval)); err != nil {
 		response.Diagnostics.AddError(fmt.Sprintf("waiting for OpenSearch Ingestion Pipeline (%s) delete", name), err.Error())
 
 		return
@@ -413,14 +415,15 @@ func statusPipeline(ctx context.Context, conn *osis.Client, name string) retry.S
 	}
 }
 
-func waitPipelineCreated(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+func waitPipelineCreated(ctx context.Context, conn *osis.Client, name string, timeout, pollInterval time.Duration) (*awstypes.Pipeline, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.PipelineStatusCreating, awstypes.PipelineStatusStarting),
-		Target:     enum.Slice(awstypes.PipelineStatusActive),
-		Refresh:    statusPipeline(ctx, conn, name),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Pending:      enum.Slice(awstypes.PipelineStatusCreating, awstypes.PipelineStatusStarting),
+		Target:       enum.Slice(awstypes.PipelineStatusActive),
+		Refresh:      statusPipeline(ctx, conn, name),
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -436,14 +439,15 @@ func waitPipelineCreated(ctx context.Context, conn *osis.Client, name string, ti
 	return nil, err
 }
 
-func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, timeout, pollInterval time.Duration) (*awstypes.Pipeline, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.PipelineStatusUpdating),
-		Target:     enum.Slice(awstypes.PipelineStatusActive),
-		Refresh:    statusPipeline(ctx, conn, name),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Pending:      enum.Slice(awstypes.PipelineStatusUpdating),
+		Target:       enum.Slice(awstypes.PipelineStatusActive),
+		Refresh:      statusPipeline(ctx, conn, name),
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -459,14 +463,15 @@ func waitPipelineUpdated(ctx context.Context, conn *osis.Client, name string, ti
 	return nil, err
 }
 
-func waitPipelineDeleted(ctx context.Context, conn *osis.Client, name string, timeout time.Duration) (*awstypes.Pipeline, error) {
+func waitPipelineDeleted(ctx context.Context, conn *osis.Client, name string, timeout, pollInterval time.Duration) (*awstypes.Pipeline, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.PipelineStatusDeleting),
-		Target:     []string{},
-		Refresh:    statusPipeline(ctx, conn, name),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Pending:      enum.Slice(awstypes.PipelineStatusDeleting),
+		Target:       []string{},
+		Refresh:      statusPipeline(ctx, conn, name),
+		Timeout:      timeout,
+		MinTimeout:   10 * time.Second,
+		Delay:        30 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -493,6 +498,7 @@ type pipelineResourceModel struct {
 	PipelineARN               types.String                                                  `tfsdk:"pipeline_arn"`
 	PipelineConfigurationBody types.String                                                  `tfsdk:"pipeline_configuration_body"`
 	PipelineName              types.String                                                  `tfsdk:"pipeline_name"`
+	PollInterval              fwtypes.Duration                                              `tfsdk:"poll_interval"`
 	Tags                      types.Map                                                     `tfsdk:"tags"`
 	TagsAll                   types.Map                                                     `tfsdk:"tags_all"`
 	Timeouts                  timeouts.Value                                                `tfsdk:"timeouts"`
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+// Exports for use in tests only.
+var (
+	ResourceDirectoryRegistration             = newDirectoryRegistrationResource
+	ResourceTemplateGroupAccessControlEntries = newTemplateGroupAccessControlEntriesResource
+
+	FindDirectoryRegistrationByARN                     = findDirectoryRegistrationByARN
+	FindTemplateGroupAccessControlEntriesByTemplateARN = findTemplateGroupAccessControlEntriesByTemplateARN
+)
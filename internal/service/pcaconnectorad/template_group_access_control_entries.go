@@ -0,0 +1,386 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pcaconnectorad"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Template Group Access Control Entries")
+func newTemplateGroupAccessControlEntriesResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &templateGroupAccessControlEntriesResource{}
+
+	return r, nil
+}
+
+type templateGroupAccessControlEntriesResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*templateGroupAccessControlEntriesResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_pcaconnectorad_template_group_access_control_entries"
+}
+
+func (r *templateGroupAccessControlEntriesResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"template_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_control_entry": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[accessControlEntryModel](ctx),
+				Validators: []validator.Set{
+					setvalidator.IsRequired(),
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"group_display_name": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 256),
+							},
+						},
+						"group_security_identifier": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(7, 256),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"access_rights": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[accessRightsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"auto_enroll": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+										Optional:   true,
+									},
+									"enroll": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AccessRight](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *templateGroupAccessControlEntriesResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	entries, diags := data.AccessControlEntries.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	templateARN := data.TemplateARN.ValueString()
+	response.Diagnostics.Append(createTemplateGroupAccessControlEntries(ctx, conn, templateARN, entries)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateGroupAccessControlEntriesResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	output, err := findTemplateGroupAccessControlEntriesByTemplateARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading PCA Connector for AD Template (%s) Group Access Control Entries", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &pcaconnectorad.ListTemplateGroupAccessControlEntriesOutput{AccessControlEntries: output}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *templateGroupAccessControlEntriesResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	if !new.AccessControlEntries.Equal(old.AccessControlEntries) {
+		oldEntries, diags := old.AccessControlEntries.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		newEntries, diags := new.AccessControlEntries.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Entries are keyed by group security identifier.
+		add, remove, unchanged := flex.DiffSlices(oldEntries, slices.Clone(newEntries), func(v1, v2 *accessControlEntryModel) bool {
+			return v1.GroupSecurityIdentifier.Equal(v2.GroupSecurityIdentifier)
+		})
+
+		templateARN := new.TemplateARN.ValueString()
+
+		response.Diagnostics.Append(deleteTemplateGroupAccessControlEntries(ctx, conn, templateARN, remove)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var modify []*accessControlEntryModel
+		for _, oldEntry := range unchanged {
+			for _, newEntry := range newEntries {
+				if !oldEntry.GroupSecurityIdentifier.Equal(newEntry.GroupSecurityIdentifier) {
+					continue
+				}
+
+				if !oldEntry.GroupDisplayName.Equal(newEntry.GroupDisplayName) || !oldEntry.AccessRights.Equal(newEntry.AccessRights) {
+					modify = append(modify, newEntry)
+				}
+			}
+		}
+
+		response.Diagnostics.Append(updateTemplateGroupAccessControlEntries(ctx, conn, templateARN, modify)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		response.Diagnostics.Append(createTemplateGroupAccessControlEntries(ctx, conn, templateARN, add)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *templateGroupAccessControlEntriesResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data templateGroupAccessControlEntriesResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PCAConnectorADClient(ctx)
+
+	entries, diags := data.AccessControlEntries.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(deleteTemplateGroupAccessControlEntries(ctx, conn, data.ID.ValueString(), entries)...)
+}
+
+func createTemplateGroupAccessControlEntries(ctx context.Context, conn *pcaconnectorad.Client, templateARN string, entries []*accessControlEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, entry := range entries {
+		input := &pcaconnectorad.CreateTemplateGroupAccessControlEntryInput{}
+		diags.Append(fwflex.Expand(ctx, entry, input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.ClientToken = aws.String(id.UniqueId())
+		input.TemplateArn = aws.String(templateARN)
+
+		if _, err := conn.CreateTemplateGroupAccessControlEntry(ctx, input); err != nil {
+			diags.AddError(fmt.Sprintf("creating PCA Connector for AD Template (%s) Group (%s) Access Control Entry", templateARN, entry.GroupSecurityIdentifier.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func updateTemplateGroupAccessControlEntries(ctx context.Context, conn *pcaconnectorad.Client, templateARN string, entries []*accessControlEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, entry := range entries {
+		input := &pcaconnectorad.UpdateTemplateGroupAccessControlEntryInput{}
+		diags.Append(fwflex.Expand(ctx, entry, input)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input.TemplateArn = aws.String(templateARN)
+
+		if _, err := conn.UpdateTemplateGroupAccessControlEntry(ctx, input); err != nil {
+			diags.AddError(fmt.Sprintf("updating PCA Connector for AD Template (%s) Group (%s) Access Control Entry", templateARN, entry.GroupSecurityIdentifier.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func deleteTemplateGroupAccessControlEntries(ctx context.Context, conn *pcaconnectorad.Client, templateARN string, entries []*accessControlEntryModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, entry := range entries {
+		_, err := conn.DeleteTemplateGroupAccessControlEntry(ctx, &pcaconnectorad.DeleteTemplateGroupAccessControlEntryInput{
+			GroupSecurityIdentifier: fwflex.StringFromFramework(ctx, entry.GroupSecurityIdentifier),
+			TemplateArn:             aws.String(templateARN),
+		})
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("deleting PCA Connector for AD Template (%s) Group (%s) Access Control Entry", templateARN, entry.GroupSecurityIdentifier.ValueString()), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+func findTemplateGroupAccessControlEntriesByTemplateARN(ctx context.Context, conn *pcaconnectorad.Client, templateARN string) ([]awstypes.AccessControlEntrySummary, error) {
+	input := &pcaconnectorad.ListTemplateGroupAccessControlEntriesInput{
+		TemplateArn: aws.String(templateARN),
+	}
+	var output []awstypes.AccessControlEntrySummary
+
+	pages := pcaconnectorad.NewListTemplateGroupAccessControlEntriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccessControlEntries...)
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type templateGroupAccessControlEntriesResourceModel struct {
+	AccessControlEntries fwtypes.SetNestedObjectValueOf[accessControlEntryModel] `tfsdk:"access_control_entry"`
+	ID                   types.String                                            `tfsdk:"id"`
+	TemplateARN          fwtypes.ARN                                             `tfsdk:"template_arn"`
+}
+
+func (m *templateGroupAccessControlEntriesResourceModel) InitFromID() error {
+	m.TemplateARN = fwtypes.ARNValue(m.ID.ValueString())
+
+	return nil
+}
+
+func (m *templateGroupAccessControlEntriesResourceModel) setID() {
+	m.ID = types.StringValue(m.TemplateARN.ValueString())
+}
+
+type accessControlEntryModel struct {
+	AccessRights            fwtypes.ListNestedObjectValueOf[accessRightsModel] `tfsdk:"access_rights"`
+	GroupDisplayName        types.String                                       `tfsdk:"group_display_name"`
+	GroupSecurityIdentifier types.String                                       `tfsdk:"group_security_identifier"`
+}
+
+type accessRightsModel struct {
+	AutoEnroll fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"auto_enroll"`
+	Enroll     fwtypes.StringEnum[awstypes.AccessRight] `tfsdk:"enroll"`
+}
@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package pcaconnectorad_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/pcaconnectorad/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfpcaconnectorad "github.com/hashicorp/terraform-provider-aws/internal/service/pcaconnectorad"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// A PCA Connector for AD template must already exist in the account.
+const envVarTemplateARN = "PCA_CONNECTOR_AD_TEMPLATE_ARN"
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntries_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	templateARN := acctest.SkipIfEnvVarNotSet(t, envVarTemplateARN)
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entries.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntriesConfig_basic(templateARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "template_arn", templateARN),
+					resource.TestCheckResourceAttr(resourceName, "access_control_entry.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_entry.*", map[string]string{
+						"group_display_name":          "example-1",
+						"group_security_identifier":   "S-1-5-21-1234567890-1234567890-1234567890-1001",
+						"access_rights.#":             acctest.Ct1,
+						"access_rights.0.auto_enroll": string(awstypes.AccessRightDeny),
+						"access_rights.0.enroll":      string(awstypes.AccessRightAllow),
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTemplateGroupAccessControlEntriesConfig_updated(templateARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntriesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "access_control_entry.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_entry.*", map[string]string{
+						"group_display_name":          "example-1",
+						"group_security_identifier":   "S-1-5-21-1234567890-1234567890-1234567890-1001",
+						"access_rights.0.auto_enroll": string(awstypes.AccessRightAllow),
+						"access_rights.0.enroll":      string(awstypes.AccessRightAllow),
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "access_control_entry.*", map[string]string{
+						"group_display_name":          "example-2",
+						"group_security_identifier":   "S-1-5-21-1234567890-1234567890-1234567890-1002",
+						"access_rights.0.auto_enroll": string(awstypes.AccessRightDeny),
+						"access_rights.0.enroll":      string(awstypes.AccessRightAllow),
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPCAConnectorADTemplateGroupAccessControlEntries_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	templateARN := acctest.SkipIfEnvVarNotSet(t, envVarTemplateARN)
+	resourceName := "aws_pcaconnectorad_template_group_access_control_entries.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.PCAConnectorADServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTemplateGroupAccessControlEntriesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTemplateGroupAccessControlEntriesConfig_basic(templateARN),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTemplateGroupAccessControlEntriesExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpcaconnectorad.ResourceTemplateGroupAccessControlEntries, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTemplateGroupAccessControlEntriesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_pcaconnectorad_template_group_access_control_entries" {
+				continue
+			}
+
+			_, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntriesByTemplateARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("PCA Connector for AD Template %s Group Access Control Entries still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTemplateGroupAccessControlEntriesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PCAConnectorADClient(ctx)
+
+		_, err := tfpcaconnectorad.FindTemplateGroupAccessControlEntriesByTemplateARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccTemplateGroupAccessControlEntriesConfig_basic(templateARN string) string {
+	return fmt.Sprintf(`
+resource "aws_pcaconnectorad_template_group_access_control_entries" "test" {
+  template_arn = %[1]q
+
+  access_control_entry {
+    group_display_name        = "example-1"
+    group_security_identifier = "S-1-5-21-1234567890-1234567890-1234567890-1001"
+
+    access_rights {
+      auto_enroll = "DENY"
+      enroll      = "ALLOW"
+    }
+  }
+}
+`, templateARN)
+}
+
+func testAccTemplateGroupAccessControlEntriesConfig_updated(templateARN string) string {
+	return fmt.Sprintf(`
+resource "aws_pcaconnectorad_template_group_access_control_entries" "test" {
+  template_arn = %[1]q
+
+  access_control_entry {
+    group_display_name        = "example-1"
+    group_security_identifier = "S-1-5-21-1234567890-1234567890-1234567890-1001"
+
+    access_rights {
+      auto_enroll = "ALLOW"
+      enroll      = "ALLOW"
+    }
+  }
+
+  access_control_entry {
+    group_display_name        = "example-2"
+    group_security_identifier = "S-1-5-21-1234567890-1234567890-1234567890-1002"
+
+    access_rights {
+      auto_enroll = "DENY"
+      enroll      = "ALLOW"
+    }
+  }
+}
+`, templateARN)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -175,7 +176,7 @@ func resourcePipeCreate(ctx context.Context, d *schema.ResourceData, meta interf
 
 	d.SetId(aws.ToString(output.Name))
 
-	if _, err := waitPipeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitPipeCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return create.AppendDiagError(diags, names.Pipes, create.ErrActionWaitingForCreation, ResNamePipe, d.Id(), err)
 	}
 
@@ -290,7 +291,7 @@ func resourcePipeUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			return create.AppendDiagError(diags, names.Pipes, create.ErrActionUpdating, ResNamePipe, d.Id(), err)
 		}
 
-		if _, err := waitPipeUpdated(ctx, conn, aws.ToString(output.Name), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitPipeUpdated(ctx, conn, aws.ToString(output.Name), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return create.AppendDiagError(diags, names.Pipes, create.ErrActionWaitingForUpdate, ResNamePipe, d.Id(), err)
 		}
 	}
@@ -315,7 +316,7 @@ func resourcePipeDelete(ctx context.Context, d *schema.ResourceData, meta interf
 		return create.AppendDiagError(diags, names.Pipes, create.ErrActionDeleting, ResNamePipe, d.Id(), err)
 	}
 
-	if _, err := waitPipeDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitPipeDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.Pipes, create.ErrActionWaitingForDeletion, ResNamePipe, d.Id(), err)
 	}
 
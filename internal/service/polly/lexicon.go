@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly
+
+import (
+	"context"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/polly/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Lexicon")
+func newResourceLexicon(context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceLexicon{}, nil
+}
+
+const (
+	ResNameLexicon = "Lexicon"
+)
+
+type resourceLexicon struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceLexicon) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_polly_lexicon"
+}
+
+func (r *resourceLexicon) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"alphabet": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrContent: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrLanguageCode: schema.StringAttribute{
+				Computed: true,
+			},
+			"last_modified": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"lexemes_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z]{1,20}$`), "must contain only alphanumeric characters and be at most 20 characters long"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSize: schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *resourceLexicon) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().PollyClient(ctx)
+
+	var plan resourceLexiconData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := plan.Name.ValueString()
+	in := &polly.PutLexiconInput{
+		Content: plan.Content.ValueStringPointer(),
+		Name:    aws.String(name),
+	}
+
+	_, err := conn.PutLexicon(ctx, in)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Polly, create.ErrActionCreating, ResNameLexicon, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(name)
+
+	out, err := findLexiconByName(ctx, conn, name)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Polly, create.ErrActionReading, ResNameLexicon, name, err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.refreshFromOutput(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceLexicon) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().PollyClient(ctx)
+
+	var state resourceLexiconData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findLexiconByName(ctx, conn, state.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Polly, create.ErrActionReading, ResNameLexicon, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Content = flex.StringToFramework(ctx, out.Lexicon.Content)
+	state.Name = flex.StringToFramework(ctx, out.Lexicon.Name)
+	state.refreshFromOutput(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceLexicon) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().PollyClient(ctx)
+
+	var plan, state resourceLexiconData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Content.Equal(state.Content) {
+		// PutLexicon overwrites an existing lexicon of the same name.
+		in := &polly.PutLexiconInput{
+			Content: plan.Content.ValueStringPointer(),
+			Name:    plan.Name.ValueStringPointer(),
+		}
+
+		_, err := conn.PutLexicon(ctx, in)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Polly, create.ErrActionUpdating, ResNameLexicon, plan.ID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	out, err := findLexiconByName(ctx, conn, plan.ID.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Polly, create.ErrActionReading, ResNameLexicon, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.refreshFromOutput(ctx, out)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceLexicon) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().PollyClient(ctx)
+
+	var state resourceLexiconData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.DeleteLexicon(ctx, &polly.DeleteLexiconInput{
+		Name: state.ID.ValueStringPointer(),
+	})
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Polly, create.ErrActionDeleting, ResNameLexicon, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceLexicon) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrName), req.ID)...)
+}
+
+func findLexiconByName(ctx context.Context, conn *polly.Client, name string) (*polly.GetLexiconOutput, error) {
+	in := &polly.GetLexiconInput{
+		Name: aws.String(name),
+	}
+
+	out, err := conn.GetLexicon(ctx, in)
+
+	if errs.IsA[*awstypes.LexiconNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Lexicon == nil || out.LexiconAttributes == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type resourceLexiconData struct {
+	Alphabet     types.String      `tfsdk:"alphabet"`
+	ARN          types.String      `tfsdk:"arn"`
+	Content      types.String      `tfsdk:"content"`
+	ID           types.String      `tfsdk:"id"`
+	LanguageCode types.String      `tfsdk:"language_code"`
+	LastModified timetypes.RFC3339 `tfsdk:"last_modified"`
+	LexemesCount types.Int64       `tfsdk:"lexemes_count"`
+	Name         types.String      `tfsdk:"name"`
+	Size         types.Int64       `tfsdk:"size"`
+}
+
+func (data *resourceLexiconData) refreshFromOutput(ctx context.Context, out *polly.GetLexiconOutput) {
+	attributes := out.LexiconAttributes
+
+	data.Alphabet = flex.StringToFramework(ctx, attributes.Alphabet)
+	data.ARN = flex.StringToFramework(ctx, attributes.LexiconArn)
+	data.LanguageCode = flex.StringValueToFramework(ctx, attributes.LanguageCode)
+	data.LastModified = flex.TimeToFramework(ctx, attributes.LastModified)
+	data.LexemesCount = flex.Int32ValueToFramework(ctx, attributes.LexemesCount)
+	data.Size = flex.Int32ValueToFramework(ctx, attributes.Size)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package polly_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/polly"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfpolly "github.com/hashicorp/terraform-provider-aws/internal/service/polly"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPollyLexicon_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var lexicon polly.GetLexiconOutput
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &lexicon),
+					resource.TestCheckResourceAttr(resourceName, "alphabet", "ipa"),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "polly", "lexicon/"+rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrLanguageCode, "en-US"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrSize),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &lexicon),
+					resource.TestCheckResourceAttr(resourceName, "lexemes_count", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPollyLexicon_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var lexicon polly.GetLexiconOutput
+	rName := sdkacctest.RandString(10)
+	resourceName := "aws_polly_lexicon.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.PollyEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PollyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLexiconDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLexiconConfig_basic(rName, "World Wide Web Consortium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLexiconExists(ctx, resourceName, &lexicon),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfpolly.ResourceLexicon, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLexiconDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_polly_lexicon" {
+				continue
+			}
+
+			_, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.Polly, create.ErrActionCheckingDestroyed, tfpolly.ResNameLexicon, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLexiconExists(ctx context.Context, name string, v *polly.GetLexiconOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Polly, create.ErrActionCheckingExistence, tfpolly.ResNameLexicon, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).PollyClient(ctx)
+
+		output, err := tfpolly.FindLexiconByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLexiconConfig_basic(rName, alias string) string {
+	return fmt.Sprintf(`
+resource "aws_polly_lexicon" "test" {
+  name    = %[1]q
+  content = <<EOT
+<?xml version="1.0" encoding="UTF-8"?>
+<lexicon version="1.0"
+      xmlns="http://www.w3.org/2005/01/pronunciation-lexicon"
+      xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"
+      xsi:schemaLocation="http://www.w3.org/2005/01/pronunciation-lexicon
+        http://www.w3.org/TR/2007/CR-pronunciation-lexicon-20071212/pls.xsd"
+      alphabet="ipa" xml:lang="en-US">
+  <lexeme>
+    <grapheme>W3C</grapheme>
+    <alias>%[2]s</alias>
+  </lexeme>
+</lexicon>
+EOT
+}
+`, rName, alias)
+}
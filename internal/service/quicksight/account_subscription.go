@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -30,11 +31,13 @@ func ResourceAccountSubscription() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountSubscriptionCreate,
 		ReadWithoutTimeout:   resourceAccountSubscriptionRead,
+		UpdateWithoutTimeout: resourceAccountSubscriptionUpdate,
 		DeleteWithoutTimeout: resourceAccountSubscriptionDelete,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
@@ -67,6 +70,10 @@ func ResourceAccountSubscription() *schema.Resource {
 					ForceNew:     true,
 					ValidateFunc: validation.StringInSlice(quicksight.AuthenticationMethodOption_Values(), false),
 				},
+				"authentication_type": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
 				"author_group": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -120,7 +127,6 @@ func ResourceAccountSubscription() *schema.Resource {
 				"notification_email": {
 					Type:     schema.TypeString,
 					Required: true,
-					ForceNew: true,
 				},
 				"reader_group": {
 					Type:     schema.TypeList,
@@ -141,6 +147,8 @@ func ResourceAccountSubscription() *schema.Resource {
 
 const (
 	ResNameAccountSubscription = "Account Subscription"
+
+	defaultAccountSettingsNamespace = "default"
 )
 
 func resourceAccountSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -215,7 +223,7 @@ func resourceAccountSubscriptionCreate(ctx context.Context, d *schema.ResourceDa
 
 	d.SetId(awsAccountId)
 
-	if _, err := waitAccountSubscriptionCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitAccountSubscriptionCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return create.AppendDiagError(diags, names.QuickSight, create.ErrActionWaitingForCreation, ResNameAccountSubscription, d.Id(), err)
 	}
 
@@ -248,11 +256,46 @@ func resourceAccountSubscriptionRead(ctx context.Context, d *schema.ResourceData
 	d.Set("edition", out.Edition)
 	d.Set("notification_email", out.NotificationEmail)
 	d.Set("account_subscription_status", out.AccountSubscriptionStatus)
+	d.Set("authentication_type", out.AuthenticationType)
 	d.Set("iam_identity_center_instance_arn", out.IAMIdentityCenterInstanceArn)
 
 	return diags
 }
 
+func resourceAccountSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
+
+	if d.HasChange("notification_email") {
+		out, err := conn.DescribeAccountSettingsWithContext(ctx, &quicksight.DescribeAccountSettingsInput{
+			AwsAccountId: aws.String(d.Id()),
+		})
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.QuickSight, create.ErrActionReading, ResNameAccountSubscription, d.Id(), err)
+		}
+
+		in := &quicksight.UpdateAccountSettingsInput{
+			AwsAccountId:      aws.String(d.Id()),
+			DefaultNamespace:  aws.String(defaultAccountSettingsNamespace),
+			NotificationEmail: aws.String(d.Get("notification_email").(string)),
+		}
+
+		// DefaultNamespace is required, so preserve the current value.
+		if out.AccountSettings != nil && out.AccountSettings.DefaultNamespace != nil {
+			in.DefaultNamespace = out.AccountSettings.DefaultNamespace
+		}
+
+		_, err = conn.UpdateAccountSettingsWithContext(ctx, in)
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.QuickSight, create.ErrActionUpdating, ResNameAccountSubscription, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceAccountSubscriptionRead(ctx, d, meta)...)
+}
+
 func resourceAccountSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).QuickSightConn(ctx)
@@ -271,7 +314,7 @@ func resourceAccountSubscriptionDelete(ctx context.Context, d *schema.ResourceDa
 		return create.AppendDiagError(diags, names.QuickSight, create.ErrActionDeleting, ResNameAccountSubscription, d.Id(), err)
 	}
 
-	if _, err := waitAccountSubscriptionDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitAccountSubscriptionDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return create.AppendDiagError(diags, names.QuickSight, create.ErrActionWaitingForDeletion, ResNameAccountSubscription, d.Id(), err)
 	}
 
@@ -43,6 +43,7 @@ func testAccAccountSubscription_basic(t *testing.T) {
 					testAccCheckAccountSubscriptionDisableTerminationProtection(ctx, resourceName), // Workaround to remove termination protection
 					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
 					resource.TestCheckResourceAttr(resourceName, "account_name", rName),
+					resource.TestCheckResourceAttrSet(resourceName, "authentication_type"),
 				),
 			},
 			{
@@ -54,6 +55,42 @@ func testAccAccountSubscription_basic(t *testing.T) {
 	})
 }
 
+func testAccAccountSubscription_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var accountsubscription quicksight.AccountInfo
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_quicksight_account_subscription.test"
+	domain := acctest.RandomDomainName()
+	email := acctest.RandomEmailAddress(domain)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, quicksight.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QuickSightServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountSubscriptionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountSubscriptionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionDisableTerminationProtection(ctx, resourceName), // Workaround to remove termination protection
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "notification_email", acctest.DefaultEmailAddress),
+				),
+			},
+			{
+				Config: testAccAccountSubscriptionConfig_notificationEmail(rName, email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountSubscriptionExists(ctx, resourceName, &accountsubscription),
+					resource.TestCheckResourceAttr(resourceName, "notification_email", email),
+				),
+			},
+		},
+	})
+}
+
 func testAccAccountSubscription_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var accountsubscription quicksight.AccountInfo
@@ -170,3 +207,14 @@ resource "aws_quicksight_account_subscription" "test" {
 }
 `, rName, acctest.DefaultEmailAddress)
 }
+
+func testAccAccountSubscriptionConfig_notificationEmail(rName, email string) string {
+	return fmt.Sprintf(`
+resource "aws_quicksight_account_subscription" "test" {
+  account_name          = %[1]q
+  authentication_method = "IAM_AND_QUICKSIGHT"
+  edition               = "ENTERPRISE"
+  notification_email    = %[2]q
+}
+`, rName, email)
+}
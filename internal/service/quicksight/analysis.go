@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	quicksightschema "github.com/hashicorp/terraform-provider-aws/internal/service/quicksight/schema"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -185,7 +186,7 @@ func resourceAnalysisCreate(ctx context.Context, d *schema.ResourceData, meta in
 		return create.AppendDiagError(diags, names.QuickSight, create.ErrActionCreating, ResNameAnalysis, d.Get(names.AttrName).(string), err)
 	}
 
-	if _, err := waitAnalysisCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitAnalysisCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return create.AppendDiagError(diags, names.QuickSight, create.ErrActionWaitingForCreation, ResNameAnalysis, d.Id(), err)
 	}
 
@@ -290,7 +291,7 @@ func resourceAnalysisUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return create.AppendDiagError(diags, names.QuickSight, create.ErrActionUpdating, ResNameAnalysis, d.Id(), err)
 		}
 
-		if _, err := waitAnalysisUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitAnalysisUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return create.AppendDiagError(diags, names.QuickSight, create.ErrActionWaitingForUpdate, ResNameAnalysis, d.Id(), err)
 		}
 	}
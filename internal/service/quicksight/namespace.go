@@ -10,11 +10,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -25,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -249,7 +250,7 @@ func (r *resourceNamespace) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *resourceNamespace) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+	importer.ImportStateByARNOrID(ctx, req, resp, namespaceIDFromARN)
 }
 
 func (r *resourceNamespace) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
@@ -298,6 +299,17 @@ func createNamespaceID(awsAccountID, namespace string) string {
 	return fmt.Sprintf("%s,%s", awsAccountID, namespace)
 }
 
+// namespaceIDFromARN returns the ID of a namespace given its ARN, e.g. arn:aws:quicksight:us-west-2:123456789012:namespace/example.
+func namespaceIDFromARN(v arn.ARN) (string, error) {
+	parts, err := importer.ARNResourceParts(v, "namespace", 1)
+
+	if err != nil {
+		return "", err
+	}
+
+	return createNamespaceID(v.AccountID, parts[0]), nil
+}
+
 type resourceNamespaceData struct {
 	ARN            types.String   `tfsdk:"arn"`
 	AWSAccountID   types.String   `tfsdk:"aws_account_id"`
@@ -46,6 +46,12 @@ func TestAccQuickSightNamespace_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -16,6 +16,7 @@ func TestAccQuickSight_serial(t *testing.T) {
 		"AccountSubscription": {
 			acctest.CtBasic:      testAccAccountSubscription_basic,
 			acctest.CtDisappears: testAccAccountSubscription_disappears,
+			"update":             testAccAccountSubscription_update,
 		},
 	}
 
@@ -26,10 +26,16 @@ func RegisterSweepers() {
 	resource.AddTestSweepers("aws_quicksight_data_set", &resource.Sweeper{
 		Name: "aws_quicksight_data_set",
 		F:    sweepDataSets,
+		Dependencies: []string{
+			"aws_quicksight_dashboard",
+		},
 	})
 	resource.AddTestSweepers("aws_quicksight_data_source", &resource.Sweeper{
 		Name: "aws_quicksight_data_source",
 		F:    sweepDataSources,
+		Dependencies: []string{
+			"aws_quicksight_data_set",
+		},
 	})
 	resource.AddTestSweepers("aws_quicksight_folder", &resource.Sweeper{
 		Name: "aws_quicksight_folder",
@@ -53,6 +59,9 @@ func RegisterSweepers() {
 	resource.AddTestSweepers("aws_quicksight_vpc_connection", &resource.Sweeper{
 		Name: "aws_quicksight_vpc_connection",
 		F:    sweepVPCConnections,
+		Dependencies: []string{
+			"aws_quicksight_data_source",
+		},
 	})
 }
 
@@ -425,15 +434,32 @@ func sweepVPCConnections(region string) error {
 		AwsAccountId: aws.String(awsAccountId),
 	}
 
-	out, err := conn.ListVPCConnectionsWithContext(ctx, input)
-	for _, v := range out.VPCConnectionSummaries {
-		vpcConnectionID := aws.StringValue(v.VPCConnectionId)
-		sweepResources = append(sweepResources, framework.NewSweepResource(newResourceVPCConnection, client,
-			framework.NewAttribute(names.AttrID, createVPCConnectionID(awsAccountId, vpcConnectionID)),
-			framework.NewAttribute(names.AttrAWSAccountID, awsAccountId),
-			framework.NewAttribute("vpc_connection_id", vpcConnectionID),
-		))
-	}
+	err = conn.ListVPCConnectionsPagesWithContext(ctx, input, func(page *quicksight.ListVPCConnectionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.VPCConnectionSummaries {
+			if v == nil {
+				continue
+			}
+
+			vpcConnectionID := aws.StringValue(v.VPCConnectionId)
+
+			if status := aws.StringValue(v.Status); status == quicksight.VPCConnectionResourceStatusDeleted || status == quicksight.VPCConnectionResourceStatusDeletionInProgress {
+				log.Printf("[INFO] Skipping QuickSight VPC Connection %s: Status=%s", vpcConnectionID, status)
+				continue
+			}
+
+			sweepResources = append(sweepResources, framework.NewSweepResource(newResourceVPCConnection, client,
+				framework.NewAttribute(names.AttrID, createVPCConnectionID(awsAccountId, vpcConnectionID)),
+				framework.NewAttribute(names.AttrAWSAccountID, awsAccountId),
+				framework.NewAttribute("vpc_connection_id", vpcConnectionID),
+			))
+		}
+
+		return !lastPage
+	})
 
 	if skipSweepError(err) {
 		log.Printf("[WARN] Skipping QuickSight VPC Connection sweep for %s: %s", region, err)
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -34,12 +35,18 @@ func resourcePrincipalAssociation() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourcePrincipalAssociationCreate,
 		ReadWithoutTimeout:   resourcePrincipalAssociationRead,
+		UpdateWithoutTimeout: resourcePrincipalAssociationUpdate,
 		DeleteWithoutTimeout: resourcePrincipalAssociationDelete,
 
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrPrincipal: {
 				Type:     schema.TypeString,
@@ -59,6 +66,15 @@ func resourcePrincipalAssociation() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"wait_for_acceptance": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -98,12 +114,13 @@ func resourcePrincipalAssociationCreate(ctx context.Context, d *schema.ResourceD
 
 	d.SetId(id)
 
-	// AWS Account ID principals need to be accepted to become ASSOCIATED.
-	if itypes.IsAWSAccountID(principal) {
+	// AWS Account ID principals need to be accepted to become ASSOCIATED,
+	// e.g. using aws_ram_resource_share_accepter.
+	if itypes.IsAWSAccountID(principal) && !d.Get("wait_for_acceptance").(bool) {
 		return append(diags, resourcePrincipalAssociationRead(ctx, d, meta)...)
 	}
 
-	if _, err := waitPrincipalAssociationCreated(ctx, conn, resourceShareARN, principal); err != nil {
+	if _, err := waitPrincipalAssociationCreated(ctx, conn, resourceShareARN, principal, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM Principal Association (%s) create: %s", d.Id(), err)
 	}
 
@@ -134,10 +151,21 @@ func resourcePrincipalAssociationRead(ctx context.Context, d *schema.ResourceDat
 
 	d.Set(names.AttrPrincipal, principalAssociation.AssociatedEntity)
 	d.Set("resource_share_arn", principalAssociation.ResourceShareArn)
+	d.Set(names.AttrStatus, principalAssociation.Status)
+	// Support in-place update of non-refreshable attribute.
+	d.Set("wait_for_acceptance", d.Get("wait_for_acceptance"))
 
 	return diags
 }
 
+func resourcePrincipalAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// wait_for_acceptance only.
+
+	return append(diags, resourcePrincipalAssociationRead(ctx, d, meta)...)
+}
+
 func resourcePrincipalAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RAMClient(ctx)
@@ -162,7 +190,7 @@ func resourcePrincipalAssociationDelete(ctx context.Context, d *schema.ResourceD
 		return sdkdiag.AppendErrorf(diags, "deleting RAM Principal Association (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitPrincipalAssociationDeleted(ctx, conn, resourceShareARN, principal); err != nil {
+	if _, err := waitPrincipalAssociationDeleted(ctx, conn, resourceShareARN, principal, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM Principal Association (%s) delete: %s", d.Id(), err)
 	}
 
@@ -208,10 +236,7 @@ func statusPrincipalAssociation(ctx context.Context, conn *ram.Client, resourceS
 	}
 }
 
-func waitPrincipalAssociationCreated(ctx context.Context, conn *ram.Client, resourceShareARN, principal string) (*awstypes.ResourceShareAssociation, error) {
-	const (
-		timeout = 3 * time.Minute
-	)
+func waitPrincipalAssociationCreated(ctx context.Context, conn *ram.Client, resourceShareARN, principal string, timeout time.Duration) (*awstypes.ResourceShareAssociation, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:        enum.Slice(awstypes.ResourceShareAssociationStatusAssociating),
 		Target:         enum.Slice(awstypes.ResourceShareAssociationStatusAssociated),
@@ -231,10 +256,7 @@ func waitPrincipalAssociationCreated(ctx context.Context, conn *ram.Client, reso
 	return nil, err
 }
 
-func waitPrincipalAssociationDeleted(ctx context.Context, conn *ram.Client, resourceShareARN, principal string) (*awstypes.ResourceShareAssociation, error) {
-	const (
-		timeout = 3 * time.Minute
-	)
+func waitPrincipalAssociationDeleted(ctx context.Context, conn *ram.Client, resourceShareARN, principal string, timeout time.Duration) (*awstypes.ResourceShareAssociation, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: enum.Slice(awstypes.ResourceShareAssociationStatusAssociated, awstypes.ResourceShareAssociationStatusDisassociating),
 		Target:  []string{},
@@ -39,6 +39,8 @@ func TestAccRAMPrincipalAssociation_basic(t *testing.T) {
 				Config: testAccPrincipalAssociationConfig_basic(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPrincipalAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ResourceShareAssociationStatusAssociated)),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_acceptance", acctest.CtFalse),
 				),
 			},
 			{
@@ -69,6 +71,7 @@ func TestAccRAMPrincipalAssociation_AccountID(t *testing.T) {
 				Config: testAccPrincipalAssociationConfig_accountID(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPrincipalAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
 				),
 			},
 			{
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
@@ -128,7 +129,7 @@ func resourceResourceShareAccepterCreate(ctx context.Context, d *schema.Resource
 	d.SetId(shareARN)
 
 	invitationARN = aws.ToString(output.ResourceShareInvitation.ResourceShareInvitationArn)
-	if _, err := waitResourceShareInvitationAccepted(ctx, conn, invitationARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitResourceShareInvitationAccepted(ctx, conn, invitationARN, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) invitation (%s) accept: %s", shareARN, invitationARN, err)
 	}
 
@@ -227,7 +228,7 @@ func resourceResourceShareAccepterDelete(ctx context.Context, d *schema.Resource
 		return sdkdiag.AppendErrorf(diags, "leaving RAM resource share: %s", err)
 	}
 
-	if _, err := waitResourceShareOwnedBySelfDisassociated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitResourceShareOwnedBySelfDisassociated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RAM Resource Share (%s) disassociate: %s", d.Id(), err)
 	}
 
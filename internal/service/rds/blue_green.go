@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -119,7 +120,7 @@ func (h *instanceHandler) precondition(ctx context.Context, d *schema.ResourceDa
 	}
 
 	if needsPreConditions {
-		err := dbInstanceModify(ctx, h.conn, d.Id(), input, d.Timeout(schema.TimeoutUpdate))
+		err := dbInstanceModify(ctx, h.conn, d.Id(), input, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("setting pre-conditions: %s", err)
 		}
@@ -24,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -41,7 +43,7 @@ const (
 // @Tags(identifierAttribute="arn")
 // @Testing(tagsTest=false)
 func ResourceCluster() *schema.Resource {
-	return &schema.Resource{
+	r := &schema.Resource{
 		CreateWithoutTimeout: resourceClusterCreate,
 		ReadWithoutTimeout:   resourceClusterRead,
 		UpdateWithoutTimeout: resourceClusterUpdate,
@@ -57,7 +59,7 @@ func ResourceCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
 
-		SchemaVersion: 1,
+		SchemaVersion: 2,
 		StateUpgraders: []schema.StateUpgrader{
 			{
 				Type:    resourceClusterResourceV0().CoreConfigSchema().ImpliedType(),
@@ -228,9 +230,10 @@ func ResourceCluster() *schema.Resource {
 				ValidateFunc: validation.StringInSlice(EngineMode_Values(), false),
 			},
 			names.AttrEngineVersion: {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: sdkv2.SuppressEquivalentEngineVersions,
 			},
 			"engine_version_actual": {
 				Type:     schema.TypeString,
@@ -286,7 +289,7 @@ func ResourceCluster() *schema.Resource {
 			"manage_master_user_password": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"master_password"},
+				ConflictsWith: []string{"master_password", "master_password_wo"},
 			},
 			"master_user_secret": {
 				Type:     schema.TypeList,
@@ -318,8 +321,10 @@ func ResourceCluster() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"manage_master_user_password"},
+				ConflictsWith: []string{"manage_master_user_password", "master_password_wo"},
 			},
+			"master_password_wo":         sdkv2.WriteOnlyStringSchema("master_password_wo", nil, "manage_master_user_password", "master_password"),
+			"master_password_wo_version": sdkv2.WriteOnlyVersionSchema("master_password_wo"),
 			"master_username": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -527,10 +532,11 @@ func ResourceCluster() *schema.Resource {
 				ForceNew: true,
 			},
 			names.AttrStorageEncrypted: {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
-				ForceNew: true,
+				Type:         nullable.TypeNullableBool,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: nullable.ValidateTypeStringNullableBool,
 			},
 			names.AttrStorageType: {
 				Type:     schema.TypeString,
@@ -549,6 +555,7 @@ func ResourceCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			customizeDiffEngineVersionDowngrade,
 			customdiff.ForceNewIf(names.AttrStorageType, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				// Aurora supports mutation of the storage_type parameter, other engines do not
 				return !strings.HasPrefix(d.Get(names.AttrEngine).(string), "aurora")
@@ -570,6 +577,12 @@ func ResourceCluster() *schema.Resource {
 			},
 		),
 	}
+
+	// Schema version 1 stored storage_encrypted as a bool. It is now a nullable bool
+	// so that an explicit false in configuration is distinguished from an unset value.
+	r.StateUpgraders = append(r.StateUpgraders, nullable.BoolStateUpgrader(1, r, names.AttrStorageEncrypted))
+
+	return r
 }
 
 func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -658,6 +671,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			requiresModifyDbCluster = true
 		}
 
+		if v, ok := sdkv2.WriteOnlyString(d, "master_password_wo"); ok {
+			modifyDbClusterInput.MasterUserPassword = aws.String(v)
+			requiresModifyDbCluster = true
+		}
+
 		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
 			modifyDbClusterInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
 			requiresModifyDbCluster = true
@@ -790,6 +808,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.MasterUserPassword = aws.String(v.(string))
 		}
 
+		if v, ok := sdkv2.WriteOnlyString(d, "master_password_wo"); ok {
+			input.MasterUserPassword = aws.String(v)
+		}
+
 		if v, ok := d.GetOk("network_type"); ok {
 			input.NetworkType = aws.String(v.(string))
 		}
@@ -806,8 +828,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.PreferredMaintenanceWindow = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOkExists(names.AttrStorageEncrypted); ok {
-			input.StorageEncrypted = aws.Bool(v.(bool))
+		if v, ok := d.GetOk(names.AttrStorageEncrypted); ok {
+			if v, null, _ := nullable.Bool(v.(string)).ValueBool(); !null {
+				input.StorageEncrypted = aws.Bool(v)
+			}
 		}
 
 		if v, ok := d.GetOk(names.AttrVPCSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
@@ -908,6 +932,11 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			requiresModifyDbCluster = true
 		}
 
+		if v, ok := sdkv2.WriteOnlyString(d, "master_password_wo"); ok {
+			modifyDbClusterInput.MasterUserPassword = aws.String(v)
+			requiresModifyDbCluster = true
+		}
+
 		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
 			modifyDbClusterInput.MasterUserSecretKmsKeyId = aws.String(v.(string))
 			requiresModifyDbCluster = true
@@ -1060,6 +1089,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 		if v, ok := d.GetOk("master_password"); ok {
 			input.MasterUserPassword = aws.String(v.(string))
 		}
+
+		if v, ok := sdkv2.WriteOnlyString(d, "master_password_wo"); ok {
+			input.MasterUserPassword = aws.String(v)
+		}
 		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
 			input.MasterUserSecretKmsKeyId = aws.String(v.(string))
 		}
@@ -1100,8 +1133,10 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			input.SourceRegion = aws.String(v.(string))
 		}
 
-		if v, ok := d.GetOkExists(names.AttrStorageEncrypted); ok {
-			input.StorageEncrypted = aws.Bool(v.(bool))
+		if v, ok := d.GetOk(names.AttrStorageEncrypted); ok {
+			if v, null, _ := nullable.Bool(v.(string)).ValueBool(); !null {
+				input.StorageEncrypted = aws.Bool(v)
+			}
 		}
 
 		if v, ok := d.GetOkExists(names.AttrStorageType); ok {
@@ -1124,7 +1159,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(identifier)
 
-	if _, err := waitDBClusterCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitDBClusterCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) create: %s", d.Id(), err)
 	}
 
@@ -1144,7 +1179,7 @@ func resourceClusterCreate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -1257,7 +1292,7 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 	} else {
 		d.Set("serverlessv2_scaling_configuration", nil)
 	}
-	d.Set(names.AttrStorageEncrypted, dbc.StorageEncrypted)
+	d.Set(names.AttrStorageEncrypted, nullable.FlattenBool(dbc.StorageEncrypted))
 	d.Set(names.AttrStorageType, dbc.StorageType)
 	var securityGroupIDs []string
 	for _, v := range dbc.VpcSecurityGroups {
@@ -1281,6 +1316,9 @@ func resourceClusterRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	// Write-only master passwords are never persisted.
+	sdkv2.ClearWriteOnly(d, "master_password_wo")
+
 	setTagsOut(ctx, dbc.TagList)
 
 	return diags
@@ -1399,6 +1437,10 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 				input.MasterUserPassword = aws.String(v.(string))
 			}
 		}
+		// The write-only master password is only sent when its version changes.
+		if v, ok := sdkv2.WriteOnlyString(d, "master_password_wo"); ok {
+			input.MasterUserPassword = aws.String(v)
+		}
 		if d.HasChange("master_user_secret_kms_key_id") {
 			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
 				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
@@ -1470,7 +1512,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -1573,7 +1615,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		func(err error) (bool, error) {
 			if tfawserr.ErrMessageContains(err, "InvalidParameterCombination", "disable deletion pro") {
 				if v, ok := d.GetOk(names.AttrDeletionProtection); (!ok || !v.(bool)) && d.Get(names.AttrApplyImmediately).(bool) {
-					_, err := tfresource.RetryWhen(ctx, d.Timeout(schema.TimeoutDelete),
+					_, err := tfresource.RetryWhen(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete),
 						func() (interface{}, error) {
 							return conn.ModifyDBClusterWithContext(ctx, &rds.ModifyDBClusterInput{
 								ApplyImmediately:    aws.Bool(true),
@@ -1597,7 +1639,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 						return false, fmt.Errorf("modifying RDS Cluster (%s) DeletionProtection=false: %s", d.Id(), err)
 					}
 
-					if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+					if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 						return false, fmt.Errorf("waiting for RDS Cluster (%s) update: %s", d.Id(), err)
 					}
 				}
@@ -1625,7 +1667,7 @@ func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "deleting RDS Cluster (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitDBClusterDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitDBClusterDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) delete: %s", d.Id(), err)
 	}
 
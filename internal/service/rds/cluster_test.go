@@ -43,6 +43,7 @@ func testAccClusterImportStep(n string) resource.TestStep {
 			"enable_local_write_forwarding",
 			"manage_master_user_password",
 			"master_password",
+			"master_password_wo_version",
 			"master_user_secret_kms_key_id",
 			"skip_final_snapshot",
 		},
@@ -127,6 +128,41 @@ func TestAccRDSCluster_disappears(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_masterPasswordWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dbCluster rds.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "avoid-plaintext-passwords", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "master_password", ""),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", acctest.Ct1),
+				),
+			},
+			testAccClusterImportStep(resourceName),
+			{
+				Config: testAccClusterConfig_masterPasswordWriteOnly(rName, "avoid-plaintext-passwords-2", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &dbCluster),
+					resource.TestCheckResourceAttr(resourceName, "master_password", ""),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo", ""),
+					resource.TestCheckResourceAttr(resourceName, "master_password_wo_version", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_identifierGenerated(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v rds.DBCluster
@@ -2773,6 +2809,21 @@ resource "aws_rds_cluster" "test" {
 `, rName, tfrds.ClusterEngineAuroraMySQL)
 }
 
+func testAccClusterConfig_masterPasswordWriteOnly(rName, password string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier         = %[1]q
+  database_name              = "test"
+  engine                     = %[2]q
+  master_username            = "tfacctest"
+  master_password_wo         = %[3]q
+  master_password_wo_version = %[4]d
+  apply_immediately          = true
+  skip_final_snapshot        = true
+}
+`, rName, tfrds.ClusterEngineAuroraMySQL, password, version)
+}
+
 func testAccClusterConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
@@ -47,8 +47,17 @@ func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	input := &rds.DescribeDBClustersInput{}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).RDSFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return create.AppendDiagError(diags, names.RDS, create.ErrActionReading, DSNameClusters, "", err)
+		}
+
+		input.Filters = filters.RDSFilters()
+		clientSideFilters = clientSide
 	}
 
 	var clusterArns []string
@@ -64,6 +73,15 @@ func dataSourceClustersRead(ctx context.Context, d *schema.ResourceData, meta in
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(dbCluster.DBClusterIdentifier), map[string][]string{
+				"clone-group-id":         {aws.StringValue(dbCluster.CloneGroupId)},
+				"db-cluster-id":          {aws.StringValue(dbCluster.DBClusterIdentifier), aws.StringValue(dbCluster.DBClusterArn)},
+				"db-cluster-resource-id": {aws.StringValue(dbCluster.DbClusterResourceId)},
+				names.AttrEngine:         {aws.StringValue(dbCluster.Engine)},
+			}) {
+				continue
+			}
+
 			clusterArns = append(clusterArns, aws.StringValue(dbCluster.DBClusterArn))
 			clusterIdentifiers = append(clusterIdentifiers, aws.StringValue(dbCluster.DBClusterIdentifier))
 		}
@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -156,6 +157,17 @@ func DataSourceEngineVersion() *schema.Resource {
 				Computed: true,
 			},
 
+			"upgrade_path": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+
+			"upgrade_targets_recursive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
 			"valid_major_targets": {
 				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
@@ -209,8 +221,17 @@ func dataSourceEngineVersionRead(ctx context.Context, d *schema.ResourceData, me
 		input.Engine = aws.String(v.(string))
 	}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).RDSFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.RDSFilters()
+		clientSideFilters = clientSide
 	}
 
 	if v, ok := d.GetOk("parameter_group_family"); ok {
@@ -253,6 +274,16 @@ func dataSourceEngineVersionRead(ctx context.Context, d *schema.ResourceData, me
 				continue
 			}
 
+			if !clientSideFilters.Match(aws.StringValue(engineVersion.EngineVersion), map[string][]string{
+				"db-parameter-group-family": {aws.StringValue(engineVersion.DBParameterGroupFamily)},
+				names.AttrEngine:            {aws.StringValue(engineVersion.Engine)},
+				"engine-mode":               aws.StringValueSlice(engineVersion.SupportedEngineModes),
+				"engine-version":            {aws.StringValue(engineVersion.EngineVersion)},
+				names.AttrStatus:            {aws.StringValue(engineVersion.Status)},
+			}) {
+				continue
+			}
+
 			engineVersions = append(engineVersions, engineVersion)
 		}
 		return !lastPage
@@ -464,6 +495,18 @@ func dataSourceEngineVersionRead(ctx context.Context, d *schema.ResourceData, me
 	d.Set("valid_minor_targets", minorTargets)
 	d.Set("valid_major_targets", majorTargets)
 
+	if v, ok := d.GetOk("upgrade_targets_recursive"); ok && v.(bool) {
+		path, err := findEngineVersionUpgradePath(ctx, conn, found)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading RDS engine version (%s) upgrade path: %s", aws.StringValue(found.EngineVersion), err)
+		}
+
+		d.Set("upgrade_path", path)
+	} else {
+		d.Set("upgrade_path", nil)
+	}
+
 	d.Set(names.AttrVersion, found.EngineVersion)
 	d.Set("version_actual", found.EngineVersion)
 	d.Set("version_description", found.DBEngineVersionDescription)
@@ -481,6 +524,86 @@ func sortEngineVersions(engineVersions []*rds.DBEngineVersion) {
 	})
 }
 
+// findEngineVersionUpgradePath returns the shortest sequence of minor version upgrades leading from
+// the specified engine version to the latest engine version reachable without a major version upgrade.
+// The specified version is not included in the path. An empty path means that the version is already the latest.
+func findEngineVersionUpgradePath(ctx context.Context, conn *rds.RDS, start *rds.DBEngineVersion) ([]string, error) {
+	engine := aws.StringValue(start.Engine)
+	startVersion := aws.StringValue(start.EngineVersion)
+
+	nodes := map[string]*rds.DBEngineVersion{startVersion: start}
+	parents := map[string]string{}
+	queue := []string{startVersion}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, upgradeTarget := range nodes[current].ValidUpgradeTarget {
+			if aws.BoolValue(upgradeTarget.IsMajorVersionUpgrade) {
+				continue
+			}
+
+			target := aws.StringValue(upgradeTarget.EngineVersion)
+
+			if _, ok := nodes[target]; ok {
+				continue
+			}
+
+			engineVersion, err := findEngineVersionByTwoPartKey(ctx, conn, engine, target)
+
+			// Upgrade targets that are no longer offered are dead ends.
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			nodes[target] = engineVersion
+			parents[target] = current
+			queue = append(queue, target)
+		}
+	}
+
+	engineVersions := make([]*rds.DBEngineVersion, 0, len(nodes))
+	for _, v := range nodes {
+		engineVersions = append(engineVersions, v)
+	}
+	sortEngineVersions(engineVersions)
+
+	var path []string
+	for v := aws.StringValue(engineVersions[len(engineVersions)-1].EngineVersion); v != startVersion; v = parents[v] {
+		path = append([]string{v}, path...)
+	}
+
+	return path, nil
+}
+
+func findEngineVersionByTwoPartKey(ctx context.Context, conn *rds.RDS, engine, engineVersion string) (*rds.DBEngineVersion, error) {
+	input := &rds.DescribeDBEngineVersionsInput{
+		Engine:        aws.String(engine),
+		EngineVersion: aws.String(engineVersion),
+	}
+
+	output, err := conn.DescribeDBEngineVersionsWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output != nil {
+		for _, v := range output.DBEngineVersions {
+			if v != nil && aws.StringValue(v.EngineVersion) == engineVersion {
+				return v, nil
+			}
+		}
+	}
+
+	return nil, tfresource.NewEmptyResultError(input)
+}
+
 // criteriaSet returns true if any of the given criteria are set. "set" means that, in the config,
 // a bool is set and true, a list is set and not empty, or a string is set and not empty.
 func criteriaSet(d *schema.ResourceData, args []string) bool {
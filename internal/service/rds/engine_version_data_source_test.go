@@ -80,6 +80,28 @@ func TestAccRDSEngineVersionDataSource_upgradeTargets(t *testing.T) {
 	})
 }
 
+func TestAccRDSEngineVersionDataSource_upgradeTargetsRecursive(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_rds_engine_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccEngineVersionPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEngineVersionDataSourceConfig_upgradeTargetsRecursive(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "upgrade_path.#", regexache.MustCompile(`^[1-9][0-9]*`)),
+					resource.TestMatchResourceAttr(dataSourceName, "upgrade_path.0", regexache.MustCompile(`^8\.0\.`)),
+					resource.TestCheckResourceAttr(dataSourceName, "version_actual", "8.0.32"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSEngineVersionDataSource_preferred(t *testing.T) {
 	ctx := acctest.Context(t)
 	dataSourceName := "data.aws_rds_engine_version.test"
@@ -426,6 +448,16 @@ data "aws_rds_engine_version" "test" {
 `, tfrds.InstanceEngineMySQL)
 }
 
+func testAccEngineVersionDataSourceConfig_upgradeTargetsRecursive() string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine                    = %[1]q
+  version                   = "8.0.32"
+  upgrade_targets_recursive = true
+}
+`, tfrds.InstanceEngineMySQL)
+}
+
 func testAccEngineVersionDataSourceConfig_preferred() string {
 	return fmt.Sprintf(`
 data "aws_rds_engine_version" "test" {
@@ -46,8 +46,17 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 
 	input := &rds.DescribeDBInstancesInput{}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).RDSFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.RDSFilters()
+		clientSideFilters = clientSide
 	}
 
 	filter := tfslices.PredicateTrue[*rds.DBInstance]()
@@ -56,6 +65,14 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 			return KeyValueTags(ctx, x.TagList).ContainsAll(tftags.New(ctx, v.(map[string]interface{})))
 		}
 	}
+	filter = tfslices.PredicateAnd(filter, func(x *rds.DBInstance) bool {
+		return clientSideFilters.Match(aws.StringValue(x.DBInstanceIdentifier), map[string][]string{
+			"db-cluster-id":   {aws.StringValue(x.DBClusterIdentifier)},
+			"db-instance-id":  {aws.StringValue(x.DBInstanceIdentifier), aws.StringValue(x.DBInstanceArn)},
+			"dbi-resource-id": {aws.StringValue(x.DbiResourceId)},
+			names.AttrEngine:  {aws.StringValue(x.Engine)},
+		})
+	})
 
 	instances, err := findDBInstancesSDKv1(ctx, conn, input, filter)
 
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -130,7 +131,7 @@ func resourceProxyEndpointCreate(ctx context.Context, d *schema.ResourceData, me
 
 	d.SetId(id)
 
-	if _, err := waitDBProxyEndpointAvailable(ctx, conn, dbProxyName, dbProxyEndpointName, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitDBProxyEndpointAvailable(ctx, conn, dbProxyName, dbProxyEndpointName, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Proxy Endpoint (%s) create: %s", d.Id(), err)
 	}
 
@@ -192,7 +193,7 @@ func resourceProxyEndpointUpdate(ctx context.Context, d *schema.ResourceData, me
 			return sdkdiag.AppendErrorf(diags, "updating RDS DB Proxy Endpoint (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitDBProxyEndpointAvailable(ctx, conn, dbProxyName, dbProxyEndpointName, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitDBProxyEndpointAvailable(ctx, conn, dbProxyName, dbProxyEndpointName, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Proxy Endpoint (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -222,7 +223,7 @@ func resourceProxyEndpointDelete(ctx context.Context, d *schema.ResourceData, me
 		return sdkdiag.AppendErrorf(diags, "deleting RDS DB Proxy Endpoint (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitDBProxyEndpointDeleted(ctx, conn, dbProxyName, dbProxyEndpointName, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitDBProxyEndpointDeleted(ctx, conn, dbProxyName, dbProxyEndpointName, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Proxy Endpoint (%s) delete: %s", d.Id(), err)
 	}
 
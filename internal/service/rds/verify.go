@@ -4,7 +4,11 @@
 package rds
 
 import (
+	"context"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -35,3 +39,14 @@ func compareActualEngineVersion(d *schema.ResourceData, oldVersion, newVersion,
 
 	d.Set(names.AttrEngineVersion, newVersion)
 }
+
+// customizeDiffEngineVersionDowngrade fails planning if `engine_version` is downgraded, which RDS does not support.
+// Changing to a configured version that omits the patch value of the current version (ie. old="1.3.27", new="1.3")
+// is not a downgrade.
+func customizeDiffEngineVersionDowngrade(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if o, n := d.GetChange(names.AttrEngineVersion); strings.HasPrefix(o.(string), n.(string)+".") {
+		return nil
+	}
+
+	return sdkv2.ErrorIfEngineVersionDowngrade(names.AttrEngineVersion)(ctx, d, meta)
+}
@@ -5,6 +5,7 @@ package redshiftdata
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"log"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -45,6 +47,30 @@ func resourceStatement() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"column_metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nullable": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrDatabase: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -55,6 +81,10 @@ func resourceStatement() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"has_result_set": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 			names.AttrParameters: {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -75,6 +105,50 @@ func resourceStatement() *schema.Resource {
 					},
 				},
 			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fields": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"blob_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"boolean_value": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"double_value": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"is_null": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+									"long_value": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"string_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"result_rows": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"secret_arn": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -147,10 +221,32 @@ func resourceStatementCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.SetId(aws.ToString(output.Id))
 
-	if _, err := waitStatementFinished(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	statement, err := waitStatementFinished(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
+
+	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Data Statement (%s) finish: %s", d.Id(), err)
 	}
 
+	// The result set is only available for a limited time after the statement finishes,
+	// so it is fetched once here and kept in state.
+	d.Set("has_result_set", statement.HasResultSet)
+	d.Set("result_rows", statement.ResultRows)
+
+	if aws.ToBool(statement.HasResultSet) {
+		result, err := findStatementResultByID(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Redshift Data Statement (%s) result: %s", d.Id(), err)
+		}
+
+		if err := d.Set("column_metadata", flattenColumnMetadata(result.ColumnMetadata)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting column_metadata: %s", err)
+		}
+		if err := d.Set("records", flattenRecords(result.Records)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
+		}
+	}
+
 	return append(diags, resourceStatementRead(ctx, d, meta)...)
 }
 
@@ -260,6 +356,98 @@ func waitStatementFinished(ctx context.Context, conn *redshiftdata.Client, id st
 	return nil, err
 }
 
+// findStatementResultByID fetches every page of a finished statement's result set.
+// Column metadata is only returned on the first page.
+func findStatementResultByID(ctx context.Context, conn *redshiftdata.Client, id string) (*redshiftdata.GetStatementResultOutput, error) {
+	input := &redshiftdata.GetStatementResultInput{
+		Id: aws.String(id),
+	}
+	output := &redshiftdata.GetStatementResultOutput{}
+
+	pages := redshiftdata.NewGetStatementResultPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(output.ColumnMetadata) == 0 {
+			output.ColumnMetadata = page.ColumnMetadata
+		}
+		output.Records = append(output.Records, page.Records...)
+		output.TotalNumRows = page.TotalNumRows
+	}
+
+	if output.ColumnMetadata == nil && output.Records == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenColumnMetadata(apiObjects []types.ColumnMetadata) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"label":        aws.ToString(apiObject.Label),
+			names.AttrName: aws.ToString(apiObject.Name),
+			"nullable":     int(apiObject.Nullable),
+			"type_name":    aws.ToString(apiObject.TypeName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenRecords(apiObjects [][]types.Field) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var fields []interface{}
+
+		for _, field := range apiObject {
+			fields = append(fields, flattenField(field))
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"fields": fields,
+		})
+	}
+
+	return tfList
+}
+
+func flattenField(apiObject types.Field) map[string]interface{} {
+	tfMap := map[string]interface{}{}
+
+	switch v := apiObject.(type) {
+	case *types.FieldMemberBlobValue:
+		tfMap["blob_value"] = base64.StdEncoding.EncodeToString(v.Value)
+	case *types.FieldMemberBooleanValue:
+		tfMap["boolean_value"] = v.Value
+	case *types.FieldMemberDoubleValue:
+		tfMap["double_value"] = v.Value
+	case *types.FieldMemberIsNull:
+		tfMap["is_null"] = v.Value
+	case *types.FieldMemberLongValue:
+		tfMap["long_value"] = v.Value
+	case *types.FieldMemberStringValue:
+		tfMap["string_value"] = v.Value
+	}
+
+	return tfMap
+}
+
 func expandParameter(tfMap map[string]interface{}) *types.SqlParameter {
 	if tfMap == nil {
 		return nil
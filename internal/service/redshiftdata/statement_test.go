@@ -44,7 +44,7 @@ func TestAccRedshiftDataStatement_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrDatabase, "db_user"},
+				ImportStateVerifyIgnore: []string{"column_metadata", names.AttrDatabase, "db_user", "has_result_set", "records", "result_rows"},
 			},
 		},
 	})
@@ -70,13 +70,45 @@ func TestAccRedshiftDataStatement_workgroup(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct0),
 					resource.TestCheckResourceAttr(resourceName, "sql", "CREATE GROUP group_name;"),
 					resource.TestCheckResourceAttrPair(resourceName, "workgroup_name", "aws_redshiftserverless_workgroup.test", "workgroup_name"),
+					resource.TestCheckResourceAttr(resourceName, "has_result_set", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "records.#", acctest.Ct0),
 				),
 			},
 			{
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{names.AttrDatabase, "db_user"},
+				ImportStateVerifyIgnore: []string{"column_metadata", names.AttrDatabase, "db_user", "has_result_set", "records", "result_rows"},
+			},
+		},
+	})
+}
+
+func TestAccRedshiftDataStatement_resultSet(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v redshiftdata.DescribeStatementOutput
+	resourceName := "aws_redshiftdata_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatementConfig_resultSet(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStatementExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "has_result_set", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "column_metadata.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "column_metadata.0.name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "column_metadata.1.name", "label"),
+					resource.TestCheckResourceAttr(resourceName, "records.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "records.0.fields.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "records.0.fields.0.long_value", "42"),
+					resource.TestCheckResourceAttr(resourceName, "records.0.fields.1.string_value", "answer"),
+				),
 			},
 		},
 	})
@@ -144,3 +176,22 @@ resource "aws_redshiftdata_statement" "test" {
 }
 `, rName)
 }
+
+func testAccStatementConfig_resultSet(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftdata_statement" "test" {
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  sql            = "SELECT 42::bigint AS id, 'answer' AS label;"
+}
+`, rName)
+}
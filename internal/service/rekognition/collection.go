@@ -30,7 +30,7 @@ import (
 )
 
 // @FrameworkResource(name="Collection")
-// @Tags(identifierAttribute="arn")
+// @Tags(identifierAttribute="arn", resourceIgnoreTags=true)
 func newResourceCollection(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCollection{}
 	r.SetDefaultCreateTimeout(2 * time.Minute)
@@ -87,6 +87,7 @@ func (r *resourceCollection) Schema(ctx context.Context, req resource.SchemaRequ
 	s.Blocks[names.AttrTimeouts] = timeouts.Block(ctx, timeouts.Opts{
 		Create: true,
 	})
+	s.Blocks["ignore_tags"] = framework.IgnoreTagsBlock()
 
 	resp.Schema = s
 }
@@ -244,6 +245,7 @@ type resourceCollectionData struct {
 	CollectionID     types.String   `tfsdk:"collection_id"`
 	FaceModelVersion types.String   `tfsdk:"face_model_version"`
 	ID               types.String   `tfsdk:"id"`
+	IgnoreTags       types.List     `tfsdk:"ignore_tags"`
 	Tags             types.Map      `tfsdk:"tags"`
 	TagsAll          types.Map      `tfsdk:"tags_all"`
 	Timeouts         timeouts.Value `tfsdk:"timeouts"`
@@ -147,6 +147,40 @@ func TestAccRekognitionCollection_tags(t *testing.T) {
 	})
 }
 
+func TestAccRekognitionCollection_ignoreTags(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rekognition_collection.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.RekognitionEndpointID)
+			testAccCollectionPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.RekognitionServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCollectionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCollectionConfig_ignoreTags(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCollectionExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", acctest.Ct1),
+					resource.TestCheckNoResourceAttr(resourceName, "tags_all.backup:plan"),
+				),
+			},
+			{
+				Config:   testAccCollectionConfig_ignoreTags(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckCollectionExists(ctx context.Context, name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -229,3 +263,28 @@ resource "aws_rekognition_collection" "test" {
 }
 `, rName, tags)
 }
+
+func testAccCollectionConfig_ignoreTags(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      "backup:plan" = "daily"
+    }
+  }
+}
+
+resource "aws_rekognition_collection" "test" {
+  collection_id = %[1]q
+
+  tags = {
+    key1 = "value1"
+  }
+
+  ignore_tags {
+    key_regexes = ["^backup:"]
+  }
+}
+`, rName)
+}
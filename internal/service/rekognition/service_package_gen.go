@@ -25,6 +25,7 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Name:    "Collection",
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
+				ResourceIgnoreTags:  true,
 			},
 		},
 		{
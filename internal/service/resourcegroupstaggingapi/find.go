@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+// FindResourceARNsByTags returns the ARNs of all resources of the specified type
+// (e.g. "ec2" or "ec2:instance") that have all of the specified tags.
+func FindResourceARNsByTags(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceType string, tags tftags.KeyValueTags) ([]string, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{resourceType},
+	}
+
+	for k, v := range tags.Map() {
+		input.TagFilters = append(input.TagFilters, types.TagFilter{
+			Key:    aws.String(k),
+			Values: []string{v},
+		})
+	}
+
+	var output []string
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			output = append(output, aws.ToString(v.ResourceARN))
+		}
+	}
+
+	return output, nil
+}
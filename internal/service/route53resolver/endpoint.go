@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -74,6 +75,12 @@ func ResourceEndpoint() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"ipv6": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IsIPv6Address,
+						},
 						names.AttrSubnetID: {
 							Type:     schema.TypeString,
 							Required: true,
@@ -87,6 +94,19 @@ func ResourceEndpoint() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validResolverName,
 			},
+			"outpost_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				RequiredWith: []string{"preferred_instance_type"},
+			},
+			"preferred_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"outpost_arn"},
+			},
 			"resolver_endpoint_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -142,6 +162,14 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 		input.Name = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("outpost_arn"); ok {
+		input.OutpostArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preferred_instance_type"); ok {
+		input.PreferredInstanceType = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("protocols"); ok && v.(*schema.Set).Len() > 0 {
 		input.Protocols = flex.ExpandStringSet(v.(*schema.Set))
 	}
@@ -158,7 +186,7 @@ func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.StringValue(output.ResolverEndpoint.Id))
 
-	if _, err := waitEndpointCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitEndpointCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Endpoint (%s) create: %s", d.Id(), err)
 	}
 
@@ -186,6 +214,8 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta inte
 	d.Set("direction", ep.Direction)
 	d.Set("host_vpc_id", ep.HostVPCId)
 	d.Set(names.AttrName, ep.Name)
+	d.Set("outpost_arn", ep.OutpostArn)
+	d.Set("preferred_instance_type", ep.PreferredInstanceType)
 	d.Set("protocols", aws.StringValueSlice(ep.Protocols))
 	d.Set("resolver_endpoint_type", ep.ResolverEndpointType)
 	d.Set(names.AttrSecurityGroupIDs, aws.StringValueSlice(ep.SecurityGroupIds))
@@ -222,6 +252,18 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		if d.HasChange("resolver_endpoint_type") {
 			input.ResolverEndpointType = aws.String(d.Get("resolver_endpoint_type").(string))
+
+			// Any configured IPv6 addresses are assigned when converting an IPv4 endpoint.
+			// Otherwise IPv6 addresses are chosen automatically from the subnets.
+			if o, n := d.GetChange("resolver_endpoint_type"); o.(string) == route53resolver.ResolverEndpointTypeIpv4 && n.(string) != route53resolver.ResolverEndpointTypeIpv4 {
+				ipAddresses, err := findResolverEndpointIPAddressesByID(ctx, conn, d.Id())
+
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "listing Route53 Resolver Endpoint (%s) IP addresses: %s", d.Id(), err)
+				}
+
+				input.UpdateIpAddresses = expandEndpointUpdateIPAddresses(d.Get(names.AttrIPAddress).(*schema.Set), ipAddresses)
+			}
 		}
 
 		_, err := conn.UpdateResolverEndpointWithContext(ctx, input)
@@ -230,7 +272,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating Route53 Resolver Endpoint (%s): %s", d.Id(), err)
 		}
 
-		if _, err := waitEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitEndpointUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Endpoint (%s) update: %s", d.Id(), err)
 		}
 	}
@@ -255,7 +297,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "associating Route53 Resolver Endpoint (%s) IP address: %s", d.Id(), err)
 			}
 
-			if _, err := waitEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitEndpointUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Endpoint (%s) update: %s", d.Id(), err)
 			}
 		}
@@ -272,7 +314,7 @@ func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "disassociating Route53 Resolver Endpoint (%s) IP address: %s", d.Id(), err)
 			}
 
-			if _, err := waitEndpointUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			if _, err := waitEndpointUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Endpoint (%s) update: %s", d.Id(), err)
 			}
 		}
@@ -298,7 +340,7 @@ func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta in
 		return sdkdiag.AppendErrorf(diags, "deleting Route53 Resolver Endpoint (%s): %s", d.Id(), err)
 	}
 
-	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitEndpointDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Route53 Resolver Endpoint (%s) delete: %s", d.Id(), err)
 	}
 
@@ -453,10 +495,50 @@ func expandEndpointIPAddressUpdate(vIpAddress interface{}) *route53resolver.IpAd
 	if vIpId, ok := mIpAddress["ip_id"].(string); ok && vIpId != "" {
 		ipAddressUpdate.IpId = aws.String(vIpId)
 	}
+	if vIpv6, ok := mIpAddress["ipv6"].(string); ok && vIpv6 != "" {
+		ipAddressUpdate.Ipv6 = aws.String(vIpv6)
+	}
 
 	return ipAddressUpdate
 }
 
+// expandEndpointUpdateIPAddresses returns the IPv6 addresses to assign to an endpoint's existing IP addresses.
+// Configured IP addresses are matched to existing IP addresses by subnet and, if configured, IPv4 address.
+func expandEndpointUpdateIPAddresses(vIpAddresses *schema.Set, ipAddresses []*route53resolver.IpAddressResponse) []*route53resolver.UpdateIpAddress {
+	updateIpAddresses := []*route53resolver.UpdateIpAddress{}
+	assigned := make(map[string]bool)
+
+	for _, vIpAddress := range vIpAddresses.List() {
+		mIpAddress := vIpAddress.(map[string]interface{})
+
+		vIpv6, ok := mIpAddress["ipv6"].(string)
+		if !ok || vIpv6 == "" {
+			continue
+		}
+
+		vSubnetId, _ := mIpAddress[names.AttrSubnetID].(string)
+		vIp, _ := mIpAddress["ip"].(string)
+
+		for _, ipAddress := range ipAddresses {
+			ipID := aws.StringValue(ipAddress.IpId)
+
+			if assigned[ipID] || aws.StringValue(ipAddress.SubnetId) != vSubnetId || (vIp != "" && aws.StringValue(ipAddress.Ip) != vIp) {
+				continue
+			}
+
+			updateIpAddresses = append(updateIpAddresses, &route53resolver.UpdateIpAddress{
+				IpId: aws.String(ipID),
+				Ipv6: aws.String(vIpv6),
+			})
+			assigned[ipID] = true
+
+			break
+		}
+	}
+
+	return updateIpAddresses
+}
+
 func expandEndpointIPAddresses(vIpAddresses *schema.Set) []*route53resolver.IpAddressRequest {
 	ipAddressRequests := []*route53resolver.IpAddressRequest{}
 
@@ -471,6 +553,9 @@ func expandEndpointIPAddresses(vIpAddresses *schema.Set) []*route53resolver.IpAd
 		if vIp, ok := mIpAddress["ip"].(string); ok && vIp != "" {
 			ipAddressRequest.Ip = aws.String(vIp)
 		}
+		if vIpv6, ok := mIpAddress["ipv6"].(string); ok && vIpv6 != "" {
+			ipAddressRequest.Ipv6 = aws.String(vIpv6)
+		}
 
 		ipAddressRequests = append(ipAddressRequests, ipAddressRequest)
 	}
@@ -490,6 +575,7 @@ func flattenEndpointIPAddresses(ipAddresses []*route53resolver.IpAddressResponse
 			names.AttrSubnetID: aws.StringValue(ipAddress.SubnetId),
 			"ip":               aws.StringValue(ipAddress.Ip),
 			"ip_id":            aws.StringValue(ipAddress.IpId),
+			"ipv6":             aws.StringValue(ipAddress.Ipv6),
 		}
 
 		vIpAddresses = append(vIpAddresses, mIpAddress)
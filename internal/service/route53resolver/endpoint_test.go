@@ -190,6 +190,14 @@ func TestAccRoute53ResolverEndpoint_resolverEndpointType(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "resolver_endpoint_type", "IPV4"),
 				),
 			},
+			{
+				Config: testAccEndpointConfig_resolverEndpointType(rName, "DUALSTACK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(ctx, resourceName, &ep),
+					resource.TestCheckResourceAttr(resourceName, "resolver_endpoint_type", "DUALSTACK"),
+					resource.TestCheckResourceAttrSet(resourceName, "ip_address.0.ipv6"),
+				),
+			},
 		},
 	})
 }
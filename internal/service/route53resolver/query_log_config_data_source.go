@@ -68,8 +68,17 @@ func dataSourceQueryLogConfigRead(ctx context.Context, d *schema.ResourceData, m
 
 	input := &route53resolver.ListResolverQueryLogConfigsInput{}
 
+	var clientSideFilters *namevaluesfilters.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok && v.(*schema.Set).Len() > 0 {
-		input.Filters = namevaluesfilters.New(v.(*schema.Set)).Route53resolverFilters()
+		filters, clientSide, err := namevaluesfilters.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.Route53resolverFilters()
+		clientSideFilters = clientSide
 	}
 
 	var configs []*route53resolver.ResolverQueryLogConfig
@@ -80,6 +89,18 @@ func dataSourceQueryLogConfigRead(ctx context.Context, d *schema.ResourceData, m
 		}
 
 		for _, v := range page.ResolverQueryLogConfigs {
+			if !clientSideFilters.Match(aws.StringValue(v.Name), map[string][]string{
+				"CreatorRequestId": {aws.StringValue(v.CreatorRequestId)},
+				"DestinationArn":   {aws.StringValue(v.DestinationArn)},
+				"Id":               {aws.StringValue(v.Id)},
+				"Name":             {aws.StringValue(v.Name)},
+				"OwnerId":          {aws.StringValue(v.OwnerId)},
+				"ShareStatus":      {aws.StringValue(v.ShareStatus)},
+				"Status":           {aws.StringValue(v.Status)},
+			}) {
+				continue
+			}
+
 			if configID != "" {
 				if aws.StringValue(v.Id) == configID {
 					configs = append(configs, v)
@@ -124,7 +145,7 @@ func dataSourceQueryLogConfigRead(ctx context.Context, d *schema.ResourceData, m
 			return create.AppendDiagError(diags, names.AppConfig, create.ErrActionReading, DSNameQueryLogConfig, configID, err)
 		}
 
-		ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+		ignoreTagsConfig := meta.(*conns.AWSClient).ResourceIgnoreTagsConfig(ctx)
 		tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 		//lintignore:AWSR002
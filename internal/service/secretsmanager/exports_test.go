@@ -6,11 +6,14 @@ package secretsmanager
 // Exports for use in tests only.
 var (
 	ResourceSecret         = resourceSecret
+	ResourceSecretJSONKey  = resourceSecretJSONKey
 	ResourceSecretPolicy   = resourceSecretPolicy
 	ResourceSecretRotation = resourceSecretRotation
 	ResourceSecretVersion  = resourceSecretVersion
 
 	FindSecretByID                = findSecretByID
+	FindSecretJSONKeys            = findSecretJSONKeys
 	FindSecretPolicyByID          = findSecretPolicyByID
 	FindSecretVersionByTwoPartKey = findSecretVersionByTwoPartKey
+	PublicPolicyStatements        = publicPolicyStatements
 )
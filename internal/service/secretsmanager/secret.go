@@ -22,8 +22,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -47,11 +49,20 @@ func resourceSecret() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"deleted_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -81,18 +92,7 @@ func resourceSecret() *schema.Resource {
 				ConflictsWith: []string{names.AttrName},
 				ValidateFunc:  validSecretNamePrefix,
 			},
-			names.AttrPolicy: {
-				Type:                  schema.TypeString,
-				Optional:              true,
-				Computed:              true,
-				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
-				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
-			},
+			names.AttrPolicy: sdkv2.IAMResourcePolicyDocumentSchemaOptionalComputed(),
 			"recovery_window_in_days": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -147,6 +147,15 @@ func resourceSecret() *schema.Resource {
 					},
 				},
 			},
+			"restore_on_read": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"scheduled_deletion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
@@ -206,6 +215,12 @@ func resourceSecretCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret (%s) create: %s", d.Id(), err)
 	}
 
+	if len(input.AddReplicaRegions) > 0 {
+		if _, err := waitSecretReplicasInSync(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret (%s) replicas create: %s", d.Id(), err)
+		}
+	}
+
 	if v, ok := d.GetOk(names.AttrPolicy); ok && v.(string) != "" && v.(string) != "{}" {
 		policy, err := structure.NormalizeJsonString(v.(string))
 		if err != nil {
@@ -229,7 +244,9 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, meta interf
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
 
-	output, err := findSecretByID(ctx, conn, d.Id())
+	output, err := findSecret(ctx, conn, &secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(d.Id()),
+	})
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Secrets Manager Secret (%s) not found, removing from state", d.Id())
@@ -241,7 +258,30 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, meta interf
 		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s): %s", d.Id(), err)
 	}
 
+	if v := output.DeletedDate; v != nil {
+		if d.IsNewResource() {
+			return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s): scheduled for deletion", d.Id())
+		}
+
+		if !d.Get("restore_on_read").(bool) {
+			log.Printf("[WARN] Secrets Manager Secret (%s) scheduled for deletion on %s, removing from state", d.Id(), aws.ToTime(v).Format(time.RFC3339))
+			d.SetId("")
+			return diags
+		}
+
+		if output, err = restoreSecret(ctx, conn, d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		diags = sdkdiag.AppendWarningf(diags, "Secrets Manager Secret (%s) was scheduled for deletion on %s and has been restored", d.Id(), aws.ToTime(v).Format(time.RFC3339))
+	}
+
 	d.Set(names.AttrARN, output.ARN)
+	if v := output.DeletedDate; v != nil {
+		d.Set("deleted_date", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("deleted_date", nil)
+	}
 	d.Set(names.AttrDescription, output.Description)
 	d.Set(names.AttrKMSKeyID, output.KmsKeyId)
 	d.Set(names.AttrName, output.Name)
@@ -249,6 +289,11 @@ func resourceSecretRead(ctx context.Context, d *schema.ResourceData, meta interf
 	if err := d.Set("replica", flattenReplicationStatusTypes(output.ReplicationStatus)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting replica: %s", err)
 	}
+	if v := output.DeletedDate; v != nil {
+		d.Set("scheduled_deletion_date", aws.ToTime(v).Format(time.RFC3339))
+	} else {
+		d.Set("scheduled_deletion_date", nil)
+	}
 
 	var policy *secretsmanager.GetResourcePolicyOutput
 	err = tfresource.Retry(ctx, PropagationTimeout, func() *retry.RetryError {
@@ -308,6 +353,10 @@ func resourceSecretUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			if err := addSecretReplicas(ctx, conn, d.Id(), d.Get("force_overwrite_replica_secret").(bool), expandReplicaRegionTypes(add)); err != nil {
 				return sdkdiag.AppendFromErr(diags, err)
 			}
+
+			if _, err := waitSecretReplicasInSync(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret (%s) replicas update: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -381,6 +430,11 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, meta inte
 		return diags
 	}
 
+	// The secret is already scheduled for deletion.
+	if errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "marked for deletion") {
+		return diags
+	}
+
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Secrets Manager Secret (%s): %s", d.Id(), err)
 	}
@@ -396,6 +450,27 @@ func resourceSecretDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func restoreSecret(ctx context.Context, conn *secretsmanager.Client, id string) (*secretsmanager.DescribeSecretOutput, error) {
+	input := &secretsmanager.RestoreSecretInput{
+		SecretId: aws.String(id),
+	}
+
+	log.Printf("[DEBUG] Restoring Secrets Manager Secret: %s", id)
+	if _, err := conn.RestoreSecret(ctx, input); err != nil {
+		return nil, fmt.Errorf("restoring Secrets Manager Secret (%s): %w", id, err)
+	}
+
+	outputRaw, err := tfresource.RetryWhenNotFound(ctx, PropagationTimeout, func() (interface{}, error) {
+		return findSecretByID(ctx, conn, id)
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("waiting for Secrets Manager Secret (%s) restore: %w", id, err)
+	}
+
+	return outputRaw.(*secretsmanager.DescribeSecretOutput), nil
+}
+
 func addSecretReplicas(ctx context.Context, conn *secretsmanager.Client, id string, forceOverwrite bool, replicas []types.ReplicaRegionType) error {
 	if len(replicas) == 0 {
 		return nil
@@ -508,6 +583,60 @@ func findSecretByID(ctx context.Context, conn *secretsmanager.Client, id string)
 	return output, nil
 }
 
+// statusSecretReplicas returns the aggregate replication status of the secret's replicas.
+// A single failed replica fails the whole secret, otherwise any in-progress replica keeps the secret in progress.
+func statusSecretReplicas(ctx context.Context, conn *secretsmanager.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findSecretByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := types.StatusTypeInSync
+		for _, v := range output.ReplicationStatus {
+			switch v.Status {
+			case types.StatusTypeFailed:
+				return output, string(types.StatusTypeFailed), nil
+			case types.StatusTypeInProgress:
+				status = types.StatusTypeInProgress
+			}
+		}
+
+		return output, string(status), nil
+	}
+}
+
+func waitSecretReplicasInSync(ctx context.Context, conn *secretsmanager.Client, id string, timeout time.Duration) (*secretsmanager.DescribeSecretOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(types.StatusTypeInProgress),
+		Target:  enum.Slice(types.StatusTypeInSync),
+		Refresh: statusSecretReplicas(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*secretsmanager.DescribeSecretOutput); ok {
+		var failures []error
+		for _, v := range output.ReplicationStatus {
+			if v.Status == types.StatusTypeFailed {
+				failures = append(failures, fmt.Errorf("%s: %s", aws.ToString(v.Region), aws.ToString(v.StatusMessage)))
+			}
+		}
+		tfresource.SetLastError(err, errors.Join(failures...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func expandReplicaRegionType(tfMap map[string]interface{}) *types.ReplicaRegionType {
 	if tfMap == nil {
 		return nil
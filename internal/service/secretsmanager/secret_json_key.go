@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_secretsmanager_secret_json_key", name="Secret JSON Key")
+func resourceSecretJSONKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecretJSONKeyCreate,
+		ReadWithoutTimeout:   resourceSecretJSONKeyRead,
+		UpdateWithoutTimeout: resourceSecretJSONKeyUpdate,
+		DeleteWithoutTimeout: resourceSecretJSONKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 4096),
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrValue: {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSecretJSONKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, key := d.Get("secret_id").(string), d.Get(names.AttrKey).(string)
+	value := d.Get(names.AttrValue).(string)
+	resourceID := secretJSONKeyCreateResourceID(secretID, key)
+
+	if err := modifySecretJSONKeys(ctx, conn, secretID, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func(m map[string]json.RawMessage) (bool, error) {
+		if _, ok := m[key]; ok {
+			return false, errors.New("key already exists in secret value; import it to manage it with Terraform")
+		}
+
+		return setSecretJSONKey(m, key, value)
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Secrets Manager Secret JSON Key (%s): %s", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return append(diags, resourceSecretJSONKeyRead(ctx, d, meta)...)
+}
+
+func resourceSecretJSONKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, key, err := secretJSONKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, m, err := findSecretJSONKeys(ctx, conn, secretID)
+
+	var value json.RawMessage
+	if err == nil {
+		var ok bool
+		if value, ok = m[key]; !ok {
+			err = &retry.NotFoundError{
+				Message: fmt.Sprintf("key %q not found in secret value", key),
+			}
+		}
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Secrets Manager Secret JSON Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret JSON Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrKey, key)
+	d.Set("secret_id", secretID)
+	d.Set(names.AttrValue, flattenSecretJSONValue(value))
+	d.Set("version_id", output.VersionId)
+
+	return diags
+}
+
+func resourceSecretJSONKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, key, err := secretJSONKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	value := d.Get(names.AttrValue).(string)
+
+	if err := modifySecretJSONKeys(ctx, conn, secretID, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), func(m map[string]json.RawMessage) (bool, error) {
+		return setSecretJSONKey(m, key, value)
+	}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating Secrets Manager Secret JSON Key (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSecretJSONKeyRead(ctx, d, meta)...)
+}
+
+func resourceSecretJSONKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, key, err := secretJSONKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Secrets Manager Secret JSON Key: %s", d.Id())
+	err = modifySecretJSONKeys(ctx, conn, secretID, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func(m map[string]json.RawMessage) (bool, error) {
+		if _, ok := m[key]; !ok {
+			return false, nil
+		}
+
+		delete(m, key)
+
+		return true, nil
+	})
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Secrets Manager Secret JSON Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const secretJSONKeyIDSeparator = "|"
+
+func secretJSONKeyCreateResourceID(secretID, key string) string {
+	parts := []string{secretID, key}
+	id := strings.Join(parts, secretJSONKeyIDSeparator)
+
+	return id
+}
+
+// secretJSONKeyParseResourceID splits the ID at the first separator.
+// Secret names and ARNs cannot contain the separator, but JSON keys can.
+func secretJSONKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, secretJSONKeyIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected SecretID%[2]sKey", id, secretJSONKeyIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// findSecretJSONKeys returns the current version of the secret and its value decoded as a JSON object.
+// A secret with no current version is treated as an empty JSON object and a nil version is returned.
+func findSecretJSONKeys(ctx context.Context, conn *secretsmanager.Client, secretID string) (*secretsmanager.GetSecretValueOutput, map[string]json.RawMessage, error) {
+	m := make(map[string]json.RawMessage)
+
+	output, err := findSecretVersion(ctx, conn, &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(secretID),
+		VersionStage: aws.String(secretVersionStageCurrent),
+	})
+
+	if tfresource.NotFound(err) {
+		// Distinguish between a missing secret and a secret that has never had a value.
+		if _, err := findSecretByID(ctx, conn, secretID); err != nil {
+			return nil, nil, err
+		}
+
+		return nil, m, nil
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if v := aws.ToString(output.SecretString); v != "" {
+		if err := json.Unmarshal([]byte(v), &m); err != nil {
+			return nil, nil, fmt.Errorf("secret value is not a JSON object: %w", err)
+		}
+	}
+
+	return output, m, nil
+}
+
+var errSecretValueModifiedConcurrently = errors.New("secret value was modified concurrently")
+
+// modifySecretJSONKeys performs an optimistically locked read-modify-write of a JSON secret value.
+// The new value is stored as a version with a unique staging label and AWSCURRENT is then moved to it
+// only if AWSCURRENT is still attached to the version that was read. If another writer got there first
+// the new version is abandoned and the whole operation is retried against the latest value.
+func modifySecretJSONKeys(ctx context.Context, conn *secretsmanager.Client, secretID string, timeout time.Duration, f func(map[string]json.RawMessage) (bool, error)) error {
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			current, m, err := findSecretJSONKeys(ctx, conn, secretID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			modified, err := f(m)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !modified {
+				return nil, nil
+			}
+
+			var currentVersionID string
+			if current != nil {
+				currentVersionID = aws.ToString(current.VersionId)
+			}
+
+			return nil, putSecretJSONValue(ctx, conn, secretID, currentVersionID, m)
+		},
+		func(err error) (bool, error) {
+			if errors.Is(err, errSecretValueModifiedConcurrently) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
+}
+
+func putSecretJSONValue(ctx context.Context, conn *secretsmanager.Client, secretID, currentVersionID string, m map[string]json.RawMessage) error {
+	b, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	token := id.UniqueId()
+	pendingStage := token
+
+	output, err := conn.PutSecretValue(ctx, &secretsmanager.PutSecretValueInput{
+		ClientRequestToken: aws.String(token),
+		SecretId:           aws.String(secretID),
+		SecretString:       aws.String(string(b)),
+		VersionStages:      []string{pendingStage},
+	})
+
+	if err != nil {
+		return fmt.Errorf("putting value: %w", err)
+	}
+
+	versionID := aws.ToString(output.VersionId)
+
+	// AWSCURRENT can only be moved away from the version that currently holds it.
+	input := &secretsmanager.UpdateSecretVersionStageInput{
+		MoveToVersionId: aws.String(versionID),
+		SecretId:        aws.String(secretID),
+		VersionStage:    aws.String(secretVersionStageCurrent),
+	}
+	if currentVersionID != "" {
+		input.RemoveFromVersionId = aws.String(currentVersionID)
+	}
+
+	_, err = conn.UpdateSecretVersionStage(ctx, input)
+
+	if errs.IsA[*types.InvalidParameterException](err) {
+		if current, _, findErr := findSecretJSONKeys(ctx, conn, secretID); findErr == nil {
+			if current != nil && aws.ToString(current.VersionId) != currentVersionID {
+				err = errSecretValueModifiedConcurrently
+			}
+		}
+	}
+
+	// Remove the temporary staging label. An abandoned version is left without labels and is deprecated.
+	if _, stageErr := conn.UpdateSecretVersionStage(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+		RemoveFromVersionId: aws.String(versionID),
+		SecretId:            aws.String(secretID),
+		VersionStage:        aws.String(pendingStage),
+	}); stageErr != nil {
+		log.Printf("[WARN] Removing Secrets Manager Secret (%s) version (%s) staging label (%s): %s", secretID, versionID, pendingStage, stageErr)
+	}
+
+	if err != nil {
+		if errors.Is(err, errSecretValueModifiedConcurrently) {
+			return err
+		}
+
+		return fmt.Errorf("moving %s staging label to version (%s): %w", secretVersionStageCurrent, versionID, err)
+	}
+
+	return nil
+}
+
+func setSecretJSONKey(m map[string]json.RawMessage, key, value string) (bool, error) {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	if v, ok := m[key]; ok && flattenSecretJSONValue(v) == value {
+		return false, nil
+	}
+
+	m[key] = b
+
+	return true, nil
+}
+
+// flattenSecretJSONValue returns string values unquoted and any other JSON value as its JSON text.
+func flattenSecretJSONValue(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+
+	return string(v)
+}
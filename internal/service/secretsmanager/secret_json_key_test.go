@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretJSONKey_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_json_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretJSONKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretJSONKeyConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretJSONKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrKey, "key1"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", "aws_secretsmanager_secret.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "value1"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"version_id"},
+			},
+			{
+				Config: testAccSecretJSONKeyConfig_basic(rName, "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretJSONKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretJSONKey_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_json_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretJSONKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretJSONKeyConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretJSONKeyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecretsmanager.ResourceSecretJSONKey(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretJSONKey_multipleKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName1 := "aws_secretsmanager_secret_json_key.test1"
+	resourceName2 := "aws_secretsmanager_secret_json_key.test2"
+	resourceName3 := "aws_secretsmanager_secret_json_key.test3"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretJSONKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretJSONKeyConfig_multipleKeys(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretJSONKeyExists(ctx, resourceName1),
+					testAccCheckSecretJSONKeyExists(ctx, resourceName2),
+					testAccCheckSecretJSONKeyExists(ctx, resourceName3),
+					resource.TestCheckResourceAttr(resourceName1, names.AttrValue, "value1"),
+					resource.TestCheckResourceAttr(resourceName2, names.AttrValue, "value2"),
+					resource.TestCheckResourceAttr(resourceName3, names.AttrValue, "value3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecretJSONKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_secretsmanager_secret_json_key" {
+				continue
+			}
+
+			_, m, err := tfsecretsmanager.FindSecretJSONKeys(ctx, conn, rs.Primary.Attributes["secret_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if _, ok := m[rs.Primary.Attributes[names.AttrKey]]; !ok {
+				continue
+			}
+
+			return fmt.Errorf("Secrets Manager Secret JSON Key %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecretJSONKeyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		_, m, err := tfsecretsmanager.FindSecretJSONKeys(ctx, conn, rs.Primary.Attributes["secret_id"])
+
+		if err != nil {
+			return err
+		}
+
+		if _, ok := m[rs.Primary.Attributes[names.AttrKey]]; !ok {
+			return fmt.Errorf("Secrets Manager Secret JSON Key %s not found", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSecretJSONKeyConfig_basic(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_json_key" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  key       = "key1"
+  value     = %[2]q
+}
+`, rName, value)
+}
+
+func testAccSecretJSONKeyConfig_multipleKeys(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 0
+}
+
+resource "aws_secretsmanager_secret_json_key" "test1" {
+  secret_id = aws_secretsmanager_secret.test.id
+  key       = "key1"
+  value     = "value1"
+}
+
+resource "aws_secretsmanager_secret_json_key" "test2" {
+  secret_id = aws_secretsmanager_secret.test.id
+  key       = "key2"
+  value     = "value2"
+}
+
+resource "aws_secretsmanager_secret_json_key" "test3" {
+  secret_id = aws_secretsmanager_secret.test.id
+  key       = "key3"
+  value     = "value3"
+}
+`, rName)
+}
@@ -5,7 +5,11 @@ package secretsmanager
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"slices"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -18,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -35,28 +41,25 @@ func resourceSecretPolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceSecretPolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"block_public_policy": {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
-			names.AttrPolicy: {
-				Type:                  schema.TypeString,
-				Required:              true,
-				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
-				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
-			},
+			names.AttrPolicy: sdkv2.IAMResourcePolicyDocumentSchemaRequired(),
 			"secret_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"validate_policy": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -190,6 +193,187 @@ func resourceSecretPolicyDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+func resourceSecretPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// Only analyze the policy when something relevant is changing.
+	if !d.NewValueKnown(names.AttrPolicy) {
+		return nil
+	}
+
+	if d.Id() != "" && !d.HasChanges("block_public_policy", names.AttrPolicy, "validate_policy") {
+		return nil
+	}
+
+	policy := d.Get(names.AttrPolicy).(string)
+
+	if d.Get("block_public_policy").(bool) {
+		statements, err := publicPolicyStatements(policy)
+
+		if err != nil {
+			// Malformed JSON is reported by the attribute's validation.
+			return nil
+		}
+
+		if len(statements) > 0 {
+			return fmt.Errorf("block_public_policy is enabled but the policy grants public access to the secret in %s; restrict the principal or scope it with a condition on the caller's account, organization or network", strings.Join(statements, ", "))
+		}
+	}
+
+	// The service-side analysis is opt-in as it needs an existing secret and the secretsmanager:ValidateResourcePolicy permission.
+	if !d.Get("validate_policy").(bool) || !d.NewValueKnown("secret_arn") {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	policy, err := structure.NormalizeJsonString(policy)
+	if err != nil {
+		return nil
+	}
+
+	input := &secretsmanager.ValidateResourcePolicyInput{
+		ResourcePolicy: aws.String(policy),
+		SecretId:       aws.String(d.Get("secret_arn").(string)),
+	}
+
+	output, err := conn.ValidateResourcePolicy(ctx, input)
+
+	// The secret may be pending replacement.
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		log.Printf("[WARN] Skipping Secrets Manager Secret Policy validation: %s", err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("validating Secrets Manager Secret (%s) policy: %w", d.Get("secret_arn").(string), err)
+	}
+
+	if output != nil && !output.PolicyValidationPassed {
+		var messages []string
+		for _, v := range output.ValidationErrors {
+			messages = append(messages, fmt.Sprintf("%s: %s", aws.ToString(v.CheckName), aws.ToString(v.ErrorMessage)))
+		}
+
+		return fmt.Errorf("policy for Secrets Manager Secret (%s) failed validation: %s", d.Get("secret_arn").(string), strings.Join(messages, "; "))
+	}
+
+	return nil
+}
+
+// publicPolicyStatements returns a description of each statement in the policy
+// that Secrets Manager's BlockPublicPolicy check would reject: an Allow statement
+// whose principal is a wildcard (or is expressed with NotPrincipal) and which is
+// not scoped to known accounts, organizations or networks by a condition.
+func publicPolicyStatements(policy string) ([]string, error) {
+	var doc struct {
+		Statement json.RawMessage
+	}
+
+	if err := json.Unmarshal([]byte(policy), &doc); err != nil {
+		return nil, err
+	}
+
+	var statements []*tfiam.IAMPolicyStatement
+
+	if len(doc.Statement) > 0 {
+		if err := json.Unmarshal(doc.Statement, &statements); err != nil {
+			var statement tfiam.IAMPolicyStatement
+
+			if err := json.Unmarshal(doc.Statement, &statement); err != nil {
+				return nil, err
+			}
+
+			statements = append(statements, &statement)
+		}
+	}
+
+	var public []string
+
+	for i, statement := range statements {
+		if statement == nil || !strings.EqualFold(statement.Effect, "Allow") || policyConditionsRestrictAccess(statement.Conditions) {
+			continue
+		}
+
+		if len(statement.NotPrincipals) == 0 && !policyPrincipalsHaveWildcard(statement.Principals) {
+			continue
+		}
+
+		if statement.Sid != "" {
+			public = append(public, fmt.Sprintf("statement %q (index %d)", statement.Sid, i))
+		} else {
+			public = append(public, fmt.Sprintf("statement at index %d", i))
+		}
+	}
+
+	return public, nil
+}
+
+func policyPrincipalsHaveWildcard(principals tfiam.IAMPolicyStatementPrincipalSet) bool {
+	for _, principal := range principals {
+		if principal.Type != "*" && principal.Type != "AWS" {
+			continue
+		}
+
+		switch v := principal.Identifiers.(type) {
+		case string:
+			if v == "*" {
+				return true
+			}
+		case []string:
+			for _, v := range v {
+				if v == "*" {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// restrictingPolicyConditionKeys are the global condition keys which, when
+// matched against fixed values, limit a wildcard principal to a known set of
+// callers.
+var restrictingPolicyConditionKeys = []string{
+	"aws:principalaccount",
+	"aws:principalarn",
+	"aws:principalorgid",
+	"aws:principalorgpaths",
+	"aws:sourceaccount",
+	"aws:sourcearn",
+	"aws:sourceip",
+	"aws:sourceowner",
+	"aws:sourcevpc",
+	"aws:sourcevpce",
+	"aws:userid",
+}
+
+// policyConditionsRestrictAccess returns whether any condition scopes the statement
+// to fixed values of a restricting key. Negated and IfExists operators and values
+// containing wildcards do not restrict access.
+func policyConditionsRestrictAccess(conditions tfiam.IAMPolicyStatementConditionSet) bool {
+	for _, condition := range conditions {
+		if !slices.Contains(restrictingPolicyConditionKeys, strings.ToLower(condition.Variable)) {
+			continue
+		}
+
+		test := strings.ToLower(condition.Test)
+		if strings.Contains(test, "not") || strings.HasSuffix(test, "ifexists") || strings.HasPrefix(test, "null") {
+			continue
+		}
+
+		values, ok := condition.Values.([]string)
+		if !ok || len(values) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(values, func(v string) bool { return strings.ContainsAny(v, "*?") }) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func findSecretPolicyByID(ctx context.Context, conn *secretsmanager.Client, id string) (*secretsmanager.GetResourcePolicyOutput, error) {
 	input := &secretsmanager.GetResourcePolicyInput{
 		SecretId: aws.String(id),
@@ -11,6 +11,7 @@ import (
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,6 +22,74 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestPublicPolicyStatements(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy   string
+		expected []string
+	}{
+		"wildcard principal": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"Public","Effect":"Allow","Principal":"*","Action":"secretsmanager:GetSecretValue","Resource":"*"}]}`,
+			expected: []string{`statement "Public" (index 0)`},
+		},
+		"wildcard AWS principal in list": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","*"]},"Action":"secretsmanager:GetSecretValue","Resource":"*"}]}`,
+			expected: []string{"statement at index 0"},
+		},
+		"single statement object": {
+			policy:   `{"Version":"2012-10-17","Statement":{"Sid":"Public","Effect":"Allow","Principal":{"AWS":"*"},"Action":"secretsmanager:GetSecretValue","Resource":"*"}}`,
+			expected: []string{`statement "Public" (index 0)`},
+		},
+		"not principal": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"Private","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"secretsmanager:GetSecretValue","Resource":"*"},{"Sid":"Others","Effect":"Allow","NotPrincipal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"secretsmanager:GetSecretValue","Resource":"*"}]}`,
+			expected: []string{`statement "Others" (index 1)`},
+		},
+		"conditioned wildcard principal": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"secretsmanager:GetSecretValue","Resource":"*","Condition":{"StringEquals":{"aws:PrincipalOrgID":"o-1234567890"}}}]}`,
+		},
+		"non-restricting condition": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Sid":"Public","Effect":"Allow","Principal":"*","Action":"secretsmanager:GetSecretValue","Resource":"*","Condition":{"Bool":{"aws:SecureTransport":"true"}}}]}`,
+			expected: []string{`statement "Public" (index 0)`},
+		},
+		"wildcard condition value": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"secretsmanager:GetSecretValue","Resource":"*","Condition":{"StringLike":{"aws:PrincipalOrgID":"o-*"}}}]}`,
+			expected: []string{"statement at index 0"},
+		},
+		"negated condition": {
+			policy:   `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"secretsmanager:GetSecretValue","Resource":"*","Condition":{"StringNotEquals":{"aws:SourceAccount":"123456789012"}}}]}`,
+			expected: []string{"statement at index 0"},
+		},
+		"source VPC endpoint condition": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"secretsmanager:GetSecretValue","Resource":"*","Condition":{"StringEquals":{"aws:SourceVpce":["vpce-1a2b3c4d"]}}}]}`,
+		},
+		"deny wildcard principal": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"secretsmanager:DeleteSecret","Resource":"*"}]}`,
+		},
+		"account principal": {
+			policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Action":"secretsmanager:GetSecretValue","Resource":"*"}]}`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfsecretsmanager.PublicPolicyStatements(testCase.policy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAccSecretsManagerSecretPolicy_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var policy secretsmanager.GetResourcePolicyOutput
@@ -102,6 +171,60 @@ func TestAccSecretsManagerSecretPolicy_blockPublicPolicy(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretPolicy_blockPublicPolicyPlanTime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSecretPolicyConfig_public(rName),
+				ExpectError: regexache.MustCompile(`grants public access to the secret in statement "PublicRead" \(index 0\)`),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretPolicy_validatePolicy(t *testing.T) {
+	ctx := acctest.Context(t)
+	var policy secretsmanager.GetResourcePolicyOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretPolicyConfig_validate(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "validate_policy", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccSecretPolicyConfig_validate(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretPolicyExists(ctx, resourceName, &policy),
+					resource.TestCheckResourceAttr(resourceName, "validate_policy", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"block_public_policy", "validate_policy"},
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecretPolicy_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var policy secretsmanager.GetResourcePolicyOutput
@@ -279,6 +402,34 @@ POLICY
 `, rName)
 }
 
+func testAccSecretPolicyConfig_public(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_policy" "test" {
+  secret_arn          = aws_secretsmanager_secret.test.arn
+  block_public_policy = true
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+	{
+	  "Sid": "PublicRead",
+	  "Effect": "Allow",
+	  "Principal": "*",
+	  "Action": "secretsmanager:GetSecretValue",
+	  "Resource": "*"
+	}
+  ]
+}
+POLICY
+}
+`, rName)
+}
+
 func testAccSecretPolicyConfig_block(rName string, block bool) string {
 	return fmt.Sprintf(`
 resource "aws_iam_role" "test" {
@@ -327,3 +478,38 @@ POLICY
 }
 `, rName, block)
 }
+
+func testAccSecretPolicyConfig_validate(rName string, validate bool) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_secretsmanager_secret_policy" "test" {
+  secret_arn          = aws_secretsmanager_secret.test.arn
+  block_public_policy = true
+  validate_policy     = %[2]t
+
+  policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+	{
+	  "Sid": "AccountRead",
+	  "Effect": "Allow",
+	  "Principal": {
+		"AWS": "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root"
+	  },
+	  "Action": "secretsmanager:GetSecretValue",
+	  "Resource": "*"
+	}
+  ]
+}
+POLICY
+}
+`, rName, validate)
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -115,6 +116,40 @@ func TestAccSecretsManagerSecret_disappears(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecret_restoreOnRead(t *testing.T) {
+	ctx := acctest.Context(t)
+	var secret1, secret2 secretsmanager.DescribeSecretOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretConfig_restoreOnRead(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretExists(ctx, resourceName, &secret1),
+					resource.TestCheckResourceAttr(resourceName, "deleted_date", ""),
+					resource.TestCheckResourceAttr(resourceName, "restore_on_read", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_deletion_date", ""),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecretsmanager.ResourceSecret(), resourceName),
+				),
+			},
+			{
+				Config: testAccSecretConfig_restoreOnRead(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretExists(ctx, resourceName, &secret2),
+					testAccCheckSecretNotRecreated(&secret1, &secret2),
+					resource.TestCheckResourceAttr(resourceName, "deleted_date", ""),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecret_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var secret secretsmanager.DescribeSecretOutput
@@ -169,6 +204,9 @@ func TestAccSecretsManagerSecret_basicReplica(t *testing.T) {
 					testAccCheckSecretExists(ctx, resourceName, &secret),
 					resource.TestCheckResourceAttr(resourceName, "force_overwrite_replica_secret", acctest.CtFalse),
 					resource.TestCheckResourceAttr(resourceName, "replica.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "replica.*", map[string]string{
+						names.AttrStatus: "InSync",
+					}),
 				),
 			},
 		},
@@ -373,6 +411,16 @@ func testAccCheckSecretExists(ctx context.Context, n string, v *secretsmanager.D
 	}
 }
 
+func testAccCheckSecretNotRecreated(before, after *secretsmanager.DescribeSecretOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.ARN), aws.ToString(after.ARN); before != after {
+			return fmt.Errorf("Secrets Manager Secret (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
 
@@ -520,6 +568,16 @@ resource "aws_secretsmanager_secret" "test" {
 `, rName)
 }
 
+func testAccSecretConfig_restoreOnRead(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name                    = %[1]q
+  recovery_window_in_days = 7
+  restore_on_read         = true
+}
+`, rName)
+}
+
 func testAccSecretConfig_recoveryWindowInDays(rName string, recoveryWindowInDays int) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	secretValueCacheMiddlewareID = "SecretValueCache"
+	secretVersionStageCurrent    = "AWSCURRENT"
+)
+
+type secretValueCacheContextKey struct{}
+
+// withSecretValueCache marks GetSecretValue calls made with the returned context as cacheable.
+func withSecretValueCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, secretValueCacheContextKey{}, true)
+}
+
+func useSecretValueCache(ctx context.Context) bool {
+	v, _ := ctx.Value(secretValueCacheContextKey{}).(bool)
+	return v
+}
+
+type secretValueCacheKey struct {
+	secretID     string
+	versionID    string
+	versionStage string
+}
+
+func newSecretValueCacheKey(secretID string, input *secretsmanager.GetSecretValueInput) secretValueCacheKey {
+	key := secretValueCacheKey{
+		secretID:     secretID,
+		versionID:    aws.ToString(input.VersionId),
+		versionStage: aws.ToString(input.VersionStage),
+	}
+
+	// GetSecretValue returns the AWSCURRENT version if neither a version ID nor a staging label is specified.
+	if key.versionID == "" && key.versionStage == "" {
+		key.versionStage = secretVersionStageCurrent
+	}
+
+	return key
+}
+
+type secretValueCall struct {
+	done       chan struct{}
+	generation uint64
+	output     *secretsmanager.GetSecretValueOutput
+	err        error
+}
+
+// secretValueCache de-duplicates GetSecretValue calls made by data sources.
+//
+// A cache is owned by a single Secrets Manager API client, which is created per provider
+// configuration and discarded with it at the end of the Terraform operation (plan, apply, refresh),
+// so cached values never outlive that operation.
+//
+// Values are keyed by the secret's resolved ARN plus version, so a secret referenced by name,
+// partial ARN and full ARN shares one entry. Concurrent lookups of the same secret version share
+// a single in-flight API call. Failed lookups are not cached. Any mutating API call made with the
+// owning client drops every cached value and bumps the cache generation; a lookup that was in
+// flight across a generation change is returned to its caller but not cached.
+type secretValueCache struct {
+	lock       sync.Mutex
+	generation uint64
+	arns       map[string]string // Requested secret ID -> resolved ARN.
+	entries    map[secretValueCacheKey]*secretsmanager.GetSecretValueOutput
+	inflight   map[secretValueCacheKey]*secretValueCall
+}
+
+func newSecretValueCache() *secretValueCache {
+	return &secretValueCache{
+		arns:     make(map[string]string),
+		entries:  make(map[secretValueCacheKey]*secretsmanager.GetSecretValueOutput),
+		inflight: make(map[secretValueCacheKey]*secretValueCall),
+	}
+}
+
+func (c *secretValueCache) get(ctx context.Context, input *secretsmanager.GetSecretValueInput, f func(context.Context, *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error)) (*secretsmanager.GetSecretValueOutput, error) {
+	secretID := aws.ToString(input.SecretId)
+	requested := newSecretValueCacheKey(secretID, input)
+
+	c.lock.Lock()
+	resolvedARN, ok := c.arns[secretID]
+	if !ok && arn.IsARN(secretID) {
+		resolvedARN, ok = secretID, true
+	}
+	if ok {
+		if output, ok := c.entries[newSecretValueCacheKey(resolvedARN, input)]; ok {
+			c.lock.Unlock()
+			return output, nil
+		}
+	}
+
+	if call, ok := c.inflight[requested]; ok {
+		c.lock.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		c.lock.Lock()
+		current := c.generation == call.generation
+		c.lock.Unlock()
+
+		if call.err == nil && current {
+			return call.output, nil
+		}
+
+		// The shared call failed or raced with a mutation; make our own.
+		return f(ctx, input)
+	}
+
+	call := &secretValueCall{
+		done:       make(chan struct{}),
+		generation: c.generation,
+	}
+	c.inflight[requested] = call
+	c.lock.Unlock()
+
+	call.output, call.err = f(ctx, input)
+
+	c.lock.Lock()
+	delete(c.inflight, requested)
+	if call.err == nil && c.generation == call.generation {
+		resolvedARN := aws.ToString(call.output.ARN)
+		if resolvedARN == "" {
+			resolvedARN = secretID
+		}
+		c.arns[secretID] = resolvedARN
+		c.entries[newSecretValueCacheKey(resolvedARN, input)] = call.output
+	}
+	c.lock.Unlock()
+	close(call.done)
+
+	return call.output, call.err
+}
+
+func (c *secretValueCache) invalidate() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.generation++
+	clear(c.arns)
+	clear(c.entries)
+}
+
+// addMiddleware registers middleware that serves cacheable GetSecretValue calls from the cache and
+// invalidates the cache around any API operation that may change a secret's value or staging labels.
+func (c *secretValueCache) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc(secretValueCacheMiddlewareID, func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		operationName := awsmiddleware.GetOperationName(ctx)
+
+		if input, ok := in.Parameters.(*secretsmanager.GetSecretValueInput); ok && operationName == "GetSecretValue" && useSecretValueCache(ctx) {
+			var metadata middleware.Metadata
+
+			output, err := c.get(ctx, input, func(ctx context.Context, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+				in.Parameters = input
+				out, md, err := next.HandleInitialize(ctx, in)
+				metadata = md
+
+				if err != nil {
+					return nil, err
+				}
+
+				output, _ := out.Result.(*secretsmanager.GetSecretValueOutput)
+
+				return output, nil
+			})
+
+			return middleware.InitializeOutput{Result: output}, metadata, err
+		}
+
+		if isReadOnlyOperation(operationName) {
+			return next.HandleInitialize(ctx, in)
+		}
+
+		// Invalidate both before and after the mutation so that no value read while it is in flight is retained.
+		c.invalidate()
+		defer c.invalidate()
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range []string{"BatchGet", "Describe", "Get", "List", "Validate"} {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findSecretVersionCached is findSecretVersion backed by the client's secret value cache.
+func findSecretVersionCached(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+	return findSecretVersion(withSecretValueCache(ctx), conn, input)
+}
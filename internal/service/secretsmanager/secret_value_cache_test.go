@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+)
+
+func TestSecretValueCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newSecretValueCache()
+
+	var calls atomic.Int32
+	var fail atomic.Bool
+	f := func(_ context.Context, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+		calls.Add(1)
+
+		if fail.Load() {
+			return nil, errors.New("throttled")
+		}
+
+		return &secretsmanager.GetSecretValueOutput{
+			SecretString: aws.String(aws.ToString(input.SecretId) + "/" + aws.ToString(input.VersionStage)),
+		}, nil
+	}
+
+	current := &secretsmanager.GetSecretValueInput{SecretId: aws.String("test"), VersionStage: aws.String("AWSCURRENT")}
+	previous := &secretsmanager.GetSecretValueInput{SecretId: aws.String("test"), VersionStage: aws.String("AWSPREVIOUS")}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			output, err := cache.get(ctx, current, f)
+
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+
+			if got, want := aws.ToString(output.SecretString), "test/AWSCURRENT"; got != want {
+				t.Errorf("SecretString = %q, want %q", got, want)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Fatalf("calls after concurrent lookups = %d, want %d", got, want)
+	}
+
+	if _, err := cache.get(ctx, previous, f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Fatalf("calls after lookup of another stage = %d, want %d", got, want)
+	}
+
+	cache.invalidate()
+	fail.Store(true)
+
+	if _, err := cache.get(ctx, current, f); err == nil {
+		t.Fatal("expected error")
+	}
+
+	fail.Store(false)
+
+	if _, err := cache.get(ctx, current, f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(4); got != want {
+		t.Fatalf("calls after invalidation and failure = %d, want %d", got, want)
+	}
+}
+
+func TestSecretValueCache_resolvedARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newSecretValueCache()
+
+	const arn = "arn:aws:secretsmanager:us-west-2:123456789012:secret:test-a1b2c3" //lintignore:AWSAT003,AWSAT005
+
+	var calls atomic.Int32
+	f := func(_ context.Context, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+		calls.Add(1)
+
+		return &secretsmanager.GetSecretValueOutput{
+			ARN:          aws.String(arn),
+			SecretString: aws.String("secret"),
+		}, nil
+	}
+
+	for _, input := range []*secretsmanager.GetSecretValueInput{
+		{SecretId: aws.String("test")},
+		{SecretId: aws.String("test"), VersionStage: aws.String("AWSCURRENT")},
+		{SecretId: aws.String(arn), VersionStage: aws.String("AWSCURRENT")},
+	} {
+		if _, err := cache.get(ctx, input, f); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if got, want := calls.Load(), int32(1); got != want {
+		t.Fatalf("calls after lookups by name and ARN = %d, want %d", got, want)
+	}
+
+	if _, err := cache.get(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(arn), VersionStage: aws.String("AWSPREVIOUS")}, f); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Fatalf("calls after lookup of another stage = %d, want %d", got, want)
+	}
+}
+
+func TestSecretValueCache_invalidateInFlight(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache := newSecretValueCache()
+
+	var calls atomic.Int32
+	started, release := make(chan struct{}), make(chan struct{})
+	f := func(_ context.Context, input *secretsmanager.GetSecretValueInput) (*secretsmanager.GetSecretValueOutput, error) {
+		if calls.Add(1) == 1 {
+			close(started)
+			<-release
+			return &secretsmanager.GetSecretValueOutput{SecretString: aws.String("stale")}, nil
+		}
+
+		return &secretsmanager.GetSecretValueOutput{SecretString: aws.String("fresh")}, nil
+	}
+
+	input := &secretsmanager.GetSecretValueInput{SecretId: aws.String("test")}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+
+		if _, err := cache.get(ctx, input, f); err != nil {
+			t.Errorf("unexpected error: %s", err)
+		}
+	}()
+
+	// A mutation completes while the first lookup is in flight.
+	<-started
+	cache.invalidate()
+	close(release)
+	<-done
+
+	output, err := cache.get(ctx, input, f)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := aws.ToString(output.SecretString), "fresh"; got != want {
+		t.Errorf("SecretString = %q, want %q", got, want)
+	}
+
+	if got, want := calls.Load(), int32(2); got != want {
+		t.Fatalf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"BatchGetSecretValue":      true,
+		"DescribeSecret":           true,
+		"GetSecretValue":           true,
+		"ListSecretVersionIds":     true,
+		"ValidateResourcePolicy":   true,
+		"PutSecretValue":           false,
+		"UpdateSecretVersionStage": false,
+		"RotateSecret":             false,
+		"DeleteSecret":             false,
+		"RestoreSecret":            false,
+	}
+
+	for name, expected := range testCases {
+		if got := isReadOnlyOperation(name); got != expected {
+			t.Errorf("isReadOnlyOperation(%q) = %t, want %t", name, got, expected)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/namevaluesfiltersv2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_secretsmanager_secret_values", name="Secret Values")
+func dataSourceSecretValues() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecretValuesRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrFilter: namevaluesfiltersv2.Schema(),
+			"secret_ids": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				MinItems:     1,
+				MaxItems:     20,
+				Elem:         &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(1, 2048)},
+				ExactlyOneOf: []string{names.AttrFilter, "secret_ids"},
+			},
+			"secret_values": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCreatedDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"secret_binary": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"secret_string": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_stages": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecretValuesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	input := &secretsmanager.BatchGetSecretValueInput{}
+
+	var clientSideFilters *namevaluesfiltersv2.ClientSideFilters
+
+	if v, ok := d.GetOk(names.AttrFilter); ok {
+		filters, clientSide, err := namevaluesfiltersv2.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.SecretsmanagerFilters()
+		clientSideFilters = clientSide
+	}
+
+	if v, ok := d.GetOk("secret_ids"); ok && v.(*schema.Set).Len() > 0 {
+		input.SecretIdList = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	var results []types.SecretValueEntry
+
+	paginator := secretsmanager.NewBatchGetSecretValuePaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret values: %s", err)
+		}
+
+		if page == nil {
+			continue
+		}
+
+		// Secrets that can't be retrieved are reported individually rather than failing the whole batch.
+		for _, apiObject := range page.Errors {
+			diags = sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s) value: %s: %s", aws.ToString(apiObject.SecretId), aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.Message))
+		}
+
+		for _, v := range page.SecretValues {
+			if clientSideFilters.Match(aws.ToString(v.Name), nil) {
+				results = append(results, v)
+			}
+		}
+	}
+
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("secret_values", flattenSecretValueEntries(results)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting secret_values: %s", err)
+	}
+
+	return diags
+}
+
+func flattenSecretValueEntries(apiObjects []types.SecretValueEntry) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:    aws.ToString(apiObject.ARN),
+			names.AttrName:   aws.ToString(apiObject.Name),
+			"secret_binary":  string(apiObject.SecretBinary),
+			"secret_string":  aws.ToString(apiObject.SecretString),
+			"version_id":     aws.ToString(apiObject.VersionId),
+			"version_stages": apiObject.VersionStages,
+		}
+
+		if v := apiObject.CreatedDate; v != nil {
+			tfMap[names.AttrCreatedDate] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
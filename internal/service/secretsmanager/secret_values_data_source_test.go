@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretValuesDataSource_secretIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_secretsmanager_secret_values.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesDataSourceConfig_secretIDs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "secret_values.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "secret_values.*", map[string]string{
+						names.AttrName:  rName + "-1",
+						"secret_string": "test-string-1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "secret_values.*", map[string]string{
+						names.AttrName:  rName + "-2",
+						"secret_string": "test-string-2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretValuesDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_secretsmanager_secret_values.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretValuesDataSourceConfig_base(rName),
+				// Sleep to allow secrets become visible in the list.
+				Check: acctest.CheckSleep(t, 30*time.Second),
+			},
+			{
+				Config: testAccSecretValuesDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "secret_values.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretValuesDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index + 1}"
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  count = 2
+
+  secret_id     = aws_secretsmanager_secret.test[count.index].id
+  secret_string = "test-string-${count.index + 1}"
+}
+`, rName)
+}
+
+func testAccSecretValuesDataSourceConfig_secretIDs(rName string) string {
+	return acctest.ConfigCompose(testAccSecretValuesDataSourceConfig_base(rName), `
+data "aws_secretsmanager_secret_values" "test" {
+  secret_ids = aws_secretsmanager_secret.test[*].arn
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`)
+}
+
+func testAccSecretValuesDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccSecretValuesDataSourceConfig_base(rName), fmt.Sprintf(`
+data "aws_secretsmanager_secret_values" "test" {
+  filter {
+    name   = "name"
+    values = [%[1]q]
+  }
+
+  depends_on = [aws_secretsmanager_secret_version.test]
+}
+`, rName))
+}
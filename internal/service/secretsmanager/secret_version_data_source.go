@@ -84,7 +84,7 @@ func dataSourceSecretVersionRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	id := secretVersionCreateResourceID(secretID, version)
-	output, err := findSecretVersion(ctx, conn, input)
+	output, err := findSecretVersionCached(ctx, conn, input)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret Version (%s): %s", id, err)
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_secretsmanager_secret_versions", name="Secret Versions")
+func dataSourceSecretVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSecretVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"include_deprecated": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrCreatedDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_accessed_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_stages": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSecretVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID := d.Get("secret_id").(string)
+	input := &secretsmanager.ListSecretVersionIdsInput{
+		SecretId: aws.String(secretID),
+	}
+
+	if v, ok := d.GetOk("include_deprecated"); ok {
+		input.IncludeDeprecated = aws.Bool(v.(bool))
+	}
+
+	output, err := findSecretVersionIDs(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret (%s) versions: %s", secretID, err)
+	}
+
+	d.SetId(aws.ToString(output.ARN))
+	d.Set(names.AttrARN, output.ARN)
+	d.Set(names.AttrName, output.Name)
+	d.Set("secret_id", secretID)
+	if err := d.Set("versions", flattenSecretVersionsListEntries(output.Versions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting versions: %s", err)
+	}
+
+	return diags
+}
+
+// findSecretVersionIDs returns the secret's ARN and name along with all of its versions.
+func findSecretVersionIDs(ctx context.Context, conn *secretsmanager.Client, input *secretsmanager.ListSecretVersionIdsInput) (*secretsmanager.ListSecretVersionIdsOutput, error) {
+	var output *secretsmanager.ListSecretVersionIdsOutput
+
+	paginator := secretsmanager.NewListSecretVersionIdsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			continue
+		}
+
+		if output == nil {
+			output = page
+			continue
+		}
+
+		output.Versions = append(output.Versions, page.Versions...)
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func flattenSecretVersionsListEntries(apiObjects []types.SecretVersionsListEntry) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"version_id":     aws.ToString(apiObject.VersionId),
+			"version_stages": apiObject.VersionStages,
+		}
+
+		if v := apiObject.CreatedDate; v != nil {
+			tfMap[names.AttrCreatedDate] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastAccessedDate; v != nil {
+			tfMap["last_accessed_date"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	secretResourceName := "aws_secretsmanager_secret.test"
+	dataSourceName := "data.aws_secretsmanager_secret_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, secretResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, secretResourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "versions.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "versions.*", map[string]string{
+						"version_stages.#": acctest.Ct1,
+					}),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceName, "versions.*.version_id", "aws_secretsmanager_secret_version.test2", "version_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSecretVersionsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test1" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string-1"
+}
+
+resource "aws_secretsmanager_secret_version" "test2" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = "test-string-2"
+
+  depends_on = [aws_secretsmanager_secret_version.test1]
+}
+
+data "aws_secretsmanager_secret_versions" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+
+  depends_on = [aws_secretsmanager_secret_version.test2]
+}
+`, rName)
+}
@@ -44,8 +44,17 @@ func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	input := &secretsmanager.ListSecretsInput{}
 
+	var clientSideFilters *namevaluesfiltersv2.ClientSideFilters
+
 	if v, ok := d.GetOk(names.AttrFilter); ok {
-		input.Filters = namevaluesfiltersv2.New(v.(*schema.Set)).SecretsmanagerFilters()
+		filters, clientSide, err := namevaluesfiltersv2.Split(v.(*schema.Set))
+
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		input.Filters = filters.SecretsmanagerFilters()
+		clientSideFilters = clientSide
 	}
 
 	var results []types.SecretListEntry
@@ -63,6 +72,20 @@ func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	results = tfslices.Filter(results, func(v types.SecretListEntry) bool {
+		attributes := map[string][]string{
+			names.AttrDescription: {aws.ToString(v.Description)},
+			"owning-service":      {aws.ToString(v.OwningService)},
+			"primary-region":      {aws.ToString(v.PrimaryRegion)},
+		}
+		for _, tag := range v.Tags {
+			attributes["tag-key"] = append(attributes["tag-key"], aws.ToString(tag.Key))
+			attributes["tag-value"] = append(attributes["tag-value"], aws.ToString(tag.Value))
+		}
+
+		return clientSideFilters.Match(aws.ToString(v.Name), attributes)
+	})
+
 	d.SetId(meta.(*conns.AWSClient).Region)
 	d.Set(names.AttrARNs, tfslices.ApplyToAll(results, func(v types.SecretListEntry) string { return aws.ToString(v.ARN) }))
 	d.Set(names.AttrNames, tfslices.ApplyToAll(results, func(v types.SecretListEntry) string { return aws.ToString(v.Name) }))
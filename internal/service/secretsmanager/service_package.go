@@ -0,0 +1,27 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
+func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*secretsmanager.Client, error) {
+	cfg := *(config["aws_sdkv2_config"].(*aws.Config))
+	// The secret value cache lives and dies with this client.
+	cache := newSecretValueCache()
+
+	return secretsmanager.NewFromConfig(cfg,
+		secretsmanager.WithEndpointResolverV2(newEndpointResolverSDKv2()),
+		withBaseEndpoint(config[names.AttrEndpoint].(string)),
+		func(o *secretsmanager.Options) {
+			o.APIOptions = append(o.APIOptions, cache.addMiddleware)
+		},
+	), nil
+}
@@ -5,8 +5,6 @@ package secretsmanager
 import (
 	"context"
 
-	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
-	secretsmanager_sdkv2 "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -42,11 +40,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_secretsmanager_secret_rotation",
 			Name:     "Secret Rotation",
 		},
+		{
+			Factory:  dataSourceSecretValues,
+			TypeName: "aws_secretsmanager_secret_values",
+			Name:     "Secret Values",
+		},
 		{
 			Factory:  dataSourceSecretVersion,
 			TypeName: "aws_secretsmanager_secret_version",
 			Name:     "Secret Version",
 		},
+		{
+			Factory:  dataSourceSecretVersions,
+			TypeName: "aws_secretsmanager_secret_versions",
+			Name:     "Secret Versions",
+		},
 		{
 			Factory:  dataSourceSecrets,
 			TypeName: "aws_secretsmanager_secrets",
@@ -65,6 +73,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceSecretJSONKey,
+			TypeName: "aws_secretsmanager_secret_json_key",
+			Name:     "Secret JSON Key",
+		},
 		{
 			Factory:  resourceSecretPolicy,
 			TypeName: "aws_secretsmanager_secret_policy",
@@ -87,16 +100,6 @@ func (p *servicePackage) ServicePackageName() string {
 	return names.SecretsManager
 }
 
-// NewClient returns a new AWS SDK for Go v2 client for this service package's AWS API.
-func (p *servicePackage) NewClient(ctx context.Context, config map[string]any) (*secretsmanager_sdkv2.Client, error) {
-	cfg := *(config["aws_sdkv2_config"].(*aws_sdkv2.Config))
-
-	return secretsmanager_sdkv2.NewFromConfig(cfg,
-		secretsmanager_sdkv2.WithEndpointResolverV2(newEndpointResolverSDKv2()),
-		withBaseEndpoint(config[names.AttrEndpoint].(string)),
-	), nil
-}
-
 func ServicePackage(ctx context.Context) conns.ServicePackage {
 	return &servicePackage{}
 }
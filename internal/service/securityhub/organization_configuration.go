@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
@@ -87,9 +88,9 @@ func resourceOrganizationConfigurationUpdate(ctx context.Context, d *schema.Reso
 		input.OrganizationConfiguration = expandOrganizationConfiguration(v.([]interface{})[0].(map[string]interface{}))
 	}
 
-	timeout := d.Timeout(schema.TimeoutUpdate)
+	timeout := sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)
 	if d.IsNewResource() {
-		timeout = d.Timeout(schema.TimeoutCreate)
+		timeout = sdkv2.Timeout(ctx, d, schema.TimeoutCreate)
 	}
 
 	// e.g. "DataUnavailableException: Central configuration couldn't be enabled because data from organization o-ira6i4k380 is still syncing. Retry later."
@@ -173,7 +174,7 @@ func resourceOrganizationConfigurationDelete(ctx context.Context, d *schema.Reso
 	}
 
 	if configurationType == types.OrganizationConfigurationConfigurationTypeCentral {
-		if _, err := waitOrganizationConfigurationEnabled(ctx, conn, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if _, err := waitOrganizationConfigurationEnabled(ctx, conn, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Security Hub Organization Configuration (%s) delete: %s", d.Id(), err)
 		}
 	}
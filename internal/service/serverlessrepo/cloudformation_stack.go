@@ -22,6 +22,7 @@ import ( // nosemgrep:ci.semgrep.aws.multiple-service-imports
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -123,7 +124,7 @@ func resourceCloudFormationStackCreate(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "executing Serverless Application Repository CloudFormation Stack (%s) change set failed: %s", d.Id(), err)
 	}
 
-	_, err = tfcloudformation.WaitStackCreated(ctx, cfConn, d.Id(), requestToken, d.Timeout(schema.TimeoutCreate))
+	_, err = tfcloudformation.WaitStackCreated(ctx, cfConn, d.Id(), requestToken, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Serverless Application Repository CloudFormation Stack (%s) creation: %s", d.Id(), err)
 	}
@@ -240,7 +241,7 @@ func resourceCloudFormationStackUpdate(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "executing Serverless Application Repository CloudFormation change set failed: %s", err)
 	}
 
-	_, err = tfcloudformation.WaitStackUpdated(ctx, cfConn, d.Id(), requestToken, d.Timeout(schema.TimeoutUpdate))
+	_, err = tfcloudformation.WaitStackUpdated(ctx, cfConn, d.Id(), requestToken, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for Serverless Application Repository CloudFormation Stack (%s) update: %s", d.Id(), err)
 	}
@@ -267,7 +268,7 @@ func resourceCloudFormationStackDelete(ctx context.Context, d *schema.ResourceDa
 		return sdkdiag.AppendErrorf(diags, "deleting Serverless Application Repository CloudFormation Stack (%s): %s", d.Id(), err)
 	}
 
-	_, err = tfcloudformation.WaitStackDeleted(ctx, cfConn, d.Id(), requestToken, d.Timeout(schema.TimeoutDelete))
+	_, err = tfcloudformation.WaitStackDeleted(ctx, cfConn, d.Id(), requestToken, sdkv2.Timeout(ctx, d, schema.TimeoutDelete))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting Serverless Application Repository CloudFormation Stack (%s): waiting for completion: %s", d.Id(), err)
 	}
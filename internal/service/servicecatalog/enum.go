@@ -17,6 +17,11 @@ const (
 	ConstraintTypeTemplate       = "TEMPLATE"
 )
 
+const (
+	shareDetailStatusError   = "ERROR"
+	shareDetailStatusSuccess = "SUCCESS"
+)
+
 func AcceptLanguage_Values() []string {
 	return []string{
 		AcceptLanguageEnglish,
@@ -58,6 +58,31 @@ func FindPortfolioShare(ctx context.Context, conn *servicecatalog.ServiceCatalog
 	return result, nil
 }
 
+func findPortfolioShareStatusByToken(ctx context.Context, conn *servicecatalog.ServiceCatalog, token string) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
+	input := &servicecatalog.DescribePortfolioShareStatusInput{
+		PortfolioShareToken: aws.String(token),
+	}
+
+	output, err := conn.DescribePortfolioShareStatusWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
 func FindProductPortfolioAssociation(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, portfolioID, productID string) (*servicecatalog.PortfolioDetail, error) {
 	// seems odd that the sourcePortfolioID is not returned or searchable...
 	input := &servicecatalog.ListPortfoliosForProductInput{
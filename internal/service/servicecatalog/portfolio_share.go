@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -51,6 +52,30 @@ func ResourcePortfolioShare() *schema.Resource {
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"account_share_status": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrMessage: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"portfolio_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -80,11 +105,19 @@ func ResourcePortfolioShare() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"share_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"share_tag_options": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				Default:  false,
 			},
+			"share_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			names.AttrType: {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -131,7 +164,7 @@ func resourcePortfolioShareCreate(ctx context.Context, d *schema.ResourceData, m
 	}
 
 	var output *servicecatalog.CreatePortfolioShareOutput
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+	err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() *retry.RetryError {
 		var err error
 
 		output, err = conn.CreatePortfolioShareWithContext(ctx, input)
@@ -168,11 +201,13 @@ func resourcePortfolioShareCreate(ctx context.Context, d *schema.ResourceData, m
 
 	// only get a token if organization node, otherwise check without token
 	if output.PortfolioShareToken != nil {
-		if _, err := WaitPortfolioShareCreatedWithToken(ctx, conn, aws.StringValue(output.PortfolioShareToken), waitForAcceptance, d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.Set("share_token", output.PortfolioShareToken)
+
+		if _, err := WaitPortfolioShareCreatedWithToken(ctx, conn, aws.StringValue(output.PortfolioShareToken), waitForAcceptance, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Portfolio Share (%s) to be ready: %s", d.Id(), err)
 		}
 	} else {
-		if _, err := WaitPortfolioShareReady(ctx, conn, d.Get("portfolio_id").(string), d.Get(names.AttrType).(string), d.Get("principal_id").(string), waitForAcceptance, d.Timeout(schema.TimeoutCreate)); err != nil {
+		if _, err := WaitPortfolioShareReady(ctx, conn, d.Get("portfolio_id").(string), d.Get(names.AttrType).(string), d.Get("principal_id").(string), waitForAcceptance, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Portfolio Share (%s) to be ready: %s", d.Id(), err)
 		}
 	}
@@ -195,7 +230,7 @@ func resourcePortfolioShareRead(ctx context.Context, d *schema.ResourceData, met
 		waitForAcceptance = v.(bool)
 	}
 
-	output, err := WaitPortfolioShareReady(ctx, conn, portfolioID, shareType, principalID, waitForAcceptance, d.Timeout(schema.TimeoutRead))
+	output, err := WaitPortfolioShareReady(ctx, conn, portfolioID, shareType, principalID, waitForAcceptance, sdkv2.Timeout(ctx, d, schema.TimeoutRead))
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
 		log.Printf("[WARN] Service Catalog Portfolio Share (%s) not found, removing from state", d.Id())
@@ -215,6 +250,23 @@ func resourcePortfolioShareRead(ctx context.Context, d *schema.ResourceData, met
 	d.Set(names.AttrType, output.Type)
 	d.Set("wait_for_acceptance", waitForAcceptance)
 
+	// Organization node shares are tracked using the token returned by the most recent create or update.
+	if token := d.Get("share_token").(string); token != "" {
+		status, err := findPortfolioShareStatusByToken(ctx, conn, token)
+
+		switch {
+		case tfresource.NotFound(err):
+			log.Printf("[WARN] Service Catalog Portfolio Share (%s) status (%s) not found", d.Id(), token)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Service Catalog Portfolio Share (%s) status: %s", d.Id(), err)
+		default:
+			if err := d.Set("account_share_status", flattenShareDetails(status.ShareDetails)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting account_share_status: %s", err)
+			}
+			d.Set("share_status", status.Status)
+		}
+	}
+
 	return diags
 }
 
@@ -222,6 +274,11 @@ func resourcePortfolioShareUpdate(ctx context.Context, d *schema.ResourceData, m
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
 
+	// UpdatePortfolioShare requires at least one of SharePrincipals or ShareTagOptions.
+	if !d.HasChanges("share_principals", "share_tag_options") {
+		return append(diags, resourcePortfolioShareRead(ctx, d, meta)...)
+	}
+
 	input := &servicecatalog.UpdatePortfolioShareInput{
 		PortfolioId:    aws.String(d.Get("portfolio_id").(string)),
 		AcceptLanguage: aws.String(d.Get("accept_language").(string)),
@@ -251,8 +308,11 @@ func resourcePortfolioShareUpdate(ctx context.Context, d *schema.ResourceData, m
 		input.OrganizationNode = orgNode
 	}
 
-	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *retry.RetryError {
-		_, err := conn.UpdatePortfolioShareWithContext(ctx, input)
+	var output *servicecatalog.UpdatePortfolioShareOutput
+	err := retry.RetryContext(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), func() *retry.RetryError {
+		var err error
+
+		output, err = conn.UpdatePortfolioShareWithContext(ctx, input)
 
 		if tfawserr.ErrMessageContains(err, servicecatalog.ErrCodeInvalidParametersException, "profile does not exist") {
 			return retry.RetryableError(err)
@@ -266,13 +326,22 @@ func resourcePortfolioShareUpdate(ctx context.Context, d *schema.ResourceData, m
 	})
 
 	if tfresource.TimedOut(err) {
-		_, err = conn.UpdatePortfolioShareWithContext(ctx, input)
+		output, err = conn.UpdatePortfolioShareWithContext(ctx, input)
 	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "updating Service Catalog Portfolio Share (%s): %s", d.Id(), err)
 	}
 
+	// only get a token if organization node
+	if output != nil && output.PortfolioShareToken != nil {
+		d.Set("share_token", output.PortfolioShareToken)
+
+		if _, err := waitPortfolioShareUpdatedWithToken(ctx, conn, aws.StringValue(output.PortfolioShareToken), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Portfolio Share (%s) update: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourcePortfolioShareRead(ctx, d, meta)...)
 }
 
@@ -316,14 +385,46 @@ func resourcePortfolioShareDelete(ctx context.Context, d *schema.ResourceData, m
 
 	// only get a token if organization node, otherwise check without token
 	if output.PortfolioShareToken != nil {
-		if _, err := WaitPortfolioShareDeletedWithToken(ctx, conn, aws.StringValue(output.PortfolioShareToken), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if _, err := WaitPortfolioShareDeletedWithToken(ctx, conn, aws.StringValue(output.PortfolioShareToken), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Portfolio Share (%s) to be deleted: %s", d.Id(), err)
 		}
 	} else {
-		if _, err := WaitPortfolioShareDeleted(ctx, conn, d.Get("portfolio_id").(string), d.Get(names.AttrType).(string), d.Get("principal_id").(string), d.Timeout(schema.TimeoutDelete)); err != nil {
+		if _, err := WaitPortfolioShareDeleted(ctx, conn, d.Get("portfolio_id").(string), d.Get(names.AttrType).(string), d.Get("principal_id").(string), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Portfolio Share (%s) to be deleted: %s", d.Id(), err)
 		}
 	}
 
 	return diags
 }
+
+func flattenShareDetails(apiObject *servicecatalog.ShareDetails) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, v := range apiObject.SuccessfulShares {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAccountID: aws.StringValue(v),
+			names.AttrStatus:    shareDetailStatusSuccess,
+		})
+	}
+
+	for _, apiObject := range apiObject.ShareErrors {
+		if apiObject == nil {
+			continue
+		}
+
+		for _, v := range apiObject.Accounts {
+			tfList = append(tfList, map[string]interface{}{
+				names.AttrAccountID: aws.StringValue(v),
+				"error":             aws.StringValue(apiObject.Error),
+				names.AttrMessage:   aws.StringValue(apiObject.Message),
+				names.AttrStatus:    shareDetailStatusError,
+			})
+		}
+	}
+
+	return tfList
+}
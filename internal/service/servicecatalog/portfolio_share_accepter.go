@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	portfolioShareAccepterResourceIDPartCount = 3
+)
+
+// @SDKResource("aws_servicecatalog_portfolio_share_accepter", name="Portfolio Share Accepter")
+func resourcePortfolioShareAccepter() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePortfolioShareAccepterCreate,
+		ReadWithoutTimeout:   resourcePortfolioShareAccepterRead,
+		DeleteWithoutTimeout: resourcePortfolioShareAccepterDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(3 * time.Minute),
+			Read:   schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(3 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"accept_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      AcceptLanguageEnglish,
+				ValidateFunc: validation.StringInSlice(AcceptLanguage_Values(), false),
+			},
+			"portfolio_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portfolio_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"portfolio_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"portfolio_share_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      servicecatalog.PortfolioShareTypeImported,
+				ValidateFunc: validation.StringInSlice([]string{servicecatalog.PortfolioShareTypeImported, servicecatalog.PortfolioShareTypeAwsOrganizations}, false),
+			},
+			names.AttrProviderName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePortfolioShareAccepterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
+
+	acceptLanguage, portfolioID, shareType := d.Get("accept_language").(string), d.Get("portfolio_id").(string), d.Get("portfolio_share_type").(string)
+	id := errs.Must(flex.FlattenResourceId([]string{acceptLanguage, portfolioID, shareType}, portfolioShareAccepterResourceIDPartCount, false))
+	input := &servicecatalog.AcceptPortfolioShareInput{
+		AcceptLanguage:     aws.String(acceptLanguage),
+		PortfolioId:        aws.String(portfolioID),
+		PortfolioShareType: aws.String(shareType),
+	}
+
+	_, err := conn.AcceptPortfolioShareWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "accepting Service Catalog Portfolio Share (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutCreate), func() (interface{}, error) {
+		return findAcceptedPortfolioShare(ctx, conn, acceptLanguage, portfolioID, shareType)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Portfolio Share Accepter (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourcePortfolioShareAccepterRead(ctx, d, meta)...)
+}
+
+func resourcePortfolioShareAccepterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), portfolioShareAccepterResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	acceptLanguage, portfolioID, shareType := parts[0], parts[1], parts[2]
+	output, err := findAcceptedPortfolioShare(ctx, conn, acceptLanguage, portfolioID, shareType)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Service Catalog Portfolio Share Accepter (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Service Catalog Portfolio Share Accepter (%s): %s", d.Id(), err)
+	}
+
+	d.Set("accept_language", acceptLanguage)
+	d.Set("portfolio_arn", output.ARN)
+	d.Set("portfolio_id", output.Id)
+	d.Set("portfolio_name", output.DisplayName)
+	d.Set("portfolio_share_type", shareType)
+	d.Set(names.AttrProviderName, output.ProviderName)
+
+	return diags
+}
+
+func resourcePortfolioShareAccepterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ServiceCatalogConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), portfolioShareAccepterResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	acceptLanguage, portfolioID, shareType := parts[0], parts[1], parts[2]
+
+	log.Printf("[DEBUG] Deleting Service Catalog Portfolio Share Accepter: %s", d.Id())
+	_, err = conn.RejectPortfolioShareWithContext(ctx, &servicecatalog.RejectPortfolioShareInput{
+		AcceptLanguage:     aws.String(acceptLanguage),
+		PortfolioId:        aws.String(portfolioID),
+		PortfolioShareType: aws.String(shareType),
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "rejecting Service Catalog Portfolio Share (%s): %s", d.Id(), err)
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, sdkv2.Timeout(ctx, d, schema.TimeoutDelete), func() (interface{}, error) {
+		return findAcceptedPortfolioShare(ctx, conn, acceptLanguage, portfolioID, shareType)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Service Catalog Portfolio Share Accepter (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findAcceptedPortfolioShare(ctx context.Context, conn *servicecatalog.ServiceCatalog, acceptLanguage, portfolioID, shareType string) (*servicecatalog.PortfolioDetail, error) {
+	input := &servicecatalog.ListAcceptedPortfolioSharesInput{
+		AcceptLanguage:     aws.String(acceptLanguage),
+		PortfolioShareType: aws.String(shareType),
+	}
+	filter := func(v *servicecatalog.PortfolioDetail) bool {
+		return aws.StringValue(v.Id) == portfolioID
+	}
+
+	return findAcceptedPortfolioShareDetail(ctx, conn, input, filter)
+}
+
+func findAcceptedPortfolioShareDetail(ctx context.Context, conn *servicecatalog.ServiceCatalog, input *servicecatalog.ListAcceptedPortfolioSharesInput, filter tfslices.Predicate[*servicecatalog.PortfolioDetail]) (*servicecatalog.PortfolioDetail, error) {
+	output, err := findAcceptedPortfolioShareDetails(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findAcceptedPortfolioShareDetails(ctx context.Context, conn *servicecatalog.ServiceCatalog, input *servicecatalog.ListAcceptedPortfolioSharesInput, filter tfslices.Predicate[*servicecatalog.PortfolioDetail]) ([]*servicecatalog.PortfolioDetail, error) {
+	var output []*servicecatalog.PortfolioDetail
+
+	err := conn.ListAcceptedPortfolioSharesPagesWithContext(ctx, input, func(page *servicecatalog.ListAcceptedPortfolioSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.PortfolioDetails {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, servicecatalog.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
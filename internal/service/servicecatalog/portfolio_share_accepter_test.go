@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package servicecatalog_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/servicecatalog"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfservicecatalog "github.com/hashicorp/terraform-provider-aws/internal/service/servicecatalog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPortfolioShareAccepter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_portfolio_share_accepter.test"
+	portfolioResourceName := "aws_servicecatalog_portfolio.test"
+	shareResourceName := "aws_servicecatalog_portfolio_share.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckPartitionHasService(t, servicecatalog.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckPortfolioShareAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortfolioShareAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortfolioShareAccepterExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "accept_language", tfservicecatalog.AcceptLanguageEnglish),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_arn", portfolioResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", shareResourceName, "portfolio_id"),
+					resource.TestCheckResourceAttr(resourceName, "portfolio_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "portfolio_share_type", servicecatalog.PortfolioShareTypeImported),
+					resource.TestCheckResourceAttr(resourceName, names.AttrProviderName, rName),
+				),
+			},
+			{
+				Config:            testAccPortfolioShareAccepterConfig_basic(rName),
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPortfolioShareAccepter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_servicecatalog_portfolio_share_accepter.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckPartitionHasService(t, servicecatalog.EndpointsID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ServiceCatalogServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckPortfolioShareAccepterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPortfolioShareAccepterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortfolioShareAccepterExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfservicecatalog.ResourcePortfolioShareAccepter(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPortfolioShareAccepterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_servicecatalog_portfolio_share_accepter" {
+				continue
+			}
+
+			_, err := tfservicecatalog.FindAcceptedPortfolioShare(ctx, conn,
+				rs.Primary.Attributes["accept_language"],
+				rs.Primary.Attributes["portfolio_id"],
+				rs.Primary.Attributes["portfolio_share_type"],
+			)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Service Catalog Portfolio Share Accepter %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPortfolioShareAccepterExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ServiceCatalogConn(ctx)
+
+		_, err := tfservicecatalog.FindAcceptedPortfolioShare(ctx, conn,
+			rs.Primary.Attributes["accept_language"],
+			rs.Primary.Attributes["portfolio_id"],
+			rs.Primary.Attributes["portfolio_share_type"],
+		)
+
+		return err
+	}
+}
+
+func testAccPortfolioShareAccepterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "receiver" {}
+
+resource "aws_servicecatalog_portfolio" "test" {
+  provider = "awsalternate"
+
+  name          = %[1]q
+  description   = %[1]q
+  provider_name = %[1]q
+}
+
+resource "aws_servicecatalog_portfolio_share" "test" {
+  provider = "awsalternate"
+
+  portfolio_id = aws_servicecatalog_portfolio.test.id
+  type         = "ACCOUNT"
+  principal_id = data.aws_caller_identity.receiver.account_id
+}
+
+resource "aws_servicecatalog_portfolio_share_accepter" "test" {
+  portfolio_id = aws_servicecatalog_portfolio_share.test.portfolio_id
+}
+`, rName))
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -103,13 +104,22 @@ func testAccPortfolioShare_sharePrincipals(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
+					"account_share_status",
+					"share_status",
+					"share_token",
 				},
 			},
 			{
 				Config: testAccPortfolioShareConfig_sharePrincipals(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPortfolioShareExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "share_principals", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "share_status", servicecatalog.ShareStatusCompleted),
 				),
 			},
 		},
@@ -134,14 +144,16 @@ func testAccPortfolioShare_organizationalUnit(t *testing.T) {
 		CheckDestroy:             testAccCheckPortfolioShareDestroy(ctx),
 		Steps: []resource.TestStep{
 			{
-				Config: testAccPortfolioShareConfig_organizationalUnit(rName),
+				Config: testAccPortfolioShareConfig_organizationalUnit(rName, true),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckPortfolioShareExists(ctx, resourceName),
 					resource.TestCheckResourceAttr(resourceName, "accept_language", tfservicecatalog.AcceptLanguageEnglish),
 					resource.TestCheckResourceAttr(resourceName, "accepted", acctest.CtTrue),
 					resource.TestCheckResourceAttrPair(resourceName, "principal_id", "aws_organizations_organizational_unit.test", names.AttrID),
 					resource.TestCheckResourceAttrPair(resourceName, "portfolio_id", compareName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "share_status", servicecatalog.ShareStatusCompleted),
 					resource.TestCheckResourceAttr(resourceName, "share_tag_options", acctest.CtTrue),
+					resource.TestCheckResourceAttrSet(resourceName, "share_token"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrType, servicecatalog.DescribePortfolioShareTypeOrganizationalUnit),
 				),
 			},
@@ -151,7 +163,24 @@ func testAccPortfolioShare_organizationalUnit(t *testing.T) {
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"accept_language",
+					"account_share_status",
+					"share_status",
+					"share_token",
+				},
+			},
+			{
+				Config: testAccPortfolioShareConfig_organizationalUnit(rName, false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
 				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPortfolioShareExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "share_status", servicecatalog.ShareStatusCompleted),
+					resource.TestCheckResourceAttr(resourceName, "share_tag_options", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(resourceName, "share_token"),
+				),
 			},
 		},
 	})
@@ -260,7 +289,7 @@ resource "aws_servicecatalog_portfolio_share" "test" {
 `, rName, share))
 }
 
-func testAccPortfolioShareConfig_organizationalUnit(rName string) string {
+func testAccPortfolioShareConfig_organizationalUnit(rName string, shareTagOptions bool) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
 
@@ -284,11 +313,11 @@ resource "aws_organizations_organizational_unit" "test" {
 resource "aws_servicecatalog_portfolio_share" "test" {
   accept_language   = "en"
   portfolio_id      = aws_servicecatalog_portfolio.test.id
-  share_tag_options = true
+  share_tag_options = %[2]t
   type              = "ORGANIZATIONAL_UNIT"
   principal_id      = aws_organizations_organizational_unit.test.arn
 }
-`, rName)
+`, rName, shareTagOptions)
 }
 
 func testAccPortfolioShareConfig_sharePrincipals(rName string, share bool) string {
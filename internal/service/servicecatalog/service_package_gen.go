@@ -79,6 +79,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourcePortfolioShare,
 			TypeName: "aws_servicecatalog_portfolio_share",
 		},
+		{
+			Factory:  resourcePortfolioShareAccepter,
+			TypeName: "aws_servicecatalog_portfolio_share_accepter",
+			Name:     "Portfolio Share Accepter",
+		},
 		{
 			Factory:  ResourcePrincipalPortfolioAssociation,
 			TypeName: "aws_servicecatalog_principal_portfolio_association",
@@ -22,6 +22,10 @@ func TestAccServiceCatalog_serial(t *testing.T) {
 			"organizationalUnit": testAccPortfolioShare_organizationalUnit,
 			acctest.CtDisappears: testAccPortfolioShare_disappears,
 		},
+		"PortfolioShareAccepter": {
+			acctest.CtBasic:      testAccPortfolioShareAccepter_basic,
+			acctest.CtDisappears: testAccPortfolioShareAccepter_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -6,12 +6,15 @@ package servicecatalog
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -194,6 +197,37 @@ func WaitPortfolioShareCreatedWithToken(ctx context.Context, conn *servicecatalo
 	return nil, err
 }
 
+func waitPortfolioShareUpdatedWithToken(ctx context.Context, conn *servicecatalog.ServiceCatalog, token string, timeout time.Duration) (*servicecatalog.DescribePortfolioShareStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{servicecatalog.ShareStatusNotStarted, servicecatalog.ShareStatusInProgress, StatusNotFound, StatusUnavailable},
+		Target:  []string{servicecatalog.ShareStatusCompleted},
+		Refresh: StatusPortfolioShareWithToken(ctx, conn, token),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*servicecatalog.DescribePortfolioShareStatusOutput); ok {
+		if v := output.ShareDetails; v != nil {
+			var errs []error
+
+			for _, v := range v.ShareErrors {
+				if v == nil {
+					continue
+				}
+
+				errs = append(errs, fmt.Errorf("%s: %s (%s)", aws.StringValue(v.Error), aws.StringValue(v.Message), strings.Join(aws.StringValueSlice(v.Accounts), ", ")))
+			}
+
+			tfresource.SetLastError(err, errors.Join(errs...))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
 func WaitPortfolioShareDeleted(ctx context.Context, conn *servicecatalog.ServiceCatalog, portfolioID, shareType, principalID string, timeout time.Duration) (*servicecatalog.PortfolioShareDetail, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending: []string{servicecatalog.ShareStatusNotStarted, servicecatalog.ShareStatusInProgress, servicecatalog.ShareStatusCompleted, StatusUnavailable},
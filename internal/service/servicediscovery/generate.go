@@ -2,6 +2,8 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -ListTags -ListTagsInIDElem=ResourceARN -ServiceTagsSlice -TagInIDElem=ResourceARN -UpdateTags
+//go:generate go run ../../generate/pluraldatasource/main.go -TypeName=aws_service_discovery_namespaces -HumanName=Namespaces -ListOp=ListNamespaces -ListOpOutputElem=Namespaces -NameElem=Name -ARNElem=Arn -Tags -TaggingResourceType=servicediscovery:namespace -- namespaces_data_source_gen.go
+//go:generate go run ../../generate/pluraldatasource/main.go
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -29,6 +29,7 @@ import (
 
 // @SDKResource("aws_service_discovery_service", name="Service")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_service_discovery_services", listOp="ListServices", listOpOutputElem="Services", nameElem="Name", arnElem="Arn", taggingResourceType="servicediscovery:service")
 func resourceService() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceServiceCreate,
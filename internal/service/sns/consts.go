@@ -136,3 +136,21 @@ func topicTracingConfig_Values() []string {
 		topicTracingConfigPassThrough,
 	}
 }
+
+const (
+	topicDeliveryStatusLoggingProtocolApplication = "application"
+	topicDeliveryStatusLoggingProtocolFirehose    = "firehose"
+	topicDeliveryStatusLoggingProtocolHTTP        = "http"
+	topicDeliveryStatusLoggingProtocolLambda      = "lambda"
+	topicDeliveryStatusLoggingProtocolSQS         = "sqs"
+)
+
+func topicDeliveryStatusLoggingProtocol_Values() []string {
+	return []string{
+		topicDeliveryStatusLoggingProtocolApplication,
+		topicDeliveryStatusLoggingProtocolFirehose,
+		topicDeliveryStatusLoggingProtocolHTTP,
+		topicDeliveryStatusLoggingProtocolLambda,
+		topicDeliveryStatusLoggingProtocolSQS,
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"maps"
 	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -40,17 +42,23 @@ var (
 		"application_failure_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"application_success_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"application_success_feedback_sample_rate": {
 			Type:         schema.TypeInt,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"archive_policy": {
 			Type:                  schema.TypeString,
@@ -87,6 +95,35 @@ var (
 				return json
 			},
 		},
+		"delivery_status_logging": {
+			Type:     schema.TypeSet,
+			Optional: true,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"failure_feedback_role_arn": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					names.AttrProtocol: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(topicDeliveryStatusLoggingProtocol_Values(), false),
+					},
+					"success_feedback_role_arn": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidARN,
+					},
+					"success_feedback_sample_rate": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 100),
+					},
+				},
+			},
+		},
 		names.AttrDisplayName: {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -100,32 +137,44 @@ var (
 		"firehose_failure_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"firehose_success_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"firehose_success_feedback_sample_rate": {
 			Type:         schema.TypeInt,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"http_failure_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"http_success_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"http_success_feedback_sample_rate": {
 			Type:         schema.TypeInt,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"kms_master_key_id": {
 			Type:     schema.TypeString,
@@ -134,17 +183,23 @@ var (
 		"lambda_failure_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"lambda_success_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"lambda_success_feedback_sample_rate": {
 			Type:         schema.TypeInt,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		names.AttrName: {
 			Type:          schema.TypeString,
@@ -164,18 +219,7 @@ var (
 			Type:     schema.TypeString,
 			Computed: true,
 		},
-		names.AttrPolicy: {
-			Type:                  schema.TypeString,
-			Optional:              true,
-			Computed:              true,
-			ValidateFunc:          validation.StringIsJSON,
-			DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
-			DiffSuppressOnRefresh: true,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
-		},
+		names.AttrPolicy: sdkv2.IAMResourcePolicyDocumentSchemaOptionalComputed(),
 		"signature_version": {
 			Type:         schema.TypeInt,
 			Optional:     true,
@@ -185,17 +229,23 @@ var (
 		"sqs_failure_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"sqs_success_feedback_role_arn": {
 			Type:         schema.TypeString,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: verify.ValidARN,
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		"sqs_success_feedback_sample_rate": {
 			Type:         schema.TypeInt,
 			Optional:     true,
+			Computed:     true,
 			ValidateFunc: validation.IntBetween(0, 100),
+			Deprecated:   "Use delivery_status_logging instead",
 		},
 		names.AttrTags:    tftags.TagsSchema(),
 		names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -254,6 +304,7 @@ func resourceTopic() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			resourceTopicCustomizeDiff,
+			resourceTopicDeliveryStatusLoggingCustomizeDiff,
 			verify.SetTagsDiff,
 		),
 
@@ -276,6 +327,10 @@ func resourceTopicCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if v, ok := d.GetOk("delivery_status_logging"); ok && v.(*schema.Set).Len() > 0 {
+		maps.Copy(attributes, expandTopicDeliveryStatusLogging(v.(*schema.Set).List()))
+	}
+
 	// The FifoTopic attribute must be passed in the call to CreateTopic.
 	if v, ok := attributes[topicAttributeNameFIFOTopic]; ok {
 		input.Attributes = map[string]string{
@@ -348,6 +403,10 @@ func resourceTopicRead(ctx context.Context, d *schema.ResourceData, meta any) di
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	if err := d.Set("delivery_status_logging", flattenTopicDeliveryStatusLogging(attributes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting delivery_status_logging: %s", err)
+	}
+
 	arn, err := arn.Parse(d.Id())
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
@@ -369,10 +428,32 @@ func resourceTopicUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).SNSClient(ctx)
 
 	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
-		attributes, err := topicAttributeMap.ResourceDataToAPIAttributesUpdate(d)
+		attributes := make(map[string]string)
+
+		// Disable delivery status logging for any protocols removed from delivery_status_logging
+		// before applying the deprecated per-protocol arguments and the new configuration.
+		if d.HasChange("delivery_status_logging") {
+			o, _ := d.GetChange("delivery_status_logging")
+			for _, tfMapRaw := range o.(*schema.Set).List() {
+				if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+					if v, ok := topicDeliveryStatusLoggingAttributeNames[tfMap[names.AttrProtocol].(string)]; ok {
+						attributes[v.failureFeedbackRoleARN] = ""
+						attributes[v.successFeedbackRoleARN] = ""
+						attributes[v.successFeedbackSampleRate] = "0"
+					}
+				}
+			}
+		}
+
+		v, err := topicAttributeMap.ResourceDataToAPIAttributesUpdate(d)
 		if err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
+		maps.Copy(attributes, v)
+
+		if d.HasChange("delivery_status_logging") {
+			maps.Copy(attributes, expandTopicDeliveryStatusLogging(d.Get("delivery_status_logging").(*schema.Set).List()))
+		}
 
 		err = putTopicAttributes(ctx, conn, d.Id(), attributes)
 		if err != nil {
@@ -436,6 +517,220 @@ func resourceTopicCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, me
 	return nil
 }
 
+type topicDeliveryStatusLoggingAttributes struct {
+	failureFeedbackRoleARN    string
+	successFeedbackRoleARN    string
+	successFeedbackSampleRate string
+}
+
+// topicDeliveryStatusLoggingAttributeNames maps each delivery status logging protocol to its topic attribute names.
+var topicDeliveryStatusLoggingAttributeNames = map[string]topicDeliveryStatusLoggingAttributes{
+	topicDeliveryStatusLoggingProtocolApplication: {
+		failureFeedbackRoleARN:    topicAttributeNameApplicationFailureFeedbackRoleARN,
+		successFeedbackRoleARN:    topicAttributeNameApplicationSuccessFeedbackRoleARN,
+		successFeedbackSampleRate: topicAttributeNameApplicationSuccessFeedbackSampleRate,
+	},
+	topicDeliveryStatusLoggingProtocolFirehose: {
+		failureFeedbackRoleARN:    topicAttributeNameFirehoseFailureFeedbackRoleARN,
+		successFeedbackRoleARN:    topicAttributeNameFirehoseSuccessFeedbackRoleARN,
+		successFeedbackSampleRate: topicAttributeNameFirehoseSuccessFeedbackSampleRate,
+	},
+	topicDeliveryStatusLoggingProtocolHTTP: {
+		failureFeedbackRoleARN:    topicAttributeNameHTTPFailureFeedbackRoleARN,
+		successFeedbackRoleARN:    topicAttributeNameHTTPSuccessFeedbackRoleARN,
+		successFeedbackSampleRate: topicAttributeNameHTTPSuccessFeedbackSampleRate,
+	},
+	topicDeliveryStatusLoggingProtocolLambda: {
+		failureFeedbackRoleARN:    topicAttributeNameLambdaFailureFeedbackRoleARN,
+		successFeedbackRoleARN:    topicAttributeNameLambdaSuccessFeedbackRoleARN,
+		successFeedbackSampleRate: topicAttributeNameLambdaSuccessFeedbackSampleRate,
+	},
+	topicDeliveryStatusLoggingProtocolSQS: {
+		failureFeedbackRoleARN:    topicAttributeNameSQSFailureFeedbackRoleARN,
+		successFeedbackRoleARN:    topicAttributeNameSQSSuccessFeedbackRoleARN,
+		successFeedbackSampleRate: topicAttributeNameSQSSuccessFeedbackSampleRate,
+	},
+}
+
+// topicDeliveryStatusLoggingDeprecatedAttributeNames returns the names of the deprecated
+// per-protocol delivery status logging arguments for the specified protocol.
+func topicDeliveryStatusLoggingDeprecatedAttributeNames(protocol string) (string, string, string) {
+	return protocol + "_failure_feedback_role_arn", protocol + "_success_feedback_role_arn", protocol + "_success_feedback_sample_rate"
+}
+
+// resourceTopicDeliveryStatusLoggingCustomizeDiff validates delivery_status_logging and keeps it consistent
+// with the deprecated per-protocol arguments.
+// When delivery_status_logging is configured it is authoritative for every protocol not configured via the deprecated arguments.
+// Otherwise delivery_status_logging is derived from the deprecated arguments.
+func resourceTopicDeliveryStatusLoggingCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil
+	}
+
+	deprecated := make(map[string]bool)
+	for _, protocol := range topicDeliveryStatusLoggingProtocol_Values() {
+		failureRoleARN, successRoleARN, successSampleRate := topicDeliveryStatusLoggingDeprecatedAttributeNames(protocol)
+		for _, k := range []string{failureRoleARN, successRoleARN, successSampleRate} {
+			if !rawConfig.GetAttr(k).IsNull() {
+				deprecated[protocol] = true
+			}
+		}
+	}
+
+	if v := rawConfig.GetAttr("delivery_status_logging"); v.IsKnown() && !v.IsNull() {
+		configured := make(map[string]bool)
+		n := 0
+
+		for it := v.ElementIterator(); it.Next(); {
+			n++
+
+			_, tfMap := it.Element()
+			if !tfMap.IsKnown() || tfMap.IsNull() {
+				continue
+			}
+
+			v := tfMap.GetAttr(names.AttrProtocol)
+			if !v.IsKnown() || v.IsNull() {
+				continue
+			}
+			protocol := v.AsString()
+
+			if configured[protocol] {
+				return fmt.Errorf("delivery_status_logging: protocol (%s) is configured more than once", protocol)
+			}
+			configured[protocol] = true
+
+			if deprecated[protocol] {
+				return fmt.Errorf("delivery_status_logging: protocol (%s) conflicts with the %s_* arguments", protocol, protocol)
+			}
+
+			failureRoleARN, successRoleARN := tfMap.GetAttr("failure_feedback_role_arn"), tfMap.GetAttr("success_feedback_role_arn")
+			if failureRoleARN.IsNull() && successRoleARN.IsNull() {
+				return fmt.Errorf("delivery_status_logging: protocol (%s) requires failure_feedback_role_arn or success_feedback_role_arn", protocol)
+			}
+
+			if !tfMap.GetAttr("success_feedback_sample_rate").IsNull() && successRoleARN.IsNull() {
+				return fmt.Errorf("delivery_status_logging: protocol (%s) success_feedback_sample_rate requires success_feedback_role_arn", protocol)
+			}
+		}
+
+		if n > 0 {
+			if diff.HasChange("delivery_status_logging") {
+				for _, protocol := range topicDeliveryStatusLoggingProtocol_Values() {
+					if deprecated[protocol] {
+						continue
+					}
+
+					failureRoleARN, successRoleARN, successSampleRate := topicDeliveryStatusLoggingDeprecatedAttributeNames(protocol)
+					for _, k := range []string{failureRoleARN, successRoleARN, successSampleRate} {
+						if err := diff.SetNewComputed(k); err != nil {
+							return err
+						}
+					}
+				}
+			}
+
+			return nil
+		}
+	}
+
+	// delivery_status_logging is not configured.
+	// Removing a deprecated argument disables the corresponding delivery status logging.
+	var tfList []interface{}
+	unknown := false
+
+	for _, protocol := range topicDeliveryStatusLoggingProtocol_Values() {
+		failureRoleARN, successRoleARN, successSampleRate := topicDeliveryStatusLoggingDeprecatedAttributeNames(protocol)
+
+		for k, zero := range map[string]interface{}{failureRoleARN: "", successRoleARN: "", successSampleRate: 0} {
+			if v := rawConfig.GetAttr(k); v.IsNull() {
+				if diff.Get(k) != zero {
+					if err := diff.SetNew(k, zero); err != nil {
+						return err
+					}
+				}
+			} else if !v.IsKnown() {
+				unknown = true
+			}
+		}
+
+		tfMap := map[string]interface{}{
+			"failure_feedback_role_arn":    diff.Get(failureRoleARN).(string),
+			names.AttrProtocol:             protocol,
+			"success_feedback_role_arn":    diff.Get(successRoleARN).(string),
+			"success_feedback_sample_rate": diff.Get(successSampleRate).(int),
+		}
+
+		if tfMap["failure_feedback_role_arn"] != "" || tfMap["success_feedback_role_arn"] != "" {
+			tfList = append(tfList, tfMap)
+		}
+	}
+
+	if unknown {
+		return diff.SetNewComputed("delivery_status_logging")
+	}
+
+	return diff.SetNew("delivery_status_logging", tfList)
+}
+
+func expandTopicDeliveryStatusLogging(tfList []interface{}) map[string]string {
+	apiObject := make(map[string]string)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		attributeNames, ok := topicDeliveryStatusLoggingAttributeNames[tfMap[names.AttrProtocol].(string)]
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["failure_feedback_role_arn"].(string); ok && v != "" {
+			apiObject[attributeNames.failureFeedbackRoleARN] = v
+		}
+
+		if v, ok := tfMap["success_feedback_role_arn"].(string); ok && v != "" {
+			apiObject[attributeNames.successFeedbackRoleARN] = v
+
+			if v, ok := tfMap["success_feedback_sample_rate"].(int); ok {
+				apiObject[attributeNames.successFeedbackSampleRate] = strconv.Itoa(v)
+			}
+		}
+	}
+
+	return apiObject
+}
+
+func flattenTopicDeliveryStatusLogging(apiObject map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, protocol := range topicDeliveryStatusLoggingProtocol_Values() {
+		attributeNames := topicDeliveryStatusLoggingAttributeNames[protocol]
+		failureRoleARN, successRoleARN := apiObject[attributeNames.failureFeedbackRoleARN], apiObject[attributeNames.successFeedbackRoleARN]
+
+		if failureRoleARN == "" && successRoleARN == "" {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"failure_feedback_role_arn": failureRoleARN,
+			names.AttrProtocol:          protocol,
+			"success_feedback_role_arn": successRoleARN,
+		}
+
+		if v, err := strconv.Atoi(apiObject[attributeNames.successFeedbackSampleRate]); err == nil {
+			tfMap["success_feedback_sample_rate"] = v
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func putTopicAttributes(ctx context.Context, conn *sns.Client, arn string, attributes map[string]string) error {
 	for name, value := range attributes {
 		// Ignore an empty policy.
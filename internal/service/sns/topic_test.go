@@ -324,6 +324,82 @@ func TestAccSNSTopic_deliveryStatus(t *testing.T) {
 	})
 }
 
+func TestAccSNSTopic_deliveryStatusLogging(t *testing.T) {
+	ctx := acctest.Context(t)
+	var attributes map[string]string
+	resourceName := "aws_sns_topic.test"
+	iamRoleResourceName := "aws_iam_role.example"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTopicConfig_deliveryStatusLogging(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "delivery_status_logging.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "delivery_status_logging.*", map[string]string{
+						names.AttrProtocol:             "lambda",
+						"success_feedback_sample_rate": "100",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "delivery_status_logging.*.success_feedback_role_arn", iamRoleResourceName, names.AttrARN),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "delivery_status_logging.*", map[string]string{
+						names.AttrProtocol:          "sqs",
+						"success_feedback_role_arn": "",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "lambda_failure_feedback_role_arn", iamRoleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "lambda_success_feedback_sample_rate", "100"),
+					resource.TestCheckResourceAttrPair(resourceName, "sqs_failure_feedback_role_arn", iamRoleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "http_success_feedback_role_arn", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTopicConfig_deliveryStatusLoggingUpdated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTopicExists(ctx, resourceName, &attributes),
+					resource.TestCheckResourceAttr(resourceName, "delivery_status_logging.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "delivery_status_logging.*", map[string]string{
+						"failure_feedback_role_arn":    "",
+						names.AttrProtocol:             "http",
+						"success_feedback_sample_rate": "50",
+					}),
+					resource.TestCheckResourceAttrPair(resourceName, "http_success_feedback_role_arn", iamRoleResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "lambda_failure_feedback_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "lambda_success_feedback_role_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "sqs_failure_feedback_role_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSNSTopic_deliveryStatusLoggingSampleRateWithoutRole(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SNSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTopicDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTopicConfig_deliveryStatusLoggingSampleRateWithoutRole(rName),
+				ExpectError: regexache.MustCompile(`success_feedback_sample_rate requires success_feedback_role_arn`),
+			},
+		},
+	})
+}
+
 func TestAccSNSTopic_NameGenerated_fifoTopic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var attributes map[string]string
@@ -890,31 +966,8 @@ EOF
 `, rName)
 }
 
-func testAccTopicConfig_deliveryStatus(rName string) string {
+func testAccTopicConfig_deliveryStatusBase(rName string) string {
 	return fmt.Sprintf(`
-resource "aws_sns_topic" "test" {
-  depends_on = [aws_iam_role_policy.example]
-
-  name                                     = %[1]q
-  application_success_feedback_role_arn    = aws_iam_role.example.arn
-  application_success_feedback_sample_rate = 100
-  application_failure_feedback_role_arn    = aws_iam_role.example.arn
-  lambda_success_feedback_role_arn         = aws_iam_role.example.arn
-  lambda_success_feedback_sample_rate      = 90
-  lambda_failure_feedback_role_arn         = aws_iam_role.example.arn
-  http_success_feedback_role_arn           = aws_iam_role.example.arn
-  http_success_feedback_sample_rate        = 80
-  http_failure_feedback_role_arn           = aws_iam_role.example.arn
-  sqs_success_feedback_role_arn            = aws_iam_role.example.arn
-  sqs_success_feedback_sample_rate         = 70
-  sqs_failure_feedback_role_arn            = aws_iam_role.example.arn
-  firehose_success_feedback_sample_rate    = 60
-  firehose_failure_feedback_role_arn       = aws_iam_role.example.arn
-  firehose_success_feedback_role_arn       = aws_iam_role.example.arn
-
-  tracing_config = "Active"
-}
-
 data "aws_partition" "current" {}
 
 resource "aws_iam_role" "example" {
@@ -965,6 +1018,87 @@ EOF
 `, rName)
 }
 
+func testAccTopicConfig_deliveryStatus(rName string) string {
+	return acctest.ConfigCompose(testAccTopicConfig_deliveryStatusBase(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  depends_on = [aws_iam_role_policy.example]
+
+  name                                     = %[1]q
+  application_success_feedback_role_arn    = aws_iam_role.example.arn
+  application_success_feedback_sample_rate = 100
+  application_failure_feedback_role_arn    = aws_iam_role.example.arn
+  lambda_success_feedback_role_arn         = aws_iam_role.example.arn
+  lambda_success_feedback_sample_rate      = 90
+  lambda_failure_feedback_role_arn         = aws_iam_role.example.arn
+  http_success_feedback_role_arn           = aws_iam_role.example.arn
+  http_success_feedback_sample_rate        = 80
+  http_failure_feedback_role_arn           = aws_iam_role.example.arn
+  sqs_success_feedback_role_arn            = aws_iam_role.example.arn
+  sqs_success_feedback_sample_rate         = 70
+  sqs_failure_feedback_role_arn            = aws_iam_role.example.arn
+  firehose_success_feedback_sample_rate    = 60
+  firehose_failure_feedback_role_arn       = aws_iam_role.example.arn
+  firehose_success_feedback_role_arn       = aws_iam_role.example.arn
+
+  tracing_config = "Active"
+}
+`, rName))
+}
+
+func testAccTopicConfig_deliveryStatusLogging(rName string) string {
+	return acctest.ConfigCompose(testAccTopicConfig_deliveryStatusBase(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  depends_on = [aws_iam_role_policy.example]
+
+  name = %[1]q
+
+  delivery_status_logging {
+    protocol                     = "lambda"
+    success_feedback_role_arn    = aws_iam_role.example.arn
+    success_feedback_sample_rate = 100
+    failure_feedback_role_arn    = aws_iam_role.example.arn
+  }
+
+  delivery_status_logging {
+    protocol                  = "sqs"
+    failure_feedback_role_arn = aws_iam_role.example.arn
+  }
+}
+`, rName))
+}
+
+func testAccTopicConfig_deliveryStatusLoggingUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccTopicConfig_deliveryStatusBase(rName), fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  depends_on = [aws_iam_role_policy.example]
+
+  name = %[1]q
+
+  delivery_status_logging {
+    protocol                     = "http"
+    success_feedback_role_arn    = aws_iam_role.example.arn
+    success_feedback_sample_rate = 50
+  }
+}
+`, rName))
+}
+
+func testAccTopicConfig_deliveryStatusLoggingSampleRateWithoutRole(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  delivery_status_logging {
+    protocol                     = "sqs"
+    failure_feedback_role_arn    = "arn:${data.aws_partition.current.partition}:iam::123456789012:role/example"
+    success_feedback_sample_rate = 50
+  }
+}
+
+data "aws_partition" "current" {}
+`, rName)
+}
+
 func testAccTopicConfig_encryption(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_sns_topic" "test" {
@@ -7,10 +7,13 @@ package sqs
 var (
 	ResourceQueue                   = resourceQueue
 	ResourceQueuePolicy             = resourceQueuePolicy
+	ResourceQueuePolicyStatement    = resourceQueuePolicyStatement
 	ResourceQueueRedriveAllowPolicy = resourceQueueRedriveAllowPolicy
 	ResourceQueueRedrivePolicy      = resourceQueueRedrivePolicy
 
-	FindQueueAttributesByURL = findQueueAttributesByURL
+	FindQueueAttributesByURL             = findQueueAttributesByURL
+	FindQueuePolicyStatementByTwoPartKey = findQueuePolicyStatementByTwoPartKey
+	SetQueuePolicyStatement              = setQueuePolicyStatement
 
 	DefaultQueueDelaySeconds                  = defaultQueueDelaySeconds
 	DefaultQueueKMSDataKeyReusePeriodSeconds  = defaultQueueKMSDataKeyReusePeriodSeconds
@@ -111,18 +111,7 @@ var (
 			ForceNew:      true,
 			ConflictsWith: []string{names.AttrName},
 		},
-		names.AttrPolicy: {
-			Type:                  schema.TypeString,
-			Optional:              true,
-			Computed:              true,
-			ValidateFunc:          validation.StringIsJSON,
-			DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
-			DiffSuppressOnRefresh: true,
-			StateFunc: func(v interface{}) string {
-				json, _ := structure.NormalizeJsonString(v)
-				return json
-			},
-		},
+		names.AttrPolicy: sdkv2.IAMResourcePolicyDocumentSchemaOptionalComputed(),
 		"receive_wait_time_seconds": {
 			Type:     schema.TypeInt,
 			Optional: true,
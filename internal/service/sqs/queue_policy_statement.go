@@ -0,0 +1,517 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	queuePolicyStatementResourceIDPartCount = 2
+
+	// Maximum amount of time to keep retrying a queue policy read-modify-write
+	// whose change was overwritten by a concurrent writer.
+	queuePolicyStatementUpdateTimeout = 5 * time.Minute
+
+	queuePolicyVersion = "2012-10-17"
+)
+
+// @SDKResource("aws_sqs_queue_policy_statement", name="Queue Policy Statement")
+func resourceQueuePolicyStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceQueuePolicyStatementCreate,
+		ReadWithoutTimeout:   resourceQueuePolicyStatementRead,
+		UpdateWithoutTimeout: resourceQueuePolicyStatementUpdate,
+		DeleteWithoutTimeout: resourceQueuePolicyStatementDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceQueuePolicyStatementImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"queue_url": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"sid": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"statement": {
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringIsJSON,
+				DiffSuppressFunc:      suppressEquivalentQueuePolicyStatementDiffs,
+				DiffSuppressOnRefresh: true,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+		},
+	}
+}
+
+func resourceQueuePolicyStatementCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url, sid := d.Get("queue_url").(string), d.Get("sid").(string)
+	id, err := flex.FlattenResourceId([]string{url, sid}, queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	statement, err := expandQueuePolicyStatement(d.Get("statement").(string), sid)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := putQueuePolicyStatement(ctx, conn, url, sid, statement); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SQS Queue Policy Statement (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceQueuePolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceQueuePolicyStatementRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	url, sid := parts[0], parts[1]
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(ctx, queueAttributeReadTimeout, func() (interface{}, error) {
+		return findQueuePolicyStatementByTwoPartKey(ctx, conn, url, sid)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SQS Queue Policy Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	statement, err := queuePolicyStatementToSet(d.Get("statement").(string), sid, outputRaw.(map[string]interface{}))
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.Set("queue_url", url)
+	d.Set("sid", sid)
+	d.Set("statement", statement)
+
+	return diags
+}
+
+func resourceQueuePolicyStatementUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url, sid := d.Get("queue_url").(string), d.Get("sid").(string)
+	statement, err := expandQueuePolicyStatement(d.Get("statement").(string), sid)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	if err := putQueuePolicyStatement(ctx, conn, url, sid, statement); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceQueuePolicyStatementRead(ctx, d, meta)...)
+}
+
+func resourceQueuePolicyStatementDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SQSClient(ctx)
+
+	url, sid := d.Get("queue_url").(string), d.Get("sid").(string)
+
+	log.Printf("[DEBUG] Deleting SQS Queue Policy Statement: %s", d.Id())
+	err := putQueuePolicyStatement(ctx, conn, url, sid, nil)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SQS Queue Policy Statement (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceQueuePolicyStatementImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := flex.ExpandResourceId(d.Id(), queuePolicyStatementResourceIDPartCount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("queue_url", parts[0])
+	d.Set("sid", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// putQueuePolicyStatement adds or replaces (or, if statement is nil, removes) the queue policy statement with the specified Sid,
+// leaving all other statements in the queue policy unchanged.
+// SQS has no conditional write, so after each read-modify-write the change is verified and, if a concurrent writer
+// outside this provider overwrote it, the read-modify-write is retried.
+func putQueuePolicyStatement(ctx context.Context, conn *sqs.Client, url, sid string, statement map[string]interface{}) error {
+	// Serialize read-modify-write cycles against the same queue within this provider.
+	conns.GlobalMutexKV.Lock(url)
+	defer conns.GlobalMutexKV.Unlock(url)
+
+	return tfresource.Retry(ctx, queuePolicyStatementUpdateTimeout, func() *retry.RetryError {
+		policy, err := findQueuePolicyByURL(ctx, conn, url)
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		newPolicy, err := setQueuePolicyStatement(policy, sid, statement)
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		input := &sqs.SetQueueAttributesInput{
+			Attributes: map[string]string{
+				string(types.QueueAttributeNamePolicy): newPolicy,
+			},
+			QueueUrl: aws.String(url),
+		}
+
+		_, err = conn.SetQueueAttributes(ctx, input)
+
+		if tfawserr.ErrMessageContains(err, errCodeInvalidAttributeValue, "Invalid value for the parameter Policy") {
+			return retry.RetryableError(err)
+		}
+
+		if err != nil {
+			return retry.NonRetryableError(err)
+		}
+
+		if err := waitQueuePolicyStatementPropagated(ctx, conn, url, sid, statement); err != nil {
+			if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+				return retry.NonRetryableError(err)
+			}
+
+			return retry.RetryableError(fmt.Errorf("waiting for SQS Queue (%s) policy statement (%s) to propagate: %w", url, sid, err))
+		}
+
+		return nil
+	})
+}
+
+// findQueuePolicyByURL returns the queue's policy, or an empty string if the queue has no policy.
+func findQueuePolicyByURL(ctx context.Context, conn *sqs.Client, url string) (string, error) {
+	input := &sqs.GetQueueAttributesInput{
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNamePolicy},
+		QueueUrl:       aws.String(url),
+	}
+
+	output, err := conn.GetQueueAttributes(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeQueueDoesNotExist) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Attributes[string(types.QueueAttributeNamePolicy)], nil
+}
+
+func findQueuePolicyStatementByTwoPartKey(ctx context.Context, conn *sqs.Client, url, sid string) (map[string]interface{}, error) {
+	policy, err := findQueuePolicyByURL(ctx, conn, url)
+
+	if err != nil {
+		return nil, err
+	}
+
+	statement, err := queuePolicyStatementBySID(policy, sid)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if statement == nil {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("SQS Queue (%s) policy statement (%s) not found", url, sid),
+		}
+	}
+
+	return statement, nil
+}
+
+func statusQueuePolicyStatement(ctx context.Context, conn *sqs.Client, url, sid string, expected map[string]interface{}) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		policy, err := findQueuePolicyByURL(ctx, conn, url)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		got, err := queuePolicyStatementBySID(policy, sid)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch {
+		case expected == nil && got == nil:
+			return policy, queueAttributeStateEqual, nil
+		case expected == nil || got == nil:
+			return policy, queueAttributeStateNotEqual, nil
+		}
+
+		equivalent, err := queuePolicyStatementsEquivalent(got, expected)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if !equivalent {
+			return policy, queueAttributeStateNotEqual, nil
+		}
+
+		return policy, queueAttributeStateEqual, nil
+	}
+}
+
+func waitQueuePolicyStatementPropagated(ctx context.Context, conn *sqs.Client, url, sid string, expected map[string]interface{}) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{queueAttributeStateNotEqual},
+		Target:                    []string{queueAttributeStateEqual},
+		Refresh:                   statusQueuePolicyStatement(ctx, conn, url, sid, expected),
+		Timeout:                   propagationTimeout,
+		ContinuousTargetOccurence: 3,
+		MinTimeout:                5 * time.Second,
+		NotFoundChecks:            10,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// expandQueuePolicyStatement parses a single policy statement, setting its Sid.
+func expandQueuePolicyStatement(s, sid string) (map[string]interface{}, error) {
+	var statement map[string]interface{}
+
+	if err := json.Unmarshal([]byte(s), &statement); err != nil {
+		return nil, fmt.Errorf("statement (%s) is not a JSON object: %w", s, err)
+	}
+
+	if v, ok := statement["Sid"]; ok && v != sid {
+		return nil, fmt.Errorf("statement Sid (%v) does not match sid (%s)", v, sid)
+	}
+
+	statement["Sid"] = sid
+
+	return statement, nil
+}
+
+// queuePolicyStatements returns the statements in a policy document.
+// A policy's Statement element may be either a single statement or an array of statements.
+func queuePolicyStatements(document map[string]interface{}) ([]interface{}, error) {
+	switch v := document["Statement"].(type) {
+	case nil:
+		return nil, nil
+	case []interface{}:
+		return v, nil
+	case map[string]interface{}:
+		return []interface{}{v}, nil
+	default:
+		return nil, fmt.Errorf("unexpected policy Statement type: %T", v)
+	}
+}
+
+func queuePolicyStatementBySID(policy, sid string) (map[string]interface{}, error) {
+	if policy == "" {
+		return nil, nil
+	}
+
+	var document map[string]interface{}
+
+	if err := json.Unmarshal([]byte(policy), &document); err != nil {
+		return nil, fmt.Errorf("parsing SQS Queue policy: %w", err)
+	}
+
+	statements, err := queuePolicyStatements(document)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range statements {
+		if statement, ok := v.(map[string]interface{}); ok && statement["Sid"] == sid {
+			return statement, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// setQueuePolicyStatement returns the policy with the statement with the specified Sid added or replaced
+// or, if statement is nil, removed. All other elements of the policy are preserved.
+// An empty string is returned if no statements remain.
+func setQueuePolicyStatement(policy, sid string, statement map[string]interface{}) (string, error) {
+	document := map[string]interface{}{
+		"Version": queuePolicyVersion,
+	}
+
+	if policy != "" {
+		if err := json.Unmarshal([]byte(policy), &document); err != nil {
+			return "", fmt.Errorf("parsing SQS Queue policy: %w", err)
+		}
+	}
+
+	statements, err := queuePolicyStatements(document)
+
+	if err != nil {
+		return "", err
+	}
+
+	var newStatements []interface{}
+	var found bool
+
+	for _, v := range statements {
+		if v, ok := v.(map[string]interface{}); ok && v["Sid"] == sid {
+			if statement != nil && !found {
+				newStatements = append(newStatements, statement)
+			}
+			found = true
+			continue
+		}
+
+		newStatements = append(newStatements, v)
+	}
+
+	if statement != nil && !found {
+		newStatements = append(newStatements, statement)
+	}
+
+	if len(newStatements) == 0 {
+		return "", nil
+	}
+
+	document["Statement"] = newStatements
+
+	output, err := json.Marshal(document)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
+
+// queuePolicyStatementsEquivalent returns whether two policy statements are semantically equivalent.
+func queuePolicyStatementsEquivalent(s1, s2 map[string]interface{}) (bool, error) {
+	p1, err := setQueuePolicyStatement("", "", s1)
+
+	if err != nil {
+		return false, err
+	}
+
+	p2, err := setQueuePolicyStatement("", "", s2)
+
+	if err != nil {
+		return false, err
+	}
+
+	return awspolicy.PoliciesAreEquivalent(p1, p2)
+}
+
+// queuePolicyStatementToSet returns the configured statement if it is equivalent to the statement read from the queue policy,
+// otherwise the statement read from the queue policy without its Sid.
+func queuePolicyStatementToSet(configured, sid string, statement map[string]interface{}) (string, error) {
+	if configured != "" {
+		if v, err := expandQueuePolicyStatement(configured, sid); err == nil {
+			if equivalent, err := queuePolicyStatementsEquivalent(v, statement); err == nil && equivalent {
+				return configured, nil
+			}
+		}
+	}
+
+	v := make(map[string]interface{}, len(statement))
+	for k, e := range statement {
+		if k != "Sid" {
+			v[k] = e
+		}
+	}
+
+	output, err := json.Marshal(v)
+
+	if err != nil {
+		return "", err
+	}
+
+	return structure.NormalizeJsonString(string(output))
+}
+
+func suppressEquivalentQueuePolicyStatementDiffs(k, old, new string, d *schema.ResourceData) bool {
+	sid := d.Get("sid").(string)
+
+	s1, err := expandQueuePolicyStatement(old, sid)
+
+	if err != nil {
+		return false
+	}
+
+	s2, err := expandQueuePolicyStatement(new, sid)
+
+	if err != nil {
+		return false
+	}
+
+	equivalent, err := queuePolicyStatementsEquivalent(s1, s2)
+
+	return err == nil && equivalent
+}
@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sqs_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsqs "github.com/hashicorp/terraform-provider-aws/internal/service/sqs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSetQueuePolicyStatement(t *testing.T) {
+	t.Parallel()
+
+	statement := map[string]interface{}{
+		"Sid":       "Producer",
+		"Effect":    "Allow",
+		"Principal": "*",
+		"Action":    "sqs:SendMessage",
+		"Resource":  "*",
+	}
+
+	testCases := []struct {
+		name      string
+		policy    string
+		sid       string
+		statement map[string]interface{}
+		want      string
+	}{
+		{
+			name:      "add to empty policy",
+			sid:       "Producer",
+			statement: statement,
+			want:      `{"Version":"2012-10-17","Statement":[{"Sid":"Producer","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+		},
+		{
+			name:      "add to existing policy",
+			policy:    `{"Version":"2012-10-17","Id":"example","Statement":{"Sid":"Consumer","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}}`,
+			sid:       "Producer",
+			statement: statement,
+			want:      `{"Version":"2012-10-17","Id":"example","Statement":[{"Sid":"Consumer","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"},{"Sid":"Producer","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+		},
+		{
+			name:      "replace",
+			policy:    `{"Version":"2012-10-17","Statement":[{"Sid":"Producer","Effect":"Deny","Principal":"*","Action":"sqs:*","Resource":"*"},{"Sid":"Consumer","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`,
+			sid:       "Producer",
+			statement: statement,
+			want:      `{"Version":"2012-10-17","Statement":[{"Sid":"Producer","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"},{"Sid":"Consumer","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`,
+		},
+		{
+			name:   "remove",
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"Producer","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"},{"Sid":"Consumer","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`,
+			sid:    "Producer",
+			want:   `{"Version":"2012-10-17","Statement":[{"Sid":"Consumer","Effect":"Allow","Principal":"*","Action":"sqs:ReceiveMessage","Resource":"*"}]}`,
+		},
+		{
+			name:   "remove last",
+			policy: `{"Version":"2012-10-17","Statement":[{"Sid":"Producer","Effect":"Allow","Principal":"*","Action":"sqs:SendMessage","Resource":"*"}]}`,
+			sid:    "Producer",
+			want:   "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfsqs.SetQueuePolicyStatement(testCase.policy, testCase.sid, testCase.statement)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if testCase.want == "" {
+				if got != "" {
+					t.Errorf("got %s, want empty policy", got)
+				}
+				return
+			}
+
+			if equivalent, err := awspolicy.PoliciesAreEquivalent(got, testCase.want); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			} else if !equivalent {
+				t.Errorf("got %s, want %s", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestAccSQSQueuePolicyStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	resource2Name := "aws_sqs_queue_policy_statement.test2"
+	queueResourceName := "aws_sqs_queue.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					testAccCheckQueuePolicyStatementExists(ctx, resource2Name),
+					resource.TestCheckResourceAttrPair(resourceName, "queue_url", queueResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "sid", "Producer"),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile(`sqs:SendMessage`)),
+					resource.TestCheckResourceAttr(resource2Name, "sid", "Consumer"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsqs.ResourceQueuePolicyStatement(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSQSQueuePolicyStatement_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_sqs_queue_policy_statement.test"
+	resource2Name := "aws_sqs_queue_policy_statement.test2"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SQSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckQueuePolicyStatementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueuePolicyStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					testAccCheckQueuePolicyStatementExists(ctx, resource2Name),
+				),
+			},
+			{
+				Config: testAccQueuePolicyStatementConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckQueuePolicyStatementExists(ctx, resourceName),
+					testAccCheckQueuePolicyStatementExists(ctx, resource2Name),
+					resource.TestMatchResourceAttr(resourceName, "statement", regexache.MustCompile(`sqs:GetQueueAttributes`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckQueuePolicyStatementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["sid"])
+
+		return err
+	}
+}
+
+func testAccCheckQueuePolicyStatementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SQSClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_sqs_queue_policy_statement" {
+				continue
+			}
+
+			_, err := tfsqs.FindQueuePolicyStatementByTwoPartKey(ctx, conn, rs.Primary.Attributes["queue_url"], rs.Primary.Attributes["sid"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SQS Queue Policy Statement %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccQueuePolicyStatementConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+`, rName)
+}
+
+func testAccQueuePolicyStatementConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_base(rName), `
+resource "aws_sqs_queue_policy_statement" "test" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "Producer"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    Action    = "sqs:SendMessage"
+    Resource  = aws_sqs_queue.test.arn
+  })
+}
+
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "Consumer"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    Action    = ["sqs:ReceiveMessage", "sqs:DeleteMessage"]
+    Resource  = aws_sqs_queue.test.arn
+  })
+}
+`)
+}
+
+func testAccQueuePolicyStatementConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccQueuePolicyStatementConfig_base(rName), `
+resource "aws_sqs_queue_policy_statement" "test" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "Producer"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    Action    = ["sqs:SendMessage", "sqs:GetQueueAttributes"]
+    Resource  = aws_sqs_queue.test.arn
+  })
+}
+
+resource "aws_sqs_queue_policy_statement" "test2" {
+  queue_url = aws_sqs_queue.test.id
+  sid       = "Consumer"
+
+  statement = jsonencode({
+    Effect    = "Allow"
+    Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+    Action    = ["sqs:ReceiveMessage", "sqs:DeleteMessage"]
+    Resource  = aws_sqs_queue.test.arn
+  })
+}
+`)
+}
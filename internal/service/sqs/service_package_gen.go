@@ -52,6 +52,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  resourceQueuePolicy,
 			TypeName: "aws_sqs_queue_policy",
 		},
+		{
+			Factory:  resourceQueuePolicyStatement,
+			TypeName: "aws_sqs_queue_policy_statement",
+			Name:     "Queue Policy Statement",
+		},
 		{
 			Factory:  resourceQueueRedriveAllowPolicy,
 			TypeName: "aws_sqs_queue_redrive_allow_policy",
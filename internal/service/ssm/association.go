@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -242,7 +243,7 @@ func resourceAssociationCreate(ctx context.Context, d *schema.ResourceData, meta
 	d.SetId(aws.ToString(output.AssociationDescription.AssociationId))
 
 	if v, ok := d.GetOk("wait_for_success_timeout_seconds"); ok {
-		timeout := time.Duration(v.(int)) * time.Second //nolint:durationcheck // should really be d.Timeout(schema.TimeoutCreate)
+		timeout := time.Duration(v.(int)) * time.Second //nolint:durationcheck // should really be sdkv2.Timeout(ctx, d, schema.TimeoutCreate)
 		if _, err := waitAssociationCreated(ctx, conn, d.Id(), timeout); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for SSM Association (%s) create: %s", d.Id(), err)
 		}
@@ -41,6 +41,7 @@ const (
 
 // @SDKResource("aws_ssm_document", name="Document")
 // @Tags(identifierAttribute="id", resourceType="Document")
+// @PluralDataSource("aws_ssm_documents", listOp="ListDocuments", listOpOutputElem="DocumentIdentifiers", nameElem="Name")
 func resourceDocument() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDocumentCreate,
@@ -6,6 +6,7 @@ package ssmcontacts
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -13,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -28,10 +30,19 @@ func ResourceContactChannel() *schema.Resource {
 		DeleteWithoutTimeout: resourceContactChannelDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("defer_activation", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
+			"activation_code": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 10),
+			},
 			"activation_status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -45,6 +56,12 @@ func ResourceContactChannel() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"defer_activation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
 			"delivery_address": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -82,7 +99,7 @@ func resourceContactChannelCreate(ctx context.Context, d *schema.ResourceData, m
 	delivery_address := expandContactChannelAddress(d.Get("delivery_address").([]interface{}))
 	in := &ssmcontacts.CreateContactChannelInput{
 		ContactId:       aws.String(d.Get("contact_id").(string)),
-		DeferActivation: aws.Bool(true),
+		DeferActivation: aws.Bool(d.Get("defer_activation").(bool)),
 		DeliveryAddress: delivery_address,
 		Name:            aws.String(d.Get(names.AttrName).(string)),
 		Type:            types.ChannelType(d.Get(names.AttrType).(string)),
@@ -99,6 +116,12 @@ func resourceContactChannelCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.ToString(out.ContactChannelArn))
 
+	if v, ok := d.GetOk("activation_code"); ok {
+		if err := activateContactChannel(ctx, conn, d.Id(), v.(string)); err != nil {
+			return create.AppendDiagError(diags, names.SSMContacts, create.ErrActionCreating, ResNameContactChannel, d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceContactChannelRead(ctx, d, meta)...)
 }
 
@@ -145,14 +168,20 @@ func resourceContactChannelUpdate(ctx context.Context, d *schema.ResourceData, m
 		update = true
 	}
 
-	if !update {
-		return diags
+	if update {
+		log.Printf("[DEBUG] Updating SSMContacts ContactChannel (%s): %#v", d.Id(), in)
+		_, err := conn.UpdateContactChannel(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.SSMContacts, create.ErrActionUpdating, ResNameContactChannel, d.Id(), err)
+		}
 	}
 
-	log.Printf("[DEBUG] Updating SSMContacts ContactChannel (%s): %#v", d.Id(), in)
-	_, err := conn.UpdateContactChannel(ctx, in)
-	if err != nil {
-		return create.AppendDiagError(diags, names.SSMContacts, create.ErrActionUpdating, ResNameContactChannel, d.Id(), err)
+	if d.HasChange("activation_code") {
+		if v, ok := d.GetOk("activation_code"); ok {
+			if err := activateContactChannel(ctx, conn, d.Id(), v.(string)); err != nil {
+				return create.AppendDiagError(diags, names.SSMContacts, create.ErrActionUpdating, ResNameContactChannel, d.Id(), err)
+			}
+		}
 	}
 
 	return append(diags, resourceContactChannelRead(ctx, d, meta)...)
@@ -179,3 +208,18 @@ func resourceContactChannelDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// activateContactChannel completes the activation of a contact channel using
+// the code sent to its delivery address.
+func activateContactChannel(ctx context.Context, conn *ssmcontacts.Client, id, code string) error {
+	_, err := conn.ActivateContactChannel(ctx, &ssmcontacts.ActivateContactChannelInput{
+		ActivationCode:   aws.String(code),
+		ContactChannelId: aws.String(id),
+	})
+
+	if err != nil {
+		return fmt.Errorf("activating: %w", err)
+	}
+
+	return nil
+}
@@ -6,9 +6,11 @@ package ssmcontacts
 // Exports for use in tests only.
 
 var (
-	ResourceRotation = newResourceRotation
+	ResourceRotation         = newResourceRotation
+	ResourceRotationOverride = newResourceRotationOverride
 )
 
 var (
-	FindRotationByID = findRotationByID
+	FindRotationByID                 = findRotationByID
+	FindRotationOverrideByTwoPartKey = findRotationOverrideByTwoPartKey
 )
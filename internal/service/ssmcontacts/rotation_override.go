@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssmcontacts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssmcontacts/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameRotationOverride = "Rotation Override"
+
+	rotationOverrideIDSeparator = ","
+)
+
+// @FrameworkResource(name="Rotation Override")
+func newResourceRotationOverride(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceRotationOverride{}
+
+	return r, nil
+}
+
+type resourceRotationOverride struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoUpdate
+}
+
+func (r *resourceRotationOverride) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ssmcontacts_rotation_override"
+}
+
+func (r *resourceRotationOverride) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"new_contact_ids": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeBetween(1, 30),
+				},
+			},
+			"rotation_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_override_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceRotationOverride) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().SSMContactsClient(ctx)
+	var plan resourceRotationOverrideData
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rotationID := plan.RotationID.ValueString()
+	input := &ssmcontacts.CreateRotationOverrideInput{
+		EndTime:          fwflex.TimeFromFramework(ctx, plan.EndTime),
+		IdempotencyToken: aws.String(id.UniqueId()),
+		NewContactIds:    fwflex.ExpandFrameworkStringValueList(ctx, plan.NewContactIDs),
+		RotationId:       aws.String(rotationID),
+		StartTime:        fwflex.TimeFromFramework(ctx, plan.StartTime),
+	}
+
+	output, err := conn.CreateRotationOverride(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSMContacts, create.ErrActionCreating, ResNameRotationOverride, rotationID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	rotationOverrideID := aws.ToString(output.RotationOverrideId)
+	plan.ID = types.StringValue(rotationOverrideCreateResourceID(rotationID, rotationOverrideID))
+	plan.RotationOverrideID = types.StringValue(rotationOverrideID)
+
+	out, err := findRotationOverrideByTwoPartKey(ctx, conn, rotationID, rotationOverrideID)
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSMContacts, create.ErrActionReading, ResNameRotationOverride, plan.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.CreateTime = fwflex.TimeToFramework(ctx, out.CreateTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceRotationOverride) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().SSMContactsClient(ctx)
+	var state resourceRotationOverrideData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rotationID, rotationOverrideID, err := rotationOverrideParseResourceID(state.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	output, err := findRotationOverrideByTwoPartKey(ctx, conn, rotationID, rotationOverrideID)
+
+	if tfresource.NotFound(err) {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSMContacts, create.ErrActionReading, ResNameRotationOverride, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.NewContactIds, &state.NewContactIDs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	state.CreateTime = fwflex.TimeToFramework(ctx, output.CreateTime)
+	state.EndTime = fwflex.TimeToFramework(ctx, output.EndTime)
+	state.RotationID = types.StringValue(rotationID)
+	state.RotationOverrideID = fwflex.StringToFramework(ctx, output.RotationOverrideId)
+	state.StartTime = fwflex.TimeToFramework(ctx, output.StartTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+func (r *resourceRotationOverride) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().SSMContactsClient(ctx)
+	var state resourceRotationOverrideData
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	rotationID, rotationOverrideID, err := rotationOverrideParseResourceID(state.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, "deleting SSMContacts Rotation Override", map[string]interface{}{
+		names.AttrID: state.ID.ValueString(),
+	})
+
+	_, err = conn.DeleteRotationOverride(ctx, &ssmcontacts.DeleteRotationOverrideInput{
+		RotationId:         aws.String(rotationID),
+		RotationOverrideId: aws.String(rotationOverrideID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SSMContacts, create.ErrActionDeleting, ResNameRotationOverride, state.ID.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+type resourceRotationOverrideData struct {
+	CreateTime         timetypes.RFC3339                 `tfsdk:"create_time"`
+	EndTime            timetypes.RFC3339                 `tfsdk:"end_time"`
+	ID                 types.String                      `tfsdk:"id"`
+	NewContactIDs      fwtypes.ListValueOf[types.String] `tfsdk:"new_contact_ids"`
+	RotationID         types.String                      `tfsdk:"rotation_id"`
+	RotationOverrideID types.String                      `tfsdk:"rotation_override_id"`
+	StartTime          timetypes.RFC3339                 `tfsdk:"start_time"`
+}
+
+func rotationOverrideCreateResourceID(rotationID, rotationOverrideID string) string {
+	parts := []string{rotationID, rotationOverrideID}
+	id := strings.Join(parts, rotationOverrideIDSeparator)
+
+	return id
+}
+
+func rotationOverrideParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, rotationOverrideIDSeparator)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected ROTATION-ID%[2]sROTATION-OVERRIDE-ID", id, rotationOverrideIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+func findRotationOverrideByTwoPartKey(ctx context.Context, conn *ssmcontacts.Client, rotationID, rotationOverrideID string) (*ssmcontacts.GetRotationOverrideOutput, error) {
+	in := &ssmcontacts.GetRotationOverrideInput{
+		RotationId:         aws.String(rotationID),
+		RotationOverrideId: aws.String(rotationOverrideID),
+	}
+	out, err := conn.GetRotationOverride(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
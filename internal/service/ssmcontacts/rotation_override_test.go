@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ssmcontacts_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfssmcontacts "github.com/hashicorp/terraform-provider-aws/internal/service/ssmcontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRotationOverride_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation_override.test"
+	rotationResourceName := "aws_ssmcontacts_rotation.test"
+	startTime := time.Now().UTC().AddDate(0, 0, 2).Truncate(time.Hour).Format(time.RFC3339)
+	endTime := time.Now().UTC().AddDate(0, 0, 3).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationOverrideDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationOverrideConfig_basic(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationOverrideExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreateTime),
+					resource.TestCheckResourceAttr(resourceName, "end_time", endTime),
+					resource.TestCheckResourceAttr(resourceName, "new_contact_ids.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "new_contact_ids.0", "aws_ssmcontacts_contact.test.1", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "rotation_id", rotationResourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "rotation_override_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStartTime, startTime),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// We need to explicitly test destroying this resource instead of just using CheckDestroy,
+				// because CheckDestroy will run after the replication set has been destroyed and destroying
+				// the replication set will destroy all other resources.
+				Config: testAccRotationConfig_twoContacts(rName),
+				Check:  testAccCheckRotationOverrideDestroy(ctx),
+			},
+		},
+	})
+}
+
+func testAccRotationOverride_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssmcontacts_rotation_override.test"
+	startTime := time.Now().UTC().AddDate(0, 0, 2).Truncate(time.Hour).Format(time.RFC3339)
+	endTime := time.Now().UTC().AddDate(0, 0, 3).Truncate(time.Hour).Format(time.RFC3339)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMContactsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRotationOverrideDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotationOverrideConfig_basic(rName, startTime, endTime),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRotationOverrideExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfssmcontacts.ResourceRotationOverride, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRotationOverrideDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssmcontacts_rotation_override" {
+				continue
+			}
+
+			_, err := tfssmcontacts.FindRotationOverrideByTwoPartKey(ctx, conn, rs.Primary.Attributes["rotation_id"], rs.Primary.Attributes["rotation_override_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				if strings.Contains(err.Error(), "Invalid value provided - Account not found for the request") {
+					continue
+				}
+
+				return err
+			}
+
+			return create.Error(names.SSMContacts, create.ErrActionCheckingDestroyed, tfssmcontacts.ResNameRotationOverride, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRotationOverrideExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotationOverride, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotationOverride, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMContactsClient(ctx)
+		_, err := tfssmcontacts.FindRotationOverrideByTwoPartKey(ctx, conn, rs.Primary.Attributes["rotation_id"], rs.Primary.Attributes["rotation_override_id"])
+
+		if err != nil {
+			return create.Error(names.SSMContacts, create.ErrActionCheckingExistence, tfssmcontacts.ResNameRotationOverride, rs.Primary.ID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccRotationOverrideConfig_basic(rName, startTime, endTime string) string {
+	return acctest.ConfigCompose(
+		testAccRotationConfig_twoContacts(rName),
+		fmt.Sprintf(`
+resource "aws_ssmcontacts_rotation_override" "test" {
+  rotation_id     = aws_ssmcontacts_rotation.test.id
+  new_contact_ids = [aws_ssmcontacts_contact.test[1].arn]
+
+  start_time = %[1]q
+  end_time   = %[2]q
+}
+`, startTime, endTime))
+}
@@ -60,6 +60,10 @@ func TestAccSSMContacts_serial(t *testing.T) {
 			"recurrence":         testAccRotation_recurrence,
 			"tags":               testAccRotation_tags,
 		},
+		"RotationOverrideResource": {
+			acctest.CtBasic:      testAccRotationOverride_basic,
+			acctest.CtDisappears: testAccRotationOverride_disappears,
+		},
 		"RotationDataSource": {
 			acctest.CtBasic:   testAccRotationDataSource_basic,
 			"dailySettings":   testAccRotationDataSource_dailySettings,
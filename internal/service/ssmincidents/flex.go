@@ -400,15 +400,15 @@ func flattenPagerDutyIntegration(integrations []types.Integration) []interface{}
 			pagerDutyData := map[string]interface{}{}
 
 			if v := pagerDutyConfiguration.Name; v != nil {
-				pagerDutyData[names.AttrName] = v
+				pagerDutyData[names.AttrName] = aws.ToString(v)
 			}
 
-			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration.ServiceId; v != nil {
-				pagerDutyData["service_id"] = v
+			if v := pagerDutyConfiguration.PagerDutyIncidentConfiguration; v != nil {
+				pagerDutyData["service_id"] = aws.ToString(v.ServiceId)
 			}
 
 			if v := pagerDutyConfiguration.SecretId; v != nil {
-				pagerDutyData["secret_id"] = v
+				pagerDutyData["secret_id"] = aws.ToString(v)
 			}
 
 			result = append(result, pagerDutyData)
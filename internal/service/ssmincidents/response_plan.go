@@ -6,15 +6,18 @@ package ssmincidents
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
 	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -52,16 +55,18 @@ func ResourceResponsePlan() *schema.Resource {
 										Required: true,
 									},
 									names.AttrRoleARN: {
-										Type:     schema.TypeString,
-										Required: true,
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
 									},
 									"document_version": {
 										Type:     schema.TypeString,
 										Optional: true,
 									},
 									"target_account": {
-										Type:     schema.TypeString,
-										Optional: true,
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[types.SsmTargetAccount](),
 									},
 									names.AttrParameter: {
 										Type:     schema.TypeSet,
@@ -81,9 +86,10 @@ func ResourceResponsePlan() *schema.Resource {
 										},
 									},
 									"dynamic_parameters": {
-										Type:     schema.TypeMap,
-										Optional: true,
-										Elem:     &schema.Schema{Type: schema.TypeString},
+										Type:             schema.TypeMap,
+										Optional:         true,
+										Elem:             &schema.Schema{Type: schema.TypeString},
+										ValidateDiagFunc: verify.MapValuesAre(enum.Validate[types.VariableType]()),
 									},
 								},
 							},
@@ -98,8 +104,11 @@ func ResourceResponsePlan() *schema.Resource {
 			"chat_channel": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				Elem:     &schema.Schema{Type: schema.TypeString},
-				Set:      schema.HashString,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+				Set: schema.HashString,
 			},
 			names.AttrDisplayName: {
 				Type:     schema.TypeString,
@@ -186,7 +195,10 @@ func ResourceResponsePlan() *schema.Resource {
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceResponsePlanCustomizeDiff,
+		),
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -275,6 +287,11 @@ func resourceResponsePlanUpdate(ctx context.Context, d *schema.ResourceData, met
 			incidentTemplate := d.Get("incident_template")
 			template := expandIncidentTemplate(incidentTemplate.([]interface{}))
 			updateResponsePlanInputWithIncidentTemplate(input, template)
+
+			// An empty map is required to remove all tags from the template.
+			if d.HasChange("incident_template.0.incident_tags") && input.IncidentTemplateTags == nil {
+				input.IncidentTemplateTags = map[string]string{}
+			}
 		}
 
 		if d.HasChanges("integration") {
@@ -316,6 +333,55 @@ func resourceResponsePlanDelete(ctx context.Context, d *schema.ResourceData, met
 	return diags
 }
 
+func resourceResponsePlanCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	tfList, ok := d.Get("action.0.ssm_automation").([]interface{})
+
+	if !ok {
+		return nil
+	}
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		dynamicParameters, ok := tfMap["dynamic_parameters"].(map[string]interface{})
+
+		if !ok || len(dynamicParameters) == 0 {
+			continue
+		}
+
+		parameters, ok := tfMap[names.AttrParameter].(*schema.Set)
+
+		if !ok {
+			continue
+		}
+
+		for _, parameterRaw := range parameters.List() {
+			parameter, ok := parameterRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			name := parameter[names.AttrName].(string)
+
+			// Unknown values are skipped until apply.
+			if name == "" {
+				continue
+			}
+
+			if _, ok := dynamicParameters[name]; ok {
+				return fmt.Errorf("action.0.ssm_automation.%d: parameter %q is also set in dynamic_parameters", i, name)
+			}
+		}
+	}
+
+	return nil
+}
+
 // input validation already done in flattenIncidentTemplate function
 func updateResponsePlanInputWithIncidentTemplate(input *ssmincidents.UpdateResponsePlanInput, template *types.IncidentTemplate) {
 	input.IncidentTemplateImpact = template.Impact
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -416,6 +417,13 @@ func testAccResponsePlan_incidentTemplateOptionalFields(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"replication_set_arn"},
 			},
+			{
+				Config: testAccResponsePlanConfig_incidentTemplateOptionalFieldsNoTags(rName, rTitle, rDedupeStringUpd, rSummaryUpd, snsTopic2, snsTopic3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResponsePlanExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "incident_template.0.incident_tags.%", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
@@ -637,6 +645,27 @@ func testAccResponsePlan_engagement(t *testing.T) {
 	})
 }
 
+func testAccResponsePlan_actionParameterConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SSMIncidentsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSMIncidentsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResponsePlanDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResponsePlanConfig_actionParameterConflict(rName),
+				ExpectError: regexache.MustCompile(`parameter "key" is also set in dynamic_parameters`),
+			},
+		},
+	})
+}
+
 func testAccResponsePlan_action(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping long-running test in short mode")
@@ -1009,6 +1038,34 @@ resource "aws_ssmincidents_response_plan" "test" {
 `, name, title, dedupeString, summary, tagKey, tagVal, snsTopic1+".arn", snsTopic2+".arn"))
 }
 
+func testAccResponsePlanConfig_incidentTemplateOptionalFieldsNoTags(name, title, dedupeString, summary, snsTopic1, snsTopic2 string) string {
+	return acctest.ConfigCompose(
+		testAccResponsePlanConfig_base(),
+		testAccResponsePlanConfig_baseSNSTopic(),
+		fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title         = %[2]q
+    impact        = "3"
+    dedupe_string = %[3]q
+    summary       = %[4]q
+
+    notification_target {
+      sns_topic_arn = %[5]s
+    }
+
+    notification_target {
+      sns_topic_arn = %[6]s
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
+}
+`, name, title, dedupeString, summary, snsTopic1+".arn", snsTopic2+".arn"))
+}
+
 func testAccResponsePlanConfig_displayName(name, displayName string) string {
 	return acctest.ConfigCompose(
 		testAccResponsePlanConfig_base(),
@@ -1179,6 +1236,39 @@ resource "aws_ssmincidents_response_plan" "test" {
 `, name))
 }
 
+func testAccResponsePlanConfig_actionParameterConflict(name string) string {
+	return acctest.ConfigCompose(
+		testAccResponsePlanConfig_base(),
+		testAccResponsePlanConfig_baseIAMRole(name),
+		testAccResponsePlanConfig_baseSSMDocument(name),
+		fmt.Sprintf(`
+resource "aws_ssmincidents_response_plan" "test" {
+  name = %[1]q
+
+  incident_template {
+    title  = %[1]q
+    impact = "1"
+  }
+
+  action {
+    ssm_automation {
+      document_name = aws_ssm_document.document1.name
+      role_arn      = aws_iam_role.role1.arn
+      parameter {
+        name   = "key"
+        values = ["value1"]
+      }
+      dynamic_parameters = {
+        key = "INVOLVED_RESOURCES"
+      }
+    }
+  }
+
+  depends_on = [aws_ssmincidents_replication_set.test_replication_set]
+}
+`, name))
+}
+
 func testAccResponsePlanConfig_action2(name string) string {
 	return acctest.ConfigCompose(
 		testAccResponsePlanConfig_base(),
@@ -26,16 +26,17 @@ func TestAccSSMIncidents_serial(t *testing.T) {
 			acctest.CtBasic: testAccReplicationSetDataSource_basic,
 		},
 		"Response Plan Resource Tests": {
-			acctest.CtBasic:          testAccResponsePlan_basic,
-			"update":                 testAccResponsePlan_updateRequiredFields,
-			"updateTags":             testAccResponsePlan_updateTags,
-			"updateEmptyTags":        testAccResponsePlan_updateEmptyTags,
-			acctest.CtDisappears:     testAccResponsePlan_disappears,
-			"incidentTemplateFields": testAccResponsePlan_incidentTemplateOptionalFields,
-			"displayName":            testAccResponsePlan_displayName,
-			"chatChannel":            testAccResponsePlan_chatChannel,
-			"engagement":             testAccResponsePlan_engagement,
-			"action":                 testAccResponsePlan_action,
+			acctest.CtBasic:           testAccResponsePlan_basic,
+			"update":                  testAccResponsePlan_updateRequiredFields,
+			"updateTags":              testAccResponsePlan_updateTags,
+			"updateEmptyTags":         testAccResponsePlan_updateEmptyTags,
+			acctest.CtDisappears:      testAccResponsePlan_disappears,
+			"incidentTemplateFields":  testAccResponsePlan_incidentTemplateOptionalFields,
+			"displayName":             testAccResponsePlan_displayName,
+			"chatChannel":             testAccResponsePlan_chatChannel,
+			"engagement":              testAccResponsePlan_engagement,
+			"action":                  testAccResponsePlan_action,
+			"actionParameterConflict": testAccResponsePlan_actionParameterConflict,
 		},
 		"Response Plan Data Source Tests": {
 			acctest.CtBasic: testAccResponsePlanDataSource_basic,
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -33,6 +34,7 @@ func ResourceAccountAssignment() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceAccountAssignmentCreate,
 		ReadWithoutTimeout:   resourceAccountAssignmentRead,
+		UpdateWithoutTimeout: resourceAccountAssignmentUpdate,
 		DeleteWithoutTimeout: resourceAccountAssignmentDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -57,6 +59,7 @@ func ResourceAccountAssignment() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"poll_interval": sdkv2.PollIntervalSchema(),
 			"principal_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -123,7 +126,7 @@ func resourceAccountAssignmentCreate(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "creating SSO Account Assignment for %s (%s): %s", principalType, principalID, err)
 	}
 
-	if _, err := waitAccountAssignmentCreated(ctx, conn, instanceARN, aws.ToString(output.AccountAssignmentCreationStatus.RequestId), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitAccountAssignmentCreated(ctx, conn, instanceARN, aws.ToString(output.AccountAssignmentCreationStatus.RequestId), sdkv2.Timeout(ctx, d, schema.TimeoutCreate), sdkv2.PollInterval(d, 0)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment for %s (%s) create: %s", principalType, principalID, err)
 	}
 
@@ -170,6 +173,11 @@ func resourceAccountAssignmentRead(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceAccountAssignmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only "poll_interval" can be updated in-place.
+	return resourceAccountAssignmentRead(ctx, d, meta)
+}
+
 func resourceAccountAssignmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).SSOAdminClient(ctx)
@@ -205,7 +213,7 @@ func resourceAccountAssignmentDelete(ctx context.Context, d *schema.ResourceData
 		return sdkdiag.AppendErrorf(diags, "deleting SSO Account Assignment for Principal (%s): %s", principalID, err)
 	}
 
-	if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, aws.ToString(output.AccountAssignmentDeletionStatus.RequestId), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitAccountAssignmentDeleted(ctx, conn, instanceARN, aws.ToString(output.AccountAssignmentDeletionStatus.RequestId), sdkv2.Timeout(ctx, d, schema.TimeoutDelete), sdkv2.PollInterval(d, 0)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for SSO Account Assignment for Principal (%s) delete: %s", principalID, err)
 	}
 
@@ -370,14 +378,15 @@ func statusAccountAssignmentDeletion(ctx context.Context, conn *ssoadmin.Client,
 	}
 }
 
-func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string, timeout time.Duration) (*awstypes.AccountAssignmentOperationStatus, error) {
+func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.Client, instanceARN, requestID string, timeout, pollInterval time.Duration) (*awstypes.AccountAssignmentOperationStatus, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.StatusValuesInProgress),
-		Target:     enum.Slice(awstypes.StatusValuesSucceeded),
-		Refresh:    statusAccountAssignmentCreation(ctx, conn, instanceARN, requestID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Pending:      enum.Slice(awstypes.StatusValuesInProgress),
+		Target:       enum.Slice(awstypes.StatusValuesSucceeded),
+		Refresh:      statusAccountAssignmentCreation(ctx, conn, instanceARN, requestID),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -391,14 +400,15 @@ func waitAccountAssignmentCreated(ctx context.Context, conn *ssoadmin.Client, in
 	return nil, err
 }
 
-func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.Client, instanceArn, requestID string, timeout time.Duration) (*awstypes.AccountAssignmentOperationStatus, error) {
+func waitAccountAssignmentDeleted(ctx context.Context, conn *ssoadmin.Client, instanceArn, requestID string, timeout, pollInterval time.Duration) (*awstypes.AccountAssignmentOperationStatus, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending:    enum.Slice(awstypes.StatusValuesInProgress),
-		Target:     enum.Slice(awstypes.StatusValuesSucceeded),
-		Refresh:    statusAccountAssignmentDeletion(ctx, conn, instanceArn, requestID),
-		Timeout:    timeout,
-		Delay:      10 * time.Second,
-		MinTimeout: 5 * time.Second,
+		Pending:      enum.Slice(awstypes.StatusValuesInProgress),
+		Target:       enum.Slice(awstypes.StatusValuesSucceeded),
+		Refresh:      statusAccountAssignmentDeletion(ctx, conn, instanceArn, requestID),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
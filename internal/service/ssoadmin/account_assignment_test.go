@@ -88,6 +88,46 @@ func TestAccSSOAdminAccountAssignment_Basic_user(t *testing.T) {
 	})
 }
 
+func TestAccSSOAdminAccountAssignment_pollInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ssoadmin_account_assignment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	userName := os.Getenv("AWS_IDENTITY_STORE_USER_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+			testAccPreCheckIdentityStoreUserName(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SSOAdminServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccountAssignmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccountAssignmentConfig_pollInterval(userName, rName, "2s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "2s"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"poll_interval"},
+			},
+			{
+				Config: testAccAccountAssignmentConfig_pollInterval(userName, rName, "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAccountAssignmentExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "30s"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSSOAdminAccountAssignment_MissingPolicy(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -293,3 +333,28 @@ resource "aws_ssoadmin_customer_managed_policy_attachment" "test" {
 }
 `, policyName, policyPath))
 }
+
+func testAccAccountAssignmentConfig_pollInterval(userName, rName, pollInterval string) string {
+	return acctest.ConfigCompose(testAccAccountAssignmentConfig_base(rName), fmt.Sprintf(`
+data "aws_identitystore_user" "test" {
+  identity_store_id = tolist(data.aws_ssoadmin_instances.test.identity_store_ids)[0]
+
+  alternate_identifier {
+    unique_attribute {
+      attribute_path  = "UserName"
+      attribute_value = %[1]q
+    }
+  }
+}
+
+resource "aws_ssoadmin_account_assignment" "test" {
+  instance_arn       = aws_ssoadmin_permission_set.test.instance_arn
+  permission_set_arn = aws_ssoadmin_permission_set.test.arn
+  target_type        = "AWS_ACCOUNT"
+  target_id          = data.aws_caller_identity.current.account_id
+  principal_type     = "USER"
+  principal_id       = data.aws_identitystore_user.test.user_id
+  poll_interval      = %[2]q
+}
+`, userName, pollInterval))
+}
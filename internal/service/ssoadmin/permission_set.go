@@ -13,6 +13,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ssoadmin"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ssoadmin/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/importer"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -39,7 +42,7 @@ func ResourcePermissionSet() *schema.Resource {
 		DeleteWithoutTimeout: resourcePermissionSetDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: importer.ImportByARNOrIDContext(permissionSetIDFromARN),
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -214,7 +217,7 @@ func resourcePermissionSetUpdate(ctx context.Context, d *schema.ResourceData, me
 		}
 
 		// Re-provision ALL accounts after making the above changes
-		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, sdkv2.Timeout(ctx, d, schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendFromErr(diags, err)
 		}
 	}
@@ -263,6 +266,24 @@ func ParseResourceID(id string) (string, string, error) {
 	return idParts[0], idParts[1], nil
 }
 
+// permissionSetIDFromARN returns the ID of a permission set given its ARN.
+// The ARN contains the ID of the SSO instance, e.g. arn:aws:sso:::permissionSet/ssoins-1234/ps-5678.
+func permissionSetIDFromARN(v arn.ARN) (string, error) {
+	parts, err := importer.ARNResourceParts(v, "permissionSet", 2)
+
+	if err != nil {
+		return "", err
+	}
+
+	instanceARN := arn.ARN{
+		Partition: v.Partition,
+		Service:   v.Service,
+		Resource:  "instance/" + parts[0],
+	}.String()
+
+	return fmt.Sprintf("%s,%s", v.String(), instanceARN), nil
+}
+
 func FindPermissionSet(ctx context.Context, conn *ssoadmin.Client, permissionSetARN, instanceARN string) (*awstypes.PermissionSet, error) {
 	input := &ssoadmin.DescribePermissionSetInput{
 		InstanceArn:      aws.String(instanceARN),
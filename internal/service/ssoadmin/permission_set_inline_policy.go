@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
@@ -41,17 +42,7 @@ func ResourcePermissionSetInlinePolicy() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
-			"inline_policy": {
-				Type:                  schema.TypeString,
-				Required:              true,
-				ValidateFunc:          verify.ValidIAMPolicyJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
-				DiffSuppressOnRefresh: true,
-				StateFunc: func(v interface{}) string {
-					json, _ := structure.NormalizeJsonString(v)
-					return json
-				},
-			},
+			"inline_policy": sdkv2.IAMPolicyDocumentSchemaRequired(),
 			"instance_arn": {
 				Type:         schema.TypeString,
 				Required:     true,
@@ -94,7 +85,7 @@ func resourcePermissionSetInlinePolicyPut(ctx context.Context, d *schema.Resourc
 	d.SetId(fmt.Sprintf("%s,%s", permissionSetARN, instanceARN))
 
 	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, sdkv2.Timeout(ctx, d, schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
@@ -159,7 +150,7 @@ func resourcePermissionSetInlinePolicyDelete(ctx context.Context, d *schema.Reso
 	}
 
 	// (Re)provision ALL accounts after making the above changes.
-	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := provisionPermissionSet(ctx, conn, permissionSetARN, instanceARN, sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
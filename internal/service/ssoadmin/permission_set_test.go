@@ -42,6 +42,12 @@ func TestAccSSOAdminPermissionSet_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -36,6 +36,13 @@ func RegisterSweepers() {
 			"aws_ssoadmin_account_assignment",
 		},
 	})
+	resource.AddTestSweepers("aws_ssoadmin_trusted_token_issuer", &resource.Sweeper{
+		Name: "aws_ssoadmin_trusted_token_issuer",
+		F:    sweepTrustedTokenIssuers,
+		Dependencies: []string{
+			"aws_ssoadmin_application",
+		},
+	})
 }
 
 func sweepAccountAssignments(region string) error {
@@ -253,3 +260,64 @@ func sweepPermissionSets(region string) error {
 
 	return sweeperErrs.ErrorOrNil()
 }
+
+func sweepTrustedTokenIssuers(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+	conn := client.SSOAdminClient(ctx)
+
+	sweepResources := make([]sweep.Sweepable, 0)
+	var sweeperErrs *multierror.Error
+
+	accessDenied := regexache.MustCompile(`AccessDeniedException: .+ is not authorized to perform:`)
+
+	// Need to Read the SSO Instance first; assumes the first instance returned
+	// is where the trusted token issuers exist as AWS SSO currently supports only 1 instance
+	ds := DataSourceInstances()
+	dsData := ds.Data(nil)
+
+	if err := sdk.ReadResource(ctx, ds, dsData, client); err != nil {
+		if accessDenied.MatchString(err.Error()) {
+			log.Printf("[WARN] Skipping SSO Trusted Token Issuer sweep for %s: %s", region, err)
+			return nil
+		}
+		return err
+	}
+
+	if v, ok := dsData.GetOk(names.AttrARNs); ok && len(v.([]interface{})) > 0 {
+		instanceArn := v.([]interface{})[0].(string)
+
+		input := &ssoadmin.ListTrustedTokenIssuersInput{
+			InstanceArn: aws.String(instanceArn),
+		}
+
+		paginator := ssoadmin.NewListTrustedTokenIssuersPaginator(conn, input)
+		for paginator.HasMorePages() {
+			page, err := paginator.NextPage(ctx)
+			if awsv2.SkipSweepError(err) {
+				log.Printf("[WARN] Skipping SSO Trusted Token Issuer sweep for %s: %s", region, err)
+				return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+			}
+			if err != nil {
+				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SSO Trusted Token Issuers: %w", err))
+				break
+			}
+
+			for _, trustedTokenIssuer := range page.TrustedTokenIssuers {
+				trustedTokenIssuerARN := aws.ToString(trustedTokenIssuer.TrustedTokenIssuerArn)
+				log.Printf("[INFO] Deleting SSO Trusted Token Issuer: %s", trustedTokenIssuerARN)
+
+				sweepResources = append(sweepResources, framework.NewSweepResource(newResourceTrustedTokenIssuer, client, framework.NewAttribute(names.AttrID, trustedTokenIssuerARN)))
+			}
+		}
+	}
+
+	if err := sweep.SweepOrchestrator(ctx, sweepResources); err != nil {
+		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping SSO Trusted Token Issuers: %w", err))
+	}
+
+	return sweeperErrs.ErrorOrNil()
+}
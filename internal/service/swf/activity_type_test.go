@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfswf "github.com/hashicorp/terraform-provider-aws/internal/service/swf"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSWFActivityType_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationDate),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrDomain, "aws_swf_domain.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1.0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFActivityType_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfswf.ResourceActivityType(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSWFActivityType_defaults(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_swf_activity_type.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckDomainTestingEnabled(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SWFServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckActivityTypeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccActivityTypeConfig_defaults(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckActivityTypeExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "default_task_heartbeat_timeout", "60"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_list", rName),
+					resource.TestCheckResourceAttr(resourceName, "default_task_priority", "5"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_close_timeout", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_schedule_to_start_timeout", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "default_task_start_to_close_timeout", "300"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckActivityTypeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_swf_activity_type" {
+				continue
+			}
+
+			_, err := tfswf.FindActivityTypeByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes[names.AttrName], rs.Primary.Attributes[names.AttrVersion])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("SWF Activity Type %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckActivityTypeExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SWFClient(ctx)
+
+		_, err := tfswf.FindActivityTypeByThreePartKey(ctx, conn, rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes[names.AttrName], rs.Primary.Attributes[names.AttrVersion])
+
+		return err
+	}
+}
+
+func testAccActivityTypeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_activity_type" "test" {
+  domain  = aws_swf_domain.test.name
+  name    = %[1]q
+  version = "1.0"
+}
+`, rName)
+}
+
+func testAccActivityTypeConfig_defaults(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_swf_domain" "test" {
+  name                                        = %[1]q
+  workflow_execution_retention_period_in_days = 1
+}
+
+resource "aws_swf_activity_type" "test" {
+  domain      = aws_swf_domain.test.name
+  name        = %[1]q
+  version     = "1.0"
+  description = "test"
+
+  default_task_heartbeat_timeout         = "60"
+  default_task_list                      = %[1]q
+  default_task_priority                  = "5"
+  default_task_schedule_to_close_timeout = "3600"
+  default_task_schedule_to_start_timeout = "NONE"
+  default_task_start_to_close_timeout    = "300"
+}
+`, rName)
+}
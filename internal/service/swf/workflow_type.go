@@ -0,0 +1,296 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package swf
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/swf"
+	"github.com/aws/aws-sdk-go-v2/service/swf/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	typeResourceIDPartCount = 3
+)
+
+var (
+	// Timeouts are specified as a number of seconds or "NONE" for an unlimited duration.
+	validTypeTimeout = validation.StringMatch(regexache.MustCompile(`^(NONE|[0-9]+)$`), `must be a number of seconds or "NONE"`)
+	// Task priorities are specified as a string representation of a signed 32-bit integer.
+	validTypeTaskPriority = validation.StringMatch(regexache.MustCompile(`^[+-]?[0-9]{1,10}$`), "must be an integer")
+)
+
+// @SDKResource("aws_swf_workflow_type", name="Workflow Type")
+func resourceWorkflowType() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkflowTypeCreate,
+		ReadWithoutTimeout:   resourceWorkflowTypeRead,
+		DeleteWithoutTimeout: resourceWorkflowTypeDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreationDate: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_child_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[types.ChildPolicy](),
+			},
+			"default_execution_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTypeTimeout,
+			},
+			"default_lambda_role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"default_task_list": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"default_task_priority": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTypeTaskPriority,
+			},
+			"default_task_start_to_close_timeout": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validTypeTimeout,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1024),
+			},
+			names.AttrDomain: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			names.AttrVersion: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+		},
+	}
+}
+
+func resourceWorkflowTypeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	domain, name, version := d.Get(names.AttrDomain).(string), d.Get(names.AttrName).(string), d.Get(names.AttrVersion).(string)
+	id := errs.Must(flex.FlattenResourceId([]string{domain, name, version}, typeResourceIDPartCount, false))
+	input := &swf.RegisterWorkflowTypeInput{
+		Domain:  aws.String(domain),
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	if v, ok := d.GetOk("default_child_policy"); ok {
+		input.DefaultChildPolicy = types.ChildPolicy(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_execution_start_to_close_timeout"); ok {
+		input.DefaultExecutionStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_lambda_role"); ok {
+		input.DefaultLambdaRole = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_list"); ok {
+		input.DefaultTaskList = &types.TaskList{
+			Name: aws.String(v.(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("default_task_priority"); ok {
+		input.DefaultTaskPriority = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_task_start_to_close_timeout"); ok {
+		input.DefaultTaskStartToCloseTimeout = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err := conn.RegisterWorkflowType(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating SWF Workflow Type (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceWorkflowTypeRead(ctx, d, meta)...)
+}
+
+func resourceWorkflowTypeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), typeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, name, version := parts[0], parts[1], parts[2]
+	output, err := findWorkflowTypeByThreePartKey(ctx, conn, domain, name, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SWF Workflow Type (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	configuration := output.Configuration
+	d.Set(names.AttrCreationDate, aws.ToTime(output.TypeInfo.CreationDate).Format(time.RFC3339))
+	d.Set("default_child_policy", configuration.DefaultChildPolicy)
+	d.Set("default_execution_start_to_close_timeout", configuration.DefaultExecutionStartToCloseTimeout)
+	d.Set("default_lambda_role", configuration.DefaultLambdaRole)
+	if v := configuration.DefaultTaskList; v != nil {
+		d.Set("default_task_list", v.Name)
+	} else {
+		d.Set("default_task_list", nil)
+	}
+	d.Set("default_task_priority", configuration.DefaultTaskPriority)
+	d.Set("default_task_start_to_close_timeout", configuration.DefaultTaskStartToCloseTimeout)
+	d.Set(names.AttrDescription, output.TypeInfo.Description)
+	d.Set(names.AttrDomain, domain)
+	d.Set(names.AttrName, output.TypeInfo.WorkflowType.Name)
+	d.Set(names.AttrVersion, output.TypeInfo.WorkflowType.Version)
+
+	return diags
+}
+
+func resourceWorkflowTypeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SWFClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), typeResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	domain, name, version := parts[0], parts[1], parts[2]
+	workflowType := &types.WorkflowType{
+		Name:    aws.String(name),
+		Version: aws.String(version),
+	}
+
+	// A workflow type must be deprecated before it can be deleted.
+	log.Printf("[DEBUG] Deprecating SWF Workflow Type: %s", d.Id())
+	_, err = conn.DeprecateWorkflowType(ctx, &swf.DeprecateWorkflowTypeInput{
+		Domain:       aws.String(domain),
+		WorkflowType: workflowType,
+	})
+
+	if errs.IsA[*types.UnknownResourceFault](err) {
+		return diags
+	}
+
+	if err != nil && !errs.IsA[*types.TypeDeprecatedFault](err) {
+		return sdkdiag.AppendErrorf(diags, "deprecating SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting SWF Workflow Type: %s", d.Id())
+	_, err = conn.DeleteWorkflowType(ctx, &swf.DeleteWorkflowTypeInput{
+		Domain:       aws.String(domain),
+		WorkflowType: workflowType,
+	})
+
+	if errs.IsA[*types.UnknownResourceFault](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting SWF Workflow Type (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findWorkflowTypeByThreePartKey(ctx context.Context, conn *swf.Client, domain, name, version string) (*swf.DescribeWorkflowTypeOutput, error) {
+	input := &swf.DescribeWorkflowTypeInput{
+		Domain: aws.String(domain),
+		WorkflowType: &types.WorkflowType{
+			Name:    aws.String(name),
+			Version: aws.String(version),
+		},
+	}
+
+	output, err := conn.DescribeWorkflowType(ctx, input)
+
+	if errs.IsA[*types.UnknownResourceFault](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Configuration == nil || output.TypeInfo == nil || output.TypeInfo.WorkflowType == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if status := output.TypeInfo.Status; status == types.RegistrationStatusDeprecated {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
@@ -26,6 +26,7 @@ import (
 
 // @SDKResource("aws_timestreamwrite_database", name="Database")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_timestreamwrite_databases", listOp="ListDatabases", listOpOutputElem="Databases", nameElem="DatabaseName", arnElem="Arn", taggingResourceType="timestream:database")
 func resourceDatabase() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDatabaseCreate,
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -91,6 +92,7 @@ func resourceService() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(3, 40),
 			},
+			"poll_interval": sdkv2.PollIntervalSchema(),
 			names.AttrStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -137,7 +139,7 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.ToString(out.Id))
 
-	if _, err := waitServiceCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitServiceCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate), sdkv2.PollInterval(d, 0)); err != nil {
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForCreation, ResNameService, d.Id(), err)
 	}
 
@@ -181,7 +183,7 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "poll_interval") {
 		in := &vpclattice.UpdateServiceInput{
 			ServiceIdentifier: aws.String(d.Id()),
 		}
@@ -221,19 +223,20 @@ func resourceServiceDelete(ctx context.Context, d *schema.ResourceData, meta int
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionDeleting, ResNameService, d.Id(), err)
 	}
 
-	if _, err := waitServiceDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitServiceDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete), sdkv2.PollInterval(d, 0)); err != nil {
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameService, d.Id(), err)
 	}
 
 	return diags
 }
 
-func waitServiceCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceOutput, error) {
+func waitServiceCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout, pollInterval time.Duration) (*vpclattice.GetServiceOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(types.ServiceStatusCreateInProgress),
 		Target:                    enum.Slice(types.ServiceStatusActive),
 		Refresh:                   statusService(ctx, conn, id),
 		Timeout:                   timeout,
+		PollInterval:              pollInterval,
 		NotFoundChecks:            20,
 		ContinuousTargetOccurence: 2,
 	}
@@ -246,12 +249,13 @@ func waitServiceCreated(ctx context.Context, conn *vpclattice.Client, id string,
 	return nil, err
 }
 
-func waitServiceDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceOutput, error) {
+func waitServiceDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout, pollInterval time.Duration) (*vpclattice.GetServiceOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ServiceStatusDeleteInProgress, types.ServiceStatusActive),
-		Target:  []string{},
-		Refresh: statusService(ctx, conn, id),
-		Timeout: timeout,
+		Pending:      enum.Slice(types.ServiceStatusDeleteInProgress, types.ServiceStatusActive),
+		Target:       []string{},
+		Refresh:      statusService(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -55,6 +56,7 @@ func resourceServiceNetworkVPCAssociation() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"poll_interval": sdkv2.PollIntervalSchema(),
 			names.AttrSecurityGroupIDs: {
 				Type:     schema.TypeList,
 				MaxItems: 5,
@@ -114,7 +116,7 @@ func resourceServiceNetworkVPCAssociationCreate(ctx context.Context, d *schema.R
 
 	d.SetId(aws.ToString(out.Id))
 
-	if _, err := waitServiceNetworkVPCAssociationCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitServiceNetworkVPCAssociationCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate), sdkv2.PollInterval(d, 0)); err != nil {
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForCreation, ResNameServiceNetworkVPCAssociation, d.Id(), err)
 	}
 
@@ -150,7 +152,7 @@ func resourceServiceNetworkVPCAssociationRead(ctx context.Context, d *schema.Res
 func resourceServiceNetworkVPCAssociationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "poll_interval") {
 		in := &vpclattice.UpdateServiceNetworkVpcAssociationInput{
 			ServiceNetworkVpcAssociationIdentifier: aws.String(d.Id()),
 		}
@@ -187,7 +189,7 @@ func resourceServiceNetworkVPCAssociationDelete(ctx context.Context, d *schema.R
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionDeleting, ResNameServiceNetworkVPCAssociation, d.Id(), err)
 	}
 
-	if _, err := waitServiceNetworkVPCAssociationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitServiceNetworkVPCAssociationDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete), sdkv2.PollInterval(d, 0)); err != nil {
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameServiceNetworkVPCAssociation, d.Id(), err)
 	}
 
@@ -218,12 +220,13 @@ func findServiceNetworkVPCAssociationByID(ctx context.Context, conn *vpclattice.
 	return out, nil
 }
 
-func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout, pollInterval time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(types.ServiceNetworkVpcAssociationStatusCreateInProgress),
 		Target:                    enum.Slice(types.ServiceNetworkVpcAssociationStatusActive),
 		Refresh:                   statusServiceNetworkVPCAssociation(ctx, conn, id),
 		Timeout:                   timeout,
+		PollInterval:              pollInterval,
 		NotFoundChecks:            20,
 		ContinuousTargetOccurence: 2,
 	}
@@ -236,12 +239,13 @@ func waitServiceNetworkVPCAssociationCreated(ctx context.Context, conn *vpclatti
 	return nil, err
 }
 
-func waitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+func waitServiceNetworkVPCAssociationDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout, pollInterval time.Duration) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ServiceNetworkVpcAssociationStatusDeleteInProgress, types.ServiceNetworkVpcAssociationStatusActive),
-		Target:  []string{},
-		Refresh: statusServiceNetworkVPCAssociation(ctx, conn, id),
-		Timeout: timeout,
+		Pending:      enum.Slice(types.ServiceNetworkVpcAssociationStatusDeleteInProgress, types.ServiceNetworkVpcAssociationStatusActive),
+		Target:       []string{},
+		Refresh:      statusServiceNetworkVPCAssociation(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -120,6 +120,47 @@ func TestAccVPCLatticeService_full(t *testing.T) {
 	})
 }
 
+func TestAccVPCLatticeService_pollInterval(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var service vpclattice.GetServiceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpclattice_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VPCLatticeEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VPCLatticeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_pollInterval(rName, "2s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "2s"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"poll_interval"},
+			},
+			{
+				Config: testAccServiceConfig_pollInterval(rName, "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "poll_interval", "30s"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccVPCLatticeService_tags(t *testing.T) {
 	ctx := acctest.Context(t)
 	var service1, service2, service3 vpclattice.GetServiceOutput
@@ -249,6 +290,15 @@ resource "aws_vpclattice_service" "test" {
 `, rName)
 }
 
+func testAccServiceConfig_pollInterval(rName, pollInterval string) string {
+	return fmt.Sprintf(`
+resource "aws_vpclattice_service" "test" {
+  name          = %[1]q
+  poll_interval = %[2]q
+}
+`, rName, pollInterval)
+}
+
 func testAccServiceConfig_full(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpclattice_service" "test" {
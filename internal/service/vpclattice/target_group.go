@@ -21,6 +21,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -190,6 +191,7 @@ func ResourceTargetGroup() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringLenBetween(3, 128),
 			},
+			"poll_interval": sdkv2.PollIntervalSchema(),
 			names.AttrStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -236,7 +238,7 @@ func resourceTargetGroupCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(out.Id))
 
-	if _, err := waitTargetGroupCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+	if _, err := waitTargetGroupCreated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutCreate), sdkv2.PollInterval(d, 0)); err != nil {
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForCreation, ResNameTargetGroup, d.Id(), err)
 	}
 
@@ -278,7 +280,7 @@ func resourceTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).VPCLatticeClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "poll_interval") {
 		in := &vpclattice.UpdateTargetGroupInput{
 			TargetGroupIdentifier: aws.String(d.Id()),
 		}
@@ -303,7 +305,7 @@ func resourceTargetGroupUpdate(ctx context.Context, d *schema.ResourceData, meta
 			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionUpdating, ResNameTargetGroup, d.Id(), err)
 		}
 
-		if _, err := waitTargetGroupUpdated(ctx, conn, aws.ToString(out.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitTargetGroupUpdated(ctx, conn, aws.ToString(out.Id), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate), sdkv2.PollInterval(d, 0)); err != nil {
 			return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForUpdate, ResNameTargetGroup, d.Id(), err)
 		}
 	}
@@ -329,19 +331,20 @@ func resourceTargetGroupDelete(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionDeleting, ResNameTargetGroup, d.Id(), err)
 	}
 
-	if _, err := waitTargetGroupDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if _, err := waitTargetGroupDeleted(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete), sdkv2.PollInterval(d, 0)); err != nil {
 		return create.AppendDiagError(diags, names.VPCLattice, create.ErrActionWaitingForDeletion, ResNameTargetGroup, d.Id(), err)
 	}
 
 	return diags
 }
 
-func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.CreateTargetGroupOutput, error) {
+func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id string, timeout, pollInterval time.Duration) (*vpclattice.CreateTargetGroupOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(types.TargetGroupStatusCreateInProgress),
 		Target:                    enum.Slice(types.TargetGroupStatusActive),
 		Refresh:                   statusTargetGroup(ctx, conn, id),
 		Timeout:                   timeout,
+		PollInterval:              pollInterval,
 		NotFoundChecks:            20,
 		ContinuousTargetOccurence: 2,
 	}
@@ -354,12 +357,13 @@ func waitTargetGroupCreated(ctx context.Context, conn *vpclattice.Client, id str
 	return nil, err
 }
 
-func waitTargetGroupUpdated(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.UpdateTargetGroupOutput, error) {
+func waitTargetGroupUpdated(ctx context.Context, conn *vpclattice.Client, id string, timeout, pollInterval time.Duration) (*vpclattice.UpdateTargetGroupOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:                   enum.Slice(types.TargetGroupStatusCreateInProgress),
 		Target:                    enum.Slice(types.TargetGroupStatusActive),
 		Refresh:                   statusTargetGroup(ctx, conn, id),
 		Timeout:                   timeout,
+		PollInterval:              pollInterval,
 		NotFoundChecks:            20,
 		ContinuousTargetOccurence: 2,
 	}
@@ -372,12 +376,13 @@ func waitTargetGroupUpdated(ctx context.Context, conn *vpclattice.Client, id str
 	return nil, err
 }
 
-func waitTargetGroupDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout time.Duration) (*vpclattice.DeleteTargetGroupOutput, error) {
+func waitTargetGroupDeleted(ctx context.Context, conn *vpclattice.Client, id string, timeout, pollInterval time.Duration) (*vpclattice.DeleteTargetGroupOutput, error) {
 	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.TargetGroupStatusDeleteInProgress, types.TargetGroupStatusActive),
-		Target:  []string{},
-		Refresh: statusTargetGroup(ctx, conn, id),
-		Timeout: timeout,
+		Pending:      enum.Slice(types.TargetGroupStatusDeleteInProgress, types.TargetGroupStatusActive),
+		Target:       []string{},
+		Refresh:      statusTargetGroup(ctx, conn, id),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceLensShare = newLensShareResource
+	ResourceWorkload  = newWorkloadResource
+
+	FindLensShareByTwoPartKey = findLensShareByTwoPartKey
+	FindWorkloadByID          = findWorkloadByID
+)
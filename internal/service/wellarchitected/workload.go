@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfsts "github.com/hashicorp/terraform-provider-aws/internal/service/sts"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	defaultMilestoneNamePrefix = "terraform-"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+func newWorkloadResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workloadResource{}
+
+	return r, nil
+}
+
+type workloadResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*workloadResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_wellarchitected_workload"
+}
+
+func (r *workloadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"architectural_design": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"create_milestone_on_apply": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"industry": schema.StringAttribute{
+				Optional: true,
+			},
+			"industry_type": schema.StringAttribute{
+				Optional: true,
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"milestone_name_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(defaultMilestoneNamePrefix),
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 74),
+				},
+			},
+			"non_aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrOwner: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pillar_priorities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"review_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *workloadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	input := &wellarchitected.CreateWorkloadInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Default the review owner to the caller's identity.
+	if data.ReviewOwner.IsUnknown() {
+		output, err := tfsts.FindCallerIdentity(ctx, r.Meta().STSClient(ctx))
+
+		if err != nil {
+			response.Diagnostics.AddError("reading STS Caller Identity", err.Error())
+
+			return
+		}
+
+		input.ReviewOwner = output.Arn
+	}
+
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkload(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s)", data.WorkloadName.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.WorkloadId)
+
+	// Set values for unknowns.
+	workload, err := findWorkloadByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.Owner = fwflex.StringToFramework(ctx, workload.Owner)
+	data.PillarPriorities = fwflex.FlattenFrameworkStringValueListOfString(ctx, workload.PillarPriorities)
+	data.ReviewOwner = fwflex.StringToFramework(ctx, workload.ReviewOwner)
+	data.WorkloadARN = fwflex.StringToFramework(ctx, output.WorkloadArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.CreateMilestoneOnApply.ValueBool() {
+		if err := createMilestone(ctx, conn, data.ID.ValueString(), data.MilestoneNamePrefix.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s) milestone", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *workloadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	output, err := findWorkloadByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Normalize return values.
+	if data.AccountIDs.IsNull() && len(output.AccountIds) == 0 {
+		output.AccountIds = nil
+	}
+	if data.AWSRegions.IsNull() && len(output.AwsRegions) == 0 {
+		output.AwsRegions = nil
+	}
+	if data.NonAWSRegions.IsNull() && len(output.NonAwsRegions) == 0 {
+		output.NonAwsRegions = nil
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	profileARNs := tfslices.ApplyToAll(output.Profiles, func(v awstypes.WorkloadProfile) string {
+		return aws.ToString(v.ProfileArn)
+	})
+	response.Diagnostics.Append(fwflex.Flatten(ctx, profileARNs, &data.ProfileARNs)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Local-only attributes are not set on import.
+	if data.CreateMilestoneOnApply.IsNull() {
+		data.CreateMilestoneOnApply = types.BoolValue(false)
+	}
+	if data.MilestoneNamePrefix.IsNull() {
+		data.MilestoneNamePrefix = types.StringValue(defaultMilestoneNamePrefix)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	if !new.AccountIDs.Equal(old.AccountIDs) ||
+		!new.ArchitecturalDesign.Equal(old.ArchitecturalDesign) ||
+		!new.AWSRegions.Equal(old.AWSRegions) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Environment.Equal(old.Environment) ||
+		!new.Industry.Equal(old.Industry) ||
+		!new.IndustryType.Equal(old.IndustryType) ||
+		!new.NonAWSRegions.Equal(old.NonAWSRegions) ||
+		!new.Notes.Equal(old.Notes) ||
+		!new.PillarPriorities.Equal(old.PillarPriorities) ||
+		!new.ReviewOwner.Equal(old.ReviewOwner) ||
+		!new.WorkloadName.Equal(old.WorkloadName) {
+		input := &wellarchitected.UpdateWorkloadInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.WorkloadId = fwflex.StringFromFramework(ctx, new.ID)
+
+		if !new.ReviewOwner.Equal(old.ReviewOwner) {
+			input.IsReviewOwnerUpdateAcknowledged = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateWorkload(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Workload (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Lenses.Equal(old.Lenses) {
+		oldLenses, newLenses := fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses)
+		add, remove := newLenses.Difference(oldLenses), oldLenses.Difference(newLenses)
+
+		if len(add) > 0 {
+			_, err := conn.AssociateLenses(ctx, &wellarchitected.AssociateLensesInput{
+				LensAliases: add,
+				WorkloadId:  fwflex.StringFromFramework(ctx, new.ID),
+			})
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) lenses", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		if len(remove) > 0 {
+			_, err := conn.DisassociateLenses(ctx, &wellarchitected.DisassociateLensesInput{
+				LensAliases: remove,
+				WorkloadId:  fwflex.StringFromFramework(ctx, new.ID),
+			})
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) lenses", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if !new.ProfileARNs.Equal(old.ProfileARNs) {
+		oldProfileARNs, newProfileARNs := fwflex.ExpandFrameworkStringValueSet(ctx, old.ProfileARNs), fwflex.ExpandFrameworkStringValueSet(ctx, new.ProfileARNs)
+		add, remove := newProfileARNs.Difference(oldProfileARNs), oldProfileARNs.Difference(newProfileARNs)
+
+		if len(add) > 0 {
+			_, err := conn.AssociateProfiles(ctx, &wellarchitected.AssociateProfilesInput{
+				ProfileArns: add,
+				WorkloadId:  fwflex.StringFromFramework(ctx, new.ID),
+			})
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) profiles", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+
+		if len(remove) > 0 {
+			_, err := conn.DisassociateProfiles(ctx, &wellarchitected.DisassociateProfilesInput{
+				ProfileArns: remove,
+				WorkloadId:  fwflex.StringFromFramework(ctx, new.ID),
+			})
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) profiles", new.ID.ValueString()), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Changes to tags or to the milestone settings alone do not record a milestone.
+	if new.CreateMilestoneOnApply.ValueBool() && new.hasReviewChanges(old) {
+		if err := createMilestone(ctx, conn, new.ID.ValueString(), new.MilestoneNamePrefix.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s) milestone", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+}
+
+func (r *workloadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	_, err := conn.DeleteWorkload(ctx, &wellarchitected.DeleteWorkloadInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		WorkloadId:         fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Workload (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *workloadResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := &wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+
+	output, err := conn.GetWorkload(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
+
+func createMilestone(ctx context.Context, conn *wellarchitected.Client, workloadID, namePrefix string) error {
+	input := &wellarchitected.CreateMilestoneInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		MilestoneName:      aws.String(id.PrefixedUniqueId(namePrefix)),
+		WorkloadId:         aws.String(workloadID),
+	}
+
+	_, err := conn.CreateMilestone(ctx, input)
+
+	return err
+}
+
+type workloadResourceModel struct {
+	AccountIDs             fwtypes.SetValueOf[types.String]                 `tfsdk:"account_ids"`
+	ArchitecturalDesign    types.String                                     `tfsdk:"architectural_design"`
+	AWSRegions             fwtypes.SetValueOf[types.String]                 `tfsdk:"aws_regions"`
+	CreateMilestoneOnApply types.Bool                                       `tfsdk:"create_milestone_on_apply"`
+	Description            types.String                                     `tfsdk:"description"`
+	Environment            fwtypes.StringEnum[awstypes.WorkloadEnvironment] `tfsdk:"environment"`
+	ID                     types.String                                     `tfsdk:"id"`
+	Industry               types.String                                     `tfsdk:"industry"`
+	IndustryType           types.String                                     `tfsdk:"industry_type"`
+	Lenses                 fwtypes.SetValueOf[types.String]                 `tfsdk:"lenses"`
+	MilestoneNamePrefix    types.String                                     `tfsdk:"milestone_name_prefix"`
+	NonAWSRegions          fwtypes.SetValueOf[types.String]                 `tfsdk:"non_aws_regions"`
+	Notes                  types.String                                     `tfsdk:"notes"`
+	Owner                  types.String                                     `tfsdk:"owner"`
+	PillarPriorities       fwtypes.ListValueOf[types.String]                `tfsdk:"pillar_priorities"`
+	ProfileARNs            fwtypes.SetValueOf[types.String]                 `tfsdk:"profile_arns"`
+	ReviewOwner            types.String                                     `tfsdk:"review_owner"`
+	Tags                   types.Map                                        `tfsdk:"tags"`
+	TagsAll                types.Map                                        `tfsdk:"tags_all"`
+	WorkloadARN            types.String                                     `tfsdk:"arn"`
+	WorkloadName           types.String                                     `tfsdk:"workload_name"`
+}
+
+func (m workloadResourceModel) hasReviewChanges(old workloadResourceModel) bool {
+	return !m.AccountIDs.Equal(old.AccountIDs) ||
+		!m.ArchitecturalDesign.Equal(old.ArchitecturalDesign) ||
+		!m.AWSRegions.Equal(old.AWSRegions) ||
+		!m.Description.Equal(old.Description) ||
+		!m.Environment.Equal(old.Environment) ||
+		!m.Industry.Equal(old.Industry) ||
+		!m.IndustryType.Equal(old.IndustryType) ||
+		!m.Lenses.Equal(old.Lenses) ||
+		!m.NonAWSRegions.Equal(old.NonAWSRegions) ||
+		!m.Notes.Equal(old.Notes) ||
+		!m.PillarPriorities.Equal(old.PillarPriorities) ||
+		!m.ProfileARNs.Equal(old.ProfileARNs) ||
+		!m.ReviewOwner.Equal(old.ReviewOwner) ||
+		!m.WorkloadName.Equal(old.WorkloadName)
+}
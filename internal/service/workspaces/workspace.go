@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -182,7 +183,7 @@ func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta i
 	workspaceID := aws.ToString(resp.PendingRequests[0].WorkspaceId)
 	d.SetId(workspaceID)
 
-	_, err = WaitWorkspaceAvailable(ctx, conn, workspaceID, d.Timeout(schema.TimeoutCreate))
+	_, err = WaitWorkspaceAvailable(ctx, conn, workspaceID, sdkv2.Timeout(ctx, d, schema.TimeoutCreate))
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating WorkSpaces Workspace: waiting for completion: %s", err)
 	}
@@ -266,7 +267,7 @@ func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WorkSpacesClient(ctx)
 
-	if err := WorkspaceDelete(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+	if err := WorkspaceDelete(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutDelete)); err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 	return diags
@@ -338,7 +339,7 @@ func workspacePropertyUpdate(ctx context.Context, p string, conn *workspaces.Cli
 		return fmt.Errorf("modifying property %q: %w", p, err)
 	}
 
-	_, err = WaitWorkspaceUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	_, err = WaitWorkspaceUpdated(ctx, conn, d.Id(), sdkv2.Timeout(ctx, d, schema.TimeoutUpdate))
 	if err != nil {
 		return fmt.Errorf("modifying property %q: waiting for completion: %w", p, err)
 	}
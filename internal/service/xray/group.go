@@ -24,6 +24,7 @@ import (
 
 // @SDKResource("aws_xray_group", name="Group")
 // @Tags(identifierAttribute="arn")
+// @PluralDataSource("aws_xray_groups", listOp="GetGroups", listOpOutputElem="Groups", nameElem="GroupName", arnElem="GroupARN", taggingResourceType="xray:group")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/xray/types;types.Group")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
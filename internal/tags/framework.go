@@ -4,8 +4,12 @@
 package tags
 
 import (
+	"context"
+
+	fwdiag "github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 )
 
 // Terraform Plugin Framework variants of tags schemas.
@@ -28,3 +32,40 @@ var (
 	Null    = types.MapNull(types.StringType)
 	Unknown = types.MapUnknown(types.StringType)
 )
+
+// IgnoreTagsModel is the Terraform Plugin Framework model of a resource's ignore_tags block.
+type IgnoreTagsModel struct {
+	KeyPrefixes  types.Set `tfsdk:"key_prefixes"`
+	KeyRegexes   types.Set `tfsdk:"key_regexes"`
+	Keys         types.Set `tfsdk:"keys"`
+	ValueRegexes types.Set `tfsdk:"value_regexes"`
+}
+
+// ResourceIgnoreConfigFramework returns the provider's IgnoreConfig supplemented by any resource-level ignore_tags.
+func ResourceIgnoreConfigFramework(ctx context.Context, ignoreConfig *IgnoreConfig, tfList types.List) (*IgnoreConfig, fwdiag.Diagnostics) {
+	var diags fwdiag.Diagnostics
+
+	if tfList.IsNull() || tfList.IsUnknown() || len(tfList.Elements()) == 0 {
+		return ignoreConfig, diags
+	}
+
+	var data []IgnoreTagsModel
+	diags.Append(tfList.ElementsAs(ctx, &data, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	resourceIgnoreConfig, err := NewIgnoreConfig(ctx,
+		flex.ExpandFrameworkStringValueSet(ctx, data[0].Keys),
+		flex.ExpandFrameworkStringValueSet(ctx, data[0].KeyPrefixes),
+		flex.ExpandFrameworkStringValueSet(ctx, data[0].KeyRegexes),
+		flex.ExpandFrameworkStringValueSet(ctx, data[0].ValueRegexes),
+	)
+
+	if err != nil {
+		diags.AddError("ignore_tags", err.Error())
+		return nil, diags
+	}
+
+	return ignoreConfig.Merge(resourceIgnoreConfig), diags
+}
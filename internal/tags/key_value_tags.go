@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"regexp"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,12 +35,38 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ExcludeResourceTypes lists resource type names (e.g. "aws_instance") to which default tags are not applied.
+	ExcludeResourceTypes []string
+	// ExcludeServices lists service package names (e.g. "ec2") to whose resources default tags are not applied.
+	ExcludeServices []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
 type IgnoreConfig struct {
 	Keys        KeyValueTags
 	KeyPrefixes KeyValueTags
+	// KeyRegexes holds compiled regular expressions matched against tag keys.
+	KeyRegexes []*regexp.Regexp
+	// ValueRegexes holds compiled regular expressions matched against tag values.
+	ValueRegexes []*regexp.Regexp
+}
+
+// Merge returns an IgnoreConfig combining this configuration with another.
+func (c *IgnoreConfig) Merge(other *IgnoreConfig) *IgnoreConfig {
+	if c == nil {
+		return other
+	}
+
+	if other == nil {
+		return c
+	}
+
+	return &IgnoreConfig{
+		Keys:         c.Keys.Merge(other.Keys),
+		KeyPrefixes:  c.KeyPrefixes.Merge(other.KeyPrefixes),
+		KeyRegexes:   slices.Concat(c.KeyRegexes, other.KeyRegexes),
+		ValueRegexes: slices.Concat(c.ValueRegexes, other.ValueRegexes),
+	}
 }
 
 // KeyValueTags is a standard implementation for AWS key-value resource tags.
@@ -56,6 +84,21 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResource returns the DefaultConfig applicable to the specified resource type
+// in the specified service package.
+// If either has been excluded, a DefaultConfig with no tags is returned.
+func (dc *DefaultConfig) ForResource(servicePackageName, typeName string) *DefaultConfig {
+	if dc == nil {
+		return nil
+	}
+
+	if slices.Contains(dc.ExcludeServices, servicePackageName) || slices.Contains(dc.ExcludeResourceTypes, typeName) {
+		return &DefaultConfig{}
+	}
+
+	return dc
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
@@ -107,6 +150,8 @@ func (tags KeyValueTags) IgnoreConfig(config *IgnoreConfig) KeyValueTags {
 
 	result := tags.IgnorePrefixes(config.KeyPrefixes)
 	result = result.Ignore(config.Keys)
+	result = result.IgnoreKeyRegexes(config.KeyRegexes)
+	result = result.IgnoreValueRegexes(config.ValueRegexes)
 
 	return result
 }
@@ -134,6 +179,42 @@ func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	return result
 }
 
+// IgnoreKeyRegexes returns tags whose keys do not match any of the regular expressions.
+func (tags KeyValueTags) IgnoreKeyRegexes(patterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if matchesAnyRegex(patterns, k) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+// IgnoreValueRegexes returns tags whose values do not match any of the regular expressions.
+func (tags KeyValueTags) IgnoreValueRegexes(patterns []*regexp.Regexp) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if matchesAnyRegex(patterns, v.ValueString()) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+func matchesAnyRegex(patterns []*regexp.Regexp, s string) bool {
+	return slices.ContainsFunc(patterns, func(pattern *regexp.Regexp) bool {
+		return pattern.MatchString(s)
+	})
+}
+
 // IgnorePrefixes returns non-matching tag key prefixes.
 func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
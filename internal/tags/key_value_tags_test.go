@@ -5,8 +5,10 @@ package tags
 
 import (
 	"context"
+	"regexp"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -57,6 +59,102 @@ func TestKeyValueTagsDefaultConfigGetTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name               string
+		defaultConfig      *DefaultConfig
+		servicePackageName string
+		typeName           string
+		want               KeyValueTags
+	}{
+		{
+			name:               "nil config",
+			defaultConfig:      nil,
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want:               nil,
+		},
+		{
+			name: "no exclusions",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "resource type excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_instance"},
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want:               nil,
+		},
+		{
+			name: "other resource type excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeResourceTypes: []string{"aws_vpc"},
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+		{
+			name: "service excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeServices: []string{"ec2"},
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want:               nil,
+		},
+		{
+			name: "other service excluded",
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"key1": "value1",
+				}),
+				ExcludeServices: []string{"s3"},
+			},
+			servicePackageName: "ec2",
+			typeName:           "aws_instance",
+			want: New(ctx, map[string]string{
+				"key1": "value1",
+			}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.servicePackageName, testCase.typeName).GetTags()
+			testKeyValueTagsVerifyMap(t, got.Map(), testCase.want.Map())
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	t.Parallel()
 
@@ -389,6 +487,65 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 		ignoreConfig *IgnoreConfig
 		want         map[string]string
 	}{
+		{
+			name: "key regexes matching",
+			tags: New(ctx, map[string]string{
+				"backup:plan":   "value1",
+				"backup:vault":  "value2",
+				"cost-center":   "value3",
+				"other:backup:": "value4",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^backup:`),
+				},
+			},
+			want: map[string]string{
+				"cost-center":   "value3",
+				"other:backup:": "value4",
+			},
+		},
+		{
+			name: "value regexes matching",
+			tags: New(ctx, map[string]string{
+				"key1": "auto-generated-1234",
+				"key2": "value2",
+				"key3": "auto-generated-5678",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				ValueRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^auto-generated-\d+$`),
+				},
+			},
+			want: map[string]string{
+				"key2": "value2",
+			},
+		},
+		{
+			name: "keys, key regexes and value regexes",
+			tags: New(ctx, map[string]string{
+				"key1":       "value1",
+				"key2":       "value2",
+				"ignore-me":  "value3",
+				"key4":       "skip",
+				"keepme-too": "value5",
+			}),
+			ignoreConfig: &IgnoreConfig{
+				Keys: New(ctx, []string{
+					"key1",
+				}),
+				KeyRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^ignore-`),
+				},
+				ValueRegexes: []*regexp.Regexp{
+					regexache.MustCompile(`^skip$`),
+				},
+			},
+			want: map[string]string{
+				"key2":       "value2",
+				"keepme-too": "value5",
+			},
+		},
 		{
 			name: "empty config",
 			tags: New(ctx, map[string]string{
@@ -582,6 +739,100 @@ func TestKeyValueTagsIgnoreConfig(t *testing.T) {
 	}
 }
 
+func TestIgnoreConfigMerge(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tags := New(ctx, map[string]string{
+		"key1":   "value1",
+		"key2":   "value2",
+		"prefix": "value3",
+		"regex":  "value4",
+		"other":  "ignored-value",
+	})
+	provider := &IgnoreConfig{
+		Keys:       New(ctx, []string{"key1"}),
+		KeyRegexes: []*regexp.Regexp{regexache.MustCompile(`^reg`)},
+	}
+	resource := &IgnoreConfig{
+		KeyPrefixes:  New(ctx, []string{"pre"}),
+		ValueRegexes: []*regexp.Regexp{regexache.MustCompile(`^ignored-`)},
+	}
+
+	got := tags.IgnoreConfig(provider.Merge(resource))
+	testKeyValueTagsVerifyMap(t, got.Map(), map[string]string{
+		"key2": "value2",
+	})
+
+	if got := (*IgnoreConfig)(nil).Merge(resource); got != resource {
+		t.Errorf("nil.Merge() = %v, want %v", got, resource)
+	}
+
+	if got := provider.Merge(nil); got != provider {
+		t.Errorf("Merge(nil) = %v, want %v", got, provider)
+	}
+
+	// Merging must not modify the receiver.
+	if len(provider.KeyPrefixes) != 0 || len(provider.ValueRegexes) != 0 {
+		t.Errorf("receiver modified: %v", provider)
+	}
+}
+
+func TestNewIgnoreConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name         string
+		keyRegexes   []string
+		valueRegexes []string
+		wantErr      bool
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:         "valid",
+			keyRegexes:   []string{`^backup:`},
+			valueRegexes: []string{`^auto-generated-\d+$`},
+		},
+		{
+			name:       "invalid key regex",
+			keyRegexes: []string{`^backup:(`},
+			wantErr:    true,
+		},
+		{
+			name:         "invalid value regex",
+			valueRegexes: []string{`[a-`},
+			wantErr:      true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NewIgnoreConfig(ctx, nil, nil, testCase.keyRegexes, testCase.valueRegexes)
+
+			if testCase.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got.KeyRegexes) != len(testCase.keyRegexes) || len(got.ValueRegexes) != len(testCase.valueRegexes) {
+				t.Errorf("got %d key and %d value regexes, want %d and %d", len(got.KeyRegexes), len(got.ValueRegexes), len(testCase.keyRegexes), len(testCase.valueRegexes))
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsIgnoreElasticbeanstalk(t *testing.T) {
 	t.Parallel()
 
@@ -4,7 +4,13 @@
 package tags
 
 import (
+	"context"
+	"fmt"
+	"regexp"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 )
 
 // TagsSchema returns the schema to use for tags.
@@ -33,3 +39,122 @@ func TagsSchemaForceNew() *schema.Schema {
 		Elem:     &schema.Schema{Type: schema.TypeString},
 	}
 }
+
+// IgnoreTagsSchema returns the schema to use for a resource's ignore_tags,
+// which supplements any provider configured ignore_tags.
+func IgnoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key_prefixes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"key_regexes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsValidRegExp,
+					},
+				},
+				"keys": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"value_regexes": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringIsValidRegExp,
+					},
+				},
+			},
+		},
+	}
+}
+
+// NewIgnoreConfig returns an IgnoreConfig, compiling any regular expressions.
+func NewIgnoreConfig(ctx context.Context, keys, keyPrefixes, keyRegexes, valueRegexes []string) (*IgnoreConfig, error) {
+	ignoreConfig := &IgnoreConfig{
+		Keys:        New(ctx, keys),
+		KeyPrefixes: New(ctx, keyPrefixes),
+	}
+
+	var err error
+
+	if ignoreConfig.KeyRegexes, err = compileRegexes(keyRegexes); err != nil {
+		return nil, fmt.Errorf("key_regexes: %w", err)
+	}
+
+	if ignoreConfig.ValueRegexes, err = compileRegexes(valueRegexes); err != nil {
+		return nil, fmt.Errorf("value_regexes: %w", err)
+	}
+
+	return ignoreConfig, nil
+}
+
+func compileRegexes(patterns []string) ([]*regexp.Regexp, error) {
+	var regexes []*regexp.Regexp
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+
+		if err != nil {
+			return nil, err
+		}
+
+		regexes = append(regexes, re)
+	}
+
+	return regexes, nil
+}
+
+// ExpandIgnoreConfig returns the IgnoreConfig represented by an ignore_tags configuration block.
+func ExpandIgnoreConfig(ctx context.Context, tfMap map[string]interface{}) (*IgnoreConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	var keys, keyPrefixes, keyRegexes, valueRegexes []string
+
+	if v, ok := tfMap["keys"].(*schema.Set); ok {
+		keys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["key_prefixes"].(*schema.Set); ok {
+		keyPrefixes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["key_regexes"].(*schema.Set); ok {
+		keyRegexes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["value_regexes"].(*schema.Set); ok {
+		valueRegexes = flex.ExpandStringValueSet(v)
+	}
+
+	return NewIgnoreConfig(ctx, keys, keyPrefixes, keyRegexes, valueRegexes)
+}
+
+// ResourceIgnoreConfig returns the provider's IgnoreConfig supplemented by any resource-level ignore_tags.
+func ResourceIgnoreConfig(ctx context.Context, ignoreConfig *IgnoreConfig, d interface{ Get(string) any }) (*IgnoreConfig, error) {
+	v, ok := d.Get("ignore_tags").([]interface{})
+	if !ok || len(v) == 0 || v[0] == nil {
+		return ignoreConfig, nil
+	}
+
+	resourceIgnoreConfig, err := ExpandIgnoreConfig(ctx, v[0].(map[string]interface{}))
+
+	if err != nil {
+		return nil, fmt.Errorf("ignore_tags: %w", err)
+	}
+
+	return ignoreConfig.Merge(resourceIgnoreConfig), nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	// PropagationTimeout is the maximum time to wait for a resource's tags to be consistently readable after a write.
+	PropagationTimeout = 2 * time.Minute
+)
+
+// WaitTagsPropagated waits for the tags returned by listTags to include all of want and none of the keys in removed.
+// Some services return stale tags for a short time after tags are written; resources opt in to this waiter
+// via the `verifyAfterWrite` argument of the `@Tags` annotation.
+func WaitTagsPropagated(ctx context.Context, timeout time.Duration, want, removed KeyValueTags, listTags func(context.Context) (KeyValueTags, error)) error {
+	checkFunc := func() (bool, error) {
+		got, err := listTags(ctx)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !got.ContainsAll(want) {
+			return false, nil
+		}
+
+		for k := range removed {
+			if got.KeyExists(k) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+
+	opts := tfresource.WaitOpts{
+		ContinuousTargetOccurence: 2,
+		MinTimeout:                1 * time.Second,
+	}
+
+	return tfresource.WaitUntil(ctx, timeout, checkFunc, opts)
+}
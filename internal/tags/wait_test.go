@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitTagsPropagated(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name      string
+		want      KeyValueTags
+		removed   KeyValueTags
+		responses []KeyValueTags
+		listErr   error
+		wantErr   bool
+	}{
+		{
+			name:      "immediately consistent",
+			want:      New(ctx, map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{New(ctx, map[string]string{"key1": "value1"})},
+		},
+		{
+			name: "stale value",
+			want: New(ctx, map[string]string{"key1": "value1updated"}),
+			responses: []KeyValueTags{
+				New(ctx, map[string]string{"key1": "value1"}),
+				New(ctx, map[string]string{"key1": "value1updated"}),
+			},
+		},
+		{
+			name:    "stale removed key",
+			want:    New(ctx, map[string]string{"key1": "value1"}),
+			removed: New(ctx, map[string]string{"key2": "value2"}),
+			responses: []KeyValueTags{
+				New(ctx, map[string]string{"key1": "value1", "key2": "value2"}),
+				New(ctx, map[string]string{"key1": "value1"}),
+			},
+		},
+		{
+			name: "additional tags",
+			want: New(ctx, map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{
+				New(ctx, map[string]string{"key1": "value1", "aws:cloudformation:stack-name": "stack"}),
+			},
+		},
+		{
+			name:    "list error",
+			want:    New(ctx, map[string]string{"key1": "value1"}),
+			listErr: errors.New("test error"),
+			wantErr: true,
+		},
+		{
+			name:      "timeout",
+			want:      New(ctx, map[string]string{"key1": "value1"}),
+			responses: []KeyValueTags{New(ctx, map[string]string{})},
+			wantErr:   true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+			listTags := func(context.Context) (KeyValueTags, error) {
+				if testCase.listErr != nil {
+					return nil, testCase.listErr
+				}
+
+				i := min(calls, len(testCase.responses)-1)
+				calls++
+
+				return testCase.responses[i], nil
+			}
+
+			err := WaitTagsPropagated(ctx, 5*time.Second, testCase.want, testCase.removed, listTags)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error = %t", err, want)
+			}
+		})
+	}
+}
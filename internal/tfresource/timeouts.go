@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource
+
+import (
+	"context"
+	"time"
+)
+
+type timeoutsScaleFactorKey struct{}
+
+// WithTimeoutsScaleFactor returns a new Context that carries the factor by which
+// default resource operation timeouts are multiplied.
+func WithTimeoutsScaleFactor(ctx context.Context, factor float64) context.Context {
+	return context.WithValue(ctx, timeoutsScaleFactorKey{}, factor)
+}
+
+// TimeoutsScaleFactor returns the factor by which default resource operation timeouts are multiplied.
+// If the Context carries no (or a non-positive) factor, 1 is returned.
+func TimeoutsScaleFactor(ctx context.Context) float64 {
+	if v, ok := ctx.Value(timeoutsScaleFactorKey{}).(float64); ok && v > 0 {
+		return v
+	}
+
+	return 1
+}
+
+// ScaleTimeout returns the timeout multiplied by the factor.
+// Non-positive factors leave the timeout unchanged.
+func ScaleTimeout(timeout time.Duration, factor float64) time.Duration {
+	if factor <= 0 || factor == 1 {
+		return timeout
+	}
+
+	return time.Duration(float64(timeout) * factor)
+}
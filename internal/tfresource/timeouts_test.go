@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfresource_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestTimeoutsScaleFactor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		ctx      context.Context
+		expected float64
+	}{
+		"not set": {
+			ctx:      context.Background(),
+			expected: 1,
+		},
+		"zero": {
+			ctx:      tfresource.WithTimeoutsScaleFactor(context.Background(), 0),
+			expected: 1,
+		},
+		"set": {
+			ctx:      tfresource.WithTimeoutsScaleFactor(context.Background(), 2.5),
+			expected: 2.5,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfresource.TimeoutsScaleFactor(testCase.ctx), testCase.expected; got != want {
+				t.Errorf("TimeoutsScaleFactor = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestScaleTimeout(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		timeout  time.Duration
+		factor   float64
+		expected time.Duration
+	}{
+		"unscaled": {
+			timeout:  10 * time.Minute,
+			factor:   1,
+			expected: 10 * time.Minute,
+		},
+		"negative": {
+			timeout:  10 * time.Minute,
+			factor:   -2,
+			expected: 10 * time.Minute,
+		},
+		"doubled": {
+			timeout:  10 * time.Minute,
+			factor:   2,
+			expected: 20 * time.Minute,
+		},
+		"fractional": {
+			timeout:  10 * time.Minute,
+			factor:   1.5,
+			expected: 15 * time.Minute,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfresource.ScaleTimeout(testCase.timeout, testCase.factor), testCase.expected; got != want {
+				t.Errorf("ScaleTimeout = %v, want %v", got, want)
+			}
+		})
+	}
+}
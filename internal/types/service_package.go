@@ -15,6 +15,8 @@ import (
 type ServicePackageResourceTags struct {
 	IdentifierAttribute string // The attribute for the identifier for UpdateTags etc.
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
+	VerifyAfterWrite    bool   // Whether to wait for tags to be consistently readable after create and update.
+	ResourceIgnoreTags  bool   // Whether the resource supports a resource-level ignore_tags argument.
 }
 
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
@@ -45,8 +47,9 @@ type ServicePackageSDKDataSource struct {
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
 // implemented by a service package.
 type ServicePackageSDKResource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory     func() *schema.Resource
+	TypeName    string
+	Name        string
+	Tags        *ServicePackageResourceTags
+	SkipDestroy bool // The resource supports a skip_destroy argument that retains the resource on delete.
 }
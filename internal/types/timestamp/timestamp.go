@@ -4,7 +4,10 @@
 package timestamp
 
 import (
+	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -53,6 +56,42 @@ func (t Timestamp) ValidateOnceAWeekWindowFormat() error {
 	return nil
 }
 
+// OnceAWeekWindowContains returns whether the specified time is within the "ddd:hh24:mi-ddd:hh24:mi" once a week window.
+// The window's times are in UTC and the window may wrap around the end of the week, e.g. "sat:22:00-sun:02:00".
+func (t Timestamp) OnceAWeekWindowContains(v time.Time) (bool, error) {
+	if err := t.ValidateOnceAWeekWindowFormat(); err != nil {
+		return false, err
+	}
+
+	if t.String() == "" {
+		return false, errors.New("once a week window is empty")
+	}
+
+	parts := strings.Split(strings.ToLower(t.String()), "-")
+	start, end := minuteOfWeek(parts[0]), minuteOfWeek(parts[1])
+
+	v = v.UTC()
+	now := int(v.Weekday())*minutesPerDay + v.Hour()*60 + v.Minute()
+
+	if start <= end {
+		return start <= now && now < end, nil
+	}
+
+	return now >= start || now < end, nil
+}
+
+const minutesPerDay = 24 * 60
+
+// minuteOfWeek returns the minute of the week, starting on Sunday, of a validated "ddd:hh24:mi" time.
+func minuteOfWeek(s string) int {
+	parts := strings.Split(s, ":")
+	day := slices.Index([]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}, parts[0])
+	hour, _ := strconv.Atoi(parts[1])
+	minute, _ := strconv.Atoi(parts[2])
+
+	return day*minutesPerDay + hour*60 + minute
+}
+
 // ValidateUTCFormat parses timestamp in RFC3339 format
 func (t Timestamp) ValidateUTCFormat() error {
 	_, err := time.Parse(time.RFC3339, t.String())
@@ -3,7 +3,10 @@
 
 package timestamp
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValidateOnceADayWindowFormat(t *testing.T) {
 	t.Parallel()
@@ -96,6 +99,80 @@ func TestValidateOnceAWeekWindowFormat(t *testing.T) {
 	}
 }
 
+func TestOnceAWeekWindowContains(t *testing.T) {
+	t.Parallel()
+	type tc struct {
+		value       string
+		time        time.Time
+		expected    bool
+		expectError bool
+	}
+	// 2024-07-03 is a Wednesday.
+	tests := map[string]tc{
+		"invalid": {
+			value:       "san:04:00-san:05:00",
+			expectError: true,
+		},
+		"empty": {
+			value:       "",
+			expectError: true,
+		},
+		"before": {
+			value: "wed:04:00-wed:05:00",
+			time:  time.Date(2024, time.July, 3, 3, 59, 0, 0, time.UTC),
+		},
+		"start": {
+			value:    "wed:04:00-wed:05:00",
+			time:     time.Date(2024, time.July, 3, 4, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		"end": {
+			value: "wed:04:00-wed:05:00",
+			time:  time.Date(2024, time.July, 3, 5, 0, 0, 0, time.UTC),
+		},
+		"multiple days": {
+			value:    "Tue:22:00-Thu:02:00",
+			time:     time.Date(2024, time.July, 3, 12, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		"wraps around week": {
+			value:    "sat:22:00-sun:02:00",
+			time:     time.Date(2024, time.July, 7, 1, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		"outside wraps around week": {
+			value: "sat:22:00-sun:02:00",
+			time:  time.Date(2024, time.July, 3, 1, 0, 0, 0, time.UTC),
+		},
+		"non-UTC time": {
+			value:    "wed:04:00-wed:05:00",
+			time:     time.Date(2024, time.July, 3, 0, 30, 0, 0, time.FixedZone("EDT", -4*60*60)),
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := New(test.value).OnceAWeekWindowContains(test.time)
+
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
+
 func TestValidateUTCFormat(t *testing.T) {
 	t.Parallel()
 	type tc struct {
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
 	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 )
 
 // SuppressEquivalentPolicyDiffs returns a difference suppression function that compares
@@ -25,28 +26,7 @@ func SuppressEquivalentPolicyDiffs(k, old, new string, d *schema.ResourceData) b
 }
 
 func PolicyStringsEquivalent(s1, s2 string) bool {
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	if strings.TrimSpace(s1) == "{}" && strings.TrimSpace(s2) == "{}" {
-		return true
-	}
-
-	equivalent, err := awspolicy.PoliciesAreEquivalent(s1, s2)
-	if err != nil {
-		return false
-	}
-
-	return equivalent
+	return sdkv2.IAMPolicyDocumentsEquivalent(s1, s2)
 }
 
 // SuppressEquivalentJSONDiffs returns a difference suppression function that compares
@@ -17,6 +17,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/arn"
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/go-cty/cty"
+	gversion "github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -454,6 +455,17 @@ func ValidDuration(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+// ValidVersionConstraints validates that a string is a valid set of version constraints, e.g. "~> 1.0".
+func ValidVersionConstraints(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := gversion.NewConstraint(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%s) is an invalid version constraint: %s", k, value, err))
+	}
+
+	return ws, errors
+}
+
 // FloatGreaterThan returns a SchemaValidateFunc which tests if the provided value
 // is of type float and is greater than threshold.
 func FloatGreaterThan(threshold float64) schema.SchemaValidateFunc {
@@ -550,6 +562,20 @@ func MapKeysAre(keyValidators ...schema.SchemaValidateDiagFunc) schema.SchemaVal
 	}
 }
 
+func MapValuesAre(valueValidators ...schema.SchemaValidateDiagFunc) schema.SchemaValidateDiagFunc {
+	return func(v interface{}, path cty.Path) diag.Diagnostics {
+		var diags diag.Diagnostics
+
+		for k, v := range v.(map[string]interface{}) {
+			for _, valueValidator := range valueValidators {
+				diags = append(diags, valueValidator(v, path.IndexString(k))...)
+			}
+		}
+
+		return diags
+	}
+}
+
 func MapSizeAtMost(max int) schema.SchemaValidateDiagFunc {
 	return func(v interface{}, path cty.Path) diag.Diagnostics {
 		var diags diag.Diagnostics
@@ -578,6 +578,44 @@ func TestValidOnceADayWindowFormat(t *testing.T) {
 	}
 }
 
+func TestValidVersionConstraints(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "~> 1.0",
+			ErrCount: 0,
+		},
+		{
+			Value:    ">= 1.0.0, < 2.0.0",
+			ErrCount: 0,
+		},
+		{
+			Value:    "1.2.3",
+			ErrCount: 0,
+		},
+		{
+			Value:    "~> one",
+			ErrCount: 1,
+		},
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidVersionConstraints(tc.Value, "version_constraint")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
 func TestValidLaunchTemplateName(t *testing.T) {
 	t.Parallel()
 
@@ -944,3 +982,45 @@ func TestMapKeysAre(t *testing.T) {
 		})
 	}
 }
+
+func TestMapValuesAre(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{
+			name: "ok",
+			value: map[string]interface{}{
+				"K1": "V1",
+				"K2": "V2",
+			},
+		},
+		{
+			name: "not ok",
+			value: map[string]interface{}{
+				"K1": "V1",
+				"K3": "V3",
+			},
+			wantErr: true,
+		},
+		{
+			name:  "empty",
+			value: map[string]interface{}{},
+		},
+	}
+	f := MapValuesAre(validation.ToDiagFunc(validation.StringInSlice([]string{"V1", "V2"}, false)))
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := f(testCase.value, cty.Path{})
+			if got, want := diags.HasError(), testCase.wantErr; got != want {
+				t.Errorf("got = %v, want = %v", got, want)
+			}
+		})
+	}
+}
@@ -126,6 +126,7 @@ const (
 	IoT                          = "iot"
 	IoTAnalytics                 = "iotanalytics"
 	IoTEvents                    = "iotevents"
+	IoTSiteWise                  = "iotsitewise"
 	KMS                          = "kms"
 	Kafka                        = "kafka"
 	KafkaConnect                 = "kafkaconnect"
@@ -370,6 +371,7 @@ const (
 	IoTServiceID                          = "IoT"
 	IoTAnalyticsServiceID                 = "IoTAnalytics"
 	IoTEventsServiceID                    = "IoT Events"
+	IoTSiteWiseServiceID                  = "IoTSiteWise"
 	KMSServiceID                          = "KMS"
 	KafkaServiceID                        = "Kafka"
 	KafkaConnectServiceID                 = "KafkaConnect"
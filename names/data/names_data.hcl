@@ -1352,7 +1352,7 @@ service "cloudcontrol" {
   }
 
   provider_package_correct = "cloudcontrol"
-  doc_prefix               = ["cloudcontrolapi_"]
+  doc_prefix               = ["cloudcontrolapi_", "resource_exists"]
   brand                    = "AWS"
 }
 
@@ -4872,6 +4872,10 @@ service "iotsitewise" {
     go_v1_client_typename = "IoTSiteWise"
   }
 
+  endpoint_info {
+    endpoint_api_call        = "ListAssetModels"
+  }
+
   resource_prefix {
     correct = "aws_iotsitewise_"
   }
@@ -4879,7 +4883,6 @@ service "iotsitewise" {
   provider_package_correct = "iotsitewise"
   doc_prefix               = ["iotsitewise_"]
   brand                    = "AWS"
-  not_implemented          = true
 }
 
 service "iotthingsgraph" {
@@ -6148,12 +6151,12 @@ service "meta" {
   }
 
   resource_prefix {
-    actual  = "aws_(arn|billing_service_account|default_tags|ip_ranges|partition|regions?|service)$"
+    actual  = "aws_(arn|billing_service_account|default_tags|ip_ranges|partition|regions?|service|service_principal)$"
     correct = "aws_meta_"
   }
 
   provider_package_correct = "meta"
-  doc_prefix               = ["arn", "ip_ranges", "billing_service_account", "default_tags", "partition", "region", "service\\."]
+  doc_prefix               = ["arn", "ip_ranges", "billing_service_account", "default_tags", "partition", "region", "service\\.", "service_principal"]
   brand                    = ""
   exclude                  = true
   allowed_subcategory      = true
@@ -8298,6 +8301,10 @@ service "secretsmanager" {
     human_friendly      = "Secrets Manager"
   }
 
+  client {
+    skip_client_generate  = true
+  }
+
   endpoint_info {
     endpoint_api_call        = "ListSecrets"
   }
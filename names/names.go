@@ -278,6 +278,53 @@ func PartitionForRegion(region string) string {
 	}
 }
 
+// servicePrincipalSuffixQuirks lists, per partition, the services whose IAM service principals
+// use the partition's DNS suffix rather than "amazonaws.com".
+var servicePrincipalSuffixQuirks = map[string][]string{
+	ChinaPartitionID: {
+		"codedeploy",
+		"elasticmapreduce",
+		"logs",
+	},
+}
+
+// ServicePrincipalSuffixForPartition returns the domain suffix of the specified service's IAM
+// service principal in the specified partition.
+func ServicePrincipalSuffixForPartition(service, partition string) string {
+	switch partition {
+	case "":
+		return ""
+	case ISOPartitionID, ISOBPartitionID, ISOEPartitionID, ISOFPartitionID:
+		return DNSSuffixForPartition(partition)
+	}
+
+	if slices.Contains(servicePrincipalSuffixQuirks[partition], service) {
+		return DNSSuffixForPartition(partition)
+	}
+
+	return DNSSuffixForPartition(StandardPartitionID)
+}
+
+// ServicePrincipalNameForPartition returns the IAM service principal for the specified service
+// in the specified partition, e.g. logs.amazonaws.com.
+func ServicePrincipalNameForPartition(service, partition string) string {
+	if service == "" || partition == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.%s", service, ServicePrincipalSuffixForPartition(service, partition))
+}
+
+// RegionalServicePrincipalName returns the Region-specific IAM service principal for the specified service
+// in the specified Region, e.g. logs.us-west-2.amazonaws.com.
+func RegionalServicePrincipalName(service, region string) string {
+	if service == "" || region == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s.%s.%s", service, region, ServicePrincipalSuffixForPartition(service, PartitionForRegion(region)))
+}
+
 // ReverseDNS switches a DNS hostname to reverse DNS and vice-versa.
 func ReverseDNS(hostname string) string {
 	parts := strings.Split(hostname, ".")
@@ -152,6 +152,118 @@ func TestPartitionForRegion(t *testing.T) {
 	}
 }
 
+func TestServicePrincipalNameForPartition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		service   string
+		partition string
+		expected  string
+	}{
+		{
+			name:      "empty service",
+			service:   "",
+			partition: StandardPartitionID,
+			expected:  "",
+		},
+		{
+			name:      "empty partition",
+			service:   "logs",
+			partition: "",
+			expected:  "",
+		},
+		{
+			name:      "standard",
+			service:   "logs",
+			partition: StandardPartitionID,
+			expected:  "logs.amazonaws.com",
+		},
+		{
+			name:      "China quirk",
+			service:   "logs",
+			partition: ChinaPartitionID,
+			expected:  "logs.amazonaws.com.cn",
+		},
+		{
+			name:      "China no quirk",
+			service:   "s3",
+			partition: ChinaPartitionID,
+			expected:  "s3.amazonaws.com",
+		},
+		{
+			name:      "GovCloud",
+			service:   "logs",
+			partition: USGovCloudPartitionID,
+			expected:  "logs.amazonaws.com",
+		},
+		{
+			name:      "ISO",
+			service:   "s3",
+			partition: ISOPartitionID,
+			expected:  "s3.c2s.ic.gov",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ServicePrincipalNameForPartition(testCase.service, testCase.partition), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRegionalServicePrincipalName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		service  string
+		region   string
+		expected string
+	}{
+		{
+			name:     "empty region",
+			service:  "logs",
+			region:   "",
+			expected: "",
+		},
+		{
+			name:     "standard",
+			service:  "logs",
+			region:   USWest2RegionID,
+			expected: "logs.us-west-2.amazonaws.com",
+		},
+		{
+			name:     "China",
+			service:  "logs",
+			region:   CNNorth1RegionID,
+			expected: "logs.cn-north-1.amazonaws.com.cn",
+		},
+		{
+			name:     "ISOB",
+			service:  "logs",
+			region:   USISOBEast1RegionID,
+			expected: "logs.us-isob-east-1.sc2s.sgov.gov",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := RegionalServicePrincipalName(testCase.service, testCase.region), testCase.expected; got != want {
+				t.Errorf("got: %s, expected: %s", got, want)
+			}
+		})
+	}
+}
+
 func TestReverseDNS(t *testing.T) {
 	t.Parallel()
 
@@ -312,7 +424,6 @@ func TestServicesForDirectories(t *testing.T) {
 		"iotjobsdata",
 		"iotjobsdataplane",
 		"iotsecuretunneling",
-		"iotsitewise",
 		"iotthingsgraph",
 		"iottwinmaker",
 		"iotwireless",